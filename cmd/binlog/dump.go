@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+type binlogDump struct {
+	Path string `json:"path"`
+	*storage.BinlogDump
+}
+
+// parseRows parses the row range a:b into [a, b), either side can be omitted, and a single row a means a:a+1,
+// the end is -1 if it's open
+func parseRows(rows string) (int, int, error) {
+	if rows == "" {
+		return 0, -1, nil
+	}
+	bounds := strings.SplitN(rows, ":", 2)
+	start, end := 0, -1
+	var err error
+	if bounds[0] != "" {
+		if start, err = strconv.Atoi(bounds[0]); err != nil || start < 0 {
+			return 0, 0, fmt.Errorf("invalid rows %s", rows)
+		}
+	}
+	if len(bounds) == 1 {
+		return start, start + 1, nil
+	}
+	if bounds[1] != "" {
+		if end, err = strconv.Atoi(bounds[1]); err != nil || end < start {
+			return 0, 0, fmt.Errorf("invalid rows %s", rows)
+		}
+	}
+	return start, end, nil
+}
+
+func runDump(args []string) error {
+	flags := flag.NewFlagSet("binlog dump", flag.ExitOnError)
+	format := flags.String("format", "json", "output format, json or csv")
+	rows := flags.String("rows", "", "rows to dump in each binlog, a:b dumps the rows from a to b exclusively")
+	fields := flags.String("field", "", "ids of the fields to dump in a directory, separated by comma")
+	minio := addMinioFlags(flags)
+	_ = flags.Parse(args)
+	if flags.NArg() == 0 {
+		return errors.New("no binlog is specified")
+	}
+	start, end, err := parseRows(*rows)
+	if err != nil {
+		return err
+	}
+	walker, err := newBinlogWalker(context.Background(), minio, *fields)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "json":
+		return dumpJSON(os.Stdout, walker, flags.Args(), start, end)
+	case "csv":
+		return dumpCSV(os.Stdout, walker, flags.Args(), start, end)
+	default:
+		return fmt.Errorf("unknown format %s", *format)
+	}
+}
+
+// dumpJSON writes a json array with an element per binlog
+func dumpJSON(w io.Writer, walker *binlogWalker, paths []string, start, end int) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	first := true
+	err := walker.walk(paths, func(binlogPath string, data []byte) error {
+		dump, err := storage.DumpBinlog(data, start, end)
+		if err != nil {
+			return fmt.Errorf("%s: %s", binlogPath, err.Error())
+		}
+		value, err := json.MarshalIndent(&binlogDump{Path: binlogPath, BinlogDump: dump}, "  ", "  ")
+		if err != nil {
+			return err
+		}
+		sep := ",\n  "
+		if first {
+			sep, first = "\n  ", false
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		_, err = w.Write(value)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n]\n")
+	return err
+}
+
+// dumpCSV writes a csv record per row, the descriptor and event headers are left out
+func dumpCSV(w io.Writer, walker *binlogWalker, paths []string, start, end int) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"path", "field_id", "event", "row", "value"}); err != nil {
+		return err
+	}
+	err := walker.walk(paths, func(binlogPath string, data []byte) error {
+		dump, err := storage.DumpBinlog(data, start, end)
+		if err != nil {
+			return fmt.Errorf("%s: %s", binlogPath, err.Error())
+		}
+		fieldID := strconv.FormatInt(dump.Descriptor.FieldID, 10)
+		for i, event := range dump.Events {
+			for j, value := range event.Values {
+				v, err := formatValue(value)
+				if err != nil {
+					return err
+				}
+				record := []string{binlogPath, fieldID, strconv.Itoa(i), strconv.Itoa(event.FirstRow + j), v}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case proto.Message:
+		return proto.CompactTextString(v), nil
+	case []float32:
+		data, err := json.Marshal(v)
+		return string(data), err
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestParseRows(t *testing.T) {
+	cases := []struct {
+		rows       string
+		start, end int
+	}{
+		{"", 0, -1},
+		{"3", 3, 4},
+		{"2:5", 2, 5},
+		{":5", 0, 5},
+		{"2:", 2, -1},
+	}
+	for _, c := range cases {
+		start, end, err := parseRows(c.rows)
+		assert.Nil(t, err)
+		assert.Equal(t, c.start, start, c.rows)
+		assert.Equal(t, c.end, end, c.rows)
+	}
+	for _, rows := range []string{"a", "-1:2", "5:2", "1:b"} {
+		_, _, err := parseRows(rows)
+		assert.NotNil(t, err, rows)
+	}
+}
+
+func writeTestBinlog(t *testing.T, dir string, fieldID int64, values []int64) {
+	w := storage.NewInsertBinlogWriter(schemapb.DataType_Int64, 1, 2, 3, fieldID)
+	defer w.Close()
+	e, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e.AddInt64ToPayload(values)
+	assert.Nil(t, err)
+	e.SetEventTimestamp(100, 200)
+	w.SetEventTimeStamp(100, 200)
+	err = w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+
+	fieldDir := path.Join(dir, "3", strconv.FormatInt(fieldID, 10))
+	err = os.MkdirAll(fieldDir, os.ModePerm)
+	assert.Nil(t, err)
+	err = ioutil.WriteFile(path.Join(fieldDir, "1"), buf, 0644)
+	assert.Nil(t, err)
+}
+
+func TestDump(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binlog")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	writeTestBinlog(t, dir, 100, []int64{1, 2, 3})
+	writeTestBinlog(t, dir, 101, []int64{4, 5, 6})
+
+	walker, err := newBinlogWalker(context.Background(), nil, "101")
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	err = dumpJSON(&buf, walker, []string{dir}, 1, -1)
+	assert.Nil(t, err)
+	var dumps []map[string]interface{}
+	err = json.Unmarshal(buf.Bytes(), &dumps)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(dumps))
+	assert.Equal(t, path.Join(dir, "3", "101", "1"), dumps[0]["path"])
+	events := dumps[0]["events"].([]interface{})
+	assert.Equal(t, []interface{}{float64(5), float64(6)}, events[0].(map[string]interface{})["values"])
+
+	walker, err = newBinlogWalker(context.Background(), nil, "")
+	assert.Nil(t, err)
+	buf.Reset()
+	err = dumpCSV(&buf, walker, []string{dir}, 0, 2)
+	assert.Nil(t, err)
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(records))
+	assert.Equal(t, []string{"path", "field_id", "event", "row", "value"}, records[0])
+	assert.Equal(t, []string{path.Join(dir, "3", "100", "1"), "100", "0", "1", "2"}, records[2])
+	assert.Equal(t, []string{path.Join(dir, "3", "101", "1"), "101", "0", "0", "4"}, records[3])
+
+	_, err = newBinlogWalker(context.Background(), nil, "a")
+	assert.NotNil(t, err)
+	err = walker.walk([]string{path.Join(dir, "none")}, func(string, []byte) error { return nil })
+	assert.NotNil(t, err)
+}
+
+func TestPrintSummaries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binlog")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	writeTestBinlog(t, dir, 100, []int64{1, 2, 3})
+
+	walker, err := newBinlogWalker(context.Background(), nil, "")
+	assert.Nil(t, err)
+	var summaries []*binlogSummary
+	err = walker.walk([]string{dir}, func(binlogPath string, data []byte) error {
+		summary, err := storage.SummarizeBinlog(data)
+		assert.Nil(t, err)
+		summaries = append(summaries, &binlogSummary{Path: binlogPath, BinlogSummary: summary})
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(summaries))
+	assert.Equal(t, 3, summaries[0].Rows)
+
+	var buf bytes.Buffer
+	err = printSummaries(&buf, summaries)
+	assert.Nil(t, err)
+	assert.Contains(t, buf.String(), path.Join(dir, "3", "100", "1"))
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// parseIDs parses the ids separated by comma
+func parseIDs(ids string) ([]int64, error) {
+	if ids == "" {
+		return nil, nil
+	}
+	var ret []int64
+	for _, id := range strings.Split(ids, ",") {
+		v, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %s", id)
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+// runExport asks DataCoord to write the flushed segments of a collection as parquet files, and polls the export
+// task until it completes or fails. The task of -task is polled instead of starting a new one.
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("binlog export", flag.ExitOnError)
+	endpoints := flags.String("endpoints", "localhost:2379", "etcd endpoints, separated by comma")
+	metaRoot := flags.String("root", "by-dev/meta", "meta root path, etcd.rootPath + '/' + etcd.metaSubPath")
+	collectionID := flags.Int64("collection", 0, "id of the collection to export")
+	partitions := flags.String("partition", "", "ids of the partitions to export, separated by comma, all partitions if empty")
+	ts := flags.Uint64("ts", 0, "only the rows inserted before the timestamp are exported, 0 means all rows")
+	output := flags.String("output", "", "a relative key prefix under the export root path of the object storage")
+	taskID := flags.Int64("task", 0, "id of a started export task to poll, no new task is started if it's set")
+	interval := flags.Duration("interval", 2*time.Second, "interval to poll the state of the export task")
+	_ = flags.Parse(args)
+	if *taskID == 0 {
+		if *collectionID == 0 {
+			return errors.New("the collection to export is not specified")
+		}
+		if *output == "" {
+			return errors.New("the output path is not specified")
+		}
+	}
+	partitionIDs, err := parseIDs(*partitions)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client, err := connectDataCoord(ctx, *endpoints, *metaRoot)
+	if err != nil {
+		return err
+	}
+	defer client.Stop()
+
+	if *taskID == 0 {
+		resp, err := client.Export(ctx, &datapb.ExportRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_Export,
+			},
+			CollectionID: *collectionID,
+			PartitionIDs: partitionIDs,
+			Timestamp:    *ts,
+			OutputPath:   *output,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetStatus().GetReason())
+		}
+		*taskID = resp.GetTaskID()
+		fmt.Printf("export task %d started.\n", *taskID)
+	}
+
+	for {
+		resp, err := client.GetExportState(ctx, &datapb.GetExportStateRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetExportState,
+			},
+			TaskID: *taskID,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetStatus().GetReason())
+		}
+		switch resp.GetState() {
+		case commonpb.ExportState_ExportCompleted:
+			for _, file := range resp.GetFiles() {
+				fmt.Println(file)
+			}
+			fmt.Printf("exported %d rows to %d files.\n", resp.GetNumRows(), len(resp.GetFiles()))
+			return nil
+		case commonpb.ExportState_ExportFailed:
+			return fmt.Errorf("export task %d failed after %d files, %s", *taskID, len(resp.GetFiles()), resp.GetFailReason())
+		}
+		time.Sleep(*interval)
+	}
+}
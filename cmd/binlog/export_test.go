@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIDs(t *testing.T) {
+	ids, err := parseIDs("")
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+
+	ids, err = parseIDs("1, 2,3")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+
+	_, err = parseIDs("1,a")
+	assert.NotNil(t, err)
+
+	err = runExport([]string{"-output", "/tmp/export"})
+	assert.NotNil(t, err)
+	err = runExport([]string{"-collection", "1"})
+	assert.NotNil(t, err)
+}
@@ -18,13 +18,37 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 )
 
+const usage = `usage: binlog file1 file2 ...
+       binlog dump [-format json|csv] [-rows a:b] [-field id,...] path1 path2 ...
+       binlog stats [-format text|json] [-field id,...] path1 path2 ...
+       binlog verify -collection id [flags]
+       binlog export -collection id -output path [flags]
+       binlog export -task id [flags]
+a path is a local file or directory, or minio://<bucket>/<key> of an object or a prefix
+`
+
 func main() {
 	if len(os.Args) == 1 {
-		fmt.Println("usage: binlog file1 file2 ...")
+		fmt.Print(usage)
+		return
+	}
+	var err error
+	switch os.Args[1] {
+	case "dump":
+		err = runDump(os.Args[2:])
+	case "stats":
+		err = runStats(os.Args[2:])
+	case "verify":
+		err = runVerify(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	default:
+		if err = storage.PrintBinlogFiles(os.Args[1:]); err == nil {
+			fmt.Printf("print binlog complete.\n")
+		}
 	}
-	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
-		fmt.Printf("error: %s\n", err.Error())
-	} else {
-		fmt.Printf("print binlog complete.\n")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+)
+
+// minioScheme prefixes the binlog paths in MinIO, minio://<bucket>/<key>
+const minioScheme = "minio://"
+
+type minioFlags struct {
+	address   *string
+	accessKey *string
+	secretKey *string
+	useSSL    *bool
+}
+
+func addMinioFlags(flags *flag.FlagSet) *minioFlags {
+	return &minioFlags{
+		address:   flags.String("minio", "localhost:9000", "minio address"),
+		accessKey: flags.String("access-key", "minioadmin", "minio access key id"),
+		secretKey: flags.String("secret-key", "minioadmin", "minio secret access key"),
+		useSSL:    flags.Bool("ssl", false, "access minio with ssl"),
+	}
+}
+
+func (f *minioFlags) newKV(ctx context.Context, bucket string) (*miniokv.MinIOKV, error) {
+	return f.newTieredKV(ctx, bucket, "", "")
+}
+
+// newTieredKV connects to the bucket whose cold tier is saved in coldBucket under coldRoot, the keys in meta are
+// read from either tier
+func (f *minioFlags) newTieredKV(ctx context.Context, bucket, coldBucket, coldRoot string) (*miniokv.MinIOKV, error) {
+	return miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           *f.address,
+		AccessKeyID:       *f.accessKey,
+		SecretAccessKeyID: *f.secretKey,
+		UseSSL:            *f.useSSL,
+		BucketName:        bucket,
+		ColdBucketName:    coldBucket,
+		ColdRootPath:      coldRoot,
+		CreateBucket:      false,
+	})
+}
+
+// binlogWalker loads the binlogs of local files or MinIO objects, a directory or a MinIO prefix is walked
+// recursively, and the binlogs in it are filtered by fields if any field is specified
+type binlogWalker struct {
+	ctx    context.Context
+	minio  *minioFlags
+	fields map[int64]struct{}
+	kvs    map[string]*miniokv.MinIOKV
+}
+
+func newBinlogWalker(ctx context.Context, minio *minioFlags, fields string) (*binlogWalker, error) {
+	walker := &binlogWalker{
+		ctx:    ctx,
+		minio:  minio,
+		fields: make(map[int64]struct{}),
+		kvs:    make(map[string]*miniokv.MinIOKV),
+	}
+	if fields == "" {
+		return walker, nil
+	}
+	for _, field := range strings.Split(fields, ",") {
+		fieldID, err := strconv.ParseInt(strings.TrimSpace(field), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field id %s", field)
+		}
+		walker.fields[fieldID] = struct{}{}
+	}
+	return walker, nil
+}
+
+// selected tells whether a binlog found in a directory belongs to the specified fields, binlogs are saved as
+// <segment>/<field>/<log index>, so the field is the name of the parent directory
+func (w *binlogWalker) selected(binlogPath string) bool {
+	if len(w.fields) == 0 {
+		return true
+	}
+	fieldID, err := strconv.ParseInt(path.Base(path.Dir(filepath.ToSlash(binlogPath))), 10, 64)
+	if err != nil {
+		return false
+	}
+	_, ok := w.fields[fieldID]
+	return ok
+}
+
+func (w *binlogWalker) walk(paths []string, fn func(binlogPath string, data []byte) error) error {
+	for _, p := range paths {
+		var err error
+		if strings.HasPrefix(p, minioScheme) {
+			err = w.walkMinio(p, fn)
+		} else {
+			err = w.walkLocal(p, fn)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *binlogWalker) walkLocal(p string, fn func(binlogPath string, data []byte) error) error {
+	info, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		return fn(p, data)
+	}
+	return filepath.Walk(p, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !w.selected(filePath) {
+			return nil
+		}
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		return fn(filePath, data)
+	})
+}
+
+func (w *binlogWalker) walkMinio(p string, fn func(binlogPath string, data []byte) error) error {
+	bucketKey := strings.SplitN(strings.TrimPrefix(p, minioScheme), "/", 2)
+	bucket, key := bucketKey[0], ""
+	if len(bucketKey) > 1 {
+		key = bucketKey[1]
+	}
+	kv, ok := w.kvs[bucket]
+	if !ok {
+		var err error
+		if kv, err = w.minio.newKV(w.ctx, bucket); err != nil {
+			return err
+		}
+		w.kvs[bucket] = kv
+	}
+
+	if key != "" && !strings.HasSuffix(key, "/") && kv.Exist(key) {
+		value, err := kv.Load(key)
+		if err != nil {
+			return err
+		}
+		return fn(p, []byte(value))
+	}
+	prefix := key
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	keys, err := kv.ListKeysWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("%s doesn't exist", p)
+	}
+	for _, k := range keys {
+		if !w.selected(k) {
+			continue
+		}
+		value, err := kv.Load(k)
+		if err != nil {
+			return err
+		}
+		if err := fn(minioScheme+bucket+"/"+k, []byte(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+type binlogSummary struct {
+	Path string `json:"path"`
+	*storage.BinlogSummary
+}
+
+func runStats(args []string) error {
+	flags := flag.NewFlagSet("binlog stats", flag.ExitOnError)
+	format := flags.String("format", "text", "output format, text or json")
+	fields := flags.String("field", "", "ids of the fields to summarise in a directory, separated by comma")
+	minio := addMinioFlags(flags)
+	_ = flags.Parse(args)
+	if flags.NArg() == 0 {
+		return errors.New("no binlog is specified")
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format %s", *format)
+	}
+	walker, err := newBinlogWalker(context.Background(), minio, *fields)
+	if err != nil {
+		return err
+	}
+
+	var summaries []*binlogSummary
+	err = walker.walk(flags.Args(), func(binlogPath string, data []byte) error {
+		summary, err := storage.SummarizeBinlog(data)
+		if err != nil {
+			return fmt.Errorf("%s: %s", binlogPath, err.Error())
+		}
+		summaries = append(summaries, &binlogSummary{Path: binlogPath, BinlogSummary: summary})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if *format == "json" {
+		data, err := json.MarshalIndent(summaries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	return printSummaries(os.Stdout, summaries)
+}
+
+func formatTs(ts uint64) string {
+	physical, _ := tsoutil.ParseTS(ts)
+	return physical.Format(time.RFC3339)
+}
+
+// printSummaries prints a line per binlog and the totals per field
+func printSummaries(out io.Writer, summaries []*binlogSummary) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "PATH\tFIELD\tTYPE\tEVENTS\tROWS\tMIN TIMESTAMP\tMAX TIMESTAMP\tSIZE\tPAYLOAD SIZE\tCOMPRESSION")
+	type fieldTotal struct {
+		binlogs, rows, size int
+	}
+	totals := make(map[int64]*fieldTotal)
+	for _, s := range summaries {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%d\t%s\t%s\t%d\t%d\t%s\n", s.Path, s.FieldID, s.PayloadDataType, s.Events, s.Rows,
+			formatTs(s.MinTimestamp), formatTs(s.MaxTimestamp), s.Size, s.PayloadSize, s.Compression)
+		total, ok := totals[s.FieldID]
+		if !ok {
+			total = &fieldTotal{}
+			totals[s.FieldID] = total
+		}
+		total.binlogs++
+		total.rows += s.Rows
+		total.size += s.Size
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fieldIDs := make([]int64, 0, len(totals))
+	for fieldID := range totals {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Slice(fieldIDs, func(i, j int) bool { return fieldIDs[i] < fieldIDs[j] })
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "FIELD\tBINLOGS\tROWS\tSIZE")
+	for _, fieldID := range fieldIDs {
+		total := totals[fieldID]
+		fmt.Fprintf(w, "%d\t%d\t%d\t%d\n", fieldID, total.binlogs, total.rows, total.size)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// connectDataCoord finds DataCoord by the session in etcd and connects to it
+func connectDataCoord(ctx context.Context, endpoints, metaRoot string) (*dcc.Client, error) {
+	client, err := dcc.NewClient(ctx, metaRoot, strings.Split(endpoints, ","))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Init(); err != nil {
+		return nil, err
+	}
+	if err := client.Start(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// runVerify asks DataCoord for the insert binlog paths of all the flushed segments of a collection, then loads
+// every binlog from MinIO and reports the ones which are missing, truncated or fail the checksum verification
+func runVerify(args []string) error {
+	flags := flag.NewFlagSet("binlog verify", flag.ExitOnError)
+	endpoints := flags.String("endpoints", "localhost:2379", "etcd endpoints, separated by comma")
+	metaRoot := flags.String("root", "by-dev/meta", "meta root path, etcd.rootPath + '/' + etcd.metaSubPath")
+	collectionID := flags.Int64("collection", 0, "id of the collection to verify")
+	partitionID := flags.Int64("partition", -1, "id of the partition to verify, -1 means all partitions")
+	bucket := flags.String("bucket", "a-bucket", "minio bucket name")
+	coldBucket := flags.String("cold-bucket", "", "minio bucket of the cold tier, storage.coldTier.bucketName, the same as -bucket if empty")
+	coldRoot := flags.String("cold-root", "cold", "key prefix of the cold tier, storage.coldTier.rootPath")
+	minio := addMinioFlags(flags)
+	_ = flags.Parse(args)
+	if *collectionID == 0 {
+		return errors.New("the collection to verify is not specified")
+	}
+
+	ctx := context.Background()
+	client, err := connectDataCoord(ctx, *endpoints, *metaRoot)
+	if err != nil {
+		return err
+	}
+	defer client.Stop()
+
+	kv, err := minio.newTieredKV(ctx, *bucket, *coldBucket, *coldRoot)
+	if err != nil {
+		return err
+	}
+
+	segments, err := client.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		CollectionID: *collectionID,
+		PartitionID:  *partitionID,
+	})
+	if err != nil {
+		return err
+	}
+	if segments.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(segments.GetStatus().GetReason())
+	}
+
+	binlogs, corrupted := 0, 0
+	for _, segmentID := range segments.GetSegments() {
+		resp, err := client.GetInsertBinlogPaths(ctx, &datapb.GetInsertBinlogPathsRequest{
+			SegmentID: segmentID,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("get binlog paths of segment %d failed, %s", segmentID, resp.GetStatus().GetReason())
+		}
+		for i, paths := range resp.GetPaths() {
+			for _, path := range paths.GetValues() {
+				binlogs++
+				if err := verifyBinlog(kv, path); err != nil {
+					corrupted++
+					fmt.Printf("corrupted binlog, segment: %d, field: %d, path: %s, error: %s\n",
+						segmentID, resp.GetFieldIDs()[i], path, err.Error())
+				}
+			}
+		}
+	}
+
+	fmt.Printf("verified %d binlogs of %d segments, %d corrupted.\n", binlogs, len(segments.GetSegments()), corrupted)
+	if corrupted > 0 {
+		return fmt.Errorf("found %d corrupted binlogs", corrupted)
+	}
+	return nil
+}
+
+func verifyBinlog(kv *miniokv.MinIOKV, path string) error {
+	if file, fieldID, ok := storage.ParseSegmentFileColumnKey(path); ok {
+		// verify the column chunks of the field in the segment file
+		reader, err := storage.NewSegmentFileReader(storage.NewMinioChunkManager(kv), file)
+		if err != nil {
+			return err
+		}
+		blobs, err := reader.ReadColumns([]storage.FieldID{fieldID})
+		if err != nil {
+			return err
+		}
+		for _, blob := range blobs {
+			if err := storage.VerifyBinlog(blob.Value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	value, err := kv.Load(path)
+	if err != nil {
+		return err
+	}
+	return storage.VerifyBinlog([]byte(value))
+}
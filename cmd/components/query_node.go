@@ -13,9 +13,12 @@ package components
 
 import (
 	"context"
+	"errors"
 
 	grpcquerynode "github.com/milvus-io/milvus/internal/distributed/querynode"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
 type QueryNode struct {
@@ -45,6 +48,22 @@ func (q *QueryNode) Run() error {
 	return nil
 }
 
+// Drain hands off the segments and channels of the query node to other query nodes
+func (q *QueryNode) Drain() error {
+	status, err := q.svr.Drain(q.ctx, &querypb.DrainRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadBalanceSegments,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+	return nil
+}
+
 // Stop terminates service
 func (q *QueryNode) Stop() error {
 	if err := q.svr.Stop(); err != nil {
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/milvus-io/milvus/internal/rootcoord"
+)
+
+// timestampKey is the key which rootcoord saves the timestamp of each meta snapshot to
+const timestampKey = rootcoord.TimestampPrefix
+
+// getRevisionByTs returns the etcd revision of the latest rootcoord meta snapshot which is not newer than ts,
+// the revision applies to the whole key space, so the metas of the other coordinators are read at it as well.
+func getRevisionByTs(ctx context.Context, cli *clientv3.Client, rootPath string, ts uint64) (int64, error) {
+	key := rootPath + "/" + timestampKey
+	resp, err := cli.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		if len(resp.Kvs) == 0 {
+			return 0, fmt.Errorf("no meta snapshot is older than timestamp %d", ts)
+		}
+		kv := resp.Kvs[0]
+		curTs, err := strconv.ParseUint(string(kv.Value), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if curTs <= ts {
+			return kv.ModRevision, nil
+		}
+		if kv.Version <= 1 {
+			return 0, fmt.Errorf("no meta snapshot is older than timestamp %d", ts)
+		}
+		// read the previous version of the timestamp key
+		resp, err = cli.Get(ctx, key, clientv3.WithRev(kv.ModRevision-1))
+		if err != nil {
+			return 0, fmt.Errorf("read meta snapshot before timestamp %d error = %w", curTs, err)
+		}
+	}
+}
+
+// dumpMeta reads all the coordinator metas at the snapshot of ts, 0 means the latest
+func dumpMeta(ctx context.Context, cli *clientv3.Client, rootPath string, ts uint64) (*metaDump, error) {
+	dump := &metaDump{
+		RootPath:  rootPath,
+		Timestamp: ts,
+		Groups:    make([]*metaGroup, 0, len(metaKinds)),
+	}
+	if ts != 0 {
+		rev, err := getRevisionByTs(ctx, cli, rootPath, ts)
+		if err != nil {
+			return nil, err
+		}
+		dump.Revision = rev
+	}
+
+	for i := range metaKinds {
+		kind := &metaKinds[i]
+		opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend)}
+		if dump.Revision != 0 {
+			opts = append(opts, clientv3.WithRev(dump.Revision))
+		}
+		resp, err := cli.Get(ctx, rootPath+"/"+kind.prefix, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("load %s/%s error = %w", kind.component, kind.name, err)
+		}
+		// read all the kinds at the same revision
+		if dump.Revision == 0 {
+			dump.Revision = resp.Header.Revision
+		}
+
+		group := &metaGroup{
+			Component: kind.component,
+			Name:      kind.name,
+			Entries:   make([]*metaEntry, 0, len(resp.Kvs)),
+		}
+		for _, kv := range resp.Kvs {
+			value, err := kind.textToJSON(string(kv.Value))
+			if err != nil {
+				return nil, fmt.Errorf("decode key %s error = %w", kv.Key, err)
+			}
+			group.Entries = append(group.Entries, &metaEntry{
+				Key:   strings.TrimPrefix(string(kv.Key), rootPath+"/"),
+				Value: value,
+			})
+		}
+		dump.Groups = append(dump.Groups, group)
+	}
+	return dump, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+)
+
+// maxTxnOps is the number of puts in one etcd transaction, which is limited to 128 by etcd by default
+const maxTxnOps = 64
+
+// cleanTimeout is the timeout of removing the partially loaded metas
+const cleanTimeout = 10 * time.Second
+
+// loadMeta saves the metas of a dump into etcd, the root path must be empty.
+// The metas are written in several transactions since a dump may exceed the limit of one transaction,
+// if any of them fails, the metas written by the former ones are removed so that the root path is left empty.
+// The coordinators must not be running against the root path during the load.
+func loadMeta(ctx context.Context, cli *clientv3.Client, rootPath string, dump *metaDump) error {
+	resp, err := cli.Get(ctx, rootPath+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return err
+	}
+	if resp.Count != 0 {
+		return fmt.Errorf("root path %s is not empty, %d keys exist", rootPath, resp.Count)
+	}
+
+	// encode all the values before writing anything
+	ops := make([]clientv3.Op, 0)
+	for _, group := range dump.Groups {
+		kind, err := getMetaKind(group.Component, group.Name)
+		if err != nil {
+			return err
+		}
+		for _, entry := range group.Entries {
+			value, err := kind.jsonToText(entry.Value)
+			if err != nil {
+				return fmt.Errorf("encode key %s error = %w", entry.Key, err)
+			}
+			ops = append(ops, clientv3.OpPut(rootPath+"/"+entry.Key, value))
+		}
+	}
+
+	for begin := 0; begin < len(ops); begin += maxTxnOps {
+		end := begin + maxTxnOps
+		if end > len(ops) {
+			end = len(ops)
+		}
+		if _, err := cli.Txn(ctx).If().Then(ops[begin:end]...).Commit(); err != nil {
+			if begin == 0 {
+				return err
+			}
+			// ctx may be already done, clean up with a new one
+			cleanCtx, cancel := context.WithTimeout(context.Background(), cleanTimeout)
+			defer cancel()
+			if _, err2 := cli.Delete(cleanCtx, rootPath+"/", clientv3.WithPrefix()); err2 != nil {
+				return fmt.Errorf("%w, remove the partially loaded metas under %s error = %v", err, rootPath, err2)
+			}
+			return err
+		}
+	}
+	return nil
+}
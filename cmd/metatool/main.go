@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+)
+
+const usage = "usage: metatool [dump|load] [flags]\n"
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(1)
+	}
+	command := os.Args[1]
+	flags := flag.NewFlagSet(os.Args[0]+" "+command, flag.ExitOnError)
+	endpoints := flags.String("endpoints", "localhost:2379", "etcd endpoints, separated by comma")
+	rootPath := flags.String("root", "by-dev/meta", "meta root path, etcd.rootPath + '/' + etcd.metaSubPath")
+	ts := flags.Uint64("ts", 0, "dump the meta snapshot at the timestamp, 0 means the latest")
+	output := flags.String("o", "", "file to write the dump to, default to stdout")
+	input := flags.String("i", "", "file to load the dump from")
+	_ = flags.Parse(os.Args[2:])
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(*endpoints, ","),
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		os.Exit(1)
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	switch command {
+	case "dump":
+		err = runDump(ctx, cli, *rootPath, *ts, *output)
+	case "load":
+		err = runLoad(ctx, cli, *rootPath, *input)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Printf("error: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func runDump(ctx context.Context, cli *clientv3.Client, rootPath string, ts uint64, output string) error {
+	dump, err := dumpMeta(ctx, cli, rootPath, ts)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	return ioutil.WriteFile(output, data, 0644)
+}
+
+func runLoad(ctx context.Context, cli *clientv3.Client, rootPath string, input string) error {
+	if input == "" {
+		return fmt.Errorf("the dump file to load is not specified")
+	}
+	data, err := ioutil.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	dump := &metaDump{}
+	if err := json.Unmarshal(data, dump); err != nil {
+		return err
+	}
+	if err := loadMeta(ctx, cli, rootPath, dump); err != nil {
+		return err
+	}
+	fmt.Printf("load meta complete.\n")
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/querycoord"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+)
+
+// metaKind is a kind of coordinator meta saved under the meta root path, the values are
+// protobuf messages in text format, or plain strings if newMsg is nil.
+type metaKind struct {
+	component string
+	name      string
+	prefix    string
+	newMsg    func() proto.Message
+}
+
+var metaKinds = []metaKind{
+	{"rootcoord", "tenant", rootcoord.TenantMetaPrefix + "/", func() proto.Message { return &etcdpb.TenantMeta{} }},
+	{"rootcoord", "proxy", rootcoord.ProxyMetaPrefix + "/", func() proto.Message { return &etcdpb.ProxyMeta{} }},
+	{"rootcoord", "database", rootcoord.DBInfoMetaPrefix + "/", func() proto.Message { return &etcdpb.DatabaseInfo{} }},
+	{"rootcoord", "collection", rootcoord.CollectionMetaPrefix + "/", func() proto.Message { return &etcdpb.CollectionInfo{} }},
+	{"rootcoord", "dropped-collection", rootcoord.DroppedCollectionMetaPrefix + "/", func() proto.Message { return &etcdpb.CollectionInfo{} }},
+	{"rootcoord", "field-index", rootcoord.IndexMetaPrefix + "/", func() proto.Message { return &etcdpb.IndexInfo{} }},
+	{"rootcoord", "segment-index", rootcoord.SegmentIndexMetaPrefix + "/", func() proto.Message { return &etcdpb.SegmentIndexInfo{} }},
+	{"rootcoord", "ddl-audit", rootcoord.DDLAuditPrefix + "/", func() proto.Message { return &milvuspb.DDLHistoryRecord{} }},
+	{"rootcoord", "timestamp", rootcoord.TimestampPrefix, nil},
+	{"rootcoord", "credential-user", rootcoord.CredentialUserPrefix + "/", func() proto.Message { return &etcdpb.CredentialInfo{} }},
+	{"rootcoord", "credential-role", rootcoord.CredentialRolePrefix + "/", nil},
+	{"rootcoord", "credential-user-role", rootcoord.CredentialUserRolePrefix + "/", func() proto.Message { return &rootcoordpb.UserRole{} }},
+	{"rootcoord", "credential-grant", rootcoord.CredentialGrantPrefix + "/", func() proto.Message { return &milvuspb.GrantEntity{} }},
+	{"datacoord", "segment", datacoord.SegmentPrefix + "/", func() proto.Message { return &datapb.SegmentInfo{} }},
+	{"datacoord", "export-task", datacoord.ExportTaskPrefix + "/", func() proto.Message { return &datapb.ExportTaskInfo{} }},
+	{"datacoord", "channel-assignment", datacoord.ClusterPrefix, func() proto.Message { return &datapb.DataNodeInfo{} }},
+	{"datacoord", "channel-buffer", datacoord.ClusterBuffer, func() proto.Message { return &datapb.DataNodeInfo{} }},
+	// the binlog paths are saved under etcd.segmentBinlogSubPath and etcd.segmentDeltalogSubPath, the defaults are taken
+	{"datacoord", "binlog", "datacoord/binlog/segment/", func() proto.Message { return &datapb.SegmentFieldBinlogMeta{} }},
+	{"datacoord", "deltalog", "datacoord/deltalog/segment/", func() proto.Message { return &datapb.SegmentDeltalogMeta{} }},
+	{"querycoord", "collection", querycoord.CollectionMetaPrefix + "/", func() proto.Message { return &querypb.CollectionInfo{} }},
+	{"querycoord", "segment", querycoord.SegmentMetaPrefix + "/", func() proto.Message { return &querypb.SegmentInfo{} }},
+	{"querycoord", "query-channel", querycoord.QueryChannelMetaPrefix + "/", func() proto.Message { return &querypb.QueryChannelInfo{} }},
+	{"indexcoord", "index", "indexes/", func() proto.Message { return &indexpb.IndexMeta{} }},
+}
+
+func getMetaKind(component, name string) (*metaKind, error) {
+	for i := range metaKinds {
+		if metaKinds[i].component == component && metaKinds[i].name == name {
+			return &metaKinds[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown meta kind %s/%s", component, name)
+}
+
+// metaEntry is a key value pair of meta, the key is relative to the meta root path
+type metaEntry struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type metaGroup struct {
+	Component string       `json:"component"`
+	Name      string       `json:"name"`
+	Entries   []*metaEntry `json:"entries"`
+}
+
+// metaDump is the json document written by dump and read by load
+type metaDump struct {
+	RootPath  string       `json:"root_path"`
+	Revision  int64        `json:"revision"`
+	Timestamp uint64       `json:"timestamp,omitempty"`
+	Groups    []*metaGroup `json:"groups"`
+}
+
+// textToJSON decodes a meta value saved in protobuf text format into json
+func (k *metaKind) textToJSON(value string) (json.RawMessage, error) {
+	if k.newMsg == nil {
+		return json.Marshal(value)
+	}
+	msg := k.newMsg()
+	if err := proto.UnmarshalText(value, msg); err != nil {
+		return nil, fmt.Errorf("unmarshal %s/%s error = %w", k.component, k.name, err)
+	}
+	m := jsonpb.Marshaler{OrigName: true}
+	var buf bytes.Buffer
+	if err := m.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonToText encodes a meta value in json back into protobuf text format
+func (k *metaKind) jsonToText(value json.RawMessage) (string, error) {
+	if k.newMsg == nil {
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			return "", fmt.Errorf("unmarshal %s/%s error = %w", k.component, k.name, err)
+		}
+		return text, nil
+	}
+	msg := k.newMsg()
+	if err := jsonpb.Unmarshal(bytes.NewReader(value), msg); err != nil {
+		return "", fmt.Errorf("unmarshal %s/%s error = %w", k.component, k.name, err)
+	}
+	return proto.MarshalTextString(msg), nil
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestMetaKinds(t *testing.T) {
+	for i, k1 := range metaKinds {
+		kind, err := getMetaKind(k1.component, k1.name)
+		assert.Nil(t, err)
+		assert.Equal(t, k1.prefix, kind.prefix)
+		for j, k2 := range metaKinds {
+			if i != j {
+				assert.False(t, strings.HasPrefix(k1.prefix, k2.prefix), "%s overlaps %s", k1.prefix, k2.prefix)
+			}
+		}
+	}
+	_, err := getMetaKind("rootcoord", "unknown")
+	assert.NotNil(t, err)
+}
+
+func TestMetaTextJSON(t *testing.T) {
+	coll := &etcdpb.CollectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Name: "coll",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			},
+		},
+		PartitionIDs:   []int64{2},
+		PartitionNames: []string{"_default"},
+	}
+	kind, err := getMetaKind("rootcoord", "collection")
+	assert.Nil(t, err)
+
+	value, err := kind.textToJSON(proto.MarshalTextString(coll))
+	assert.Nil(t, err)
+	m := make(map[string]interface{})
+	err = json.Unmarshal(value, &m)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"_default"}, m["partitionNames"])
+
+	text, err := kind.jsonToText(value)
+	assert.Nil(t, err)
+	coll2 := &etcdpb.CollectionInfo{}
+	err = proto.UnmarshalText(text, coll2)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(coll, coll2))
+
+	_, err = kind.textToJSON("invalid text")
+	assert.NotNil(t, err)
+	_, err = kind.jsonToText(json.RawMessage("{\"unknown\": 1}"))
+	assert.NotNil(t, err)
+
+	// values which aren't protobuf messages are kept as strings
+	kind, err = getMetaKind("rootcoord", "timestamp")
+	assert.Nil(t, err)
+	value, err = kind.textToJSON("431260628520108033")
+	assert.Nil(t, err)
+	assert.Equal(t, "\"431260628520108033\"", string(value))
+	text, err = kind.jsonToText(value)
+	assert.Nil(t, err)
+	assert.Equal(t, "431260628520108033", text)
+	_, err = kind.jsonToText(json.RawMessage("1"))
+	assert.NotNil(t, err)
+}
+
+func TestDumpLoadMeta(t *testing.T) {
+	var params paramtable.BaseTable
+	params.Init()
+	endpoints, err := params.Load("_EtcdEndpoints")
+	require.NoError(t, err)
+	cli, err := clientv3.New(clientv3.Config{Endpoints: strings.Split(endpoints, ",")})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	ctx := context.Background()
+	srcRoot := "/metatool/test/src"
+	dstRoot := "/metatool/test/dst"
+	clean := func() {
+		_, err := cli.Delete(ctx, srcRoot+"/", clientv3.WithPrefix())
+		assert.NoError(t, err)
+		_, err = cli.Delete(ctx, dstRoot+"/", clientv3.WithPrefix())
+		assert.NoError(t, err)
+	}
+	clean()
+	defer clean()
+
+	metas := map[string]proto.Message{
+		"root-coord/database/collection-info/1/1": &etcdpb.CollectionInfo{ID: 1, PartitionIDs: []int64{2}},
+		"datacoord-meta/s/1/2/3":                  &datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2, NumOfRows: 10},
+		"datacoord/binlog/segment/3/100/4":        &datapb.SegmentFieldBinlogMeta{FieldID: 100, BinlogPath: "file/insert_log/1/2/3/100/4"},
+		"datacoord/deltalog/segment/3/5":          &datapb.SegmentDeltalogMeta{DeltalogPath: "file/delta_log/1/2/3/5"},
+	}
+	for key, msg := range metas {
+		_, err = cli.Put(ctx, srcRoot+"/"+key, proto.MarshalTextString(msg))
+		require.NoError(t, err)
+	}
+
+	dump, err := dumpMeta(ctx, cli, srcRoot, 0)
+	require.NoError(t, err)
+	err = loadMeta(ctx, cli, dstRoot, dump)
+	require.NoError(t, err)
+	// the root path to load to must be empty
+	err = loadMeta(ctx, cli, dstRoot, dump)
+	assert.Error(t, err)
+
+	for key, msg := range metas {
+		resp, err := cli.Get(ctx, dstRoot+"/"+key)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Kvs), key)
+		loaded := proto.Clone(msg)
+		loaded.Reset()
+		err = proto.UnmarshalText(string(resp.Kvs[0].Value), loaded)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(msg, loaded), key)
+	}
+
+	// the metas of the former transactions are removed if a later one fails,
+	// etcd rejects a transaction which puts the same key twice
+	_, err = cli.Delete(ctx, dstRoot+"/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	value, err := json.Marshal(&datapb.SegmentDeltalogMeta{DeltalogPath: "file/delta_log/1/2/3/5"})
+	require.NoError(t, err)
+	group := &metaGroup{Component: "datacoord", Name: "deltalog"}
+	for i := 0; i < maxTxnOps; i++ {
+		group.Entries = append(group.Entries, &metaEntry{Key: fmt.Sprintf("datacoord/deltalog/segment/3/%d", i), Value: value})
+	}
+	dupEntry := &metaEntry{Key: "datacoord/deltalog/segment/3/dup", Value: value}
+	group.Entries = append(group.Entries, dupEntry, dupEntry)
+	err = loadMeta(ctx, cli, dstRoot, &metaDump{Groups: []*metaGroup{group}})
+	assert.Error(t, err)
+	resp, err := cli.Get(ctx, dstRoot+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.Count)
+}
@@ -421,6 +421,13 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 	sig := <-sc
 	fmt.Printf("Get %s signal to exit\n", sig.String())
 
+	// hand off the segments and channels of query node before exit
+	if sig == syscall.SIGTERM && qn != nil {
+		if err := qn.Drain(); err != nil {
+			fmt.Printf("Drain query node failed: %s\n", err.Error())
+		}
+	}
+
 	// some deferred Stop has race with context cancel
 	cancel()
 }
@@ -28,3 +28,10 @@ struct LoadFieldDataInfo {
     const void* blob = nullptr;
     int64_t row_count = -1;
 };
+
+// NOTE: the deletes of a sealed segment, primary_keys[i] is deleted at timestamps[i]
+struct LoadDeletedRecordInfo {
+    const uint64_t* timestamps = nullptr;
+    const int64_t* primary_keys = nullptr;
+    int64_t row_count = -1;
+};
@@ -26,6 +26,9 @@ class SegmentSealed : public SegmentInternalInterface {
     LoadSegmentMeta(const milvus::proto::segcore::LoadSegmentMeta& meta) = 0;
     virtual void
     LoadFieldData(const LoadFieldDataInfo& info) = 0;
+    // the deleted record replaces the loaded one, it's loaded after the primary keys and the timestamps
+    virtual void
+    LoadDeletedRecord(const LoadDeletedRecordInfo& info) = 0;
     virtual void
     DropIndex(const FieldId field_id) = 0;
     virtual void
@@ -158,6 +158,49 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     }
 }
 
+void
+SegmentSealedImpl::LoadDeletedRecord(const LoadDeletedRecordInfo& info) {
+    AssertInfo(info.row_count > 0, "deleted record is empty");
+    // the delete timestamps of every primary key, in ascending order
+    std::unordered_map<int64_t, std::vector<Timestamp>> delete_timestamps;
+    for (int64_t i = 0; i < info.row_count; ++i) {
+        delete_timestamps[info.primary_keys[i]].push_back(info.timestamps[i]);
+    }
+    for (auto& [pk, timestamps] : delete_timestamps) {
+        std::sort(timestamps.begin(), timestamps.end());
+    }
+
+    std::unique_lock lck(mutex_);
+    AssertInfo(is_system_field_ready(), "deleted record is loaded before the system fields");
+    // the deletes refer to the row ids if the segment has no primary key field
+    const int64_t* primary_keys = row_ids_.data();
+    auto primary_key_offset = schema_->get_primary_key_offset();
+    if (primary_key_offset.has_value()) {
+        AssertInfo(get_bit(field_data_ready_bitset_, primary_key_offset.value()),
+                   "deleted record is loaded before the primary key field");
+        AssertInfo(schema_->operator[](primary_key_offset.value()).get_data_type() == DataType::INT64,
+                   "deletes are only supported by int64 primary keys");
+        primary_keys = reinterpret_cast<const int64_t*>(field_datas_[primary_key_offset->get()].data());
+    }
+
+    // a row is deleted by the first delete of its primary key no earlier than it's inserted, the deletes before
+    // it's inserted are the deletes of the former rows of the same primary key
+    std::unordered_map<int64_t, Timestamp> deleted_rows;
+    auto row_count = row_count_opt_.value();
+    for (int64_t offset = 0; offset < row_count; ++offset) {
+        auto iter = delete_timestamps.find(primary_keys[offset]);
+        if (iter == delete_timestamps.end()) {
+            continue;
+        }
+        auto& timestamps = iter->second;
+        auto delete_timestamp = std::lower_bound(timestamps.begin(), timestamps.end(), timestamps_[offset]);
+        if (delete_timestamp != timestamps.end()) {
+            deleted_rows.emplace(offset, *delete_timestamp);
+        }
+    }
+    deleted_rows_ = std::move(deleted_rows);
+}
+
 int64_t
 SegmentSealedImpl::num_chunk_index(FieldOffset field_offset) const {
     return 1;
@@ -446,7 +489,25 @@ SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) cons
     AssertInfo(id_array.has_int_id(), "string ids are not implemented");
     auto arr = id_array.int_id();
     Assert(primary_key_index_);
-    return primary_key_index_->do_search_ids(id_array);
+    auto [ids, seg_offsets] = primary_key_index_->do_search_ids(id_array);
+
+    // the rows deleted no later than timestamp are not found
+    std::shared_lock lck(mutex_);
+    if (deleted_rows_.empty()) {
+        return {std::move(ids), std::move(seg_offsets)};
+    }
+    auto res_ids = std::make_unique<IdArray>();
+    auto res_ids_arr = res_ids->mutable_int_id();
+    std::vector<SegOffset> res_offsets;
+    for (size_t i = 0; i < seg_offsets.size(); ++i) {
+        auto iter = deleted_rows_.find(seg_offsets[i].get());
+        if (iter != deleted_rows_.end() && iter->second <= timestamp) {
+            continue;
+        }
+        res_ids_arr->add_data(ids->int_id().data(i));
+        res_offsets.push_back(seg_offsets[i]);
+    }
+    return {std::move(res_ids), std::move(res_offsets)};
 }
 
 std::string
@@ -477,6 +538,7 @@ void
 SegmentSealedImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const {
     // TODO change the
     Assert(this->timestamps_.size() == get_row_count());
+    mask_with_deletes(bitset_chunk, timestamp);
     auto range = timestamp_index_.get_active_range(timestamp);
     if (range.first == range.second && range.first == this->timestamps_.size()) {
         // just skip
@@ -486,6 +548,24 @@ SegmentSealedImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, T
     bitset_chunk &= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_deletes(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const {
+    std::shared_lock lck(mutex_);
+    if (deleted_rows_.empty()) {
+        return;
+    }
+    if (bitset_chunk.empty()) {
+        // no predicate, all the rows are searched
+        bitset_chunk.resize(row_count_opt_.value(), true);
+    }
+    Assert(bitset_chunk.size() == row_count_opt_.value());
+    for (auto [offset, delete_timestamp] : deleted_rows_) {
+        if (delete_timestamp <= timestamp) {
+            bitset_chunk[offset] = false;
+        }
+    }
+}
+
 SegmentSealedPtr
 CreateSealedSegment(SchemaPtr schema) {
     return std::make_unique<SegmentSealedImpl>(schema);
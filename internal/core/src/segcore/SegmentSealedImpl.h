@@ -14,8 +14,10 @@
 #include "segcore/SegmentSealed.h"
 #include "SealedIndexingRecord.h"
 #include "ScalarIndex.h"
+#include <algorithm>
 #include <deque>
 #include <map>
+#include <unordered_map>
 #include <vector>
 #include <memory>
 #include <utility>
@@ -30,6 +32,8 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     LoadFieldData(const LoadFieldDataInfo& info) override;
     void
+    LoadDeletedRecord(const LoadDeletedRecordInfo& info) override;
+    void
     LoadSegmentMeta(const milvus::proto::segcore::LoadSegmentMeta& segment_meta) override;
     void
     DropIndex(const FieldId field_id) override;
@@ -109,6 +113,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_deletes(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -150,6 +157,8 @@ class SegmentSealedImpl : public SegmentSealed {
     aligned_vector<idx_t> row_ids_;
     aligned_vector<Timestamp> timestamps_;
     TimestampIndex timestamp_index_;
+    // offsets of the deleted rows to the timestamps they're deleted at
+    std::unordered_map<int64_t, Timestamp> deleted_rows_;
     SchemaPtr schema_;
 };
 }  // namespace milvus::segcore
@@ -340,3 +340,49 @@ TEST(Sealed, LoadFieldData) {
 ])");
     ASSERT_EQ(std_json.dump(-2), json.dump(-2));
 }
+
+TEST(Sealed, LoadDeletedRecord) {
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("counter", DataType::INT64);
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 1000;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    auto counter_col = dataset.get_col<int64_t>(0);
+
+    // the timestamp of the row of offset i is i
+    std::vector<int64_t> pks{counter_col[3], counter_col[10]};
+    std::vector<Timestamp> timestamps{5, 2};
+    LoadDeletedRecordInfo info;
+    info.primary_keys = pks.data();
+    info.timestamps = timestamps.data();
+    info.row_count = pks.size();
+    ASSERT_ANY_THROW(segment->LoadDeletedRecord(info));
+
+    SealedLoader(dataset, *segment);
+    segment->LoadDeletedRecord(info);
+
+    // row 3 is deleted at 5, the delete at 2 is earlier than row 10 is inserted
+    auto req_ids = std::make_unique<IdArray>();
+    req_ids->mutable_int_id()->add_data(counter_col[3]);
+    req_ids->mutable_int_id()->add_data(counter_col[10]);
+    std::vector<FieldOffset> target_offsets{FieldOffset(0)};
+    auto results = segment->GetEntityById(target_offsets, *req_ids, 4);
+    ASSERT_EQ(results->ids().int_id().data_size(), 2);
+    results = segment->GetEntityById(target_offsets, *req_ids, N);
+    ASSERT_EQ(results->ids().int_id().data_size(), 1);
+    ASSERT_EQ(results->ids().int_id().data(0), counter_col[10]);
+
+    boost::dynamic_bitset<> bitset;
+    segment->mask_with_timestamps(bitset, 4);
+    ASSERT_EQ(bitset.size(), (size_t)N);
+    ASSERT_TRUE(bitset[3]);
+    bitset.clear();
+    segment->mask_with_timestamps(bitset, N);
+    ASSERT_EQ(bitset.size(), (size_t)N);
+    ASSERT_FALSE(bitset[3]);
+    ASSERT_TRUE(bitset[10]);
+    ASSERT_EQ(bitset.count(), (size_t)(N - 1));
+}
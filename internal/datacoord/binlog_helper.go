@@ -17,6 +17,8 @@ import (
 //   ${prefix}/${collectionID}/${idx}
 // segment binlog etcd meta key:
 //   ${prefix}/${segmentID}/${fieldID}/${idx}
+// segment deltalog etcd meta key:
+//   ${prefix}/${segmentID}/${idx}
 
 // genKey gives a valid key string for lists of UniqueIDs:
 //  if alloc is true, the returned keys will have a generated-unique ID at the end.
@@ -77,6 +79,24 @@ func (s *Server) prepareField2PathMeta(segID UniqueID, field2Paths *datapb.ID2Pa
 	return result, err
 }
 
+// prepareDeltalogMeta parses the delta log paths of a segment into key-value for kv store
+func (s *Server) prepareDeltalogMeta(segID UniqueID, deltalogs []string) (result map[string]string, err error) {
+	result = make(map[string]string, len(deltalogs))
+	var key string
+	for _, p := range deltalogs {
+		key, err = s.genKey(true, segID)
+		if err != nil {
+			return nil, err
+		}
+		deltalogPath := proto.MarshalTextString(&datapb.SegmentDeltalogMeta{
+			DeltalogPath: p,
+		})
+
+		result[path.Join(Params.SegmentDeltalogSubPath, key)] = deltalogPath
+	}
+	return result, err
+}
+
 // getFieldBinlogMeta querys field binlog meta from kv store
 func (s *Server) getFieldBinlogMeta(segmentID UniqueID,
 	fieldID UniqueID) (metas []*datapb.SegmentFieldBinlogMeta, err error) {
@@ -127,6 +147,31 @@ func (s *Server) getSegmentBinlogMeta(segmentID UniqueID) (metas []*datapb.Segme
 	return
 }
 
+// getSegmentDeltalogs querys the delta log paths of a segment from kv store
+func (s *Server) getSegmentDeltalogs(segmentID UniqueID) (deltalogs []string, err error) {
+
+	prefix, err := s.genKey(false, segmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// prefix/id/ instead of prefix/id
+	_, vs, err := s.kvClient.LoadWithPrefix(path.Join(Params.SegmentDeltalogSubPath, prefix) + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, blob := range vs {
+		m := &datapb.SegmentDeltalogMeta{}
+		if err = proto.UnmarshalText(blob, m); err != nil {
+			return nil, err
+		}
+
+		deltalogs = append(deltalogs, m.DeltalogPath)
+	}
+	return
+}
+
 // GetVChanPositions get vchannel latest postitions with provided dml channel names
 func (s *Server) GetVChanPositions(vchans []vchannel, isAccurate bool) ([]*datapb.VchannelInfo, error) {
 	if s.kvClient == nil {
@@ -28,8 +28,10 @@ import (
 	"golang.org/x/net/context"
 )
 
-const clusterPrefix = "cluster-prefix/"
-const clusterBuffer = "cluster-buffer"
+// ClusterPrefix is the prefix of the channel assignments of data nodes, and ClusterBuffer is the key
+// of the channels not assigned yet
+const ClusterPrefix = "cluster-prefix/"
+const ClusterBuffer = "cluster-buffer"
 const nodeEventChBufferSize = 1024
 
 const eventTimeout = 5 * time.Second
@@ -128,7 +130,7 @@ func NewCluster(ctx context.Context, kv kv.TxnKV, store ClusterStore,
 }
 
 func (c *Cluster) loadFromKv() error {
-	_, values, err := c.kv.LoadWithPrefix(clusterPrefix)
+	_, values, err := c.kv.LoadWithPrefix(ClusterPrefix)
 	if err != nil {
 		return err
 	}
@@ -143,7 +145,7 @@ func (c *Cluster) loadFromKv() error {
 		c.nodes.SetNode(info.GetVersion(), node)
 		go c.handleEvent(node)
 	}
-	dn, _ := c.kv.Load(clusterBuffer)
+	dn, _ := c.kv.Load(ClusterBuffer)
 	//TODO add not value error check
 	if dn != "" {
 		info := &datapb.DataNodeInfo{}
@@ -521,7 +523,7 @@ func (c *Cluster) watch(n *NodeInfo) {
 }
 
 func (c *Cluster) saveNode(n *NodeInfo) error {
-	key := fmt.Sprintf("%s%d", clusterPrefix, n.Info.GetVersion())
+	key := fmt.Sprintf("%s%d", ClusterPrefix, n.Info.GetVersion())
 	value := proto.MarshalTextString(n.Info)
 	return c.kv.Save(key, value)
 }
@@ -532,7 +534,7 @@ func (c *Cluster) txnSaveNodesAndBuffer(nodes []*NodeInfo, buffer []*datapb.Chan
 	}
 	data := make(map[string]string)
 	for _, n := range nodes {
-		key := fmt.Sprintf("%s%d", clusterPrefix, n.Info.GetVersion())
+		key := fmt.Sprintf("%s%d", ClusterPrefix, n.Info.GetVersion())
 		value := proto.MarshalTextString(n.Info)
 		data[key] = value
 	}
@@ -542,7 +544,7 @@ func (c *Cluster) txnSaveNodesAndBuffer(nodes []*NodeInfo, buffer []*datapb.Chan
 		Channels: buffer,
 	}
 
-	data[clusterBuffer] = proto.MarshalTextString(bufNode)
+	data[ClusterBuffer] = proto.MarshalTextString(bufNode)
 	return c.kv.MultiSave(data)
 }
 
@@ -0,0 +1,293 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// coldTierKV is the object storage the binlogs and index files of the cold segments are moved in
+type coldTierKV interface {
+	// CopyToColdTier copies the object of key to the cold tier and returns the key of the copy
+	CopyToColdTier(key string) (string, error)
+	ListKeysWithPrefix(prefix string) ([]string, error)
+	Remove(key string) error
+}
+
+// segmentAccessStats records the last time the segments are searched or queried, reported by the query nodes.
+// The segments never reported are taken as accessed when DataCoord starts, so no segment is moved to the cold
+// tier before the query nodes had the chance to report it.
+type segmentAccessStats struct {
+	mu         sync.RWMutex
+	startTime  time.Time
+	lastAccess map[UniqueID]time.Time
+}
+
+func newSegmentAccessStats(startTime time.Time) *segmentAccessStats {
+	return &segmentAccessStats{
+		startTime:  startTime,
+		lastAccess: make(map[UniqueID]time.Time),
+	}
+}
+
+// update records the segment is accessed at accessTime, the unix seconds reported by the query node
+func (stats *segmentAccessStats) update(segmentID UniqueID, accessTime int64) {
+	if accessTime <= 0 {
+		return
+	}
+	t := time.Unix(accessTime, 0)
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if last, ok := stats.lastAccess[segmentID]; !ok || t.After(last) {
+		stats.lastAccess[segmentID] = t
+	}
+}
+
+func (stats *segmentAccessStats) get(segmentID UniqueID) time.Time {
+	stats.mu.RLock()
+	defer stats.mu.RUnlock()
+	if last, ok := stats.lastAccess[segmentID]; ok && last.After(stats.startTime) {
+		return last
+	}
+	return stats.startTime
+}
+
+func (stats *segmentAccessStats) remove(segmentID UniqueID) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	delete(stats.lastAccess, segmentID)
+}
+
+// isColdSegment tells whether the segment should be moved to the cold tier, a segment is cold if it's flushed
+// longer than segmentAge ago and not accessed within accessWindow
+func isColdSegment(segment *SegmentInfo, lastAccess time.Time, now time.Time, segmentAge, accessWindow time.Duration) bool {
+	if segment.GetState() != commonpb.SegmentState_Flushed || segment.GetIsCold() || segment.GetDmlPosition() == nil {
+		return false
+	}
+	flushTime, _ := tsoutil.ParseTS(segment.GetDmlPosition().GetTimestamp())
+	return now.Sub(flushTime) >= segmentAge && now.Sub(lastAccess) >= accessWindow
+}
+
+// startQueryNodeStatsLoop consumes the segment statistics of the query nodes to record the access time of the segments
+func (s *Server) startQueryNodeStatsLoop(ctx context.Context) {
+	defer logutil.LogPanic()
+	defer s.serverLoopWg.Done()
+	statsStream, _ := s.msFactory.NewMsgStream(ctx)
+	statsStream.AsConsumer([]string{Params.QueryNodeStatsChannelName}, Params.DataCoordSubscriptionName)
+	log.Debug("dataCoord create query node stats channel consumer",
+		zap.String("channelName", Params.QueryNodeStatsChannelName),
+		zap.String("subscriptionName", Params.DataCoordSubscriptionName))
+	statsStream.Start()
+	defer statsStream.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("query node stats channel shutdown")
+			return
+		default:
+		}
+		msgPack := statsStream.Consume()
+		if msgPack == nil {
+			log.Debug("receive nil query node stats msg, shutdown query node stats channel")
+			return
+		}
+		for _, msg := range msgPack.Msgs {
+			if msg.Type() != commonpb.MsgType_QueryNodeStats {
+				log.Warn("receive unknown msg from query node stats channel",
+					zap.Stringer("msgType", msg.Type()))
+				continue
+			}
+			for _, stat := range msg.(*msgstream.QueryNodeStatsMsg).SegStats {
+				s.accessStats.update(stat.GetSegmentID(), stat.GetLastAccessTime())
+			}
+		}
+	}
+}
+
+// startColdTierLoop moves the cold segments to the cold tier every ColdTierCheckInterval
+func (s *Server) startColdTierLoop(ctx context.Context) {
+	defer logutil.LogPanic()
+	defer s.serverLoopWg.Done()
+	if Params.StorageType == paramtable.StorageTypeLocal {
+		log.Warn("cold tier is not supported by local storage")
+		return
+	}
+	binlogKV, err := newStorageKV(ctx)
+	if err != nil {
+		log.Error("failed to connect to object storage, cold tier is disabled", zap.Error(err))
+		return
+	}
+	minioKV, ok := binlogKV.(*miniokv.MinIOKV)
+	if !ok || !minioKV.ColdTierEnabled() {
+		log.Warn("cold tier of object storage is not configured, cold tier is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(Params.ColdTierCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("cold tier loop shutdown")
+			return
+		case <-ticker.C:
+			s.moveColdSegments(ctx, minioKV)
+		}
+	}
+}
+
+// moveColdSegments moves all the cold segments to the cold tier, the segments failed to move are retried next time
+func (s *Server) moveColdSegments(ctx context.Context, kv coldTierKV) {
+	now := time.Now()
+	for _, segment := range s.meta.GetFlushedSegments() {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		if !isColdSegment(segment, s.accessStats.get(segment.GetID()), now, Params.ColdTierSegmentAge, Params.ColdTierAccessWindow) {
+			continue
+		}
+		if err := s.moveSegmentToColdTier(ctx, kv, segment); err != nil {
+			log.Warn("failed to move segment to cold tier", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			continue
+		}
+		s.accessStats.remove(segment.GetID())
+		log.Info("segment moved to cold tier", zap.Int64("segmentID", segment.GetID()))
+	}
+}
+
+// moveSegmentToColdTier copies the binlogs, delta logs and index files of the segment to the cold tier, then
+// rewrites the binlog and delta log paths and marks the segment cold in one meta transaction, and removes the
+// objects in the hot tier at last. The index file paths are kept, the index files are read from the cold tier
+// since they're not found in the hot tier. The stats logs are small, so they're kept in the hot tier.
+func (s *Server) moveSegmentToColdTier(ctx context.Context, kv coldTierKV, segment *SegmentInfo) error {
+	if s.kvClient == nil {
+		return errNilKvClient
+	}
+	prefix, err := s.genKey(false, segment.GetID())
+	if err != nil {
+		return err
+	}
+	metas := make(map[string]string)
+	var hotKeys []string
+	// the cold keys of the copied objects, a segment file is the binlog of all the fields of a flush, so it's
+	// copied and removed only once
+	coldKeys := make(map[string]string)
+	copyToColdTier := func(hotKey string) (string, error) {
+		if coldKey, ok := coldKeys[hotKey]; ok {
+			return coldKey, nil
+		}
+		coldKey, err := kv.CopyToColdTier(hotKey)
+		if err != nil {
+			return "", err
+		}
+		coldKeys[hotKey] = coldKey
+		hotKeys = append(hotKeys, hotKey)
+		return coldKey, nil
+	}
+
+	// prefix/id/ instead of prefix/id
+	keys, values, err := s.kvClient.LoadWithPrefix(path.Join(Params.SegmentBinlogSubPath, prefix) + "/")
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		m := &datapb.SegmentFieldBinlogMeta{}
+		if err = proto.UnmarshalText(values[i], m); err != nil {
+			return err
+		}
+		if m.BinlogPath, err = copyToColdTier(m.BinlogPath); err != nil {
+			return err
+		}
+		metas[metaKey(keys[i])] = proto.MarshalTextString(m)
+	}
+
+	keys, values, err = s.kvClient.LoadWithPrefix(path.Join(Params.SegmentDeltalogSubPath, prefix) + "/")
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		m := &datapb.SegmentDeltalogMeta{}
+		if err = proto.UnmarshalText(values[i], m); err != nil {
+			return err
+		}
+		if m.DeltalogPath, err = copyToColdTier(m.DeltalogPath); err != nil {
+			return err
+		}
+		metas[metaKey(keys[i])] = proto.MarshalTextString(m)
+	}
+
+	indexKeys, err := s.getSegmentIndexFiles(ctx, kv, segment)
+	if err != nil {
+		return err
+	}
+	for _, key := range indexKeys {
+		if _, err = copyToColdTier(key); err != nil {
+			return err
+		}
+	}
+
+	if err = s.meta.SetSegmentCold(segment.GetID(), metas); err != nil {
+		return err
+	}
+	for _, key := range hotKeys {
+		if err = kv.Remove(key); err != nil {
+			log.Warn("failed to remove object moved to cold tier", zap.String("key", key), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// getSegmentIndexFiles returns the keys of the index files of the segment in the hot tier
+func (s *Server) getSegmentIndexFiles(ctx context.Context, kv coldTierKV, segment *SegmentInfo) ([]string, error) {
+	resp, err := s.rootCoordClient.DescribeSegment(ctx, &milvuspb.DescribeSegmentRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DescribeSegment,
+			SourceID: Params.NodeID,
+		},
+		CollectionID: segment.GetCollectionID(),
+		SegmentID:    segment.GetID(),
+	})
+	if err = VerifyResponse(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to describe segment %d: %s", segment.GetID(), err.Error())
+	}
+	if resp.GetBuildID() == 0 {
+		return nil, nil
+	}
+	// the index files are saved as <build id>/<version>/<partition id>/<segment id>/<name>
+	return kv.ListKeysWithPrefix(strconv.FormatInt(resp.GetBuildID(), 10) + "/")
+}
+
+// metaKey strips the meta root path from the key returned by the kv client
+func metaKey(key string) string {
+	return strings.TrimPrefix(key, path.Clean(Params.MetaRootPath)+"/")
+}
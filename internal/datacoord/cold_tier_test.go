@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentAccessStats(t *testing.T) {
+	startTime := time.Unix(1000, 0)
+	stats := newSegmentAccessStats(startTime)
+	assert.Equal(t, startTime, stats.get(1))
+
+	stats.update(1, 2000)
+	assert.Equal(t, time.Unix(2000, 0), stats.get(1))
+	// the stats of the query nodes are not in order, the latest access is kept
+	stats.update(1, 1500)
+	assert.Equal(t, time.Unix(2000, 0), stats.get(1))
+	// never accessed
+	stats.update(2, 0)
+	assert.Equal(t, startTime, stats.get(2))
+	// accessed before DataCoord starts
+	stats.update(3, 500)
+	assert.Equal(t, startTime, stats.get(3))
+
+	stats.remove(1)
+	assert.Equal(t, startTime, stats.get(1))
+}
+
+func TestIsColdSegment(t *testing.T) {
+	now := time.Now()
+	day := 24 * time.Hour
+	newSegment := func(state commonpb.SegmentState, flushTime time.Time, isCold bool) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:          1,
+			State:       state,
+			IsCold:      isCold,
+			DmlPosition: &internalpb.MsgPosition{Timestamp: tsoutil.ComposeTS(flushTime.UnixNano()/int64(time.Millisecond), 0)},
+		})
+	}
+
+	cases := []struct {
+		name       string
+		segment    *SegmentInfo
+		lastAccess time.Time
+		expected   bool
+	}{
+		{"old and not accessed", newSegment(commonpb.SegmentState_Flushed, now.Add(-100*day), false), now.Add(-40 * day), true},
+		{"old but accessed recently", newSegment(commonpb.SegmentState_Flushed, now.Add(-100*day), false), now.Add(-day), false},
+		{"new", newSegment(commonpb.SegmentState_Flushed, now.Add(-10*day), false), now.Add(-10 * day), false},
+		{"not flushed", newSegment(commonpb.SegmentState_Sealed, now.Add(-100*day), false), now.Add(-40 * day), false},
+		{"already cold", newSegment(commonpb.SegmentState_Flushed, now.Add(-100*day), true), now.Add(-40 * day), false},
+		{"no position", NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Flushed}), now.Add(-40 * day), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, isColdSegment(c.segment, c.lastAccess, now, 90*day, 30*day))
+		})
+	}
+}
+
+func TestMeta_SetSegmentCold(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           1,
+		CollectionID: 100,
+		PartitionID:  10,
+		State:        commonpb.SegmentState_Flushed,
+	})
+	assert.Nil(t, meta.AddSegment(segment))
+	assert.Equal(t, 1, len(meta.GetFlushedSegments()))
+
+	binlogMeta := proto.MarshalTextString(&datapb.SegmentFieldBinlogMeta{FieldID: 101, BinlogPath: "cold/binlog/1"})
+	assert.Nil(t, meta.SetSegmentCold(1, map[string]string{"binlog/1/101/1": binlogMeta}))
+	assert.True(t, meta.GetSegment(1).GetIsCold())
+	value, err := meta.client.Load("binlog/1/101/1")
+	assert.Nil(t, err)
+	assert.Equal(t, binlogMeta, value)
+
+	// the segment info is persisted in the same transaction
+	reloaded, err := newMeta(meta.client)
+	assert.Nil(t, err)
+	assert.True(t, reloaded.GetSegment(1).GetIsCold())
+
+	assert.NotNil(t, meta.SetSegmentCold(2, nil))
+}
+
+// coldTierMockKV records the objects copied to and removed from the cold tier, keys are the index files
+type coldTierMockKV struct {
+	keys    []string
+	copied  []string
+	removed []string
+}
+
+func (kv *coldTierMockKV) CopyToColdTier(key string) (string, error) {
+	kv.copied = append(kv.copied, key)
+	return path.Join("cold", key), nil
+}
+
+func (kv *coldTierMockKV) ListKeysWithPrefix(prefix string) ([]string, error) {
+	var keys []string
+	for _, key := range kv.keys {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (kv *coldTierMockKV) Remove(key string) error {
+	kv.removed = append(kv.removed, key)
+	return nil
+}
+
+// coldTierRootCoord reports the index build of every segment
+type coldTierRootCoord struct {
+	types.RootCoord
+	buildID UniqueID
+}
+
+func (rc *coldTierRootCoord) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	return &milvuspb.DescribeSegmentResponse{
+		Status:  &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		BuildID: rc.buildID,
+	}, nil
+}
+
+func TestMoveSegmentToColdTier(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+	svr.rootCoordClient = &coldTierRootCoord{RootCoord: svr.rootCoordClient, buildID: 7}
+
+	const segmentID = 1
+	err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           segmentID,
+		CollectionID: 0,
+		PartitionID:  0,
+		State:        commonpb.SegmentState_Flushed,
+	}))
+	assert.Nil(t, err)
+
+	// a segment file is the binlog of both fields
+	segmentFile := "insert_log/0/0/1/segment/10"
+	deltalog := "delta_log/0/0/1/11"
+	for _, fieldID := range []UniqueID{100, 101} {
+		metas, err := svr.prepareField2PathMeta(segmentID, &datapb.ID2PathList{ID: fieldID, Paths: []string{segmentFile}})
+		assert.Nil(t, err)
+		assert.Nil(t, svr.SaveBinLogMetaTxn(metas))
+	}
+	metas, err := svr.prepareDeltalogMeta(segmentID, []string{deltalog})
+	assert.Nil(t, err)
+	assert.Nil(t, svr.SaveBinLogMetaTxn(metas))
+
+	indexFile := "7/1/0/1/index"
+	kv := &coldTierMockKV{keys: []string{indexFile, "8/1/0/2/index"}}
+	err = svr.moveSegmentToColdTier(context.TODO(), kv, svr.meta.GetSegment(segmentID))
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{segmentFile, deltalog, indexFile}, kv.copied)
+	assert.ElementsMatch(t, []string{segmentFile, deltalog, indexFile}, kv.removed)
+	assert.True(t, svr.meta.GetSegment(segmentID).GetIsCold())
+
+	binlogMetas, err := svr.getSegmentBinlogMeta(segmentID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(binlogMetas))
+	for _, m := range binlogMetas {
+		assert.Equal(t, path.Join("cold", segmentFile), m.GetBinlogPath())
+	}
+	deltalogs, err := svr.getSegmentDeltalogs(segmentID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{path.Join("cold", deltalog)}, deltalogs)
+}
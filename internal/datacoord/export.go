@@ -0,0 +1,376 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	localkv "github.com/milvus-io/milvus/internal/kv/local"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// newStorageKV connects to the object storage where the binlogs are saved
+func newStorageKV(ctx context.Context) (kv.BaseKV, error) {
+	if Params.StorageType == paramtable.StorageTypeLocal {
+		return localkv.NewLocalKV(Params.StoragePath)
+	}
+	return miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+		ColdBucketName:    Params.ColdBucketName,
+		ColdRootPath:      Params.ColdRootPath,
+	})
+}
+
+// exportKeyPrefix resolves the output path of an export under the export root path of the object storage, the
+// output path must be relative and stay under the root
+func exportKeyPrefix(outputPath string) (string, error) {
+	if outputPath == "" {
+		return "", fmt.Errorf("output path is not specified")
+	}
+	if path.IsAbs(outputPath) {
+		return "", fmt.Errorf("output path %s is absolute", outputPath)
+	}
+	for _, elem := range strings.Split(outputPath, "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("output path %s contains ..", outputPath)
+		}
+	}
+	return path.Join(Params.ExportRootPath, outputPath), nil
+}
+
+// ExportTaskPrefix is the prefix of the export task keys in the meta, it's also used by the meta tool
+const ExportTaskPrefix = MetaPrefix + "/export-task"
+
+// the completed and failed export tasks are kept for a day for the clients to poll
+const exportTaskRetention = 24 * time.Hour
+
+// exportTasks keeps the states of the export tasks, the states are saved in the meta so the clients can poll the
+// tasks after DataCoord restarts
+type exportTasks struct {
+	mu    sync.RWMutex
+	kv    kv.BaseKV
+	tasks map[UniqueID]*datapb.ExportTaskInfo
+}
+
+// newExportTasks loads the export tasks from the meta, the tasks left unfinished by the last run are failed since
+// their goroutines are gone
+func newExportTasks(kv kv.BaseKV) (*exportTasks, error) {
+	t := &exportTasks{
+		kv:    kv,
+		tasks: make(map[UniqueID]*datapb.ExportTaskInfo),
+	}
+	_, values, err := kv.LoadWithPrefix(ExportTaskPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		task := &datapb.ExportTaskInfo{}
+		if err := proto.UnmarshalText(value, task); err != nil {
+			return nil, fmt.Errorf("DataCoord newExportTasks UnMarshalText datapb.ExportTaskInfo err:%w", err)
+		}
+		t.tasks[task.GetTaskID()] = task
+		if task.GetState() == commonpb.ExportState_ExportPending || task.GetState() == commonpb.ExportState_ExportInProgress {
+			task.State = commonpb.ExportState_ExportFailed
+			task.FailReason = "export is interrupted by the restart of DataCoord"
+			task.FinishTime = time.Now().Unix()
+			if err := t.save(task); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return t, nil
+}
+
+func buildExportTaskPath(taskID UniqueID) string {
+	return fmt.Sprintf("%s/%d", ExportTaskPrefix, taskID)
+}
+
+// save saves a copy of the task
+func (t *exportTasks) save(task *datapb.ExportTaskInfo) error {
+	task = proto.Clone(task).(*datapb.ExportTaskInfo)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := t.kv.Save(buildExportTaskPath(task.GetTaskID()), proto.MarshalTextString(task)); err != nil {
+		return err
+	}
+	t.tasks[task.GetTaskID()] = task
+	return nil
+}
+
+// get returns a copy of the task, nil if the task doesn't exist
+func (t *exportTasks) get(taskID UniqueID) *datapb.ExportTaskInfo {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	task, ok := t.tasks[taskID]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(task).(*datapb.ExportTaskInfo)
+}
+
+// removeExpired removes the tasks finished more than exportTaskRetention ago
+func (t *exportTasks) removeExpired(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for taskID, task := range t.tasks {
+		if task.GetFinishTime() == 0 || now.Sub(time.Unix(task.GetFinishTime(), 0)) < exportTaskRetention {
+			continue
+		}
+		if err := t.kv.Remove(buildExportTaskPath(taskID)); err != nil {
+			log.Warn("remove expired export task failed", zap.Int64("taskID", taskID), zap.Error(err))
+			continue
+		}
+		delete(t.tasks, taskID)
+	}
+}
+
+// Export starts a task writing the flushed segments of a collection as parquet files, a file named
+// <segment id>.parquet per segment. The files are written to the object storage with the output path under the
+// export root path as the key prefix. The deletes of the segments are applied, the data which is not flushed yet is
+// not exported. The task runs in the background segment by segment, its state is polled by GetExportState with the
+// returned task id.
+func (s *Server) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	resp := &datapb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	prefix, err := exportKeyPrefix(req.GetOutputPath())
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	collection := s.meta.GetCollection(req.GetCollectionID())
+	if collection == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		collection = s.meta.GetCollection(req.GetCollectionID())
+	}
+
+	taskID, err := s.allocator.allocID()
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	task := &datapb.ExportTaskInfo{
+		TaskID:       taskID,
+		CollectionID: req.GetCollectionID(),
+		PartitionIDs: req.GetPartitionIDs(),
+		Timestamp:    req.GetTimestamp(),
+		OutputPath:   req.GetOutputPath(),
+		State:        commonpb.ExportState_ExportPending,
+	}
+	s.exportTasks.removeExpired(time.Now())
+	if err := s.exportTasks.save(task); err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	s.serverLoopWg.Add(1)
+	go s.runExportTask(s.serverLoopCtx, task, collection, prefix)
+
+	log.Debug("export collection", zap.Int64("taskID", taskID), zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()), zap.String("outputPath", req.GetOutputPath()))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.TaskID = taskID
+	return resp, nil
+}
+
+// GetExportState returns the state of an export task and the files written so far
+func (s *Server) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	resp := &datapb.GetExportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	task := s.exportTasks.get(req.GetTaskID())
+	if task == nil || (req.GetCollectionID() != 0 && req.GetCollectionID() != task.GetCollectionID()) {
+		resp.Status.Reason = fmt.Sprintf("export task %d not found", req.GetTaskID())
+		return resp, nil
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.State = task.GetState()
+	resp.Files = task.GetFiles()
+	resp.NumRows = task.GetNumRows()
+	resp.FailReason = task.GetFailReason()
+	return resp, nil
+}
+
+// runExportTask exports the segments one by one, the progress is saved after each segment so only a segment is
+// kept in memory and the clients see the files written so far
+func (s *Server) runExportTask(ctx context.Context, task *datapb.ExportTaskInfo, collection *datapb.CollectionInfo, prefix string) {
+	defer s.serverLoopWg.Done()
+	defer logutil.LogPanic()
+
+	err := s.exportSegments(ctx, task, collection, prefix)
+	if err != nil {
+		task.State = commonpb.ExportState_ExportFailed
+		task.FailReason = err.Error()
+	} else {
+		task.State = commonpb.ExportState_ExportCompleted
+	}
+	task.FinishTime = time.Now().Unix()
+	if err := s.exportTasks.save(task); err != nil {
+		log.Warn("save export task failed", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+	}
+	log.Debug("export collection done", zap.Int64("taskID", task.GetTaskID()),
+		zap.Int64("collectionID", task.GetCollectionID()), zap.String("state", task.GetState().String()),
+		zap.Int("files", len(task.GetFiles())), zap.Int64("rows", task.GetNumRows()), zap.Error(err))
+}
+
+func (s *Server) exportSegments(ctx context.Context, task *datapb.ExportTaskInfo, collection *datapb.CollectionInfo, prefix string) error {
+	task.State = commonpb.ExportState_ExportInProgress
+	if err := s.exportTasks.save(task); err != nil {
+		return err
+	}
+
+	var segmentIDs []UniqueID
+	if len(task.GetPartitionIDs()) == 0 {
+		segmentIDs = s.meta.GetSegmentsOfCollection(task.GetCollectionID())
+	} else {
+		for _, partitionID := range task.GetPartitionIDs() {
+			segmentIDs = append(segmentIDs, s.meta.GetSegmentsOfPartition(task.GetCollectionID(), partitionID)...)
+		}
+	}
+
+	binlogKV, err := newStorageKV(ctx)
+	if err != nil {
+		return err
+	}
+	// the encrypted binlogs are decrypted, the exported files are not encrypted
+	keyManager, err := storage.NewKeyManagerFromKeyFile(Params.EncryptionKeyFile)
+	if err != nil {
+		return err
+	}
+	for _, segmentID := range segmentIDs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		segment := s.meta.GetSegment(segmentID)
+		if segment == nil || segment.GetState() != commonpb.SegmentState_Flushed {
+			continue
+		}
+		buf, rows, err := s.exportSegment(binlogKV, keyManager, collection, segmentID, task.GetTimestamp())
+		if err != nil {
+			return fmt.Errorf("export segment %d failed, %s", segmentID, err.Error())
+		}
+		if rows == 0 {
+			continue
+		}
+		key := path.Join(prefix, fmt.Sprintf("%d.parquet", segmentID))
+		if err := binlogKV.Save(key, string(buf)); err != nil {
+			return err
+		}
+		task.Files = append(task.Files, key)
+		task.NumRows += int64(rows)
+		if err := s.exportTasks.save(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportSegment loads the insert binlogs and delta logs of a segment and encodes the rows inserted no later than ts
+// and not deleted by then as parquet
+func (s *Server) exportSegment(binlogKV kv.BaseKV, keyManager *storage.KeyManager, collection *datapb.CollectionInfo, segmentID UniqueID, ts Timestamp) ([]byte, int, error) {
+	metas, err := s.getSegmentBinlogMeta(segmentID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(metas) == 0 {
+		return nil, 0, nil
+	}
+	keys := make([]string, 0, len(metas))
+	loaded := make(map[string]bool, len(metas))
+	for _, meta := range metas {
+		// a segment file is listed once for each of its fields
+		if !loaded[meta.GetBinlogPath()] {
+			keys = append(keys, meta.GetBinlogPath())
+			loaded[meta.GetBinlogPath()] = true
+		}
+	}
+	values, err := binlogKV.MultiLoad(keys)
+	if err != nil {
+		return nil, 0, err
+	}
+	blobs := make([]*storage.Blob, 0, len(keys))
+	for i, key := range keys {
+		blobs = append(blobs, &storage.Blob{Key: key, Value: []byte(values[i])})
+	}
+
+	codec := storage.NewInsertCodec(&etcdpb.CollectionMeta{
+		ID:     collection.GetID(),
+		Schema: collection.GetSchema(),
+	})
+	codec.KeyManager = keyManager
+	defer codec.Close()
+	_, _, data, err := codec.Deserialize(blobs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	deltalogs, err := s.getSegmentDeltalogs(segmentID)
+	if err != nil {
+		return nil, 0, err
+	}
+	var deleteData *storage.DeleteData
+	if len(deltalogs) > 0 {
+		values, err := binlogKV.MultiLoad(deltalogs)
+		if err != nil {
+			return nil, 0, err
+		}
+		blobs := make([]*storage.Blob, 0, len(deltalogs))
+		for i, key := range deltalogs {
+			blobs = append(blobs, &storage.Blob{Key: key, Value: []byte(values[i])})
+		}
+		deleteCodec := storage.NewDeleteCodec()
+		deleteCodec.KeyManager = keyManager
+		defer deleteCodec.Close()
+		if _, _, deleteData, err = deleteCodec.Deserialize(blobs); err != nil {
+			return nil, 0, err
+		}
+	}
+	return storage.ExportInsertData(collection.GetSchema(), data, deleteData, ts, storage.CompressionSnappy)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"go.uber.org/zap"
 )
 
@@ -55,7 +56,7 @@ func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.F
 		resp.Status.Reason = serverNotServingErrMsg
 		return resp, nil
 	}
-	sealedSegments, err := s.segmentManager.SealAllSegments(ctx, req.CollectionID)
+	sealedSegments, err := s.segmentManager.SealAllSegments(ctx, req.CollectionID, req.GetChannels())
 	if err != nil {
 		resp.Status.Reason = fmt.Sprintf("failed to flush %d, %s", req.CollectionID, err)
 		return resp, nil
@@ -186,7 +187,12 @@ func (s *Server) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsert
 			resp.Status.Reason = fmt.Errorf("DataCoord GetInsertBinlogPaths UnmarshalText datapb.SegmentFieldBinlogMeta err:%w", err).Error()
 			return resp, nil
 		}
-		m[tMeta.FieldID] = append(m[tMeta.FieldID], tMeta.BinlogPath)
+		binlogPath := tMeta.BinlogPath
+		if storage.IsSegmentFileKey(binlogPath) {
+			// a segment file holds all the fields, refer to the column of the field
+			binlogPath = storage.SegmentFileColumnKey(binlogPath, tMeta.FieldID)
+		}
+		m[tMeta.FieldID] = append(m[tMeta.FieldID], binlogPath)
 	}
 
 	fids := make([]UniqueID, len(m))
@@ -349,6 +355,8 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	}
 	segmentIDs := s.meta.GetSegmentsOfPartition(collectionID, partitionID)
 	segment2Binlogs := make(map[UniqueID][]*datapb.FieldBinlog)
+	segment2Deltalogs := make(map[UniqueID][]string)
+	segment2NumRows := make(map[UniqueID]int64)
 	for _, id := range segmentIDs {
 		segment := s.meta.GetSegment(id)
 		if segment == nil {
@@ -379,6 +387,15 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			}
 			segment2Binlogs[id] = append(segment2Binlogs[id], fieldBinlogs)
 		}
+
+		deltalogs, err := s.getSegmentDeltalogs(id)
+		if err != nil {
+			log.Error("get segment deltalog meta failed", zap.Int64("segmentID", id))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		segment2Deltalogs[id] = deltalogs
+		segment2NumRows[id] = segment.NumOfRows
 	}
 
 	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
@@ -386,6 +403,8 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		sbl := &datapb.SegmentBinlogs{
 			SegmentID:    segmentID,
 			FieldBinlogs: fieldBinlogs,
+			Deltalogs:    segment2Deltalogs[segmentID],
+			NumOfRows:    segment2NumRows[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
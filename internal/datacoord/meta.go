@@ -21,9 +21,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 )
 
+// the prefixes of the meta keys, they're also used by the meta tool
 const (
-	metaPrefix    = "datacoord-meta"
-	segmentPrefix = metaPrefix + "/s"
+	MetaPrefix    = "datacoord-meta"
+	SegmentPrefix = MetaPrefix + "/s"
 )
 
 type meta struct {
@@ -47,7 +48,7 @@ func newMeta(kv kv.TxnKV) (*meta, error) {
 }
 
 func (m *meta) reloadFromKV() error {
-	_, values, err := m.client.LoadWithPrefix(segmentPrefix)
+	_, values, err := m.client.LoadWithPrefix(SegmentPrefix)
 	if err != nil {
 		return err
 	}
@@ -279,6 +280,41 @@ func (m *meta) GetFlushingSegments() []*SegmentInfo {
 	return ret
 }
 
+func (m *meta) GetFlushedSegments() []*SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	ret := make([]*SegmentInfo, 0)
+	segments := m.segments.GetSegments()
+	for _, info := range segments {
+		if info.State == commonpb.SegmentState_Flushed {
+			ret = append(ret, info)
+		}
+	}
+	return ret
+}
+
+// SetSegmentCold marks the segment moved to the cold tier, binlogs are the binlog metas rewritten with the paths in
+// the cold tier, they're saved in the same transaction with the segment info
+func (m *meta) SetSegmentCold(segmentID UniqueID, binlogs map[string]string) error {
+	m.Lock()
+	defer m.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil {
+		return fmt.Errorf("segment %d not found", segmentID)
+	}
+	cloned := m.segments.Clone(segment, SetIsCold(true))
+	kv := make(map[string]string, len(binlogs)+1)
+	for k, v := range binlogs {
+		kv[k] = v
+	}
+	kv[buildSegmentPath(cloned.GetCollectionID(), cloned.GetPartitionID(), cloned.GetID())] = proto.MarshalTextString(cloned.SegmentInfo)
+	if err := m.saveKvTxn(kv); err != nil {
+		return err
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	return nil
+}
+
 func (m *meta) AddAllocation(segmentID UniqueID, allocation *Allocation) error {
 	m.Lock()
 	defer m.Unlock()
@@ -295,6 +331,18 @@ func (m *meta) SetAllocations(segmentID UniqueID, allocations []*Allocation) {
 	m.segments.SetAllocations(segmentID, allocations)
 }
 
+// SetBinlogSize sets the binlog sizes reported by datanode and saves the segment if they are changed
+func (m *meta) SetBinlogSize(segmentID UniqueID, size, rawSize int64) error {
+	m.Lock()
+	defer m.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || (segment.GetBinlogSize() == size && segment.GetRawBinlogSize() == rawSize) {
+		return nil
+	}
+	m.segments.SetBinlogSize(segmentID, size, rawSize)
+	return m.saveSegmentInfo(m.segments.GetSegment(segmentID))
+}
+
 func (m *meta) SetCurrentRows(segmentID UniqueID, rows int64) {
 	m.Lock()
 	defer m.Unlock()
@@ -318,15 +366,15 @@ func (m *meta) saveKvTxn(kv map[string]string) error {
 }
 
 func buildSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
-	return fmt.Sprintf("%s/%d/%d/%d", segmentPrefix, collectionID, partitionID, segmentID)
+	return fmt.Sprintf("%s/%d/%d/%d", SegmentPrefix, collectionID, partitionID, segmentID)
 }
 
 func buildCollectionPath(collectionID UniqueID) string {
-	return fmt.Sprintf("%s/%d/", segmentPrefix, collectionID)
+	return fmt.Sprintf("%s/%d/", SegmentPrefix, collectionID)
 }
 
 func buildPartitionPath(collectionID UniqueID, partitionID UniqueID) string {
-	return fmt.Sprintf("%s/%d/%d/", segmentPrefix, collectionID, partitionID)
+	return fmt.Sprintf("%s/%d/%d/", SegmentPrefix, collectionID, partitionID)
 }
 
 func buildSegment(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, channelName string) *SegmentInfo {
@@ -115,6 +115,14 @@ func TestMeta_Basic(t *testing.T) {
 		info0_0 = meta.GetSegment(segID0_0)
 		assert.NotNil(t, info0_0)
 		assert.EqualValues(t, commonpb.SegmentState_Flushed, info0_0.State)
+
+		err = meta.SetBinlogSize(segID0_0, 1024, 4096)
+		assert.Nil(t, err)
+		info0_0 = meta.GetSegment(segID0_0)
+		assert.EqualValues(t, 1024, info0_0.BinlogSize)
+		assert.EqualValues(t, 4096, info0_0.RawBinlogSize)
+		err = meta.SetBinlogSize(segID1_0, 1024, 4096)
+		assert.Nil(t, err)
 	})
 
 	t.Run("Test GetCount", func(t *testing.T) {
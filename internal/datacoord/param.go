@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 
@@ -33,6 +34,7 @@ type ParamTable struct {
 	MetaRootPath            string
 	KvRootPath              string
 	SegmentBinlogSubPath    string
+	SegmentDeltalogSubPath  string
 	CollectionBinlogSubPath string
 
 	// --- Pulsar ---
@@ -41,6 +43,20 @@ type ParamTable struct {
 	// --- Rocksmq ---
 	RocksmqPath string
 
+	// --- MinIO ---
+	MinioAddress         string
+	MinioAccessKeyID     string
+	MinioSecretAccessKey string
+	MinioUseSSL          bool
+	MinioBucketName      string
+
+	// --- Storage ---
+	StorageType       string
+	StoragePath       string
+	EncryptionKeyFile string
+	ColdBucketName    string
+	ColdRootPath      string
+
 	FlushStreamPosSubPath string
 	StatsStreamPosSubPath string
 
@@ -49,11 +65,21 @@ type ParamTable struct {
 	SegmentSealProportion   float64
 	SegAssignmentExpiration int64
 
+	// cold tier
+	ColdTierEnabled       bool
+	ColdTierSegmentAge    time.Duration
+	ColdTierAccessWindow  time.Duration
+	ColdTierCheckInterval time.Duration
+
+	// export
+	ExportRootPath string
+
 	InsertChannelPrefixName   string
 	StatisticsChannelName     string
 	TimeTickChannelName       string
 	SegmentInfoChannelName    string
 	DataCoordSubscriptionName string
+	QueryNodeStatsChannelName string
 
 	Log log.Config
 }
@@ -75,19 +101,30 @@ func (p *ParamTable) Init() {
 		p.initMetaRootPath()
 		p.initKvRootPath()
 		p.initSegmentBinlogSubPath()
+		p.initSegmentDeltalogSubPath()
 		p.initCollectionBinlogSubPath()
 
 		p.initPulsarAddress()
 		p.initRocksmqPath()
 
+		p.initMinioAddress()
+		p.initMinioAccessKeyID()
+		p.initMinioSecretAccessKey()
+		p.initMinioUseSSL()
+		p.initMinioBucketName()
+		p.initStorage()
+
 		p.initSegmentMaxSize()
 		p.initSegmentSealProportion()
 		p.initSegAssignmentExpiration()
+		p.initColdTier()
+		p.initExportRootPath()
 		p.initInsertChannelPrefixName()
 		p.initStatisticsChannelName()
 		p.initTimeTickChannelName()
 		p.initSegmentInfoChannelName()
 		p.initDataCoordSubscriptionName()
+		p.initQueryNodeStatsChannelName()
 		p.initLogCfg()
 
 		p.initFlushStreamPosSubPath()
@@ -119,6 +156,52 @@ func (p *ParamTable) initRocksmqPath() {
 	p.RocksmqPath = path
 }
 
+func (p *ParamTable) initMinioAddress() {
+	endpoint, err := p.Load("_MinioAddress")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAddress = endpoint
+}
+
+func (p *ParamTable) initMinioAccessKeyID() {
+	keyID, err := p.Load("minio.accessKeyID")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAccessKeyID = keyID
+}
+
+func (p *ParamTable) initMinioSecretAccessKey() {
+	key, err := p.Load("minio.secretAccessKey")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioSecretAccessKey = key
+}
+
+func (p *ParamTable) initMinioUseSSL() {
+	usessl, err := p.Load("minio.useSSL")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioUseSSL, _ = strconv.ParseBool(usessl)
+}
+
+func (p *ParamTable) initMinioBucketName() {
+	bucketName, err := p.Load("minio.bucketName")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioBucketName = bucketName
+}
+
+func (p *ParamTable) initStorage() {
+	p.StorageType, p.StoragePath = p.LoadStorage()
+	p.EncryptionKeyFile = p.LoadEncryptionKeyFile()
+	p.ColdBucketName, p.ColdRootPath = p.LoadColdTier()
+}
+
 func (p *ParamTable) initMetaRootPath() {
 	rootPath, err := p.Load("etcd.rootPath")
 	if err != nil {
@@ -151,6 +234,14 @@ func (p *ParamTable) initSegmentBinlogSubPath() {
 	p.SegmentBinlogSubPath = subPath
 }
 
+func (p *ParamTable) initSegmentDeltalogSubPath() {
+	subPath, err := p.Load("etcd.segmentDeltalogSubPath")
+	if err != nil {
+		panic(err)
+	}
+	p.SegmentDeltalogSubPath = subPath
+}
+
 func (p *ParamTable) initCollectionBinlogSubPath() {
 	subPath, err := p.Load("etcd.collectionBinlogSubPath")
 	if err != nil {
@@ -171,6 +262,28 @@ func (p *ParamTable) initSegAssignmentExpiration() {
 	p.SegAssignmentExpiration = p.ParseInt64("datacoord.segment.assignmentExpiration")
 }
 
+func (p *ParamTable) initColdTier() {
+	enabled, err := p.Load("datacoord.coldTier.enabled")
+	if err != nil {
+		panic(err)
+	}
+	p.ColdTierEnabled, err = strconv.ParseBool(enabled)
+	if err != nil {
+		panic(err)
+	}
+	p.ColdTierSegmentAge = time.Duration(p.ParseInt64("datacoord.coldTier.segmentAge")) * time.Hour
+	p.ColdTierAccessWindow = time.Duration(p.ParseInt64("datacoord.coldTier.accessWindow")) * time.Hour
+	p.ColdTierCheckInterval = time.Duration(p.ParseInt64("datacoord.coldTier.checkInterval")) * time.Second
+}
+
+func (p *ParamTable) initExportRootPath() {
+	rootPath, err := p.Load("datacoord.export.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.ExportRootPath = rootPath
+}
+
 func (p *ParamTable) initInsertChannelPrefixName() {
 	var err error
 	p.InsertChannelPrefixName, err = p.Load("msgChannel.chanNamePrefix.dataCoordInsertChannel")
@@ -211,6 +324,14 @@ func (p *ParamTable) initDataCoordSubscriptionName() {
 	}
 }
 
+func (p *ParamTable) initQueryNodeStatsChannelName() {
+	var err error
+	p.QueryNodeStatsChannelName, err = p.Load("msgChannel.chanNamePrefix.queryNodeStats")
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.Log = log.Config{}
 	format, err := p.Load("log.format")
@@ -95,6 +95,12 @@ func (s *SegmentsInfo) AddAllocation(segmentID UniqueID, allocation *Allocation)
 	}
 }
 
+func (s *SegmentsInfo) SetBinlogSize(segmentID UniqueID, size, rawSize int64) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = s.Clone(segment, SetBinlogSize(size, rawSize))
+	}
+}
+
 func (s *SegmentsInfo) SetCurrentRows(segmentID UniqueID, rows int64) {
 	if segment, ok := s.segments[segmentID]; ok {
 		s.segments[segmentID] = s.ShadowClone(segment, SetCurrentRows(rows))
@@ -172,6 +178,19 @@ func AddAllocation(allocation *Allocation) SegmentInfoOption {
 	}
 }
 
+func SetBinlogSize(size, rawSize int64) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.BinlogSize = size
+		segment.RawBinlogSize = rawSize
+	}
+}
+
+func SetIsCold(isCold bool) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.IsCold = isCold
+	}
+}
+
 func SetCurrentRows(rows int64) SegmentInfoOption {
 	return func(segment *SegmentInfo) {
 		segment.currRows = rows
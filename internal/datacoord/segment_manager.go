@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 
@@ -35,8 +36,9 @@ type Manager interface {
 	AllocSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error)
 	// DropSegment drop the segment from allocator.
 	DropSegment(ctx context.Context, segmentID UniqueID)
-	// SealAllSegments sealed all segmetns of collection with collectionID and return sealed segments
-	SealAllSegments(ctx context.Context, collectionID UniqueID) ([]UniqueID, error)
+	// SealAllSegments sealed all segmetns of collection with collectionID and return sealed segments,
+	// only the segments on the channels are sealed if channels is not empty
+	SealAllSegments(ctx context.Context, collectionID UniqueID, channels []string) ([]UniqueID, error)
 	// GetFlushableSegments return flushable segment ids
 	GetFlushableSegments(ctx context.Context, channel string, ts Timestamp) ([]UniqueID, error)
 	// ExpireAllocations notify segment status to expire old allocations
@@ -343,7 +345,7 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 	}
 }
 
-func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
+func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID, channels []string) ([]UniqueID, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
 	s.mu.Lock()
@@ -358,6 +360,9 @@ func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID Uniqu
 		if info.CollectionID != collectionID {
 			continue
 		}
+		if len(channels) > 0 && !funcutil.SliceContain(channels, info.InsertChannel) {
+			continue
+		}
 		if info.State == commonpb.SegmentState_Sealed {
 			ret = append(ret, id)
 			continue
@@ -107,7 +107,7 @@ func TestSaveSegmentsToMeta(t *testing.T) {
 	allocations, err := segmentManager.AllocSegment(context.Background(), collID, 0, "c1", 1000)
 	assert.Nil(t, err)
 	assert.EqualValues(t, 1, len(allocations))
-	_, err = segmentManager.SealAllSegments(context.Background(), collID)
+	_, err = segmentManager.SealAllSegments(context.Background(), collID, nil)
 	assert.Nil(t, err)
 	segment := meta.GetSegment(allocations[0].SegmentID)
 	assert.NotNil(t, segment)
@@ -115,6 +115,30 @@ func TestSaveSegmentsToMeta(t *testing.T) {
 	assert.EqualValues(t, commonpb.SegmentState_Sealed, segment.State)
 }
 
+func TestSealSegmentsOfChannels(t *testing.T) {
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+
+	schema := newTestSchema()
+	collID, err := mockAllocator.allocID()
+	assert.Nil(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: schema})
+	segmentManager := newSegmentManager(meta, mockAllocator)
+	allocations1, err := segmentManager.AllocSegment(context.Background(), collID, 0, "c1", 1000)
+	assert.Nil(t, err)
+	allocations2, err := segmentManager.AllocSegment(context.Background(), collID, 0, "c2", 1000)
+	assert.Nil(t, err)
+
+	// only the segment on c1 is sealed
+	sealed, err := segmentManager.SealAllSegments(context.Background(), collID, []string{"c1"})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []UniqueID{allocations1[0].SegmentID}, sealed)
+	assert.EqualValues(t, commonpb.SegmentState_Sealed, meta.GetSegment(allocations1[0].SegmentID).State)
+	assert.EqualValues(t, commonpb.SegmentState_Growing, meta.GetSegment(allocations2[0].SegmentID).State)
+}
+
 func TestDropSegment(t *testing.T) {
 	Params.Init()
 	mockAllocator := newMockAllocator()
@@ -92,6 +92,9 @@ type Server struct {
 	flushCh   chan UniqueID
 	msFactory msgstream.Factory
 
+	accessStats *segmentAccessStats
+	exportTasks *exportTasks
+
 	session  *sessionutil.Session
 	activeCh <-chan bool
 	eventCh  <-chan *sessionutil.SessionEvent
@@ -131,6 +134,7 @@ func CreateServer(ctx context.Context, factory msgstream.Factory, opts ...Option
 		ctx:                    ctx,
 		msFactory:              factory,
 		flushCh:                make(chan UniqueID, 1024),
+		accessStats:            newSegmentAccessStats(time.Now()),
 		dataClientCreator:      defaultDataNodeCreatorFunc,
 		rootCoordClientCreator: defaultRootCoordCreatorFunc,
 		helper:                 defaultServerHelper(),
@@ -275,6 +279,10 @@ func (s *Server) initMeta() error {
 		if err != nil {
 			return err
 		}
+		s.exportTasks, err = newExportTasks(s.kvClient)
+		if err != nil {
+			return err
+		}
 		return nil
 	}
 	return retry.Do(s.ctx, connectEtcdFn, retry.Attempts(connEtcdMaxRetryTime))
@@ -288,6 +296,11 @@ func (s *Server) startServerLoop() {
 	go s.startWatchService(s.serverLoopCtx)
 	go s.startActiveCheck(s.serverLoopCtx)
 	go s.startFlushLoop(s.serverLoopCtx)
+	if Params.ColdTierEnabled {
+		s.serverLoopWg.Add(2)
+		go s.startQueryNodeStatsLoop(s.serverLoopCtx)
+		go s.startColdTierLoop(s.serverLoopCtx)
+	}
 }
 
 func (s *Server) startStatsChannel(ctx context.Context) {
@@ -321,6 +334,12 @@ func (s *Server) startStatsChannel(ctx context.Context) {
 			ssMsg := msg.(*msgstream.SegmentStatisticsMsg)
 			for _, stat := range ssMsg.SegStats {
 				s.meta.SetCurrentRows(stat.GetSegmentID(), stat.GetNumRows())
+				if stat.GetBinlogSize() == 0 {
+					continue
+				}
+				if err := s.meta.SetBinlogSize(stat.GetSegmentID(), stat.GetBinlogSize(), stat.GetRawBinlogSize()); err != nil {
+					log.Warn("failed to save binlog size of segment", zap.Int64("segmentID", stat.GetSegmentID()), zap.Error(err))
+				}
 			}
 		}
 	}
@@ -606,5 +625,13 @@ func (s *Server) prepareBinlog(req *datapb.SaveBinlogPathsRequest) (map[string]s
 		}
 	}
 
+	deltalogMeta, err := s.prepareDeltalogMeta(req.SegmentID, req.Deltalogs)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range deltalogMeta {
+		meta[k] = v
+	}
+
 	return meta, nil
 }
@@ -272,6 +272,62 @@ func TestGetSegmentInfo(t *testing.T) {
 	assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 }
 
+func TestExport(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	t.Run("test export without output path", func(t *testing.T) {
+		resp, err := svr.Export(context.TODO(), &datapb.ExportRequest{CollectionID: 0})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("test export to the paths out of the export root", func(t *testing.T) {
+		for _, outputPath := range []string{"/tmp/export", "../export", "a/../../export"} {
+			resp, err := svr.Export(context.TODO(), &datapb.ExportRequest{CollectionID: 0, OutputPath: outputPath})
+			assert.Nil(t, err)
+			assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		}
+	})
+
+	t.Run("test export collection without flushed segments", func(t *testing.T) {
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: newTestSchema()})
+		resp, err := svr.Export(context.TODO(), &datapb.ExportRequest{CollectionID: 0, OutputPath: "test_export"})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		var stateResp *datapb.GetExportStateResponse
+		assert.Eventually(t, func() bool {
+			stateResp, err = svr.GetExportState(context.TODO(), &datapb.GetExportStateRequest{TaskID: resp.GetTaskID()})
+			assert.Nil(t, err)
+			assert.EqualValues(t, commonpb.ErrorCode_Success, stateResp.Status.ErrorCode)
+			return stateResp.GetState() == commonpb.ExportState_ExportCompleted
+		}, 10*time.Second, 10*time.Millisecond)
+		assert.EqualValues(t, 0, len(stateResp.GetFiles()))
+		assert.EqualValues(t, 0, stateResp.GetNumRows())
+
+		// the task is not seen from another collection
+		stateResp, err = svr.GetExportState(context.TODO(), &datapb.GetExportStateRequest{TaskID: resp.GetTaskID(), CollectionID: 1})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, stateResp.Status.ErrorCode)
+	})
+
+	t.Run("test export tasks are reloaded", func(t *testing.T) {
+		err := svr.exportTasks.save(&datapb.ExportTaskInfo{TaskID: 100, State: commonpb.ExportState_ExportInProgress})
+		assert.Nil(t, err)
+		err = svr.exportTasks.save(&datapb.ExportTaskInfo{TaskID: 101, State: commonpb.ExportState_ExportCompleted,
+			FinishTime: time.Now().Add(-2 * exportTaskRetention).Unix()})
+		assert.Nil(t, err)
+
+		tasks, err := newExportTasks(svr.kvClient)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ExportState_ExportFailed, tasks.get(100).GetState())
+		tasks.removeExpired(time.Now())
+		assert.NotNil(t, tasks.get(100))
+		assert.Nil(t, tasks.get(101))
+	})
+}
+
 func TestChannel(t *testing.T) {
 	svr := newTestServer(t, nil)
 	defer closeTestServer(t, svr)
@@ -862,6 +918,7 @@ func TestGetRecoveryInfo(t *testing.T) {
 					},
 				},
 			},
+			Deltalogs: []string{"/deltalog/file1"},
 		}
 		meta, err := svr.prepareBinlog(binlogReq)
 		assert.Nil(t, err)
@@ -880,6 +937,7 @@ func TestGetRecoveryInfo(t *testing.T) {
 		assert.EqualValues(t, 1, len(resp.GetBinlogs()[0].GetFieldBinlogs()))
 		assert.EqualValues(t, 1, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetFieldID())
 		assert.ElementsMatch(t, []string{"/binlog/file1", "/binlog/file2"}, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetBinlogs())
+		assert.EqualValues(t, []string{"/deltalog/file1"}, resp.GetBinlogs()[0].GetDeltalogs())
 	})
 }
 
@@ -14,12 +14,17 @@ package datanode
 import (
 	"context"
 	"fmt"
+	"math"
+	"path"
+	"strconv"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 
@@ -136,6 +141,7 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 			Field2BinlogPaths: id2path,
 			CheckPoints:       checkPoints,
 			StartPositions:    fu.startPositions,
+			Deltalogs:         fu.deltalogs,
 			Flushed:           fu.flushed,
 		}
 		rsp, err := dsService.dataCoord.SaveBinlogPaths(dsService.ctx, req)
@@ -167,6 +173,7 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 	)
 
 	// recover segment checkpoints
+	var recovered []*datapb.SegmentInfo
 	for _, us := range vchanInfo.GetUnflushedSegments() {
 		if us.CollectionID != dsService.collectionID ||
 			us.GetInsertChannel() != vchanInfo.ChannelName {
@@ -187,6 +194,32 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 
 		dsService.replica.addNormalSegment(us.GetID(), us.CollectionID, us.PartitionID, us.GetInsertChannel(),
 			us.GetNumOfRows(), &segmentCheckPoint{us.GetNumOfRows(), *us.GetDmlPosition()})
+		recovered = append(recovered, us)
+	}
+
+	// recover flushed segments, the deletes of their rows are flushed as delta logs
+	if len(vchanInfo.GetFlushedSegments()) > 0 {
+		resp, err := dsService.dataCoord.GetSegmentInfo(dsService.ctx, &datapb.GetSegmentInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_SegmentInfo,
+				SourceID: Params.NodeID,
+			},
+			SegmentIDs: vchanInfo.GetFlushedSegments(),
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("get flushed segments info failed, reason = %s", resp.GetStatus().GetReason())
+		}
+		for _, fs := range resp.GetInfos() {
+			dsService.replica.addFlushedSegment(fs.GetID(), fs.GetCollectionID(), fs.GetPartitionID(),
+				fs.GetInsertChannel(), fs.GetNumOfRows())
+			recovered = append(recovered, fs)
+		}
+	}
+	if err := dsService.recoverPKRanges(recovered); err != nil {
+		return err
 	}
 
 	dsService.fg.AddNode(dmStreamNode)
@@ -224,3 +257,60 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 	}
 	return nil
 }
+
+// recoverPKRanges loads the primary key ranges of the recovered segments from the stats logs of their flushed
+// binlogs, so that the deletes are buffered only for the segments which may have the rows
+func (dsService *dataSyncService) recoverPKRanges(segments []*datapb.SegmentInfo) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	schema, err := dsService.replica.getCollectionSchema(dsService.collectionID, 0)
+	if err != nil {
+		return err
+	}
+	pkFieldID := primaryKeyFieldID(schema)
+	statsKV, err := newStorageKV(dsService.ctx)
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		minPK, maxPK, err := loadPKRange(statsKV, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), pkFieldID)
+		if err != nil {
+			return fmt.Errorf("load primary key range of segment %d failed, %s", segment.GetID(), err.Error())
+		}
+		dsService.replica.setPKRange(segment.GetID(), minPK, maxPK)
+	}
+	return nil
+}
+
+// loadPKRange merges the primary key stats of all the flushed binlogs of a segment, minPK > maxPK if the segment
+// has no flushed rows
+func loadPKRange(statsKV kv.BaseKV, collID, partitionID, segID, pkFieldID UniqueID) (minPK, maxPK int64, err error) {
+	// prefix/id/ instead of prefix/id
+	prefix := path.Join(Params.StatsBinlogRootPath, strconv.FormatInt(collID, 10), strconv.FormatInt(partitionID, 10),
+		strconv.FormatInt(segID, 10), strconv.FormatInt(pkFieldID, 10)) + "/"
+	keys, values, err := statsKV.LoadWithPrefix(prefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(values) != len(keys) {
+		return 0, 0, fmt.Errorf("load stats logs with prefix %s failed", prefix)
+	}
+	minPK, maxPK = math.MaxInt64, math.MinInt64
+	for _, value := range values {
+		// the stats of an empty binlog are empty
+		if value == "" {
+			continue
+		}
+		reader := &storage.StatsReader{}
+		reader.SetBuffer([]byte(value))
+		stats := reader.GetInt64Stats()
+		if stats.Min < minPK {
+			minPK = stats.Min
+		}
+		if stats.Max > maxPK {
+			maxPK = stats.Max
+		}
+	}
+	return minPK, maxPK, nil
+}
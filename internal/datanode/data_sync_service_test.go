@@ -14,11 +14,13 @@ package datanode
 import (
 	"context"
 	"math"
+	"path"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	localkv "github.com/milvus-io/milvus/internal/kv/local"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -138,3 +140,25 @@ func TestDataSyncService_Start(t *testing.T) {
 
 	sync.close()
 }
+
+func TestLoadPKRange(t *testing.T) {
+	statsKV, err := localkv.NewLocalKV(t.TempDir())
+	assert.Nil(t, err)
+
+	minPK, maxPK, err := loadPKRange(statsKV, 1, 2, 3, 100)
+	assert.Nil(t, err)
+	assert.Greater(t, minPK, maxPK)
+
+	prefix := path.Join(Params.StatsBinlogRootPath, "1", "2", "3", "100")
+	err = statsKV.MultiSave(map[string]string{
+		path.Join(prefix, "10"): `{"max":20,"min":5}`,
+		path.Join(prefix, "11"): `{"max":30,"min":10}`,
+		path.Join(prefix, "12"): "",
+		path.Join(Params.StatsBinlogRootPath, "1", "2", "3", "101", "10"): `{"max":100,"min":0}`,
+	})
+	assert.Nil(t, err)
+	minPK, maxPK, err = loadPKRange(statsKV, 1, 2, 3, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), minPK)
+	assert.Equal(t, int64(30), maxPK)
+}
@@ -74,6 +74,7 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var iMsg = insertMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msMsg.TimestampMin(),
 			timestampMax: msMsg.TimestampMax(),
@@ -90,6 +91,17 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				ddn.clearSignal <- ddn.collectionID
 				return []Msg{}
 			}
+		case commonpb.MsgType_AddField:
+			if msg.(*msgstream.AddFieldMsg).GetCollectionID() == ddn.collectionID {
+				log.Info("Collection schema changed", zap.Any("collectionID", ddn.collectionID),
+					zap.Int32("schemaVersion", msg.(*msgstream.AddFieldMsg).GetSchemaVersion()))
+			}
+		case commonpb.MsgType_RenameCollection:
+			// data is organized by collection id, nothing changes but the name
+			if msg.(*msgstream.RenameCollectionMsg).GetCollectionID() == ddn.collectionID {
+				log.Info("Collection renamed", zap.Any("collectionID", ddn.collectionID),
+					zap.String("newName", msg.(*msgstream.RenameCollectionMsg).GetNewName()))
+			}
 		case commonpb.MsgType_Insert:
 			log.Debug("DDNode with insert messages")
 			if msg.EndTs() < FilterThreshold {
@@ -102,6 +114,11 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				}
 			}
 			iMsg.insertMessages = append(iMsg.insertMessages, msg.(*msgstream.InsertMsg))
+		case commonpb.MsgType_Delete:
+			if msg.(*msgstream.DeleteMsg).GetCollectionID() == ddn.collectionID {
+				log.Debug("DDNode with delete messages")
+				iMsg.deleteMessages = append(iMsg.deleteMessages, msg.(*msgstream.DeleteMsg))
+			}
 		}
 	}
 
@@ -24,11 +24,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
+	localkv "github.com/milvus-io/milvus/internal/kv/local"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 
@@ -52,12 +54,15 @@ type insertBufferNode struct {
 	BaseNode
 	channelName  string
 	insertBuffer *insertBuffer
+	deleteBuffer map[UniqueID]*storage.DeleteData // SegmentID to the deletes of the rows of the segment
 	replica      Replica
 	idAllocator  allocatorInterface
 	flushMap     sync.Map
 	flushChan    <-chan *flushMsg
 
 	minIOKV kv.BaseKV
+	// keyManager encrypts the binlogs, it's nil if encryption is disabled
+	keyManager *storage.KeyManager
 
 	timeTickStream          msgstream.MsgStream
 	segmentStatisticsStream msgstream.MsgStream
@@ -78,6 +83,7 @@ type segmentFlushUnit struct {
 	field2Path     map[UniqueID]string
 	checkPoint     map[UniqueID]segmentCheckPoint
 	startPositions []*datapb.SegmentStartPosition
+	deltalogs      []string
 	flushed        bool
 }
 
@@ -234,6 +240,10 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			log.Error("Get schema wrong:", zap.Error(err))
 			continue
 		}
+		// fields added to the collection after rows are buffered are filled for the buffered rows
+		if ok {
+			storage.FillDefaultFieldsData(collSchema, idata)
+		}
 
 		// 1.2 Get Fields
 		var pos int = 0 // Record position of blob
@@ -481,10 +491,20 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		// 1.3 store in buffer
 		ibNode.insertBuffer.insertData[currentSegID] = idata
 
+		// 1.4 track the primary key range of the segment to route the deletes
+		if pkData, ok := idata.Data[primaryKeyFieldID(collSchema)].(*storage.Int64FieldData); ok && len(pkData.Data) >= len(msg.RowIDs) {
+			ibNode.replica.updatePKRange(currentSegID, pkData.Data[len(pkData.Data)-len(msg.RowIDs):])
+		}
+
 		// store current endPositions as Segment->EndPostion
 		ibNode.replica.updateSegmentEndPosition(currentSegID, iMsg.endPositions[0])
 	}
 
+	// 2. deletes -> buffer
+	for _, msg := range iMsg.deleteMessages {
+		ibNode.bufferDelete(msg)
+	}
+
 	if len(iMsg.insertMessages) > 0 {
 		log.Debug("---insert buffer status---")
 		var stopSign int = 0
@@ -528,6 +548,12 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	}
 	finishCnt.Wait()
 	close(finishCh)
+	if len(finishCh) > 0 {
+		// the check points move forward, the buffered deletes of the flushed segments are not replayed any more
+		ibNode.flushFlushedSegmentsDelta(0)
+	} else {
+		ibNode.flushFlushedSegmentsDelta(Params.FlushDeleteBufferSize)
+	}
 	for fu := range finishCh {
 		if fu.field2Path == nil {
 			log.Debug("segment is empty")
@@ -535,6 +561,7 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		}
 		fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
 		fu.flushed = false
+		fu.deltalogs = ibNode.flushDelta(fu.segID)
 		if err := ibNode.dsSaveBinlog(&fu); err != nil {
 			log.Debug("data service save bin log path failed", zap.Error(err))
 		}
@@ -548,6 +575,7 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			zap.Int64("segmentID", currentSegID),
 			zap.Int64("collectionID", fmsg.collectionID),
 		)
+		ibNode.flushFlushedSegmentsDelta(0)
 
 		if ibNode.insertBuffer.size(currentSegID) <= 0 {
 			log.Debug(".. Buffer empty ...")
@@ -556,9 +584,11 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				segID:      currentSegID,
 				field2Path: map[UniqueID]string{},
 				checkPoint: ibNode.replica.listSegmentsCheckPoints(),
+				deltalogs:  ibNode.flushDelta(currentSegID),
 				flushed:    true,
 			})
 			ibNode.replica.segmentFlushed(currentSegID)
+			ibNode.releaseSegmentKey(currentSegID)
 			fmsg.dmlFlushedCh <- []*datapb.ID2PathList{{ID: currentSegID, Paths: []string{}}}
 		} else { //insertBuffer(not empty) -> binLogs -> minIO/S3
 			log.Debug(".. Buffer not empty, flushing ..")
@@ -596,11 +626,17 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			close(finishCh)
 			if fu.field2Path != nil {
 				fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
+				fu.deltalogs = ibNode.flushDelta(fu.segID)
 				fu.flushed = true
 				if err := ibNode.dsSaveBinlog(&fu); err != nil {
 					log.Debug("Data service save binlog path failed", zap.Error(err))
 				} else {
+					// report the final binlog size before the segment is removed from the statistics
+					if err := ibNode.updateSegStatistics([]UniqueID{fu.segID}); err != nil {
+						log.Error("update segment statistics error", zap.Error(err))
+					}
 					ibNode.replica.segmentFlushed(fu.segID)
+					ibNode.releaseSegmentKey(fu.segID)
 				}
 			}
 			fmsg.dmlFlushedCh <- []*datapb.ID2PathList{{ID: currentSegID, Paths: []string{}}}
@@ -621,6 +657,14 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	return nil
 }
 
+// releaseSegmentKey drops the cached data key of a flushed segment, the delta logs saved for it later are
+// encrypted by a new data key
+func (ibNode *insertBufferNode) releaseSegmentKey(segID UniqueID) {
+	if ibNode.keyManager != nil {
+		ibNode.keyManager.ReleaseSegment(segID)
+	}
+}
+
 func flushSegment(
 	collMeta *etcdpb.CollectionMeta,
 	segID, partitionID, collID UniqueID,
@@ -645,6 +689,8 @@ func flushSegment(
 	}
 
 	inCodec := storage.NewInsertCodec(collMeta)
+	inCodec.Compression = Params.BinlogCompression
+	inCodec.KeyManager = ibNode.keyManager
 
 	// buffer data to binlogs
 	data, ok := insertData.Load(segID)
@@ -654,18 +700,47 @@ func flushSegment(
 		return
 	}
 
-	binLogs, statsBinlogs, err := inCodec.Serialize(partitionID, segID, data.(*InsertData))
+	var binLogs, statsBinlogs []*storage.Blob
+	var segmentFile *storage.Blob
+	var err error
+	if Params.BinlogFormat == storage.BinlogFormatV2 {
+		segmentFile, statsBinlogs, err = inCodec.SerializeSegmentFile(partitionID, segID, data.(*InsertData), Params.RowGroupSize)
+	} else {
+		binLogs, statsBinlogs, err = inCodec.Serialize(partitionID, segID, data.(*InsertData))
+	}
 	if err != nil {
 		log.Error("Flush failed ... cannot generate binlog ..", zap.Error(err))
 		clearFn(false)
 		return
 	}
 
-	log.Debug(".. Saving binlogs to MinIO ..", zap.Int("number", len(binLogs)))
-	field2Path := make(map[UniqueID]string, len(binLogs))
-	kvs := make(map[string]string, len(binLogs))
-	paths := make([]string, 0, len(binLogs))
-	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
+	log.Debug(".. Saving binlogs to MinIO ..", zap.Int("number", len(binLogs)), zap.String("format", Params.BinlogFormat))
+	field2Path := make(map[UniqueID]string, len(statsBinlogs))
+	kvs := make(map[string]string, len(statsBinlogs)+1)
+	paths := make([]string, 0, len(binLogs)+1)
+	field2Logidx := make(map[UniqueID]UniqueID, len(statsBinlogs))
+	var binlogSize, rawBinlogSize int64
+
+	// write segment file, which is the binlog of all the fields
+	if segmentFile != nil {
+		logidx, err := idAllocator.allocID()
+		if err != nil {
+			log.Error("Flush failed ... cannot alloc ID ..", zap.Error(err))
+			clearFn(false)
+			return
+		}
+
+		k, _ := idAllocator.genKey(false, collID, partitionID, segID)
+		key := path.Join(Params.InsertBinlogRootPath, k, storage.SegmentFileDir, strconv.FormatInt(logidx, 10))
+		paths = append(paths, key)
+		kvs[key] = string(segmentFile.Value)
+		for _, field := range collMeta.Schema.Fields {
+			field2Path[field.FieldID] = key
+			field2Logidx[field.FieldID] = logidx
+		}
+		binlogSize += int64(len(segmentFile.Value))
+		rawBinlogSize += segmentFile.RawSize
+	}
 
 	// write insert binlog
 	for _, blob := range binLogs {
@@ -692,6 +767,8 @@ func flushSegment(
 		kvs[key] = string(blob.Value[:])
 		field2Path[fieldID] = key
 		field2Logidx[fieldID] = logidx
+		binlogSize += int64(len(blob.Value))
+		rawBinlogSize += blob.RawSize
 	}
 
 	// write stats binlog
@@ -722,11 +799,106 @@ func flushSegment(
 	}
 
 	ibNode.replica.updateSegmentCheckPoint(segID)
+	if err := ibNode.replica.updateBinlogSize(segID, binlogSize, rawBinlogSize); err != nil {
+		log.Warn("update binlog size of segment failed", zap.Int64("segmentID", segID), zap.Error(err))
+	}
+	log.Debug("binlogs of segment are saved", zap.Int64("segmentID", segID),
+		zap.Int64("binlogSize", binlogSize), zap.Int64("rawBinlogSize", rawBinlogSize))
 	startPos := ibNode.replica.listNewSegmentsStartPositions()
 	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, startPositions: startPos}
 	clearFn(true)
 }
 
+// primaryKeyFieldID returns the field of the primary keys deletes refer to, which is the row id if the
+// collection has no int64 primary key field
+func primaryKeyFieldID(schema *schemapb.CollectionSchema) UniqueID {
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey && field.DataType == schemapb.DataType_Int64 {
+			return field.FieldID
+		}
+	}
+	return 0 // rowIDs
+}
+
+// bufferDelete buffers the deletes for all the segments which may have the rows of the primary keys
+func (ibNode *insertBufferNode) bufferDelete(msg *msgstream.DeleteMsg) {
+	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+		log.Error("misaligned delete messages detected")
+		return
+	}
+	for i, pk := range msg.PrimaryKeys {
+		for _, segID := range ibNode.replica.filterSegmentsByPK(pk) {
+			data, ok := ibNode.deleteBuffer[segID]
+			if !ok {
+				data = &storage.DeleteData{}
+				ibNode.deleteBuffer[segID] = data
+			}
+			data.Append(pk, msg.Timestamps[i])
+		}
+	}
+}
+
+// flushDelta saves the buffered deletes of the segment as a delta log, it returns the path of the delta log,
+// the deletes are kept in buffer if the delta log fails to save
+func (ibNode *insertBufferNode) flushDelta(segID UniqueID) []string {
+	data, ok := ibNode.deleteBuffer[segID]
+	if !ok || data.RowCount() == 0 {
+		return nil
+	}
+
+	collID, partitionID, err := ibNode.getCollectionandPartitionIDbySegID(segID)
+	if err != nil {
+		log.Error("Flush delta failed .. cannot get segment ..", zap.Int64("segmentID", segID), zap.Error(err))
+		return nil
+	}
+	deleteCodec := storage.NewDeleteCodec()
+	deleteCodec.Compression = Params.BinlogCompression
+	deleteCodec.KeyManager = ibNode.keyManager
+	blob, err := deleteCodec.Serialize(collID, partitionID, segID, data)
+	if err != nil {
+		log.Error("Flush delta failed .. cannot generate delta log ..", zap.Int64("segmentID", segID), zap.Error(err))
+		return nil
+	}
+
+	logidx, err := ibNode.idAllocator.allocID()
+	if err != nil {
+		log.Error("Flush delta failed .. cannot alloc ID ..", zap.Int64("segmentID", segID), zap.Error(err))
+		return nil
+	}
+	// no error raise if alloc=false
+	k, _ := ibNode.idAllocator.genKey(false, collID, partitionID, segID, logidx)
+	key := path.Join(Params.DeltaBinlogRootPath, k)
+	if err := ibNode.minIOKV.Save(key, string(blob.Value)); err != nil {
+		log.Error("Flush delta failed .. cannot save to MinIO ..", zap.Int64("segmentID", segID), zap.Error(err))
+		return nil
+	}
+	log.Debug("delta log of segment is saved", zap.Int64("segmentID", segID), zap.Int("deletes", data.RowCount()))
+	delete(ibNode.deleteBuffer, segID)
+	return []string{key}
+}
+
+// flushFlushedSegmentsDelta saves the delta logs of the flushed segments which have at least minRowCount
+// buffered deletes, a flushed segment is not flushed again, so its delta logs are saved on their own
+func (ibNode *insertBufferNode) flushFlushedSegmentsDelta(minRowCount int64) {
+	for segID, data := range ibNode.deleteBuffer {
+		if int64(data.RowCount()) < minRowCount || ibNode.replica.hasSegment(segID, false) {
+			continue
+		}
+		deltalogs := ibNode.flushDelta(segID)
+		if deltalogs == nil {
+			continue
+		}
+		if err := ibNode.dsSaveBinlog(&segmentFlushUnit{
+			collID:     ibNode.replica.getCollectionID(),
+			segID:      segID,
+			field2Path: map[UniqueID]string{},
+			deltalogs:  deltalogs,
+		}); err != nil {
+			log.Debug("data service save delta log path failed", zap.Int64("segmentID", segID), zap.Error(err))
+		}
+	}
+}
+
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp) error {
 	msgPack := msgstream.MsgPack{}
 	timeTickMsg := msgstream.DataNodeTtMsg{
@@ -762,6 +934,8 @@ func (ibNode *insertBufferNode) updateSegStatistics(segIDs []UniqueID) error {
 		log.Debug("Segment Statistics to Update",
 			zap.Int64("Segment ID", updates.GetSegmentID()),
 			zap.Int64("NumOfRows", updates.GetNumRows()),
+			zap.Int64("BinlogSize", updates.GetBinlogSize()),
+			zap.Int64("RawBinlogSize", updates.GetRawBinlogSize()),
 		)
 
 		statsUpdates = append(statsUpdates, updates)
@@ -812,6 +986,23 @@ func (ibNode *insertBufferNode) getCollectionandPartitionIDbySegID(segmentID Uni
 	return ibNode.replica.getCollectionAndPartitionID(segmentID)
 }
 
+// newStorageKV connects to MinIO, or the local file system in standalone mode, where the binlogs are saved
+func newStorageKV(ctx context.Context) (kv.BaseKV, error) {
+	if Params.StorageType == paramtable.StorageTypeLocal {
+		return localkv.NewLocalKV(Params.StoragePath)
+	}
+	return miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+		ColdBucketName:    Params.ColdBucketName,
+		ColdRootPath:      Params.ColdRootPath,
+	})
+}
+
 func newInsertBufferNode(
 	ctx context.Context,
 	replica Replica,
@@ -835,17 +1026,11 @@ func newInsertBufferNode(
 		maxSize:    maxSize,
 	}
 
-	// MinIO
-	option := &miniokv.Option{
-		Address:           Params.MinioAddress,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSL,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
+	minIOKV, err := newStorageKV(ctx)
+	if err != nil {
+		panic(err)
 	}
-
-	minIOKV, err := miniokv.NewMinIOKV(ctx, option)
+	keyManager, err := storage.NewKeyManagerFromKeyFile(Params.EncryptionKeyFile)
 	if err != nil {
 		panic(err)
 	}
@@ -867,7 +1052,9 @@ func newInsertBufferNode(
 	return &insertBufferNode{
 		BaseNode:     baseNode,
 		insertBuffer: iBuffer,
+		deleteBuffer: make(map[UniqueID]*storage.DeleteData),
 		minIOKV:      minIOKV,
+		keyManager:   keyManager,
 		channelName:  channelName,
 
 		timeTickStream:          wTtMsgStream,
@@ -197,6 +197,91 @@ func TestFlushSegment(t *testing.T) {
 	_, values, _ := mockMinIO.LoadWithPrefix(key)
 	assert.Equal(t, len(values), 1)
 	assert.Equal(t, values[0], `{"max":9,"min":0}`)
+
+	// all the fields are written into one segment file with binlog format v2
+	Params.BinlogFormat = storage.BinlogFormatV2
+	defer func() { Params.BinlogFormat = storage.BinlogFormatV1 }()
+	flushMap.Store(segmentID, insertData)
+	flushSegment(collMeta,
+		segmentID,
+		partitionID,
+		collectionID,
+		&flushMap,
+		mockMinIO,
+		finishCh,
+		nil,
+		ibNode,
+		idAllocMock)
+
+	fu = <-finishCh
+	assert.Equal(t, len(collMeta.Schema.Fields), len(fu.field2Path))
+	segmentFileKey := fu.field2Path[0]
+	assert.True(t, storage.IsSegmentFileKey(segmentFileKey))
+	for _, p := range fu.field2Path {
+		assert.Equal(t, segmentFileKey, p)
+	}
+	value, err := mockMinIO.Load(segmentFileKey)
+	assert.Nil(t, err)
+	assert.True(t, storage.IsSegmentFile([]byte(value)))
+}
+
+func TestFlushDelta(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	collMeta := genCollectionMeta(1, "test_flush_delta")
+	replica := newReplica(&RootCoordFactory{}, collMeta.ID)
+
+	err := replica.addNewSegment(10, collMeta.ID, 2, "insert-01", &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
+	require.NoError(t, err)
+	replica.updatePKRange(10, []int64{100, 200})
+	err = replica.addFlushedSegment(20, collMeta.ID, 2, "insert-01", 10)
+	require.NoError(t, err)
+
+	msFactory := msgstream.NewPmsFactory()
+	err = msFactory.SetParams(map[string]interface{}{
+		"receiveBufSize": 1024,
+		"pulsarAddress":  Params.PulsarAddress,
+		"pulsarBufSize":  1024})
+	assert.Nil(t, err)
+	var saved []*segmentFlushUnit
+	saveBinlog := func(fu *segmentFlushUnit) error {
+		saved = append(saved, fu)
+		return nil
+	}
+	ibNode := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), make(chan *flushMsg, 100), saveBinlog, "string")
+	mockMinIO := memkv.NewMemoryKV()
+	ibNode.minIOKV = mockMinIO
+
+	ibNode.bufferDelete(&msgstream.DeleteMsg{
+		DeleteRequest: internalpb.DeleteRequest{
+			CollectionID: collMeta.ID,
+			PrimaryKeys:  []int64{150, 300},
+			Timestamps:   []uint64{1000, 1001},
+		},
+	})
+	// the range of the primary keys of the flushed segment is unknown, it gets all the deletes
+	assert.Equal(t, []int64{150}, ibNode.deleteBuffer[10].Pks)
+	assert.Equal(t, []int64{150, 300}, ibNode.deleteBuffer[20].Pks)
+
+	deltalogs := ibNode.flushDelta(10)
+	assert.Equal(t, 1, len(deltalogs))
+	assert.Nil(t, ibNode.flushDelta(10))
+	value, err := mockMinIO.Load(deltalogs[0])
+	assert.Nil(t, err)
+	partitionID, segmentID, data, err := storage.NewDeleteCodec().Deserialize([]*Blob{{Key: deltalogs[0], Value: []byte(value)}})
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(2), partitionID)
+	assert.Equal(t, UniqueID(10), segmentID)
+	assert.Equal(t, []int64{150}, data.Pks)
+	assert.Equal(t, []Timestamp{1000}, data.Tss)
+
+	ibNode.flushFlushedSegmentsDelta(Params.FlushDeleteBufferSize)
+	assert.Equal(t, 0, len(saved))
+	ibNode.flushFlushedSegmentsDelta(0)
+	assert.Equal(t, 1, len(saved))
+	assert.Equal(t, UniqueID(20), saved[0].segID)
+	assert.Equal(t, 1, len(saved[0].deltalogs))
+	assert.Equal(t, 0, len(ibNode.deleteBuffer))
 }
 
 func genCollectionMeta(collectionID UniqueID, collectionName string) *etcdpb.CollectionMeta {
@@ -12,12 +12,14 @@
 package datanode
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -31,8 +33,13 @@ type ParamTable struct {
 	FlowGraphMaxQueueLength int32
 	FlowGraphMaxParallelism int32
 	FlushInsertBufferSize   int64
+	FlushDeleteBufferSize   int64
+	BinlogCompression       storage.CompressionType
+	BinlogFormat            string
+	RowGroupSize            int
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
+	DeltaBinlogRootPath     string
 	Log                     log.Config
 	Alias                   string // Different datanode in one machine
 
@@ -62,6 +69,13 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSL          bool
 	MinioBucketName      string
+
+	// --- Storage ---
+	StorageType       string
+	StoragePath       string
+	EncryptionKeyFile string
+	ColdBucketName    string
+	ColdRootPath      string
 }
 
 var Params ParamTable
@@ -83,8 +97,13 @@ func (p *ParamTable) Init() {
 		p.initFlowGraphMaxQueueLength()
 		p.initFlowGraphMaxParallelism()
 		p.initFlushInsertBufferSize()
+		p.initFlushDeleteBufferSize()
+		p.initBinlogCompression()
+		p.initBinlogFormat()
+		p.initRowGroupSize()
 		p.initInsertBinlogRootPath()
 		p.initStatsBinlogRootPath()
+		p.initDeltaBinlogRootPath()
 		p.initLogCfg()
 
 		// === DataNode External Components Configs ===
@@ -109,6 +128,9 @@ func (p *ParamTable) Init() {
 		p.initMinioSecretAccessKey()
 		p.initMinioUseSSL()
 		p.initMinioBucketName()
+
+		// --- Storage ---
+		p.initStorage()
 	})
 }
 
@@ -127,6 +149,46 @@ func (p *ParamTable) initFlushInsertBufferSize() {
 	p.FlushInsertBufferSize = p.ParseInt64("datanode.flush.insertBufSize")
 }
 
+func (p *ParamTable) initFlushDeleteBufferSize() {
+	p.FlushDeleteBufferSize = p.ParseInt64("datanode.flush.deleteBufSize")
+}
+
+func (p *ParamTable) initBinlogCompression() {
+	name, err := p.Load("dataNode.flush.binlogCompression")
+	if err != nil {
+		name = ""
+	}
+	compression, err := storage.ParseCompressionType(name)
+	if err != nil {
+		panic(err)
+	}
+	p.BinlogCompression = compression
+}
+
+func (p *ParamTable) initBinlogFormat() {
+	format, err := p.Load("dataNode.flush.binlogFormat")
+	if err != nil {
+		format = storage.BinlogFormatV1
+	}
+	if format != storage.BinlogFormatV1 && format != storage.BinlogFormatV2 {
+		panic(fmt.Errorf("unknown binlog format %s", format))
+	}
+	p.BinlogFormat = format
+}
+
+func (p *ParamTable) initRowGroupSize() {
+	size, err := p.Load("dataNode.flush.rowGroupSize")
+	if err != nil {
+		// all the rows of a flush are put into one row group
+		p.RowGroupSize = 0
+		return
+	}
+	p.RowGroupSize, err = strconv.Atoi(size)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (p *ParamTable) initInsertBinlogRootPath() {
 	// GOOSE TODO: rootPath change to  TenentID
 	rootPath, err := p.Load("etcd.rootPath")
@@ -144,6 +206,14 @@ func (p *ParamTable) initStatsBinlogRootPath() {
 	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
 }
 
+func (p *ParamTable) initDeltaBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DeltaBinlogRootPath = path.Join(rootPath, "delta_log")
+}
+
 // ---- Pulsar ----
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
@@ -249,6 +319,12 @@ func (p *ParamTable) initMinioBucketName() {
 	p.MinioBucketName = bucketName
 }
 
+func (p *ParamTable) initStorage() {
+	p.StorageType, p.StoragePath = p.LoadStorage()
+	p.EncryptionKeyFile = p.LoadEncryptionKeyFile()
+	p.ColdBucketName, p.ColdRootPath = p.LoadColdTier()
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.Log = log.Config{}
 	format, err := p.Load("log.format")
@@ -44,11 +44,26 @@ func TestParamTable_DataNode(t *testing.T) {
 		log.Println("FlushInsertBufferSize:", size)
 	})
 
+	t.Run("Test FlushDeleteBufSize", func(t *testing.T) {
+		size := Params.FlushDeleteBufferSize
+		log.Println("FlushDeleteBufferSize:", size)
+	})
+
+	t.Run("Test BinlogCompression", func(t *testing.T) {
+		compression := Params.BinlogCompression
+		log.Println("BinlogCompression:", compression)
+	})
+
 	t.Run("Test InsertBinlogRootPath", func(t *testing.T) {
 		path := Params.InsertBinlogRootPath
 		log.Println("InsertBinlogRootPath:", path)
 	})
 
+	t.Run("Test DeltaBinlogRootPath", func(t *testing.T) {
+		path := Params.DeltaBinlogRootPath
+		log.Println("DeltaBinlogRootPath:", path)
+	})
+
 	t.Run("Test PulsarAddress", func(t *testing.T) {
 		address := Params.PulsarAddress
 		log.Println("PulsarAddress:", address)
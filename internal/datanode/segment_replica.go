@@ -33,13 +33,18 @@ type Replica interface {
 
 	addNewSegment(segID, collID, partitionID UniqueID, channelName string, startPos, endPos *internalpb.MsgPosition) error
 	addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, cp *segmentCheckPoint) error
+	addFlushedSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64) error
 	listNewSegmentsStartPositions() []*datapb.SegmentStartPosition
 	listSegmentsCheckPoints() map[UniqueID]segmentCheckPoint
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
 	hasSegment(segID UniqueID, countFlushed bool) bool
+	updatePKRange(segID UniqueID, pks []int64)
+	setPKRange(segID UniqueID, minPK, maxPK int64)
+	filterSegmentsByPK(pk int64) []UniqueID
 
 	updateStatistics(segID UniqueID, numRows int64) error
+	updateBinlogSize(segID UniqueID, size, rawSize int64) error
 	getSegmentStatisticsUpdates(segID UniqueID) (*internalpb.SegmentStatisticsUpdates, error)
 	segmentFlushed(segID UniqueID)
 }
@@ -55,6 +60,16 @@ type Segment struct {
 	isFlushed    atomic.Value // bool
 	channelName  string
 
+	// binlogSize and rawBinlogSize are the sizes of the flushed binlogs after and before compression
+	binlogSize    int64
+	rawBinlogSize int64
+
+	// minPK and maxPK are the range of the primary keys inserted into the segment, the range of the segments
+	// recovered from data coord is loaded from the stats logs, an empty range has minPK > maxPK
+	minPK      int64
+	maxPK      int64
+	hasPKRange bool
+
 	checkPoint segmentCheckPoint
 	startPos   *internalpb.MsgPosition // TODO readonly
 	endPos     *internalpb.MsgPosition
@@ -145,6 +160,10 @@ func (replica *SegmentReplica) getCollectionAndPartitionID(segID UniqueID) (coll
 		return seg.collectionID, seg.partitionID, nil
 	}
 
+	if seg, ok := replica.flushedSegments[segID]; ok {
+		return seg.collectionID, seg.partitionID, nil
+	}
+
 	return 0, 0, fmt.Errorf("Cannot find segment, id = %v", segID)
 }
 
@@ -220,6 +239,38 @@ func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID Uniqu
 	return nil
 }
 
+// addFlushedSegment adds a *NotNew* and *Flushed* segment, the deletes of its rows are flushed as delta logs
+func (replica *SegmentReplica) addFlushedSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64) error {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	if collID != replica.collectionID {
+		log.Warn("Mismatch collection", zap.Int64("ID", collID))
+		return fmt.Errorf("Mismatch collection, ID=%d", collID)
+	}
+
+	log.Debug("Add Flushed segment",
+		zap.Int64("segment ID", segID),
+		zap.Int64("collection ID", collID),
+		zap.Int64("partition ID", partitionID),
+		zap.String("channel name", channelName),
+	)
+
+	seg := &Segment{
+		collectionID: collID,
+		partitionID:  partitionID,
+		segmentID:    segID,
+		channelName:  channelName,
+		numRows:      numOfRows,
+	}
+
+	seg.isNew.Store(false)
+	seg.isFlushed.Store(true)
+
+	replica.flushedSegments[segID] = seg
+	return nil
+}
+
 // listNewSegmentsStartPositions gets all *New Segments* start positions and
 //   transfer segments states from *New* to *Normal*.
 func (replica *SegmentReplica) listNewSegmentsStartPositions() []*datapb.SegmentStartPosition {
@@ -298,6 +349,66 @@ func (replica *SegmentReplica) hasSegment(segID UniqueID, countFlushed bool) boo
 	return inNew || inNormal || inFlush
 }
 
+// updatePKRange extends the primary key range of a *New* or *Normal* segment by the inserted primary keys.
+func (replica *SegmentReplica) updatePKRange(segID UniqueID, pks []int64) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	seg, ok := replica.newSegments[segID]
+	if !ok {
+		seg, ok = replica.normalSegments[segID]
+	}
+	if !ok {
+		log.Warn("No match segment", zap.Int64("ID", segID))
+		return
+	}
+
+	for _, pk := range pks {
+		if !seg.hasPKRange {
+			seg.minPK, seg.maxPK, seg.hasPKRange = pk, pk, true
+			continue
+		}
+		if pk < seg.minPK {
+			seg.minPK = pk
+		}
+		if pk > seg.maxPK {
+			seg.maxPK = pk
+		}
+	}
+}
+
+// setPKRange sets the primary key range of a recovered segment, which is loaded from the stats logs of its
+// flushed binlogs, minPK > maxPK means the segment has no flushed rows.
+func (replica *SegmentReplica) setPKRange(segID UniqueID, minPK, maxPK int64) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		if seg, ok := segments[segID]; ok {
+			seg.minPK, seg.maxPK, seg.hasPKRange = minPK, maxPK, true
+			return
+		}
+	}
+	log.Warn("No match segment", zap.Int64("ID", segID))
+}
+
+// filterSegmentsByPK returns the segments which may have the rows of the primary key, including all the segments
+// whose primary key range is unknown.
+func (replica *SegmentReplica) filterSegmentsByPK(pk int64) []UniqueID {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	var result []UniqueID
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		for id, seg := range segments {
+			if !seg.hasPKRange || (pk >= seg.minPK && pk <= seg.maxPK) {
+				result = append(result, id)
+			}
+		}
+	}
+	return result
+}
+
 // updateStatistics updates the number of rows of a segment in replica.
 func (replica *SegmentReplica) updateStatistics(segID UniqueID, numRows int64) error {
 	replica.segMu.Lock()
@@ -319,6 +430,26 @@ func (replica *SegmentReplica) updateStatistics(segID UniqueID, numRows int64) e
 	return fmt.Errorf("There's no segment %v", segID)
 }
 
+// updateBinlogSize adds the sizes of the newly flushed binlogs of a segment in replica.
+func (replica *SegmentReplica) updateBinlogSize(segID UniqueID, size, rawSize int64) error {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	if seg, ok := replica.newSegments[segID]; ok {
+		seg.binlogSize += size
+		seg.rawBinlogSize += rawSize
+		return nil
+	}
+
+	if seg, ok := replica.normalSegments[segID]; ok {
+		seg.binlogSize += size
+		seg.rawBinlogSize += rawSize
+		return nil
+	}
+
+	return fmt.Errorf("There's no segment %v", segID)
+}
+
 // getSegmentStatisticsUpdates gives current segment's statistics updates.
 func (replica *SegmentReplica) getSegmentStatisticsUpdates(segID UniqueID) (*internalpb.SegmentStatisticsUpdates, error) {
 	replica.segMu.Lock()
@@ -329,11 +460,15 @@ func (replica *SegmentReplica) getSegmentStatisticsUpdates(segID UniqueID) (*int
 
 	if seg, ok := replica.newSegments[segID]; ok {
 		updates.NumRows = seg.numRows
+		updates.BinlogSize = seg.binlogSize
+		updates.RawBinlogSize = seg.rawBinlogSize
 		return updates, nil
 	}
 
 	if seg, ok := replica.normalSegments[segID]; ok {
 		updates.NumRows = seg.numRows
+		updates.BinlogSize = seg.binlogSize
+		updates.RawBinlogSize = seg.rawBinlogSize
 		return updates, nil
 	}
 
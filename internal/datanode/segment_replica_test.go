@@ -12,6 +12,7 @@
 package datanode
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -185,9 +186,55 @@ func TestSegmentReplica(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int64(20), updates.NumRows)
 
+		err = replica.updateBinlogSize(1, 100, 400)
+		assert.NoError(t, err)
+		err = replica.updateBinlogSize(1, 50, 200)
+		assert.NoError(t, err)
+		updates, err = replica.getSegmentStatisticsUpdates(1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(150), updates.BinlogSize)
+		assert.Equal(t, int64(600), updates.RawBinlogSize)
+		err = replica.updateBinlogSize(2, 100, 400)
+		assert.Error(t, err)
+
 		replica.updateSegmentCheckPoint(0)
 		assert.Equal(t, int64(10), replica.normalSegments[UniqueID(0)].checkPoint.numRows)
 		replica.updateSegmentCheckPoint(1)
 		assert.Equal(t, int64(20), replica.normalSegments[UniqueID(1)].checkPoint.numRows)
 	})
+
+	t.Run("Test primary key range", func(t *testing.T) {
+		replica := newSegmentReplica(rc, collID)
+		pos := &internalpb.MsgPosition{ChannelName: "insert-01"}
+		err := replica.addNewSegment(0, 1, 2, "insert-01", pos, pos)
+		assert.NoError(t, err)
+		err = replica.addFlushedSegment(1, 1, 2, "insert-01", 10)
+		assert.NoError(t, err)
+		assert.True(t, replica.hasSegment(1, true))
+		assert.False(t, replica.hasSegment(1, false))
+		_, partitionID, err := replica.getCollectionAndPartitionID(1)
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(2), partitionID)
+		err = replica.addFlushedSegment(2, 2, 2, "insert-01", 10)
+		assert.Error(t, err)
+
+		// the range of a segment is unknown until rows are inserted
+		assert.ElementsMatch(t, []UniqueID{0, 1}, replica.filterSegmentsByPK(100))
+		replica.updatePKRange(0, []int64{10, 5, 20})
+		assert.ElementsMatch(t, []UniqueID{1}, replica.filterSegmentsByPK(100))
+		assert.ElementsMatch(t, []UniqueID{0, 1}, replica.filterSegmentsByPK(5))
+		assert.ElementsMatch(t, []UniqueID{0, 1}, replica.filterSegmentsByPK(20))
+
+		// the range is kept after the segment is flushed
+		replica.segmentFlushed(0)
+		assert.ElementsMatch(t, []UniqueID{1}, replica.filterSegmentsByPK(21))
+		assert.ElementsMatch(t, []UniqueID{0, 1}, replica.filterSegmentsByPK(15))
+
+		// the range of a recovered segment is loaded from the stats logs, an empty range matches nothing
+		replica.setPKRange(1, 30, 40)
+		assert.ElementsMatch(t, []UniqueID{0}, replica.filterSegmentsByPK(15))
+		assert.ElementsMatch(t, []UniqueID{1}, replica.filterSegmentsByPK(35))
+		replica.setPKRange(1, math.MaxInt64, math.MinInt64)
+		assert.Empty(t, replica.filterSegmentsByPK(35))
+	})
 }
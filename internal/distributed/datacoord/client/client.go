@@ -252,3 +252,17 @@ func (c *Client) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	})
 	return ret.(*datapb.GetFlushedSegmentsResponse), err
 }
+
+func (c *Client) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.Export(ctx, req)
+	})
+	return ret.(*datapb.ExportResponse), err
+}
+
+func (c *Client) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetExportState(ctx, req)
+	})
+	return ret.(*datapb.GetExportStateResponse), err
+}
@@ -233,3 +233,11 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	return s.dataCoord.GetFlushedSegments(ctx, req)
 }
+
+func (s *Server) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	return s.dataCoord.Export(ctx, req)
+}
+
+func (s *Server) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return s.dataCoord.GetExportState(ctx, req)
+}
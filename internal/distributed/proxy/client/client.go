@@ -159,3 +159,17 @@ func (c *Client) ReleaseDQLMessageStream(ctx context.Context, req *proxypb.Relea
 	})
 	return ret.(*commonpb.Status), err
 }
+
+func (c *Client) InvalidateCredentialCache(ctx context.Context, req *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.InvalidateCredentialCache(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *Client) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.RefreshPolicyInfoCache(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
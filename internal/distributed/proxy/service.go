@@ -28,6 +28,7 @@ import (
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -97,8 +98,9 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			grpc_opentracing.UnaryServerInterceptor(opts...),
+			proxy.UnaryServerAuthInterceptor())),
 		grpc.StreamInterceptor(
 			grpc_opentracing.StreamServerInterceptor(opts...)))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
@@ -284,6 +286,14 @@ func (s *Server) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.R
 	return s.proxy.ReleaseDQLMessageStream(ctx, request)
 }
 
+func (s *Server) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateCredentialCache(ctx, request)
+}
+
+func (s *Server) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.RefreshPolicyInfoCache(ctx, request)
+}
+
 func (s *Server) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateCollection(ctx, request)
 }
@@ -316,6 +326,34 @@ func (s *Server) ShowCollections(ctx context.Context, request *milvuspb.ShowColl
 	return s.proxy.ShowCollections(ctx, request)
 }
 
+func (s *Server) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddField(ctx, request)
+}
+
+func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.RenameCollection(ctx, request)
+}
+
+func (s *Server) ListDDLHistory(ctx context.Context, request *milvuspb.ListDDLHistoryRequest) (*milvuspb.ListDDLHistoryResponse, error) {
+	return s.proxy.ListDDLHistory(ctx, request)
+}
+
+func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterCollection(ctx, request)
+}
+
+func (s *Server) ListDroppedCollections(ctx context.Context, request *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return s.proxy.ListDroppedCollections(ctx, request)
+}
+
+func (s *Server) RecoverCollection(ctx context.Context, request *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.RecoverCollection(ctx, request)
+}
+
+func (s *Server) ReshardCollection(ctx context.Context, request *milvuspb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.ReshardCollection(ctx, request)
+}
+
 func (s *Server) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreatePartition(ctx, request)
 }
@@ -344,6 +382,54 @@ func (s *Server) ShowPartitions(ctx context.Context, request *milvuspb.ShowParti
 	return s.proxy.ShowPartitions(ctx, request)
 }
 
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, request)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, request)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, request)
+}
+
+func (s *Server) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateCredential(ctx, request)
+}
+
+func (s *Server) UpdateCredential(ctx context.Context, request *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.UpdateCredential(ctx, request)
+}
+
+func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.DeleteCredential(ctx, request)
+}
+
+func (s *Server) ListUsers(ctx context.Context, request *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return s.proxy.ListUsers(ctx, request)
+}
+
+func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRole(ctx, request)
+}
+
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRole(ctx, request)
+}
+
+func (s *Server) OperateUserRole(ctx context.Context, request *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.OperateUserRole(ctx, request)
+}
+
+func (s *Server) OperatePrivilege(ctx context.Context, request *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.OperatePrivilege(ctx, request)
+}
+
+func (s *Server) SelectGrant(ctx context.Context, request *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return s.proxy.SelectGrant(ctx, request)
+}
+
 func (s *Server) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateIndex(ctx, request)
 }
@@ -378,6 +464,14 @@ func (s *Server) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*mi
 	return s.proxy.Flush(ctx, request)
 }
 
+func (s *Server) Export(ctx context.Context, request *milvuspb.ExportRequest) (*milvuspb.ExportResponse, error) {
+	return s.proxy.Export(ctx, request)
+}
+
+func (s *Server) GetExportState(ctx context.Context, request *milvuspb.GetExportStateRequest) (*milvuspb.GetExportStateResponse, error) {
+	return s.proxy.GetExportState(ctx, request)
+}
+
 func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
 	return s.proxy.Query(ctx, request)
 }
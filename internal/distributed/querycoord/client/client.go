@@ -245,6 +245,20 @@ func (c *Client) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	return ret.(*querypb.GetSegmentInfoResponse), err
 }
 
+func (c *Client) LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.LoadBalance(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *Client) ReshardCollection(ctx context.Context, req *querypb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ReshardCollection(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpcClient.GetMetrics(ctx, req)
@@ -276,6 +276,14 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	return s.queryCoord.GetSegmentInfo(ctx, req)
 }
 
+func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+	return s.queryCoord.LoadBalance(ctx, req)
+}
+
+func (s *Server) ReshardCollection(ctx context.Context, req *querypb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	return s.queryCoord.ReshardCollection(ctx, req)
+}
+
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.queryCoord.GetMetrics(ctx, req)
 }
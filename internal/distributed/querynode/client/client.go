@@ -209,6 +209,20 @@ func (c *Client) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	return ret.(*querypb.GetSegmentInfoResponse), err
 }
 
+func (c *Client) Drain(ctx context.Context, req *querypb.DrainRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.Drain(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *Client) RemoveDmChannels(ctx context.Context, req *querypb.RemoveDmChannelsRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.RemoveDmChannels(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpcClient.GetMetrics(ctx, req)
@@ -30,6 +30,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	isc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	qcc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -158,6 +159,15 @@ func (s *Server) init() error {
 		panic(err)
 	}
 
+	// --- QueryCoord ---
+	// query coordinator is only used to drain the query node, so it's connected on the first drain
+	queryCoord, err := qcc.NewClient(s.ctx, qn.Params.MetaRootPath, qn.Params.EtcdEndpoints)
+	if err != nil {
+		log.Warn("QueryNode init QueryCoordClient failed, query node can't be drained", zap.Error(err))
+	} else if err := s.SetQueryCoord(queryCoord); err != nil {
+		panic(err)
+	}
+
 	s.querynode.UpdateStateCode(internalpb.StateCode_Initializing)
 	log.Debug("QueryNode", zap.Any("State", internalpb.StateCode_Initializing))
 	if err := s.querynode.Init(); err != nil {
@@ -260,6 +270,10 @@ func (s *Server) SetIndexCoord(indexCoord types.IndexCoord) error {
 	return s.querynode.SetIndexCoord(indexCoord)
 }
 
+func (s *Server) SetQueryCoord(queryCoord types.QueryCoord) error {
+	return s.querynode.SetQueryCoord(queryCoord)
+}
+
 func (s *Server) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
 	return s.querynode.GetTimeTickChannel(ctx)
 }
@@ -312,6 +326,14 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	return s.querynode.GetSegmentInfo(ctx, req)
 }
 
+func (s *Server) Drain(ctx context.Context, req *querypb.DrainRequest) (*commonpb.Status, error) {
+	return s.querynode.Drain(ctx, req)
+}
+
+func (s *Server) RemoveDmChannels(ctx context.Context, req *querypb.RemoveDmChannelsRequest) (*commonpb.Status, error) {
+	return s.querynode.RemoveDmChannels(ctx, req)
+}
+
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.querynode.GetMetrics(ctx, req)
 }
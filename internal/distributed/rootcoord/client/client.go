@@ -224,6 +224,55 @@ func (c *GrpcClient) ShowCollections(ctx context.Context, in *milvuspb.ShowColle
 	})
 	return ret.(*milvuspb.ShowCollectionsResponse), err
 }
+func (c *GrpcClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.AddField(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.RenameCollection(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListDDLHistory(ctx context.Context, in *milvuspb.ListDDLHistoryRequest) (*milvuspb.ListDDLHistoryResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListDDLHistory(ctx, in)
+	})
+	return ret.(*milvuspb.ListDDLHistoryResponse), err
+}
+
+func (c *GrpcClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.AlterCollection(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListDroppedCollections(ctx, in)
+	})
+	return ret.(*milvuspb.ListDroppedCollectionsResponse), err
+}
+
+func (c *GrpcClient) RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.RecoverCollection(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ReshardCollection(ctx context.Context, in *milvuspb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ReshardCollection(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
 func (c *GrpcClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpcClient.CreatePartition(ctx, in)
@@ -252,6 +301,105 @@ func (c *GrpcClient) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartit
 	return ret.(*milvuspb.ShowPartitionsResponse), err
 }
 
+func (c *GrpcClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateDatabase(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DropDatabase(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListDatabases(ctx, in)
+	})
+	return ret.(*milvuspb.ListDatabasesResponse), err
+}
+
+// CreateCredential credential and access control
+func (c *GrpcClient) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateCredential(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.UpdateCredential(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DeleteCredential(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListUsers(ctx, in)
+	})
+	return ret.(*milvuspb.ListUsersResponse), err
+}
+
+func (c *GrpcClient) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetCredential(ctx, in)
+	})
+	return ret.(*rootcoordpb.GetCredentialResponse), err
+}
+
+func (c *GrpcClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DropRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.OperateUserRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.OperatePrivilege(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.SelectGrant(ctx, in)
+	})
+	return ret.(*milvuspb.SelectGrantResponse), err
+}
+
+func (c *GrpcClient) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListPolicy(ctx, in)
+	})
+	return ret.(*rootcoordpb.ListPolicyResponse), err
+}
+
 // CreateIndex index builder service
 func (c *GrpcClient) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
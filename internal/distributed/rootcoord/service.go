@@ -295,6 +295,34 @@ func (s *Server) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectio
 	return s.rootCoord.ShowCollections(ctx, in)
 }
 
+func (s *Server) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddField(ctx, in)
+}
+
+func (s *Server) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RenameCollection(ctx, in)
+}
+
+func (s *Server) ListDDLHistory(ctx context.Context, in *milvuspb.ListDDLHistoryRequest) (*milvuspb.ListDDLHistoryResponse, error) {
+	return s.rootCoord.ListDDLHistory(ctx, in)
+}
+
+func (s *Server) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterCollection(ctx, in)
+}
+
+func (s *Server) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return s.rootCoord.ListDroppedCollections(ctx, in)
+}
+
+func (s *Server) RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RecoverCollection(ctx, in)
+}
+
+func (s *Server) ReshardCollection(ctx context.Context, in *milvuspb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.ReshardCollection(ctx, in)
+}
+
 func (s *Server) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreatePartition(ctx, in)
 }
@@ -311,6 +339,63 @@ func (s *Server) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitions
 	return s.rootCoord.ShowPartitions(ctx, in)
 }
 
+func (s *Server) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateDatabase(ctx, in)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabase(ctx, in)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.rootCoord.ListDatabases(ctx, in)
+}
+
+// CreateCredential credential and access control
+func (s *Server) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateCredential(ctx, in)
+}
+
+func (s *Server) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.UpdateCredential(ctx, in)
+}
+
+func (s *Server) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DeleteCredential(ctx, in)
+}
+
+func (s *Server) ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return s.rootCoord.ListUsers(ctx, in)
+}
+
+func (s *Server) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return s.rootCoord.GetCredential(ctx, in)
+}
+
+func (s *Server) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateRole(ctx, in)
+}
+
+func (s *Server) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRole(ctx, in)
+}
+
+func (s *Server) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperateUserRole(ctx, in)
+}
+
+func (s *Server) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperatePrivilege(ctx, in)
+}
+
+func (s *Server) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return s.rootCoord.SelectGrant(ctx, in)
+}
+
+func (s *Server) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	return s.rootCoord.ListPolicy(ctx, in)
+}
+
 // CreateIndex index builder service
 func (s *Server) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreateIndex(ctx, in)
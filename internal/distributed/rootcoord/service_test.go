@@ -451,7 +451,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -502,7 +502,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.CreatePartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partName2, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -530,7 +530,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -551,7 +551,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -596,13 +596,13 @@ func TestGrpcService(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Zero(t, len(collMeta.FieldIndexes))
 		rsp, err := cli.CreateIndex(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 
@@ -618,7 +618,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -658,7 +658,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -719,7 +719,7 @@ func TestGrpcService(t *testing.T) {
 			FieldName:      fieldName,
 			IndexName:      rootcoord.Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, rootcoord.Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName("", collName, rootcoord.Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, len(idx), 1)
 		rsp, err := cli.DropIndex(ctx, req)
@@ -747,7 +747,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	localkv "github.com/milvus-io/milvus/internal/kv/local"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/tso"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -163,21 +165,27 @@ func (i *IndexCoord) Init() error {
 		return err
 	}
 
-	option := &miniokv.Option{
-		Address:           Params.MinIOAddress,
-		AccessKeyID:       Params.MinIOAccessKeyID,
-		SecretAccessKeyID: Params.MinIOSecretAccessKey,
-		UseSSL:            Params.MinIOUseSSL,
-		BucketName:        Params.MinioBucketName,
-		CreateBucket:      true,
-	}
+	if Params.StorageType == paramtable.StorageTypeLocal {
+		i.kv, err = localkv.NewLocalKV(Params.StoragePath)
+	} else {
+		option := &miniokv.Option{
+			Address:           Params.MinIOAddress,
+			AccessKeyID:       Params.MinIOAccessKeyID,
+			SecretAccessKeyID: Params.MinIOSecretAccessKey,
+			UseSSL:            Params.MinIOUseSSL,
+			BucketName:        Params.MinioBucketName,
+			ColdBucketName:    Params.ColdBucketName,
+			ColdRootPath:      Params.ColdRootPath,
+			CreateBucket:      true,
+		}
 
-	i.kv, err = miniokv.NewMinIOKV(i.loopCtx, option)
+		i.kv, err = miniokv.NewMinIOKV(i.loopCtx, option)
+	}
 	if err != nil {
-		log.Debug("IndexCoord new minio kv failed", zap.Error(err))
+		log.Debug("IndexCoord new storage kv failed", zap.Error(err))
 		return err
 	}
-	log.Debug("IndexCoord new minio kv success")
+	log.Debug("IndexCoord new storage kv success")
 
 	i.sched, err = NewTaskScheduler(i.loopCtx, i.idAllocator, i.kv, i.metaTable)
 	if err != nil {
@@ -39,6 +39,11 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	StorageType    string
+	StoragePath    string
+	ColdBucketName string
+	ColdRootPath   string
+
 	Log log.Config
 }
 
@@ -58,6 +63,7 @@ func (pt *ParamTable) Init() {
 		pt.initMinIOSecretAccessKey()
 		pt.initMinIOUseSSL()
 		pt.initMinioBucketName()
+		pt.initStorage()
 	})
 }
 
@@ -144,6 +150,11 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+func (pt *ParamTable) initStorage() {
+	pt.StorageType, pt.StoragePath = pt.LoadStorage()
+	pt.ColdBucketName, pt.ColdRootPath = pt.LoadColdTier()
+}
+
 func (pt *ParamTable) initLogCfg() {
 	pt.Log = log.Config{}
 	format, err := pt.Load("log.format")
@@ -23,12 +23,15 @@ import (
 
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	localkv "github.com/milvus-io/milvus/internal/kv/local"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -46,8 +49,11 @@ type IndexNode struct {
 
 	sched *TaskScheduler
 
-	kv      kv.BaseKV
-	session *sessionutil.Session
+	kv           kv.BaseKV
+	chunkManager storage.ChunkManager
+	downloader   *storage.DownloadManager
+	keyManager   *storage.KeyManager
+	session      *sessionutil.Session
 
 	// Add callback functions at different stages
 	startCallbacks []func()
@@ -97,20 +103,41 @@ func (i *IndexNode) Init() error {
 	}
 	log.Debug("IndexNode try connect etcd success")
 
-	option := &miniokv.Option{
-		Address:           Params.MinIOAddress,
-		AccessKeyID:       Params.MinIOAccessKeyID,
-		SecretAccessKeyID: Params.MinIOSecretAccessKey,
-		UseSSL:            Params.MinIOUseSSL,
-		BucketName:        Params.MinioBucketName,
-		CreateBucket:      true,
+	if Params.StorageType == paramtable.StorageTypeLocal {
+		i.kv, err = localkv.NewLocalKV(Params.StoragePath)
+		// the files are laid out the same as LocalKV does
+		i.chunkManager = storage.NewLocalChunkManager(Params.StoragePath)
+	} else {
+		option := &miniokv.Option{
+			Address:           Params.MinIOAddress,
+			AccessKeyID:       Params.MinIOAccessKeyID,
+			SecretAccessKeyID: Params.MinIOSecretAccessKey,
+			UseSSL:            Params.MinIOUseSSL,
+			BucketName:        Params.MinioBucketName,
+			ColdBucketName:    Params.ColdBucketName,
+			ColdRootPath:      Params.ColdRootPath,
+			CreateBucket:      true,
+		}
+		var minIOKV *miniokv.MinIOKV
+		minIOKV, err = miniokv.NewMinIOKV(i.loopCtx, option)
+		i.kv = minIOKV
+		i.chunkManager = storage.NewMinioChunkManager(minIOKV)
 	}
-	i.kv, err = miniokv.NewMinIOKV(i.loopCtx, option)
 	if err != nil {
-		log.Debug("IndexNode NewMinIOKV failed", zap.Error(err))
+		log.Debug("IndexNode new storage kv failed", zap.Error(err))
+		return err
+	}
+	log.Debug("IndexNode new storage kv success")
+	i.downloader = storage.NewDownloadManager(i.chunkManager,
+		storage.DownloadParallelism(Params.DownloadParallelism),
+		storage.DownloadPartSize(Params.DownloadPartSize),
+		storage.DownloadRetry(retry.Attempts(Params.DownloadRetryAttempts)))
+
+	i.keyManager, err = storage.NewKeyManagerFromKeyFile(Params.EncryptionKeyFile)
+	if err != nil {
+		log.Debug("IndexNode load encryption key file failed", zap.Error(err))
 		return err
 	}
-	log.Debug("IndexNode NewMinIOKV success")
 	i.closer = trace.InitTracing("index_node")
 
 	i.UpdateStateCode(internalpb.StateCode_Healthy)
@@ -170,10 +197,13 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 			ctx:  ctx,
 			done: make(chan error),
 		},
-		req:    request,
-		kv:     i.kv,
-		etcdKV: i.etcdKV,
-		nodeID: Params.NodeID,
+		req:        request,
+		kv:         i.kv,
+		cm:         i.chunkManager,
+		downloader: i.downloader,
+		keyManager: i.keyManager,
+		etcdKV:     i.etcdKV,
+		nodeID:     Params.NodeID,
 	}
 
 	ret := &commonpb.Status{
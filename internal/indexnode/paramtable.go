@@ -47,6 +47,16 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	StorageType       string
+	StoragePath       string
+	EncryptionKeyFile string
+	ColdBucketName    string
+	ColdRootPath      string
+
+	DownloadParallelism   int
+	DownloadPartSize      int64
+	DownloadRetryAttempts uint
+
 	Log log.Config
 }
 
@@ -71,6 +81,7 @@ func (pt *ParamTable) initParams() {
 	pt.initMinIOSecretAccessKey()
 	pt.initMinIOUseSSL()
 	pt.initMinioBucketName()
+	pt.initStorage()
 	pt.initEtcdEndpoints()
 	pt.initMetaRootPath()
 }
@@ -138,6 +149,13 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+func (pt *ParamTable) initStorage() {
+	pt.StorageType, pt.StoragePath = pt.LoadStorage()
+	pt.EncryptionKeyFile = pt.LoadEncryptionKeyFile()
+	pt.ColdBucketName, pt.ColdRootPath = pt.LoadColdTier()
+	pt.DownloadParallelism, pt.DownloadPartSize, pt.DownloadRetryAttempts = pt.LoadDownload()
+}
+
 func (pt *ParamTable) initLogCfg() {
 	pt.Log = log.Config{}
 	format, err := pt.Load("log.format")
@@ -86,12 +86,15 @@ func (bt *BaseTask) Notify(err error) {
 
 type IndexBuildTask struct {
 	BaseTask
-	index     Index
-	kv        kv.BaseKV
-	etcdKV    *etcdkv.EtcdKV
-	savePaths []string
-	req       *indexpb.CreateIndexRequest
-	nodeID    UniqueID
+	index      Index
+	kv         kv.BaseKV
+	cm         storage.ChunkManager
+	downloader *storage.DownloadManager
+	keyManager *storage.KeyManager
+	etcdKV     *etcdkv.EtcdKV
+	savePaths  []string
+	req        *indexpb.CreateIndexRequest
+	nodeID     UniqueID
 }
 
 func (it *IndexBuildTask) Ctx() context.Context {
@@ -249,51 +252,58 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		// return splitElements[len(splitElements)-1]
 		return path
 	}
-	getValueByPath := func(path string) ([]byte, error) {
-		data, err := it.kv.Load(path)
-		if err != nil {
-			return nil, err
-		}
-		return []byte(data), nil
-	}
-	getBlobByPath := func(path string) (*Blob, error) {
-		value, err := getValueByPath(path)
-		if err != nil {
-			return nil, err
-		}
-		return &Blob{
-			Key:   getKeyByPathNaive(path),
-			Value: value,
-		}, nil
-	}
 	getStorageBlobs := func(blobs []*Blob) []*storage.Blob {
 		return blobs
 	}
 
 	toLoadDataPaths := it.req.GetDataPaths()
-	keys := make([]string, len(toLoadDataPaths))
-	blobs := make([]*Blob, len(toLoadDataPaths))
+	blobs := make([][]*Blob, len(toLoadDataPaths))
+
+	// the binlogs are downloaded in parallel by the download manager, only the column chunks of the field are
+	// read from the segment files
+	var binlogIdxs, columnIdxs []int
+	var binlogPaths []string
+	for idx, path := range toLoadDataPaths {
+		if _, _, ok := storage.ParseSegmentFileColumnKey(path); ok {
+			columnIdxs = append(columnIdxs, idx)
+			continue
+		}
+		binlogIdxs = append(binlogIdxs, idx)
+		binlogPaths = append(binlogPaths, path)
+	}
+	values, err := it.downloader.Download(ctx, binlogPaths)
+	if err != nil {
+		return err
+	}
+	for i, idx := range binlogIdxs {
+		blobs[idx] = []*Blob{{
+			Key:   getKeyByPathNaive(toLoadDataPaths[idx]),
+			Value: values[i],
+		}}
+	}
 
-	loadKey := func(idx int) error {
-		keys[idx] = getKeyByPathNaive(toLoadDataPaths[idx])
-		blob, err := getBlobByPath(toLoadDataPaths[idx])
+	loadColumns := func(i int) error {
+		file, fieldID, _ := storage.ParseSegmentFileColumnKey(toLoadDataPaths[columnIdxs[i]])
+		reader, err := storage.NewSegmentFileReader(it.cm, file)
 		if err != nil {
 			return err
 		}
-
-		blobs[idx] = blob
-
-		return nil
+		blobs[columnIdxs[i]], err = reader.ReadColumns([]storage.FieldID{fieldID})
+		return err
 	}
-	err = funcutil.ProcessFuncParallel(len(toLoadDataPaths), runtime.NumCPU(), loadKey, "loadKey")
+	err = funcutil.ProcessFuncParallel(len(columnIdxs), runtime.NumCPU(), loadColumns, "loadColumns")
 	if err != nil {
 		return err
 	}
 	log.Debug("IndexNode load data success")
 	tr.Record("loadKey done")
 
-	storageBlobs := getStorageBlobs(blobs)
-	var insertCodec storage.InsertCodec
+	loadedBlobs := make([]*Blob, 0, len(blobs))
+	for _, b := range blobs {
+		loadedBlobs = append(loadedBlobs, b...)
+	}
+	storageBlobs := getStorageBlobs(loadedBlobs)
+	insertCodec := storage.InsertCodec{KeyManager: it.keyManager}
 	defer insertCodec.Close()
 	partitionID, segmentID, insertData, err2 := insertCodec.Deserialize(storageBlobs)
 	if err2 != nil {
@@ -337,7 +347,7 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		}
 		tr.Record("serialize index done")
 
-		var indexCodec storage.IndexCodec
+		indexCodec := storage.IndexCodec{KeyManager: it.keyManager}
 		serializedIndexBlobs, err := indexCodec.Serialize(getStorageBlobs(indexBlobs), indexParams, it.req.IndexName, it.req.IndexID)
 		if err != nil {
 			return err
@@ -41,4 +41,6 @@ type SnapShotKV interface {
 	MultiSave(kvs map[string]string, ts typeutil.Timestamp, additions ...func(ts typeutil.Timestamp) (string, string, error)) error
 	LoadWithPrefix(key string, ts typeutil.Timestamp) ([]string, []string, error)
 	MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts typeutil.Timestamp, additions ...func(ts typeutil.Timestamp) (string, string, error)) error
+	// Compact drops the snapshots older than ts, the snapshot taken at ts is still readable
+	Compact(ts typeutil.Timestamp) error
 }
@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package localkv
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// tmpDir is the directory under the root path where the values are written before being renamed to their keys,
+// keys under it are not listed
+const tmpDir = ".tmp"
+
+// LocalKV is an object storage kv on the local file system, every key is saved as a file under the root path, it
+// replaces MinIO when milvus runs as a single process.
+type LocalKV struct {
+	rootPath string
+}
+
+// NewLocalKV creates the root path if it doesn't exist
+func NewLocalKV(rootPath string) (*LocalKV, error) {
+	if err := os.MkdirAll(path.Join(rootPath, tmpDir), os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &LocalKV{
+		rootPath: path.Clean(rootPath),
+	}, nil
+}
+
+func (kv *LocalKV) keyPath(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || strings.TrimPrefix(cleaned, "/") != strings.TrimPrefix(key, "/") {
+		return "", fmt.Errorf("invalid key %s", key)
+	}
+	if cleaned == "/"+tmpDir || strings.HasPrefix(cleaned, "/"+tmpDir+"/") {
+		return "", fmt.Errorf("key %s is reserved", key)
+	}
+	return path.Join(kv.rootPath, cleaned), nil
+}
+
+func (kv *LocalKV) Exist(key string) bool {
+	p, err := kv.keyPath(key)
+	if err != nil {
+		return false
+	}
+	info, err := os.Stat(p)
+	return err == nil && !info.IsDir()
+}
+
+func (kv *LocalKV) Load(key string) (string, error) {
+	p, err := kv.keyPath(key)
+	if err != nil {
+		return "", err
+	}
+	value, err := ioutil.ReadFile(p)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func (kv *LocalKV) MultiLoad(keys []string) ([]string, error) {
+	var resultErr error
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, err := kv.Load(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+		values = append(values, value)
+	}
+	return values, resultErr
+}
+
+// ListKeysWithPrefix returns the keys of all the objects under the prefix, including those in the sub directories
+func (kv *LocalKV) ListKeysWithPrefix(prefix string) ([]string, error) {
+	// walk the deepest directory which contains all the keys with the prefix
+	dir := kv.rootPath
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = path.Join(kv.rootPath, path.Clean("/"+prefix[:i]))
+	}
+	tmp := path.Join(kv.rootPath, tmpDir)
+	var keys []string
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			if p == tmp {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(kv.rootPath, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (kv *LocalKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	keys, err := kv.ListKeysWithPrefix(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := kv.MultiLoad(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+// Save writes the value to a temporary file first, then renames it to the key, so readers never see a partially
+// written value
+func (kv *LocalKV) Save(key, value string) error {
+	p, err := kv.keyPath(key)
+	if err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(path.Join(kv.rootPath, tmpDir), path.Base(p))
+	if err != nil {
+		return err
+	}
+	tmpPath := file.Name()
+	if _, err := file.WriteString(value); err != nil {
+		file.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	// the parent directory may be removed by a concurrent Remove after it's created, so retry the rename
+	for i := 0; ; i++ {
+		if err = os.MkdirAll(path.Dir(p), os.ModePerm); err == nil {
+			if err = os.Rename(tmpPath, p); err == nil {
+				return nil
+			}
+		}
+		if !os.IsNotExist(err) || i >= 2 {
+			os.Remove(tmpPath)
+			return err
+		}
+	}
+}
+
+func (kv *LocalKV) MultiSave(kvs map[string]string) error {
+	for key, value := range kvs {
+		if err := kv.Save(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove succeeds if the key doesn't exist, and the directories left empty are removed as well
+func (kv *LocalKV) Remove(key string) error {
+	p, err := kv.keyPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for dir := path.Dir(p); dir != kv.rootPath; dir = path.Dir(dir) {
+		// fails if the directory is not empty
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (kv *LocalKV) MultiRemove(keys []string) error {
+	for _, key := range keys {
+		if err := kv.Remove(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (kv *LocalKV) RemoveWithPrefix(prefix string) error {
+	keys, err := kv.ListKeysWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return kv.MultiRemove(keys)
+}
+
+func (kv *LocalKV) Close() {
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package localkv
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLocalKV(t *testing.T) (*LocalKV, string) {
+	dir, err := ioutil.TempDir("", "local_kv")
+	assert.Nil(t, err)
+	kv, err := NewLocalKV(path.Join(dir, "storage"))
+	assert.Nil(t, err)
+	return kv, dir
+}
+
+func TestLocalKV_Load(t *testing.T) {
+	kv, dir := newTestLocalKV(t)
+	defer os.RemoveAll(dir)
+	defer kv.Close()
+
+	err := kv.Save("abc", "123")
+	assert.Nil(t, err)
+	err = kv.Save("abcd", "1234")
+	assert.Nil(t, err)
+	err = kv.MultiSave(map[string]string{
+		"insert_log/1/2/3/100/1": "a",
+		"insert_log/1/2/3/101/1": "b",
+		"insert_log/1/2/4/100/1": "c",
+	})
+	assert.Nil(t, err)
+
+	val, err := kv.Load("abc")
+	assert.Nil(t, err)
+	assert.Equal(t, "123", val)
+	assert.True(t, kv.Exist("abc"))
+	assert.False(t, kv.Exist("ab"))
+	assert.False(t, kv.Exist("insert_log/1"))
+
+	_, err = kv.Load("ab")
+	assert.NotNil(t, err)
+
+	keys, vals, err := kv.LoadWithPrefix("abc")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"abc", "abcd"}, keys)
+	assert.Equal(t, []string{"123", "1234"}, vals)
+
+	keys, vals, err = kv.LoadWithPrefix("insert_log/1/2/3/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"insert_log/1/2/3/100/1", "insert_log/1/2/3/101/1"}, keys)
+	assert.Equal(t, []string{"a", "b"}, vals)
+
+	keys, err = kv.ListKeysWithPrefix("insert_log/1/2/")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(keys))
+
+	keys, err = kv.ListKeysWithPrefix("")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(keys))
+
+	keys, err = kv.ListKeysWithPrefix("none/")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	vals, err = kv.MultiLoad([]string{"abc", "ab"})
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"123", ""}, vals)
+
+	err = kv.Save("abc", "456")
+	assert.Nil(t, err)
+	val, err = kv.Load("abc")
+	assert.Nil(t, err)
+	assert.Equal(t, "456", val)
+}
+
+func TestLocalKV_InvalidKey(t *testing.T) {
+	kv, dir := newTestLocalKV(t)
+	defer os.RemoveAll(dir)
+
+	for _, key := range []string{"", "../abc", "a/../../b", "a//b", "a/", tmpDir, tmpDir + "/a"} {
+		err := kv.Save(key, "123")
+		assert.NotNil(t, err, key)
+		_, err = kv.Load(key)
+		assert.NotNil(t, err, key)
+	}
+	keys, err := kv.ListKeysWithPrefix("../")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestLocalKV_Remove(t *testing.T) {
+	kv, dir := newTestLocalKV(t)
+	defer os.RemoveAll(dir)
+
+	err := kv.MultiSave(map[string]string{
+		"key_1":   "1",
+		"key_2":   "2",
+		"a/b/c/1": "3",
+		"a/b/c/2": "4",
+		"a/d/1":   "5",
+	})
+	assert.Nil(t, err)
+
+	err = kv.Remove("key_1")
+	assert.Nil(t, err)
+	assert.False(t, kv.Exist("key_1"))
+	err = kv.Remove("key_1")
+	assert.Nil(t, err)
+
+	err = kv.MultiRemove([]string{"a/b/c/1", "a/b/c/2"})
+	assert.Nil(t, err)
+	_, err = os.Stat(path.Join(kv.rootPath, "a/b"))
+	assert.True(t, os.IsNotExist(err))
+	assert.True(t, kv.Exist("a/d/1"))
+
+	err = kv.RemoveWithPrefix("")
+	assert.Nil(t, err)
+	keys, err := kv.ListKeysWithPrefix("")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+	_, err = os.Stat(kv.rootPath)
+	assert.Nil(t, err)
+
+	files, err := ioutil.ReadDir(path.Join(kv.rootPath, tmpDir))
+	assert.Nil(t, err)
+	assert.Empty(t, files)
+}
@@ -12,11 +12,13 @@
 package miniokv
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
 
 	"io"
+	"path"
 	"strings"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -30,6 +32,10 @@ type MinIOKV struct {
 	ctx         context.Context
 	minioClient *minio.Client
 	bucketName  string
+
+	// the objects moved to the cold tier are saved in coldBucketName with coldRootPath prepended to their keys
+	coldBucketName string
+	coldRootPath   string
 }
 
 type Option struct {
@@ -39,6 +45,13 @@ type Option struct {
 	SecretAccessKeyID string
 	UseSSL            bool
 	CreateBucket      bool // when bucket not existed, create it
+
+	// ColdBucketName and ColdRootPath configure the cold tier, the keys under ColdRootPath are saved in
+	// ColdBucketName, which is BucketName if empty. An object which is not found is read from the cold tier,
+	// so the objects moved to the cold tier are still readable by their original keys. The cold tier is
+	// disabled if ColdRootPath is empty.
+	ColdBucketName string
+	ColdRootPath   string
 }
 
 func NewMinIOKV(ctx context.Context, option *Option) (*MinIOKV, error) {
@@ -53,32 +66,45 @@ func NewMinIOKV(ctx context.Context, option *Option) (*MinIOKV, error) {
 	if err != nil {
 		return nil, err
 	}
-	var bucketExists bool
-	// check valid in first query
-	checkBucketFn := func() error {
-		bucketExists, err = minIOClient.BucketExists(ctx, option.BucketName)
-		if err != nil {
-			return err
-		}
-		if !bucketExists {
-			log.Debug("MinioKV NewMinioKV", zap.Any("Check bucket", "bucket not exist"))
-			if option.CreateBucket {
-				log.Debug("MinioKV NewMinioKV create bucket.")
-				return minIOClient.MakeBucket(ctx, option.BucketName, minio.MakeBucketOptions{})
+	coldBucketName := option.ColdBucketName
+	if coldBucketName == "" {
+		coldBucketName = option.BucketName
+	}
+	bucketNames := []string{option.BucketName}
+	if option.ColdRootPath != "" && coldBucketName != option.BucketName {
+		bucketNames = append(bucketNames, coldBucketName)
+	}
+	for _, bucketName := range bucketNames {
+		bucketName := bucketName
+		var bucketExists bool
+		// check valid in first query
+		checkBucketFn := func() error {
+			bucketExists, err = minIOClient.BucketExists(ctx, bucketName)
+			if err != nil {
+				return err
+			}
+			if !bucketExists {
+				log.Debug("MinioKV NewMinioKV", zap.Any("Check bucket", "bucket not exist"))
+				if option.CreateBucket {
+					log.Debug("MinioKV NewMinioKV create bucket.")
+					return minIOClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
+				}
+				return fmt.Errorf("bucket %s not Existed", bucketName)
 			}
-			return fmt.Errorf("bucket %s not Existed", option.BucketName)
+			return nil
+		}
+		err = retry.Do(ctx, checkBucketFn, retry.Attempts(300))
+		if err != nil {
+			return nil, err
 		}
-		return nil
-	}
-	err = retry.Do(ctx, checkBucketFn, retry.Attempts(300))
-	if err != nil {
-		return nil, err
 	}
 
 	kv := &MinIOKV{
-		ctx:         ctx,
-		minioClient: minIOClient,
-		bucketName:  option.BucketName,
+		ctx:            ctx,
+		minioClient:    minIOClient,
+		bucketName:     option.BucketName,
+		coldBucketName: coldBucketName,
+		coldRootPath:   option.ColdRootPath,
 	}
 	log.Debug("MinioKV new MinioKV success.")
 	//go kv.performanceTest(false, 16<<20)
@@ -86,13 +112,69 @@ func NewMinIOKV(ctx context.Context, option *Option) (*MinIOKV, error) {
 	return kv, nil
 }
 
+// ColdTierEnabled tells whether the cold tier is configured
+func (kv *MinIOKV) ColdTierEnabled() bool {
+	return kv.coldRootPath != ""
+}
+
+// IsColdKey tells whether the key is the key of an object in the cold tier
+func (kv *MinIOKV) IsColdKey(key string) bool {
+	return kv.ColdTierEnabled() && strings.HasPrefix(key, kv.coldRootPath+"/")
+}
+
+// ColdKey returns the key of the object of key after it's moved to the cold tier
+func (kv *MinIOKV) ColdKey(key string) string {
+	if kv.IsColdKey(key) {
+		return key
+	}
+	return path.Join(kv.coldRootPath, key)
+}
+
+// CopyToColdTier copies the object of key to the cold tier by a server side copy, and returns the key of the copy.
+// The object is not removed, so it can be read by its key until the references to it are updated.
+func (kv *MinIOKV) CopyToColdTier(key string) (string, error) {
+	if !kv.ColdTierEnabled() {
+		return "", fmt.Errorf("cold tier is not enabled")
+	}
+	coldKey := kv.ColdKey(key)
+	if coldKey == key {
+		return key, nil
+	}
+	_, err := kv.minioClient.CopyObject(kv.ctx,
+		minio.CopyDestOptions{Bucket: kv.coldBucketName, Object: coldKey},
+		minio.CopySrcOptions{Bucket: kv.bucketName, Object: key})
+	if err != nil {
+		return "", err
+	}
+	return coldKey, nil
+}
+
+// bucket returns the bucket the object of key is saved in
+func (kv *MinIOKV) bucket(key string) string {
+	if kv.IsColdKey(key) {
+		return kv.coldBucketName
+	}
+	return kv.bucketName
+}
+
+// fallbackKey returns the key in the cold tier to read if the object of key is not found
+func (kv *MinIOKV) fallbackKey(key string, err error) (string, bool) {
+	if !kv.ColdTierEnabled() || kv.IsColdKey(key) || minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		return "", false
+	}
+	return kv.ColdKey(key), true
+}
+
 func (kv *MinIOKV) Exist(key string) bool {
-	_, err := kv.minioClient.StatObject(kv.ctx, kv.bucketName, key, minio.StatObjectOptions{})
+	_, err := kv.minioClient.StatObject(kv.ctx, kv.bucket(key), key, minio.StatObjectOptions{})
+	if coldKey, ok := kv.fallbackKey(key, err); ok {
+		_, err = kv.minioClient.StatObject(kv.ctx, kv.coldBucketName, coldKey, minio.StatObjectOptions{})
+	}
 	return err == nil
 }
 
 func (kv *MinIOKV) LoadWithPrefix(key string) ([]string, []string, error) {
-	objects := kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: key})
+	objects := kv.minioClient.ListObjects(kv.ctx, kv.bucket(key), minio.ListObjectsOptions{Prefix: key})
 
 	var objectsKeys []string
 	var objectsValues []string
@@ -108,26 +190,122 @@ func (kv *MinIOKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return objectsKeys, objectsValues, nil
 }
 
+// ListKeysWithPrefix returns the keys of all the objects under the prefix, including those in the sub directories
+func (kv *MinIOKV) ListKeysWithPrefix(prefix string) ([]string, error) {
+	var keys []string
+	for object := range kv.minioClient.ListObjects(kv.ctx, kv.bucket(prefix), minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		keys = append(keys, object.Key)
+	}
+	return keys, nil
+}
+
 func (kv *MinIOKV) Load(key string) (string, error) {
-	object, err := kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
+	buf := new(strings.Builder)
+	if err := kv.getObject(key, minio.GetObjectOptions{}, buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// getObject copies the object of key to w, the object is read from the cold tier if it's not found
+func (kv *MinIOKV) getObject(key string, opts minio.GetObjectOptions, w io.Writer) error {
+	err := kv.copyObject(kv.bucket(key), key, opts, w)
+	if coldKey, ok := kv.fallbackKey(key, err); ok {
+		err = kv.copyObject(kv.coldBucketName, coldKey, opts, w)
+	}
+	return err
+}
+
+func (kv *MinIOKV) copyObject(bucketName, key string, opts minio.GetObjectOptions, w io.Writer) error {
+	object, err := kv.minioClient.GetObject(kv.ctx, bucketName, key, opts)
 	if object != nil {
 		defer object.Close()
 	}
 	if err != nil {
-		return "", err
+		return err
 	}
-
-	buf := new(strings.Builder)
-	_, err = io.Copy(buf, object)
+	_, err = io.Copy(w, object)
 	if err != nil && err != io.EOF {
-		return "", err
+		return err
 	}
-	return buf.String(), nil
+	return nil
+}
+
+// LoadPartial loads length bytes of the object from offset with a ranged request, the result is shorter than
+// length if the object ends before the range does.
+func (kv *MinIOKV) LoadPartial(key string, offset, length int64) ([]byte, error) {
+	if offset < 0 || length <= 0 {
+		return nil, fmt.Errorf("invalid range, offset: %d, length: %d", offset, length)
+	}
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(offset, offset+length-1); err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, length))
+	if err := kv.getObject(key, opts, buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ReadAt reads len(p) bytes of the object from off into p with a ranged request, it returns io.EOF if the object
+// ends before p is filled. The data is written into p directly without an intermediate buffer.
+func (kv *MinIOKV) ReadAt(key string, p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("invalid offset %d", off)
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(off, off+int64(len(p))-1); err != nil {
+		return 0, err
+	}
+	w := &sliceWriter{buf: p}
+	if err := kv.getObject(key, opts, w); err != nil {
+		return w.n, err
+	}
+	if w.n < len(p) {
+		return w.n, io.EOF
+	}
+	return w.n, nil
+}
+
+// sliceWriter writes into a pre-sized buffer, it fails if the data exceeds the buffer
+type sliceWriter struct {
+	buf []byte
+	n   int
+}
+
+func (w *sliceWriter) Write(p []byte) (int, error) {
+	if len(p) > len(w.buf)-w.n {
+		return 0, io.ErrShortBuffer
+	}
+	w.n += copy(w.buf[w.n:], p)
+	return len(p), nil
+}
+
+// Size returns the size of the object of key
+func (kv *MinIOKV) Size(key string) (int64, error) {
+	info, err := kv.minioClient.StatObject(kv.ctx, kv.bucket(key), key, minio.StatObjectOptions{})
+	if coldKey, ok := kv.fallbackKey(key, err); ok {
+		info, err = kv.minioClient.StatObject(kv.ctx, kv.coldBucketName, coldKey, minio.StatObjectOptions{})
+	}
+	if err != nil {
+		return 0, err
+	}
+	return info.Size, nil
 }
 
 // FGetObject download file from minio to local storage system.
 func (kv *MinIOKV) FGetObject(key, localPath string) error {
-	err := kv.minioClient.FGetObject(kv.ctx, kv.bucketName, key, localPath+key, minio.GetObjectOptions{})
+	err := kv.minioClient.FGetObject(kv.ctx, kv.bucket(key), key, localPath+key, minio.GetObjectOptions{})
+	if coldKey, ok := kv.fallbackKey(key, err); ok {
+		err = kv.minioClient.FGetObject(kv.ctx, kv.coldBucketName, coldKey, localPath+key, minio.GetObjectOptions{})
+	}
 	if err != nil {
 		return err
 	}
@@ -142,7 +320,7 @@ func (kv *MinIOKV) FGetObjects(keys []string, localPath string) error {
 	for i, key := range keys {
 		wg.Add(1)
 		go func(i int, key string) {
-			err := kv.minioClient.FGetObject(kv.ctx, kv.bucketName, key, localPath+key, minio.GetObjectOptions{})
+			err := kv.FGetObject(key, localPath)
 			if err != nil {
 				el[i] = err
 			}
@@ -176,7 +354,7 @@ func (kv *MinIOKV) MultiLoad(keys []string) ([]string, error) {
 
 func (kv *MinIOKV) Save(key, value string) error {
 	reader := strings.NewReader(value)
-	_, err := kv.minioClient.PutObject(kv.ctx, kv.bucketName, key, reader, int64(len(value)), minio.PutObjectOptions{})
+	_, err := kv.minioClient.PutObject(kv.ctx, kv.bucket(key), key, reader, int64(len(value)), minio.PutObjectOptions{})
 
 	if err != nil {
 		return err
@@ -198,18 +376,29 @@ func (kv *MinIOKV) MultiSave(kvs map[string]string) error {
 	return resultErr
 }
 
+// RemoveWithPrefix removes the objects under the prefix, including those moved to the cold tier
 func (kv *MinIOKV) RemoveWithPrefix(prefix string) error {
+	if err := kv.removeWithPrefix(kv.bucket(prefix), prefix); err != nil {
+		return err
+	}
+	if kv.ColdTierEnabled() && !kv.IsColdKey(prefix) {
+		return kv.removeWithPrefix(kv.coldBucketName, kv.ColdKey(prefix))
+	}
+	return nil
+}
+
+func (kv *MinIOKV) removeWithPrefix(bucketName, prefix string) error {
 	objectsCh := make(chan minio.ObjectInfo)
 
 	go func() {
 		defer close(objectsCh)
 
-		for object := range kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: prefix}) {
+		for object := range kv.minioClient.ListObjects(kv.ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix}) {
 			objectsCh <- object
 		}
 	}()
 
-	for rErr := range kv.minioClient.RemoveObjects(kv.ctx, kv.bucketName, objectsCh, minio.RemoveObjectsOptions{GovernanceBypass: true}) {
+	for rErr := range kv.minioClient.RemoveObjects(kv.ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{GovernanceBypass: true}) {
 		if rErr.Err != nil {
 			return rErr.Err
 		}
@@ -218,7 +407,7 @@ func (kv *MinIOKV) RemoveWithPrefix(prefix string) error {
 }
 
 func (kv *MinIOKV) Remove(key string) error {
-	err := kv.minioClient.RemoveObject(kv.ctx, kv.bucketName, string(key), minio.RemoveObjectOptions{})
+	err := kv.minioClient.RemoveObject(kv.ctx, kv.bucket(key), string(key), minio.RemoveObjectOptions{})
 	return err
 }
 
@@ -13,6 +13,7 @@ package miniokv_test
 
 import (
 	"context"
+	"io"
 	"io/ioutil"
 	"os"
 	"strconv"
@@ -96,6 +97,98 @@ func TestMinIOKV_Load(t *testing.T) {
 
 }
 
+func TestMinIOKV_ListKeysWithPrefix(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketName := "fantastic-tech-test"
+	MinIOKV, err := newMinIOKVClient(ctx, bucketName)
+	assert.Nil(t, err)
+	defer MinIOKV.RemoveWithPrefix("")
+
+	kvs := map[string]string{
+		"segment/100/1": "a",
+		"segment/100/2": "b",
+		"segment/101/1": "c",
+		"other/1":       "d",
+	}
+	err = MinIOKV.MultiSave(kvs)
+	assert.Nil(t, err)
+
+	keys, err := MinIOKV.ListKeysWithPrefix("segment/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"segment/100/1", "segment/100/2", "segment/101/1"}, keys)
+
+	keys, err = MinIOKV.ListKeysWithPrefix("segment/100/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"segment/100/1", "segment/100/2"}, keys)
+
+	keys, err = MinIOKV.ListKeysWithPrefix("none/")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestMinIOKV_LoadPartial(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketName := "fantastic-tech-test"
+	MinIOKV, err := newMinIOKVClient(ctx, bucketName)
+	assert.Nil(t, err)
+	defer MinIOKV.RemoveWithPrefix("")
+
+	err = MinIOKV.Save("partial", "0123456789")
+	assert.Nil(t, err)
+
+	value, err := MinIOKV.LoadPartial("partial", 2, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "234", string(value))
+
+	value, err = MinIOKV.LoadPartial("partial", 8, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "89", string(value))
+
+	_, err = MinIOKV.LoadPartial("partial", -1, 5)
+	assert.NotNil(t, err)
+	_, err = MinIOKV.LoadPartial("partial", 0, 0)
+	assert.NotNil(t, err)
+}
+
+func TestMinIOKV_ReadAt(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketName := "fantastic-tech-test"
+	MinIOKV, err := newMinIOKVClient(ctx, bucketName)
+	assert.Nil(t, err)
+	defer MinIOKV.RemoveWithPrefix("")
+
+	err = MinIOKV.Save("partial", "0123456789")
+	assert.Nil(t, err)
+	size, err := MinIOKV.Size("partial")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), size)
+	_, err = MinIOKV.Size("none")
+	assert.NotNil(t, err)
+
+	p := make([]byte, 3)
+	n, err := MinIOKV.ReadAt("partial", p, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, "234", string(p))
+
+	p = make([]byte, 5)
+	n, err = MinIOKV.ReadAt("partial", p, 8)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "89", string(p[:n]))
+
+	_, err = MinIOKV.ReadAt("partial", p, -1)
+	assert.NotNil(t, err)
+}
+
 func TestMinIOKV_MultiSave(t *testing.T) {
 	Params.Init()
 	ctx, cancel := context.WithCancel(context.Background())
@@ -279,3 +372,59 @@ func TestMinIOKV_FGetObjects(t *testing.T) {
 	defer file1.Close()
 	defer os.Remove(path + name2)
 }
+
+func TestMinIOKV_ColdTier(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	endPoint, _ := Params.Load("_MinioAddress")
+	accessKeyID, _ := Params.Load("minio.accessKeyID")
+	secretAccessKey, _ := Params.Load("minio.secretAccessKey")
+	useSSLStr, _ := Params.Load("minio.useSSL")
+	useSSL, _ := strconv.ParseBool(useSSLStr)
+	kv, err := miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           endPoint,
+		AccessKeyID:       accessKeyID,
+		SecretAccessKeyID: secretAccessKey,
+		UseSSL:            useSSL,
+		BucketName:        "fantastic-tech-test",
+		CreateBucket:      true,
+		ColdRootPath:      "cold",
+	})
+	assert.Nil(t, err)
+	defer kv.RemoveWithPrefix("")
+	assert.True(t, kv.ColdTierEnabled())
+
+	err = kv.Save("binlog/1", "0123456789")
+	assert.Nil(t, err)
+	coldKey, err := kv.CopyToColdTier("binlog/1")
+	assert.Nil(t, err)
+	assert.Equal(t, "cold/binlog/1", coldKey)
+	assert.True(t, kv.IsColdKey(coldKey))
+	coldKey, err = kv.CopyToColdTier(coldKey)
+	assert.Nil(t, err)
+	assert.Equal(t, "cold/binlog/1", coldKey)
+
+	// the key in the hot tier is read from the cold tier once the hot copy is removed
+	err = kv.Remove("binlog/1")
+	assert.Nil(t, err)
+	assert.True(t, kv.Exist("binlog/1"))
+	val, err := kv.Load("binlog/1")
+	assert.Nil(t, err)
+	assert.Equal(t, "0123456789", val)
+	buf, err := kv.LoadPartial("binlog/1", 2, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "234", string(buf))
+	size, err := kv.Size("binlog/1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), size)
+	val, err = kv.Load(coldKey)
+	assert.Nil(t, err)
+	assert.Equal(t, "0123456789", val)
+
+	// a key in neither tier is not found
+	assert.False(t, kv.Exist("binlog/2"))
+	_, err = kv.Load("binlog/2")
+	assert.NotNil(t, err)
+}
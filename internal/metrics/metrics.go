@@ -16,6 +16,11 @@ const (
 	subSystemDataCoord = "dataCoord"
 	subSystemDataNode  = "dataNode"
 	subSystemProxy     = "proxy"
+
+	// CacheHitLabel is the label of cache hits
+	CacheHitLabel = "hit"
+	// CacheMissLabel is the label of cache misses
+	CacheMissLabel = "miss"
 )
 
 /*
@@ -88,6 +93,177 @@ var (
 			Help:      "Counter of show collections",
 		}, []string{"client_id", "type"})
 
+	// RootCoordAddFieldCounter used to count the num of calls of AddField
+	RootCoordAddFieldCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "add_field_total",
+			Help:      "Counter of add field",
+		}, []string{"client_id", "type"})
+
+	// RootCoordRenameCollectionCounter used to count the num of calls of RenameCollection
+	RootCoordRenameCollectionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "rename_collection_total",
+			Help:      "Counter of rename collection",
+		}, []string{"client_id", "type"})
+
+	// RootCoordListDDLHistoryCounter used to count the num of calls of ListDDLHistory
+	RootCoordListDDLHistoryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "list_ddl_history_total",
+			Help:      "Counter of list ddl history",
+		}, []string{"client_id", "type"})
+
+	// RootCoordAlterCollectionCounter used to count the num of calls of AlterCollection
+	RootCoordAlterCollectionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "alter_collection_total",
+			Help:      "Counter of alter collection",
+		}, []string{"client_id", "type"})
+
+	// RootCoordListDroppedCollectionsCounter used to count the num of calls of ListDroppedCollections
+	RootCoordListDroppedCollectionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "list_dropped_collections_total",
+			Help:      "Counter of list dropped collections",
+		}, []string{"client_id", "type"})
+
+	// RootCoordRecoverCollectionCounter used to count the num of calls of RecoverCollection
+	RootCoordRecoverCollectionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "recover_collection_total",
+			Help:      "Counter of recover collection",
+		}, []string{"client_id", "type"})
+
+	// RootCoordReshardCollectionCounter used to count the num of calls of ReshardCollection
+	RootCoordReshardCollectionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "reshard_collection_total",
+			Help:      "Counter of reshard collection",
+		}, []string{"client_id", "type"})
+
+	// RootCoordCreateDatabaseCounter used to count the num of calls of CreateDatabase
+	RootCoordCreateDatabaseCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "create_database_total",
+			Help:      "Counter of create database",
+		}, []string{"client_id", "type"})
+
+	// RootCoordDropDatabaseCounter used to count the num of calls of DropDatabase
+	RootCoordDropDatabaseCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "drop_database_total",
+			Help:      "Counter of drop database",
+		}, []string{"client_id", "type"})
+
+	// RootCoordListDatabasesCounter used to count the num of calls of ListDatabases
+	RootCoordListDatabasesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "list_databases_total",
+			Help:      "Counter of list databases",
+		}, []string{"client_id", "type"})
+
+	// RootCoordCreateCredentialCounter used to count the num of calls of CreateCredential
+	RootCoordCreateCredentialCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "create_credential_total",
+			Help:      "Counter of create credential",
+		}, []string{"client_id", "type"})
+
+	// RootCoordUpdateCredentialCounter used to count the num of calls of UpdateCredential
+	RootCoordUpdateCredentialCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "update_credential_total",
+			Help:      "Counter of update credential",
+		}, []string{"client_id", "type"})
+
+	// RootCoordDeleteCredentialCounter used to count the num of calls of DeleteCredential
+	RootCoordDeleteCredentialCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "delete_credential_total",
+			Help:      "Counter of delete credential",
+		}, []string{"client_id", "type"})
+
+	// RootCoordListUsersCounter used to count the num of calls of ListUsers
+	RootCoordListUsersCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "list_users_total",
+			Help:      "Counter of list users",
+		}, []string{"client_id", "type"})
+
+	// RootCoordCreateRoleCounter used to count the num of calls of CreateRole
+	RootCoordCreateRoleCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "create_role_total",
+			Help:      "Counter of create role",
+		}, []string{"client_id", "type"})
+
+	// RootCoordDropRoleCounter used to count the num of calls of DropRole
+	RootCoordDropRoleCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "drop_role_total",
+			Help:      "Counter of drop role",
+		}, []string{"client_id", "type"})
+
+	// RootCoordOperateUserRoleCounter used to count the num of calls of OperateUserRole
+	RootCoordOperateUserRoleCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "operate_user_role_total",
+			Help:      "Counter of operate user role",
+		}, []string{"client_id", "type"})
+
+	// RootCoordOperatePrivilegeCounter used to count the num of calls of OperatePrivilege
+	RootCoordOperatePrivilegeCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "operate_privilege_total",
+			Help:      "Counter of operate privilege",
+		}, []string{"client_id", "type"})
+
+	// RootCoordSelectGrantCounter used to count the num of calls of SelectGrant
+	RootCoordSelectGrantCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "select_grant_total",
+			Help:      "Counter of select grant",
+		}, []string{"client_id", "type"})
+
 	// RootCoordCreatePartitionCounter used to count the num of calls of CreatePartition
 	RootCoordCreatePartitionCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -201,6 +377,25 @@ func RegisterRootCoord() {
 	prometheus.MustRegister(RootCoordHasCollectionCounter)
 	prometheus.MustRegister(RootCoordDescribeCollectionCounter)
 	prometheus.MustRegister(RootCoordShowCollectionsCounter)
+	prometheus.MustRegister(RootCoordAddFieldCounter)
+	prometheus.MustRegister(RootCoordRenameCollectionCounter)
+	prometheus.MustRegister(RootCoordListDDLHistoryCounter)
+	prometheus.MustRegister(RootCoordAlterCollectionCounter)
+	prometheus.MustRegister(RootCoordListDroppedCollectionsCounter)
+	prometheus.MustRegister(RootCoordRecoverCollectionCounter)
+	prometheus.MustRegister(RootCoordReshardCollectionCounter)
+	prometheus.MustRegister(RootCoordCreateDatabaseCounter)
+	prometheus.MustRegister(RootCoordDropDatabaseCounter)
+	prometheus.MustRegister(RootCoordListDatabasesCounter)
+	prometheus.MustRegister(RootCoordCreateCredentialCounter)
+	prometheus.MustRegister(RootCoordUpdateCredentialCounter)
+	prometheus.MustRegister(RootCoordDeleteCredentialCounter)
+	prometheus.MustRegister(RootCoordListUsersCounter)
+	prometheus.MustRegister(RootCoordCreateRoleCounter)
+	prometheus.MustRegister(RootCoordDropRoleCounter)
+	prometheus.MustRegister(RootCoordOperateUserRoleCounter)
+	prometheus.MustRegister(RootCoordOperatePrivilegeCounter)
+	prometheus.MustRegister(RootCoordSelectGrantCounter)
 	prometheus.MustRegister(RootCoordCreatePartitionCounter)
 	prometheus.MustRegister(RootCoordDropPartitionCounter)
 	prometheus.MustRegister(RootCoordHasPartitionCounter)
@@ -532,6 +727,15 @@ var (
 			Name:      "dml_channels_time_tick",
 			Help:      "Time tick of dml channels",
 		}, []string{"pchan"})
+
+	// ProxySearchCacheCounter used to count the hits and misses of search result cache
+	ProxySearchCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "search_cache_total",
+			Help:      "Counter of search result cache hits and misses",
+		}, []string{"type"})
 )
 
 //RegisterProxy register Proxy metrics
@@ -581,6 +785,8 @@ func RegisterProxy() {
 	prometheus.MustRegister(ProxyReleaseDQLMessageStreamCounter)
 
 	prometheus.MustRegister(ProxyDmlChannelTimeTick)
+
+	prometheus.MustRegister(ProxySearchCacheCounter)
 }
 
 //RegisterQueryCoord register QueryCoord metrics
@@ -748,6 +748,112 @@ func (dc *DropCollectionMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 	return dropCollectionMsg, nil
 }
 
+/////////////////////////////////////////AddField//////////////////////////////////////////
+type AddFieldMsg struct {
+	BaseMsg
+	internalpb.AddFieldRequest
+}
+
+func (af *AddFieldMsg) TraceCtx() context.Context {
+	return af.BaseMsg.Ctx
+}
+
+func (af *AddFieldMsg) SetTraceCtx(ctx context.Context) {
+	af.BaseMsg.Ctx = ctx
+}
+
+func (af *AddFieldMsg) ID() UniqueID {
+	return af.Base.MsgID
+}
+
+func (af *AddFieldMsg) Type() MsgType {
+	return af.Base.MsgType
+}
+
+func (af *AddFieldMsg) SourceID() int64 {
+	return af.Base.SourceID
+}
+
+func (af *AddFieldMsg) Marshal(input TsMsg) (MarshalType, error) {
+	addFieldMsg := input.(*AddFieldMsg)
+	addFieldRequest := &addFieldMsg.AddFieldRequest
+	mb, err := proto.Marshal(addFieldRequest)
+	if err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+func (af *AddFieldMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	addFieldRequest := internalpb.AddFieldRequest{}
+	in, err := ConvertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &addFieldRequest)
+	if err != nil {
+		return nil, err
+	}
+	addFieldMsg := &AddFieldMsg{AddFieldRequest: addFieldRequest}
+	addFieldMsg.BeginTimestamp = addFieldMsg.Base.Timestamp
+	addFieldMsg.EndTimestamp = addFieldMsg.Base.Timestamp
+
+	return addFieldMsg, nil
+}
+
+/////////////////////////////////////////RenameCollection//////////////////////////////////////////
+type RenameCollectionMsg struct {
+	BaseMsg
+	internalpb.RenameCollectionRequest
+}
+
+func (rc *RenameCollectionMsg) TraceCtx() context.Context {
+	return rc.BaseMsg.Ctx
+}
+
+func (rc *RenameCollectionMsg) SetTraceCtx(ctx context.Context) {
+	rc.BaseMsg.Ctx = ctx
+}
+
+func (rc *RenameCollectionMsg) ID() UniqueID {
+	return rc.Base.MsgID
+}
+
+func (rc *RenameCollectionMsg) Type() MsgType {
+	return rc.Base.MsgType
+}
+
+func (rc *RenameCollectionMsg) SourceID() int64 {
+	return rc.Base.SourceID
+}
+
+func (rc *RenameCollectionMsg) Marshal(input TsMsg) (MarshalType, error) {
+	renameCollectionMsg := input.(*RenameCollectionMsg)
+	renameCollectionRequest := &renameCollectionMsg.RenameCollectionRequest
+	mb, err := proto.Marshal(renameCollectionRequest)
+	if err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+func (rc *RenameCollectionMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	renameCollectionRequest := internalpb.RenameCollectionRequest{}
+	in, err := ConvertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &renameCollectionRequest)
+	if err != nil {
+		return nil, err
+	}
+	renameCollectionMsg := &RenameCollectionMsg{RenameCollectionRequest: renameCollectionRequest}
+	renameCollectionMsg.BeginTimestamp = renameCollectionMsg.Base.Timestamp
+	renameCollectionMsg.EndTimestamp = renameCollectionMsg.Base.Timestamp
+
+	return renameCollectionMsg, nil
+}
+
 /////////////////////////////////////////CreatePartition//////////////////////////////////////////
 type CreatePartitionMsg struct {
 	BaseMsg
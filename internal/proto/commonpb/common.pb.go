@@ -155,6 +155,40 @@ func (IndexState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{1}
 }
 
+type ExportState int32
+
+const (
+	ExportState_ExportStateNone  ExportState = 0
+	ExportState_ExportPending    ExportState = 1
+	ExportState_ExportInProgress ExportState = 2
+	ExportState_ExportCompleted  ExportState = 3
+	ExportState_ExportFailed     ExportState = 4
+)
+
+var ExportState_name = map[int32]string{
+	0: "ExportStateNone",
+	1: "ExportPending",
+	2: "ExportInProgress",
+	3: "ExportCompleted",
+	4: "ExportFailed",
+}
+
+var ExportState_value = map[string]int32{
+	"ExportStateNone":  0,
+	"ExportPending":    1,
+	"ExportInProgress": 2,
+	"ExportCompleted":  3,
+	"ExportFailed":     4,
+}
+
+func (x ExportState) String() string {
+	return proto.EnumName(ExportState_name, int32(x))
+}
+
+func (ExportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{2}
+}
+
 type SegmentState int32
 
 const (
@@ -189,7 +223,7 @@ func (x SegmentState) String() string {
 }
 
 func (SegmentState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{2}
+	return fileDescriptor_555bd8c177793206, []int{3}
 }
 
 type MsgType int32
@@ -197,14 +231,21 @@ type MsgType int32
 const (
 	MsgType_Undefined MsgType = 0
 	// DEFINITION REQUESTS: COLLECTION
-	MsgType_CreateCollection   MsgType = 100
-	MsgType_DropCollection     MsgType = 101
-	MsgType_HasCollection      MsgType = 102
-	MsgType_DescribeCollection MsgType = 103
-	MsgType_ShowCollections    MsgType = 104
-	MsgType_GetSystemConfigs   MsgType = 105
-	MsgType_LoadCollection     MsgType = 106
-	MsgType_ReleaseCollection  MsgType = 107
+	MsgType_CreateCollection       MsgType = 100
+	MsgType_DropCollection         MsgType = 101
+	MsgType_HasCollection          MsgType = 102
+	MsgType_DescribeCollection     MsgType = 103
+	MsgType_ShowCollections        MsgType = 104
+	MsgType_GetSystemConfigs       MsgType = 105
+	MsgType_LoadCollection         MsgType = 106
+	MsgType_ReleaseCollection      MsgType = 107
+	MsgType_AddField               MsgType = 108
+	MsgType_RenameCollection       MsgType = 109
+	MsgType_ListDDLHistory         MsgType = 110
+	MsgType_AlterCollection        MsgType = 111
+	MsgType_ListDroppedCollections MsgType = 112
+	MsgType_RecoverCollection      MsgType = 113
+	MsgType_ReshardCollection      MsgType = 114
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -213,6 +254,22 @@ const (
 	MsgType_ShowPartitions    MsgType = 204
 	MsgType_LoadPartitions    MsgType = 205
 	MsgType_ReleasePartitions MsgType = 206
+	// DEFINITION REQUESTS: DATABASE
+	MsgType_CreateDatabase MsgType = 220
+	MsgType_DropDatabase   MsgType = 221
+	MsgType_ListDatabases  MsgType = 222
+	// CREDENTIAL AND ACCESS CONTROL
+	MsgType_CreateCredential MsgType = 1500
+	MsgType_UpdateCredential MsgType = 1501
+	MsgType_DeleteCredential MsgType = 1502
+	MsgType_ListUsers        MsgType = 1503
+	MsgType_GetCredential    MsgType = 1504
+	MsgType_CreateRole       MsgType = 1505
+	MsgType_DropRole         MsgType = 1506
+	MsgType_OperateUserRole  MsgType = 1507
+	MsgType_OperatePrivilege MsgType = 1508
+	MsgType_SelectGrant      MsgType = 1509
+	MsgType_ListPolicy       MsgType = 1510
 	// DEFINE REQUESTS: SEGMENT
 	MsgType_ShowSegments        MsgType = 250
 	MsgType_DescribeSegment     MsgType = 251
@@ -225,9 +282,11 @@ const (
 	MsgType_DescribeIndex MsgType = 301
 	MsgType_DropIndex     MsgType = 302
 	// MANIPULATION REQUESTS
-	MsgType_Insert MsgType = 400
-	MsgType_Delete MsgType = 401
-	MsgType_Flush  MsgType = 402
+	MsgType_Insert         MsgType = 400
+	MsgType_Delete         MsgType = 401
+	MsgType_Flush          MsgType = 402
+	MsgType_Export         MsgType = 403
+	MsgType_GetExportState MsgType = 404
 	// QUERY
 	MsgType_Search                  MsgType = 500
 	MsgType_SearchResult            MsgType = 501
@@ -265,6 +324,13 @@ var MsgType_name = map[int32]string{
 	105:  "GetSystemConfigs",
 	106:  "LoadCollection",
 	107:  "ReleaseCollection",
+	108:  "AddField",
+	109:  "RenameCollection",
+	110:  "ListDDLHistory",
+	111:  "AlterCollection",
+	112:  "ListDroppedCollections",
+	113:  "RecoverCollection",
+	114:  "ReshardCollection",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -272,6 +338,20 @@ var MsgType_name = map[int32]string{
 	204:  "ShowPartitions",
 	205:  "LoadPartitions",
 	206:  "ReleasePartitions",
+	220:  "CreateDatabase",
+	221:  "DropDatabase",
+	222:  "ListDatabases",
+	1500: "CreateCredential",
+	1501: "UpdateCredential",
+	1502: "DeleteCredential",
+	1503: "ListUsers",
+	1504: "GetCredential",
+	1505: "CreateRole",
+	1506: "DropRole",
+	1507: "OperateUserRole",
+	1508: "OperatePrivilege",
+	1509: "SelectGrant",
+	1510: "ListPolicy",
 	250:  "ShowSegments",
 	251:  "DescribeSegment",
 	252:  "LoadSegments",
@@ -284,6 +364,8 @@ var MsgType_name = map[int32]string{
 	400:  "Insert",
 	401:  "Delete",
 	402:  "Flush",
+	403:  "Export",
+	404:  "GetExportState",
 	500:  "Search",
 	501:  "SearchResult",
 	502:  "GetIndexState",
@@ -318,6 +400,13 @@ var MsgType_value = map[string]int32{
 	"GetSystemConfigs":        105,
 	"LoadCollection":          106,
 	"ReleaseCollection":       107,
+	"AddField":                108,
+	"RenameCollection":        109,
+	"ListDDLHistory":          110,
+	"AlterCollection":         111,
+	"ListDroppedCollections":  112,
+	"RecoverCollection":       113,
+	"ReshardCollection":       114,
 	"CreatePartition":         200,
 	"DropPartition":           201,
 	"HasPartition":            202,
@@ -325,6 +414,20 @@ var MsgType_value = map[string]int32{
 	"ShowPartitions":          204,
 	"LoadPartitions":          205,
 	"ReleasePartitions":       206,
+	"CreateDatabase":          220,
+	"DropDatabase":            221,
+	"ListDatabases":           222,
+	"CreateCredential":        1500,
+	"UpdateCredential":        1501,
+	"DeleteCredential":        1502,
+	"ListUsers":               1503,
+	"GetCredential":           1504,
+	"CreateRole":              1505,
+	"DropRole":                1506,
+	"OperateUserRole":         1507,
+	"OperatePrivilege":        1508,
+	"SelectGrant":             1509,
+	"ListPolicy":              1510,
 	"ShowSegments":            250,
 	"DescribeSegment":         251,
 	"LoadSegments":            252,
@@ -337,6 +440,8 @@ var MsgType_value = map[string]int32{
 	"Insert":                  400,
 	"Delete":                  401,
 	"Flush":                   402,
+	"Export":                  403,
+	"GetExportState":          404,
 	"Search":                  500,
 	"SearchResult":            501,
 	"GetIndexState":           502,
@@ -366,7 +471,7 @@ func (x MsgType) String() string {
 }
 
 func (MsgType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{3}
+	return fileDescriptor_555bd8c177793206, []int{4}
 }
 
 type DslType int32
@@ -391,7 +496,163 @@ func (x DslType) String() string {
 }
 
 func (DslType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{4}
+	return fileDescriptor_555bd8c177793206, []int{5}
+}
+
+type ConsistencyLevel int32
+
+const (
+	ConsistencyLevel_Strong     ConsistencyLevel = 0
+	ConsistencyLevel_Session    ConsistencyLevel = 1
+	ConsistencyLevel_Bounded    ConsistencyLevel = 2
+	ConsistencyLevel_Eventually ConsistencyLevel = 3
+)
+
+var ConsistencyLevel_name = map[int32]string{
+	0: "Strong",
+	1: "Session",
+	2: "Bounded",
+	3: "Eventually",
+}
+
+var ConsistencyLevel_value = map[string]int32{
+	"Strong":     0,
+	"Session":    1,
+	"Bounded":    2,
+	"Eventually": 3,
+}
+
+func (x ConsistencyLevel) String() string {
+	return proto.EnumName(ConsistencyLevel_name, int32(x))
+}
+
+func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{6}
+}
+
+type ObjectType int32
+
+const (
+	ObjectType_Global     ObjectType = 0
+	ObjectType_Collection ObjectType = 1
+	ObjectType_Database   ObjectType = 2
+)
+
+var ObjectType_name = map[int32]string{
+	0: "Global",
+	1: "Collection",
+	2: "Database",
+}
+
+var ObjectType_value = map[string]int32{
+	"Global":     0,
+	"Collection": 1,
+	"Database":   2,
+}
+
+func (x ObjectType) String() string {
+	return proto.EnumName(ObjectType_name, int32(x))
+}
+
+func (ObjectType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{7}
+}
+
+type ObjectPrivilege int32
+
+const (
+	ObjectPrivilege_PrivilegeAll                ObjectPrivilege = 0
+	ObjectPrivilege_PrivilegeCreateCollection   ObjectPrivilege = 1
+	ObjectPrivilege_PrivilegeDropCollection     ObjectPrivilege = 2
+	ObjectPrivilege_PrivilegeDescribeCollection ObjectPrivilege = 3
+	ObjectPrivilege_PrivilegeShowCollections    ObjectPrivilege = 4
+	ObjectPrivilege_PrivilegeLoad               ObjectPrivilege = 5
+	ObjectPrivilege_PrivilegeRelease            ObjectPrivilege = 6
+	ObjectPrivilege_PrivilegeInsert             ObjectPrivilege = 7
+	ObjectPrivilege_PrivilegeDelete             ObjectPrivilege = 8
+	ObjectPrivilege_PrivilegeSearch             ObjectPrivilege = 9
+	ObjectPrivilege_PrivilegeQuery              ObjectPrivilege = 10
+	ObjectPrivilege_PrivilegeFlush              ObjectPrivilege = 11
+	ObjectPrivilege_PrivilegeCreateIndex        ObjectPrivilege = 12
+	ObjectPrivilege_PrivilegeDropIndex          ObjectPrivilege = 13
+	ObjectPrivilege_PrivilegeCreatePartition    ObjectPrivilege = 14
+	ObjectPrivilege_PrivilegeDropPartition      ObjectPrivilege = 15
+	ObjectPrivilege_PrivilegeAddField           ObjectPrivilege = 16
+	ObjectPrivilege_PrivilegeCreateDatabase     ObjectPrivilege = 17
+	ObjectPrivilege_PrivilegeDropDatabase       ObjectPrivilege = 18
+	ObjectPrivilege_PrivilegeManageUser         ObjectPrivilege = 19
+	ObjectPrivilege_PrivilegeRenameCollection   ObjectPrivilege = 20
+	ObjectPrivilege_PrivilegeListDDLHistory     ObjectPrivilege = 21
+	ObjectPrivilege_PrivilegeAlterCollection    ObjectPrivilege = 22
+	ObjectPrivilege_PrivilegeRecoverCollection  ObjectPrivilege = 23
+	ObjectPrivilege_PrivilegeReshardCollection  ObjectPrivilege = 24
+	ObjectPrivilege_PrivilegeExport             ObjectPrivilege = 25
+)
+
+var ObjectPrivilege_name = map[int32]string{
+	0:  "PrivilegeAll",
+	1:  "PrivilegeCreateCollection",
+	2:  "PrivilegeDropCollection",
+	3:  "PrivilegeDescribeCollection",
+	4:  "PrivilegeShowCollections",
+	5:  "PrivilegeLoad",
+	6:  "PrivilegeRelease",
+	7:  "PrivilegeInsert",
+	8:  "PrivilegeDelete",
+	9:  "PrivilegeSearch",
+	10: "PrivilegeQuery",
+	11: "PrivilegeFlush",
+	12: "PrivilegeCreateIndex",
+	13: "PrivilegeDropIndex",
+	14: "PrivilegeCreatePartition",
+	15: "PrivilegeDropPartition",
+	16: "PrivilegeAddField",
+	17: "PrivilegeCreateDatabase",
+	18: "PrivilegeDropDatabase",
+	19: "PrivilegeManageUser",
+	20: "PrivilegeRenameCollection",
+	21: "PrivilegeListDDLHistory",
+	22: "PrivilegeAlterCollection",
+	23: "PrivilegeRecoverCollection",
+	24: "PrivilegeReshardCollection",
+	25: "PrivilegeExport",
+}
+
+var ObjectPrivilege_value = map[string]int32{
+	"PrivilegeAll":                0,
+	"PrivilegeCreateCollection":   1,
+	"PrivilegeDropCollection":     2,
+	"PrivilegeDescribeCollection": 3,
+	"PrivilegeShowCollections":    4,
+	"PrivilegeLoad":               5,
+	"PrivilegeRelease":            6,
+	"PrivilegeInsert":             7,
+	"PrivilegeDelete":             8,
+	"PrivilegeSearch":             9,
+	"PrivilegeQuery":              10,
+	"PrivilegeFlush":              11,
+	"PrivilegeCreateIndex":        12,
+	"PrivilegeDropIndex":          13,
+	"PrivilegeCreatePartition":    14,
+	"PrivilegeDropPartition":      15,
+	"PrivilegeAddField":           16,
+	"PrivilegeCreateDatabase":     17,
+	"PrivilegeDropDatabase":       18,
+	"PrivilegeManageUser":         19,
+	"PrivilegeRenameCollection":   20,
+	"PrivilegeListDDLHistory":     21,
+	"PrivilegeAlterCollection":    22,
+	"PrivilegeRecoverCollection":  23,
+	"PrivilegeReshardCollection":  24,
+	"PrivilegeExport":             25,
+}
+
+func (x ObjectPrivilege) String() string {
+	return proto.EnumName(ObjectPrivilege_name, int32(x))
+}
+
+func (ObjectPrivilege) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{8}
 }
 
 type Status struct {
@@ -680,9 +941,13 @@ func (m *MsgHeader) GetBase() *MsgBase {
 func init() {
 	proto.RegisterEnum("milvus.proto.common.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("milvus.proto.common.IndexState", IndexState_name, IndexState_value)
+	proto.RegisterEnum("milvus.proto.common.ExportState", ExportState_name, ExportState_value)
 	proto.RegisterEnum("milvus.proto.common.SegmentState", SegmentState_name, SegmentState_value)
 	proto.RegisterEnum("milvus.proto.common.MsgType", MsgType_name, MsgType_value)
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
+	proto.RegisterEnum("milvus.proto.common.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
+	proto.RegisterEnum("milvus.proto.common.ObjectType", ObjectType_name, ObjectType_value)
+	proto.RegisterEnum("milvus.proto.common.ObjectPrivilege", ObjectPrivilege_name, ObjectPrivilege_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
 	proto.RegisterType((*Blob)(nil), "milvus.proto.common.Blob")
@@ -694,86 +959,119 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xd9, 0x6e, 0xdb, 0x46,
-	0x17, 0xb6, 0x44, 0xd9, 0x32, 0xc7, 0xb2, 0x3d, 0x1e, 0x2f, 0x71, 0xf2, 0x1b, 0x3f, 0x02, 0x5f,
-	0x05, 0x06, 0x62, 0xb7, 0x0d, 0xda, 0x5e, 0xe5, 0x22, 0x16, 0xbd, 0x08, 0x89, 0x97, 0x52, 0x4e,
-	0x5a, 0xf4, 0x26, 0x18, 0x93, 0x47, 0xd2, 0x34, 0xe4, 0x8c, 0x3a, 0x33, 0x74, 0xac, 0xfb, 0x3e,
-	0x40, 0x9b, 0xe7, 0x68, 0x8b, 0xee, 0x2d, 0xfa, 0x04, 0xdd, 0xaf, 0xfb, 0x08, 0x7d, 0x80, 0xae,
-	0x59, 0x8b, 0x33, 0xa4, 0x24, 0x06, 0x48, 0xef, 0x78, 0xbe, 0xb3, 0x7d, 0xf3, 0x9d, 0x39, 0x43,
-	0xd2, 0x88, 0x54, 0x9a, 0x2a, 0xb9, 0xd9, 0xd7, 0xca, 0x2a, 0xb6, 0x98, 0x8a, 0xe4, 0x2c, 0x33,
-	0xb9, 0xb5, 0x99, 0xbb, 0xd6, 0xef, 0x92, 0xa9, 0xb6, 0xe5, 0x36, 0x33, 0xec, 0x3a, 0x21, 0xa0,
-	0xb5, 0xd2, 0x77, 0x23, 0x15, 0xc3, 0x6a, 0xe5, 0x72, 0xe5, 0xca, 0xdc, 0x2b, 0xff, 0xdf, 0x7c,
-	0x41, 0xce, 0xe6, 0x0e, 0x86, 0x35, 0x55, 0x0c, 0xa1, 0x0f, 0xc3, 0x4f, 0xb6, 0x42, 0xa6, 0x34,
-	0x70, 0xa3, 0xe4, 0x6a, 0xf5, 0x72, 0xe5, 0x8a, 0x1f, 0x16, 0xd6, 0xfa, 0x6b, 0xa4, 0x71, 0x13,
-	0x06, 0x77, 0x78, 0x92, 0xc1, 0x31, 0x17, 0x9a, 0x51, 0xe2, 0xdd, 0x83, 0x81, 0xab, 0xef, 0x87,
-	0xf8, 0xc9, 0x96, 0xc8, 0xe4, 0x19, 0xba, 0x8b, 0xc4, 0xdc, 0x58, 0x5f, 0x23, 0xb5, 0xed, 0x44,
-	0x9d, 0x8e, 0xbd, 0x98, 0xd1, 0x18, 0x7a, 0xaf, 0x92, 0xfa, 0x8d, 0x38, 0xd6, 0x60, 0x0c, 0x9b,
-	0x23, 0x55, 0xd1, 0x2f, 0xea, 0x55, 0x45, 0x9f, 0x31, 0x52, 0xeb, 0x2b, 0x6d, 0x5d, 0x35, 0x2f,
-	0x74, 0xdf, 0xeb, 0x0f, 0x2a, 0xa4, 0x7e, 0x60, 0xba, 0xdb, 0xdc, 0x00, 0x7b, 0x9d, 0x4c, 0xa7,
-	0xa6, 0x7b, 0xd7, 0x0e, 0xfa, 0xc3, 0x53, 0xae, 0xbd, 0xf0, 0x94, 0x07, 0xa6, 0x7b, 0x32, 0xe8,
-	0x43, 0x58, 0x4f, 0xf3, 0x0f, 0x64, 0x92, 0x9a, 0x6e, 0x2b, 0x28, 0x2a, 0xe7, 0x06, 0x5b, 0x23,
-	0xbe, 0x15, 0x29, 0x18, 0xcb, 0xd3, 0xfe, 0xaa, 0x77, 0xb9, 0x72, 0xa5, 0x16, 0x8e, 0x01, 0x76,
-	0x89, 0x4c, 0x1b, 0x95, 0xe9, 0x08, 0x5a, 0xc1, 0x6a, 0xcd, 0xa5, 0x8d, 0xec, 0xf5, 0xeb, 0xc4,
-	0x3f, 0x30, 0xdd, 0x7d, 0xe0, 0x31, 0x68, 0xf6, 0x12, 0xa9, 0x9d, 0x72, 0x93, 0x33, 0x9a, 0xf9,
-	0x6f, 0x46, 0x78, 0x82, 0xd0, 0x45, 0x6e, 0x7c, 0x5b, 0x23, 0xfe, 0x68, 0x12, 0x6c, 0x86, 0xd4,
-	0xdb, 0x59, 0x14, 0x81, 0x31, 0x74, 0x82, 0x2d, 0x92, 0xf9, 0xdb, 0x12, 0xce, 0xfb, 0x10, 0x59,
-	0x88, 0x5d, 0x0c, 0xad, 0xb0, 0x05, 0x32, 0xdb, 0x54, 0x52, 0x42, 0x64, 0x77, 0xb9, 0x48, 0x20,
-	0xa6, 0x55, 0xb6, 0x44, 0xe8, 0x31, 0xe8, 0x54, 0x18, 0x23, 0x94, 0x0c, 0x40, 0x0a, 0x88, 0xa9,
-	0xc7, 0x2e, 0x90, 0xc5, 0xa6, 0x4a, 0x12, 0x88, 0xac, 0x50, 0xf2, 0x50, 0xd9, 0x9d, 0x73, 0x61,
-	0xac, 0xa1, 0x35, 0x2c, 0xdb, 0x4a, 0x12, 0xe8, 0xf2, 0xe4, 0x86, 0xee, 0x66, 0x29, 0x48, 0x4b,
-	0x27, 0xb1, 0x46, 0x01, 0x06, 0x22, 0x05, 0x89, 0x95, 0x68, 0xbd, 0x84, 0xb6, 0x64, 0x0c, 0xe7,
-	0xa8, 0x1f, 0x9d, 0x66, 0x17, 0xc9, 0x72, 0x81, 0x96, 0x1a, 0xf0, 0x14, 0xa8, 0xcf, 0xe6, 0xc9,
-	0x4c, 0xe1, 0x3a, 0x39, 0x3a, 0xbe, 0x49, 0x49, 0xa9, 0x42, 0xa8, 0xee, 0x87, 0x10, 0x29, 0x1d,
-	0xd3, 0x99, 0x12, 0x85, 0x3b, 0x10, 0x59, 0xa5, 0x5b, 0x01, 0x6d, 0x20, 0xe1, 0x02, 0x6c, 0x03,
-	0xd7, 0x51, 0x2f, 0x04, 0x93, 0x25, 0x96, 0xce, 0x32, 0x4a, 0x1a, 0xbb, 0x22, 0x81, 0x43, 0x65,
-	0x77, 0x55, 0x26, 0x63, 0x3a, 0xc7, 0xe6, 0x08, 0x39, 0x00, 0xcb, 0x0b, 0x05, 0xe6, 0xb1, 0x6d,
-	0x93, 0x47, 0x3d, 0x28, 0x00, 0xca, 0x56, 0x08, 0x6b, 0x72, 0x29, 0x95, 0x6d, 0x6a, 0xe0, 0x16,
-	0x76, 0x55, 0x12, 0x83, 0xa6, 0x0b, 0x48, 0xe7, 0x39, 0x5c, 0x24, 0x40, 0xd9, 0x38, 0x3a, 0x80,
-	0x04, 0x46, 0xd1, 0x8b, 0xe3, 0xe8, 0x02, 0xc7, 0xe8, 0x25, 0x24, 0xbf, 0x9d, 0x89, 0x24, 0x76,
-	0x92, 0xe4, 0x63, 0x59, 0x46, 0x8e, 0x05, 0xf9, 0xc3, 0x5b, 0xad, 0xf6, 0x09, 0x5d, 0x61, 0xcb,
-	0x64, 0xa1, 0x40, 0x0e, 0xc0, 0x6a, 0x11, 0x39, 0xf1, 0x2e, 0x20, 0xd5, 0xa3, 0xcc, 0x1e, 0x75,
-	0x0e, 0x20, 0x55, 0x7a, 0x40, 0x57, 0x71, 0xa0, 0xae, 0xd2, 0x70, 0x44, 0xf4, 0x22, 0x76, 0xd8,
-	0x49, 0xfb, 0x76, 0x30, 0x96, 0x97, 0x5e, 0x62, 0x8c, 0xcc, 0x06, 0x41, 0x08, 0xef, 0x66, 0x60,
-	0x6c, 0xc8, 0x23, 0xa0, 0xbf, 0xd5, 0x37, 0xde, 0x22, 0xc4, 0xe5, 0xe2, 0xee, 0x03, 0x63, 0x64,
-	0x6e, 0x6c, 0x1d, 0x2a, 0x09, 0x74, 0x82, 0x35, 0xc8, 0xf4, 0x6d, 0x29, 0x8c, 0xc9, 0x20, 0xa6,
-	0x15, 0xd4, 0xad, 0x25, 0x8f, 0xb5, 0xea, 0xe2, 0xca, 0xd1, 0x2a, 0x7a, 0x77, 0x85, 0x14, 0xa6,
-	0xe7, 0x6e, 0x0c, 0x21, 0x53, 0x85, 0x80, 0xb5, 0x8d, 0x0e, 0x69, 0xb4, 0xa1, 0x8b, 0x97, 0x23,
-	0xaf, 0xbd, 0x44, 0x68, 0xd9, 0x1e, 0x57, 0x1f, 0xd1, 0xae, 0xe0, 0xe5, 0xdd, 0xd3, 0xea, 0xbe,
-	0x90, 0x5d, 0x5a, 0xc5, 0x62, 0x6d, 0xe0, 0x89, 0x2b, 0x3c, 0x43, 0xea, 0xbb, 0x49, 0xe6, 0xba,
-	0xd4, 0x5c, 0x4f, 0x34, 0x30, 0x6c, 0x72, 0xe3, 0xbd, 0x69, 0xb7, 0xd2, 0x6e, 0x33, 0x67, 0x89,
-	0x7f, 0x5b, 0xc6, 0xd0, 0x11, 0x12, 0x62, 0x3a, 0xe1, 0xd4, 0x77, 0x53, 0x2a, 0xc9, 0x10, 0xe3,
-	0x21, 0x03, 0xad, 0xfa, 0x25, 0x0c, 0x50, 0xc2, 0x7d, 0x6e, 0x4a, 0x50, 0x07, 0x47, 0x1a, 0x80,
-	0x89, 0xb4, 0x38, 0x2d, 0xa7, 0x77, 0x51, 0xda, 0x76, 0x4f, 0xdd, 0x1f, 0x63, 0x86, 0xf6, 0xb0,
-	0xd3, 0x1e, 0xd8, 0xf6, 0xc0, 0x58, 0x48, 0x9b, 0x4a, 0x76, 0x44, 0xd7, 0x50, 0x81, 0x9d, 0x6e,
-	0x29, 0x1e, 0x97, 0xd2, 0xdf, 0xc1, 0xa1, 0x86, 0x90, 0x00, 0x37, 0xe5, 0xaa, 0xf7, 0xd8, 0x12,
-	0x99, 0xcf, 0xa9, 0x1e, 0x73, 0x6d, 0x85, 0x03, 0xbf, 0xab, 0xb8, 0x89, 0x69, 0xd5, 0x1f, 0x63,
-	0xdf, 0xe3, 0xfa, 0x36, 0xf6, 0xb9, 0x19, 0x43, 0x3f, 0x54, 0xd8, 0x0a, 0x59, 0x18, 0x52, 0x1d,
-	0xe3, 0x3f, 0x56, 0xd8, 0x22, 0x99, 0x43, 0xaa, 0x23, 0xcc, 0xd0, 0x9f, 0x1c, 0x88, 0xa4, 0x4a,
-	0xe0, 0xcf, 0xae, 0x42, 0xc1, 0xaa, 0x84, 0xff, 0xe2, 0x9a, 0x61, 0x85, 0x62, 0x70, 0x86, 0x3e,
-	0xac, 0x20, 0xd3, 0x61, 0xb3, 0x02, 0xa6, 0x8f, 0x5c, 0x20, 0x56, 0x1d, 0x05, 0x3e, 0x76, 0x81,
-	0x45, 0xcd, 0x11, 0xfa, 0xc4, 0xa1, 0xfb, 0x5c, 0xc6, 0xaa, 0xd3, 0x19, 0xa1, 0x4f, 0x2b, 0x6c,
-	0x95, 0x2c, 0x62, 0xfa, 0x36, 0x4f, 0xb8, 0x8c, 0xc6, 0xf1, 0xcf, 0x2a, 0x8c, 0x92, 0x99, 0x5c,
-	0x18, 0x77, 0x31, 0xe9, 0x87, 0x55, 0x27, 0x4a, 0x41, 0x20, 0xc7, 0x3e, 0xaa, 0xb2, 0x39, 0xe2,
-	0xa3, 0x50, 0xb9, 0xfd, 0x71, 0x95, 0xcd, 0x90, 0xa9, 0x96, 0x34, 0xa0, 0x2d, 0x7d, 0x1f, 0x2f,
-	0xcf, 0x54, 0xbe, 0x7e, 0xf4, 0x03, 0xbc, 0xa2, 0x93, 0xee, 0xf2, 0xd0, 0x07, 0xce, 0x91, 0x3f,
-	0x14, 0xf4, 0x77, 0xcf, 0x1d, 0xb5, 0xfc, 0x6a, 0xfc, 0xe1, 0x61, 0xa7, 0x3d, 0xb0, 0xe3, 0x8d,
-	0xa0, 0x7f, 0x7a, 0xec, 0x12, 0x59, 0x1e, 0x62, 0x6e, 0x87, 0x47, 0xbb, 0xf0, 0x97, 0xc7, 0xd6,
-	0xc8, 0x85, 0x3d, 0xb0, 0xe3, 0xb9, 0x62, 0x92, 0x30, 0x56, 0x44, 0x86, 0xfe, 0xed, 0xb1, 0xff,
-	0x91, 0x95, 0x3d, 0xb0, 0x23, 0x7d, 0x4b, 0xce, 0x7f, 0x3c, 0x36, 0x4b, 0xa6, 0x43, 0x5c, 0x72,
-	0x38, 0x03, 0xfa, 0xd0, 0xc3, 0x21, 0x0d, 0xcd, 0x82, 0xce, 0x23, 0x0f, 0xa5, 0x7b, 0x93, 0xdb,
-	0xa8, 0x17, 0xa4, 0xcd, 0x1e, 0x97, 0x12, 0x12, 0x43, 0x1f, 0x7b, 0x6c, 0x99, 0xd0, 0x10, 0x52,
-	0x75, 0x06, 0x25, 0xf8, 0x09, 0x3e, 0xde, 0xcc, 0x05, 0xbf, 0x91, 0x81, 0x1e, 0x8c, 0x1c, 0x4f,
-	0x3d, 0x94, 0x3a, 0x8f, 0x7f, 0xde, 0xf3, 0xcc, 0x43, 0xa9, 0x0b, 0xe5, 0x5b, 0xb2, 0xa3, 0xe8,
-	0xaf, 0x35, 0x64, 0x75, 0x22, 0x52, 0x38, 0x11, 0xd1, 0x3d, 0xfa, 0x89, 0x8f, 0xac, 0x5c, 0xd2,
-	0xa1, 0x8a, 0x01, 0xe9, 0x1b, 0xfa, 0xa9, 0x8f, 0xd2, 0xe3, 0xe8, 0x72, 0xe9, 0x3f, 0x73, 0x76,
-	0xf1, 0xc6, 0xb4, 0x02, 0xfa, 0x39, 0x3e, 0xe8, 0xa4, 0xb0, 0x4f, 0xda, 0x47, 0xf4, 0x0b, 0x1f,
-	0x8f, 0x71, 0x23, 0x49, 0x54, 0xc4, 0xed, 0xe8, 0x02, 0x7d, 0xe9, 0xe3, 0x0d, 0x2c, 0x3d, 0x0f,
-	0x85, 0x30, 0x5f, 0xf9, 0x78, 0xbc, 0x02, 0x77, 0x63, 0x0b, 0xf0, 0xd9, 0xf8, 0xda, 0x55, 0x0d,
-	0xb8, 0xe5, 0xc8, 0xe4, 0xc4, 0xd2, 0x6f, 0xfc, 0x8d, 0x75, 0x52, 0x0f, 0x4c, 0xe2, 0x5e, 0x81,
-	0x3a, 0xf1, 0x02, 0x93, 0xd0, 0x09, 0x7c, 0xac, 0xb6, 0x95, 0x4a, 0x76, 0xce, 0xfb, 0xfa, 0xce,
-	0xcb, 0xb4, 0xb2, 0xfd, 0xea, 0xdb, 0xd7, 0xba, 0xc2, 0xf6, 0xb2, 0x53, 0xfc, 0x91, 0x6e, 0xe5,
-	0x7f, 0xd6, 0xab, 0x42, 0x15, 0x5f, 0x5b, 0x42, 0x5a, 0xd0, 0x92, 0x27, 0x5b, 0xee, 0x67, 0xbb,
-	0x95, 0xff, 0x6c, 0xfb, 0xa7, 0xa7, 0x53, 0xce, 0xbe, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0x24, 0x61, 0xe5, 0x46, 0x09, 0x00, 0x00,
+	// 1818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x57, 0x49, 0x73, 0x1b, 0xc7,
+	0xf5, 0x17, 0x16, 0x11, 0xc4, 0x03, 0x48, 0x36, 0x9b, 0xab, 0x28, 0xfd, 0xfd, 0x57, 0xf1, 0xa4,
+	0x62, 0x95, 0xa5, 0x24, 0xae, 0x38, 0x27, 0x1f, 0x48, 0x80, 0x0b, 0xca, 0xe2, 0x12, 0x80, 0x54,
+	0x52, 0xb9, 0xa8, 0x9a, 0x33, 0x8f, 0x60, 0x5b, 0x3d, 0xdd, 0x70, 0x77, 0x83, 0x22, 0xbe, 0x45,
+	0xe2, 0xe4, 0x63, 0x24, 0xa9, 0x38, 0x7b, 0xe5, 0x13, 0x64, 0x3f, 0xe4, 0x94, 0x83, 0xed, 0xec,
+	0xa9, 0x7c, 0x80, 0xac, 0x5e, 0x53, 0xaf, 0x67, 0x38, 0x18, 0x40, 0xce, 0x6d, 0xfa, 0xf7, 0x5e,
+	0xbf, 0x7d, 0xe9, 0x81, 0x66, 0x64, 0x92, 0xc4, 0xe8, 0x87, 0x03, 0x6b, 0xbc, 0xe1, 0x4b, 0x89,
+	0x54, 0x57, 0x43, 0x97, 0x9e, 0x1e, 0xa6, 0xa4, 0xcd, 0xa7, 0x30, 0xd3, 0xf3, 0xc2, 0x0f, 0x1d,
+	0x7f, 0x0d, 0x00, 0xad, 0x35, 0xf6, 0x69, 0x64, 0x62, 0x5c, 0x2f, 0xdd, 0x2f, 0x3d, 0x98, 0xff,
+	0xdc, 0x4b, 0x0f, 0x3f, 0xe5, 0xce, 0xc3, 0x5d, 0x62, 0x6b, 0x99, 0x18, 0xbb, 0x75, 0xbc, 0xf9,
+	0xe4, 0xab, 0x30, 0x63, 0x51, 0x38, 0xa3, 0xd7, 0xcb, 0xf7, 0x4b, 0x0f, 0xea, 0xdd, 0xec, 0xb4,
+	0xf9, 0x2a, 0x34, 0x5f, 0xc7, 0xd1, 0x13, 0xa1, 0x86, 0x78, 0x22, 0xa4, 0xe5, 0x0c, 0x2a, 0xcf,
+	0x70, 0x14, 0xe4, 0xd7, 0xbb, 0xf4, 0xc9, 0x97, 0xe1, 0xf6, 0x15, 0x91, 0xb3, 0x8b, 0xe9, 0x61,
+	0xf3, 0x1e, 0x54, 0x77, 0x94, 0x39, 0x1f, 0x53, 0xe9, 0x46, 0xf3, 0x86, 0xfa, 0x32, 0xd4, 0xb6,
+	0xe3, 0xd8, 0xa2, 0x73, 0x7c, 0x1e, 0xca, 0x72, 0x90, 0xc9, 0x2b, 0xcb, 0x01, 0xe7, 0x50, 0x1d,
+	0x18, 0xeb, 0x83, 0xb4, 0x4a, 0x37, 0x7c, 0x6f, 0xbe, 0x55, 0x82, 0xda, 0xa1, 0xeb, 0xef, 0x08,
+	0x87, 0xfc, 0x0b, 0x30, 0x9b, 0xb8, 0xfe, 0x53, 0x3f, 0x1a, 0xdc, 0x78, 0x79, 0xef, 0x53, 0xbd,
+	0x3c, 0x74, 0xfd, 0xd3, 0xd1, 0x00, 0xbb, 0xb5, 0x24, 0xfd, 0x20, 0x4b, 0x12, 0xd7, 0xef, 0xb4,
+	0x33, 0xc9, 0xe9, 0x81, 0xdf, 0x83, 0xba, 0x97, 0x09, 0x3a, 0x2f, 0x92, 0xc1, 0x7a, 0xe5, 0x7e,
+	0xe9, 0x41, 0xb5, 0x3b, 0x06, 0xf8, 0x06, 0xcc, 0x3a, 0x33, 0xb4, 0x11, 0x76, 0xda, 0xeb, 0xd5,
+	0x70, 0x2d, 0x3f, 0x6f, 0xbe, 0x06, 0xf5, 0x43, 0xd7, 0x3f, 0x40, 0x11, 0xa3, 0xe5, 0x9f, 0x81,
+	0xea, 0xb9, 0x70, 0xa9, 0x45, 0x8d, 0xff, 0x6d, 0x11, 0x79, 0xd0, 0x0d, 0x9c, 0x5b, 0x3f, 0xa9,
+	0x42, 0x3d, 0xcf, 0x04, 0x6f, 0x40, 0xad, 0x37, 0x8c, 0x22, 0x74, 0x8e, 0xdd, 0xe2, 0x4b, 0xb0,
+	0x70, 0xa6, 0xf1, 0x7a, 0x80, 0x91, 0xc7, 0x38, 0xf0, 0xb0, 0x12, 0x5f, 0x84, 0xb9, 0x96, 0xd1,
+	0x1a, 0x23, 0xbf, 0x27, 0xa4, 0xc2, 0x98, 0x95, 0xf9, 0x32, 0xb0, 0x13, 0xb4, 0x89, 0x74, 0x4e,
+	0x1a, 0xdd, 0x46, 0x2d, 0x31, 0x66, 0x15, 0xbe, 0x06, 0x4b, 0x2d, 0xa3, 0x14, 0x46, 0x5e, 0x1a,
+	0x7d, 0x64, 0xfc, 0xee, 0xb5, 0x74, 0xde, 0xb1, 0x2a, 0x89, 0xed, 0x28, 0x85, 0x7d, 0xa1, 0xb6,
+	0x6d, 0x7f, 0x98, 0xa0, 0xf6, 0xec, 0x36, 0xc9, 0xc8, 0xc0, 0xb6, 0x4c, 0x50, 0x93, 0x24, 0x56,
+	0x2b, 0xa0, 0x1d, 0x1d, 0xe3, 0x35, 0xc5, 0x8f, 0xcd, 0xf2, 0x3b, 0xb0, 0x92, 0xa1, 0x05, 0x05,
+	0x22, 0x41, 0x56, 0xe7, 0x0b, 0xd0, 0xc8, 0x48, 0xa7, 0xc7, 0x27, 0xaf, 0x33, 0x28, 0x48, 0xe8,
+	0x9a, 0xe7, 0x5d, 0x8c, 0x8c, 0x8d, 0x59, 0xa3, 0x60, 0xc2, 0x13, 0x8c, 0xbc, 0xb1, 0x9d, 0x36,
+	0x6b, 0x92, 0xc1, 0x19, 0xd8, 0x43, 0x61, 0xa3, 0xcb, 0x2e, 0xba, 0xa1, 0xf2, 0x6c, 0x8e, 0x33,
+	0x68, 0xee, 0x49, 0x85, 0x47, 0xc6, 0xef, 0x99, 0xa1, 0x8e, 0xd9, 0x3c, 0x9f, 0x07, 0x38, 0x44,
+	0x2f, 0xb2, 0x08, 0x2c, 0x90, 0xda, 0x96, 0x88, 0x2e, 0x31, 0x03, 0x18, 0x5f, 0x05, 0xde, 0x12,
+	0x5a, 0x1b, 0xdf, 0xb2, 0x28, 0x3c, 0xee, 0x19, 0x15, 0xa3, 0x65, 0x8b, 0x64, 0xce, 0x04, 0x2e,
+	0x15, 0x32, 0x3e, 0xe6, 0x6e, 0xa3, 0xc2, 0x9c, 0x7b, 0x69, 0xcc, 0x9d, 0xe1, 0xc4, 0xbd, 0x4c,
+	0xc6, 0xef, 0x0c, 0xa5, 0x8a, 0x43, 0x48, 0xd2, 0xb4, 0xac, 0x90, 0x8d, 0x99, 0xf1, 0x47, 0x8f,
+	0x3b, 0xbd, 0x53, 0xb6, 0xca, 0x57, 0x60, 0x31, 0x43, 0x0e, 0xd1, 0x5b, 0x19, 0x85, 0xe0, 0xad,
+	0x91, 0xa9, 0xc7, 0x43, 0x7f, 0x7c, 0x71, 0x88, 0x89, 0xb1, 0x23, 0xb6, 0x4e, 0x09, 0x0d, 0x92,
+	0x6e, 0x52, 0xc4, 0xee, 0x90, 0x86, 0xdd, 0x64, 0xe0, 0x47, 0xe3, 0xf0, 0xb2, 0x0d, 0xce, 0x61,
+	0xae, 0xdd, 0xee, 0xe2, 0x9b, 0x43, 0x74, 0xbe, 0x2b, 0x22, 0x64, 0x7f, 0xab, 0x6d, 0x7d, 0x19,
+	0x20, 0xdc, 0xa5, 0xde, 0x47, 0xce, 0x61, 0x7e, 0x7c, 0x3a, 0x32, 0x1a, 0xd9, 0x2d, 0xde, 0x84,
+	0xd9, 0x33, 0x2d, 0x9d, 0x1b, 0x62, 0xcc, 0x4a, 0x14, 0xb7, 0x8e, 0x3e, 0xb1, 0xa6, 0x4f, 0x2d,
+	0xc7, 0xca, 0x44, 0xdd, 0x93, 0x5a, 0xba, 0xcb, 0x50, 0x31, 0x00, 0x33, 0x59, 0x00, 0xab, 0x5b,
+	0x16, 0x1a, 0xbb, 0xd7, 0xd4, 0x74, 0xa9, 0x68, 0xb2, 0x68, 0x7c, 0xcc, 0x64, 0x2f, 0xc2, 0x5c,
+	0x0a, 0x9e, 0xa0, 0x8e, 0xa5, 0xee, 0xb3, 0x12, 0x45, 0x2c, 0x85, 0x26, 0xd4, 0xe4, 0xb7, 0x5b,
+	0x26, 0x19, 0x28, 0xf4, 0x41, 0x1b, 0x83, 0x66, 0x0a, 0xe6, 0x3a, 0x2f, 0xa0, 0xd9, 0xc3, 0x3e,
+	0x15, 0x64, 0xaa, 0x74, 0x19, 0x58, 0xf1, 0x3c, 0xf6, 0x28, 0x0f, 0x55, 0x89, 0x1a, 0x66, 0xdf,
+	0x9a, 0xe7, 0xa4, 0xbd, 0x4c, 0x0e, 0xf4, 0x50, 0xa8, 0x20, 0xbe, 0x01, 0xb5, 0x3d, 0x35, 0x0c,
+	0x9e, 0x55, 0x83, 0x9f, 0x74, 0x20, 0xb6, 0xdb, 0x5b, 0xbf, 0x69, 0x84, 0x31, 0x12, 0xa6, 0xc1,
+	0x1c, 0xd4, 0xcf, 0x74, 0x8c, 0x17, 0x52, 0x63, 0xcc, 0x6e, 0x85, 0x8c, 0x87, 0xca, 0x28, 0x84,
+	0x3e, 0xa6, 0xc0, 0xb6, 0xad, 0x19, 0x14, 0x30, 0x24, 0xe7, 0x0f, 0x84, 0x2b, 0x40, 0x17, 0x54,
+	0x46, 0x6d, 0x74, 0x91, 0x95, 0xe7, 0xc5, 0xeb, 0x7d, 0x72, 0xbf, 0x77, 0x69, 0x9e, 0x8f, 0x31,
+	0xc7, 0x2e, 0x49, 0xd3, 0x3e, 0xfa, 0xde, 0xc8, 0x79, 0x4c, 0x5a, 0x46, 0x5f, 0xc8, 0xbe, 0x63,
+	0x92, 0x34, 0x3d, 0x36, 0x22, 0x2e, 0x5c, 0x7f, 0x83, 0x0a, 0xa9, 0x8b, 0x0a, 0x85, 0x2b, 0x4a,
+	0x7d, 0x46, 0x3e, 0x6d, 0xc7, 0xf1, 0x9e, 0x44, 0x15, 0x33, 0x45, 0xe2, 0xba, 0xa8, 0x45, 0x52,
+	0xe4, 0x49, 0x82, 0x38, 0xe9, 0x7c, 0xbb, 0xfd, 0xf8, 0x40, 0x3a, 0x4f, 0xf5, 0xa6, 0xc9, 0x9a,
+	0x6d, 0xe5, 0xd1, 0x16, 0x18, 0x0d, 0xdf, 0x80, 0xd5, 0xc0, 0x68, 0xcd, 0x60, 0x80, 0x71, 0xd1,
+	0xd2, 0x41, 0xaa, 0x3f, 0x32, 0x57, 0x13, 0x57, 0xde, 0x4c, 0x61, 0x77, 0x29, 0x6c, 0xd1, 0x5a,
+	0xcb, 0x97, 0x61, 0x21, 0x8d, 0xe0, 0x89, 0xb0, 0x5e, 0x06, 0xf0, 0xa7, 0xa5, 0x50, 0xbc, 0xd6,
+	0x0c, 0xc6, 0xd8, 0xcf, 0x68, 0x92, 0x35, 0x0f, 0x84, 0x1b, 0x43, 0x3f, 0x2f, 0xf1, 0x55, 0x58,
+	0xbc, 0x89, 0xe0, 0x18, 0xff, 0x45, 0x89, 0x2f, 0xc1, 0x3c, 0x45, 0x30, 0xc7, 0x1c, 0xfb, 0x65,
+	0x00, 0x29, 0x56, 0x05, 0xf0, 0x57, 0x41, 0x42, 0x16, 0xac, 0x02, 0xfe, 0xeb, 0xc0, 0x9c, 0x9a,
+	0xd5, 0x16, 0x5e, 0xd0, 0xe0, 0x65, 0xef, 0x04, 0x0b, 0xc8, 0xaa, 0x1c, 0x7a, 0x37, 0x18, 0x1a,
+	0x02, 0x91, 0x41, 0x8e, 0xbd, 0x57, 0xe2, 0x2b, 0x79, 0x51, 0x58, 0x8c, 0x51, 0x7b, 0x29, 0x14,
+	0x7b, 0xa7, 0x41, 0xf0, 0xd9, 0x20, 0x9e, 0x84, 0xdf, 0x0d, 0x70, 0x3a, 0x2e, 0x0a, 0xf0, 0x7b,
+	0x0d, 0x3e, 0x0f, 0x75, 0x12, 0x7c, 0xe6, 0xd0, 0x3a, 0xf6, 0xbb, 0x06, 0x29, 0xda, 0x47, 0x5f,
+	0xe0, 0xf9, 0x7d, 0x83, 0x2f, 0x00, 0xa4, 0x8a, 0xba, 0x46, 0x21, 0xfb, 0x43, 0x83, 0xcf, 0xc1,
+	0x2c, 0x19, 0x18, 0x8e, 0x7f, 0x6c, 0x50, 0x6c, 0x8f, 0x07, 0x68, 0x85, 0x47, 0x12, 0x13, 0xd0,
+	0x3f, 0x05, 0x85, 0x19, 0x7a, 0x62, 0xe5, 0x95, 0x54, 0xd8, 0x47, 0xf6, 0xe7, 0x06, 0x67, 0xd0,
+	0xe8, 0x21, 0xe5, 0x65, 0xdf, 0x0a, 0xed, 0xd9, 0x5f, 0x82, 0x78, 0x32, 0xe1, 0xc4, 0x28, 0x19,
+	0x8d, 0xd8, 0x5f, 0x1b, 0xe4, 0x3f, 0x85, 0x35, 0x6b, 0x32, 0xc7, 0xde, 0xa7, 0x06, 0x5e, 0xb8,
+	0xc9, 0x40, 0x06, 0xb3, 0x0f, 0x42, 0xa0, 0x28, 0xd4, 0x39, 0xe3, 0x87, 0x81, 0x31, 0x0b, 0x74,
+	0x8e, 0x7e, 0x14, 0xd0, 0x03, 0xa1, 0x63, 0x73, 0x71, 0x91, 0xa3, 0x1f, 0x97, 0xf8, 0x3a, 0x2c,
+	0xd1, 0xf5, 0x1d, 0xa1, 0x84, 0x8e, 0xc6, 0xfc, 0x9f, 0x94, 0xc8, 0xc8, 0xd4, 0xe3, 0x30, 0xb8,
+	0xd8, 0x37, 0xcb, 0xa1, 0x52, 0x32, 0x03, 0x52, 0xec, 0x5b, 0x65, 0x8a, 0x1d, 0x85, 0x21, 0x3d,
+	0x7f, 0xbb, 0xcc, 0x1b, 0x30, 0xd3, 0xd1, 0x0e, 0xad, 0x67, 0x5f, 0xa5, 0x46, 0x9f, 0x49, 0xe3,
+	0xcd, 0xbe, 0x46, 0x23, 0xec, 0x76, 0x68, 0x74, 0xf6, 0x56, 0x20, 0xa4, 0x03, 0x86, 0x7d, 0xbd,
+	0x42, 0xf9, 0xdf, 0x47, 0x5f, 0x98, 0x61, 0xec, 0x1b, 0x81, 0x23, 0x5d, 0x35, 0xec, 0xef, 0x95,
+	0x10, 0x8c, 0xe2, 0xde, 0xf9, 0x47, 0x25, 0xcb, 0xd1, 0x78, 0xa6, 0xb2, 0x7f, 0x56, 0xf8, 0x06,
+	0xac, 0xdc, 0x60, 0x61, 0x0b, 0xe4, 0x63, 0xee, 0x5f, 0x15, 0x7e, 0x0f, 0xd6, 0x28, 0xa7, 0x79,
+	0x3b, 0xd0, 0x25, 0xe9, 0xbc, 0x8c, 0x1c, 0xfb, 0x77, 0x85, 0xdf, 0x85, 0xd5, 0x7d, 0xf4, 0x79,
+	0x59, 0x16, 0x88, 0xff, 0xa9, 0x50, 0xa6, 0xbb, 0xb4, 0x26, 0xf0, 0x0a, 0xd9, 0xfb, 0xc1, 0xdc,
+	0x9b, 0x63, 0x66, 0xce, 0x07, 0x15, 0x0a, 0xee, 0x97, 0x84, 0x8f, 0x2e, 0xdb, 0x49, 0xeb, 0x52,
+	0x68, 0x8d, 0xca, 0xb1, 0x0f, 0x2b, 0x94, 0xfe, 0x2e, 0x26, 0xe6, 0x0a, 0x0b, 0xf0, 0x47, 0xb4,
+	0xfe, 0x79, 0x60, 0xfe, 0xe2, 0x10, 0xed, 0x28, 0x27, 0x7c, 0x5c, 0xa1, 0x64, 0xa4, 0xfc, 0x93,
+	0x94, 0x4f, 0x2a, 0x69, 0xc5, 0x84, 0xdc, 0x74, 0xf4, 0x85, 0x61, 0xbf, 0xad, 0x92, 0x55, 0xa7,
+	0x32, 0xc1, 0x53, 0x19, 0x3d, 0x63, 0xdf, 0xa9, 0x93, 0x55, 0xe1, 0xd2, 0x91, 0x89, 0x91, 0xcc,
+	0x77, 0xec, 0xed, 0x7a, 0x28, 0x6c, 0x23, 0xd2, 0x6d, 0xc8, 0xbe, 0x1b, 0xce, 0xd9, 0x96, 0xea,
+	0xb4, 0xd9, 0xf7, 0xe8, 0x49, 0x00, 0xd9, 0xf9, 0xb4, 0x77, 0xcc, 0xbe, 0x5f, 0x27, 0x37, 0xb6,
+	0x95, 0x32, 0x91, 0xf0, 0x79, 0x89, 0xfd, 0xa0, 0x4e, 0x8d, 0x5b, 0x18, 0xf6, 0x59, 0x60, 0x7e,
+	0x58, 0x27, 0xf7, 0x32, 0x3c, 0x24, 0xb6, 0x4d, 0x4b, 0xe0, 0x47, 0x41, 0x2a, 0xf5, 0x28, 0x59,
+	0x72, 0xea, 0xd9, 0x8f, 0xeb, 0x5b, 0x9b, 0x50, 0x6b, 0x3b, 0x15, 0x66, 0x7a, 0x0d, 0x2a, 0x6d,
+	0xa7, 0xd8, 0x2d, 0x5a, 0x77, 0x3b, 0xc6, 0xa8, 0xdd, 0xeb, 0x81, 0x7d, 0xf2, 0x59, 0x56, 0xda,
+	0x3a, 0x00, 0xd6, 0x32, 0xda, 0x49, 0xe7, 0x51, 0x47, 0xa3, 0xc7, 0x78, 0x85, 0x2a, 0xec, 0x0c,
+	0x6f, 0x8d, 0xee, 0xb3, 0x5b, 0xe1, 0xf5, 0x85, 0xe1, 0x15, 0x95, 0x6e, 0x96, 0x1d, 0x7a, 0x6e,
+	0x84, 0x27, 0xd6, 0x3c, 0xc0, 0xee, 0x15, 0x6a, 0x3f, 0x14, 0x4a, 0x8d, 0x58, 0x65, 0xeb, 0x55,
+	0x80, 0xe3, 0xf3, 0x37, 0x30, 0xf2, 0x41, 0x21, 0xc0, 0xcc, 0xbe, 0x32, 0xe7, 0x22, 0xd3, 0x59,
+	0x98, 0x87, 0x25, 0x1a, 0xd3, 0xf9, 0x7c, 0x29, 0x6f, 0xbd, 0x7d, 0x1b, 0x16, 0xd2, 0x8b, 0x79,
+	0xaf, 0xd2, 0x22, 0xcc, 0x0f, 0xdb, 0x8a, 0x64, 0xfc, 0x1f, 0xdc, 0xc9, 0x91, 0x17, 0xd6, 0x51,
+	0x89, 0xdf, 0x85, 0xb5, 0x9c, 0x3c, 0xb5, 0x97, 0xca, 0xfc, 0xff, 0xe1, 0xee, 0x98, 0xf8, 0xe2,
+	0x36, 0xa2, 0x22, 0x5d, 0xcf, 0x19, 0xa6, 0xd7, 0x52, 0x95, 0xd6, 0x5a, 0x4e, 0xa5, 0xb4, 0xa6,
+	0x4f, 0xc3, 0x1c, 0xca, 0x5a, 0x9e, 0xcd, 0xd0, 0x1a, 0xc9, 0xd1, 0xac, 0x19, 0x6b, 0x13, 0x60,
+	0xd6, 0x94, 0xb3, 0x13, 0x60, 0xd6, 0x6e, 0x75, 0xda, 0x4c, 0x39, 0x18, 0x6a, 0x8a, 0xc1, 0x04,
+	0x96, 0x76, 0x71, 0x83, 0xaf, 0xc3, 0xf2, 0x54, 0x28, 0xd2, 0x42, 0x6b, 0xd2, 0xb6, 0x9d, 0x88,
+	0x42, 0x8a, 0xcf, 0x4d, 0xf8, 0x37, 0xbd, 0x89, 0xe6, 0x69, 0xd1, 0x4d, 0xdc, 0x1a, 0xd3, 0x16,
+	0x68, 0xa3, 0x8d, 0x13, 0x71, 0xb3, 0x5a, 0xd9, 0x44, 0xb8, 0xa7, 0x76, 0xc8, 0x22, 0xbd, 0x85,
+	0x27, 0xe4, 0xe5, 0x24, 0x4e, 0xef, 0xd9, 0x9c, 0x74, 0x28, 0xb4, 0xe8, 0x87, 0xa9, 0xcd, 0x96,
+	0x26, 0xd2, 0xfb, 0xc2, 0xd2, 0x5e, 0x9e, 0xd0, 0x37, 0xb5, 0xbd, 0x57, 0x26, 0xbc, 0x9b, 0x5e,
+	0xe3, 0xab, 0xfc, 0x25, 0xd8, 0x28, 0x48, 0x9e, 0xde, 0xd9, 0x6b, 0x53, 0xf4, 0xe9, 0xe5, 0xbd,
+	0x3e, 0x91, 0xaa, 0x6c, 0x76, 0xde, 0xd9, 0xf9, 0xfc, 0x57, 0x5e, 0xe9, 0x4b, 0x7f, 0x39, 0x3c,
+	0xa7, 0x1f, 0x98, 0x47, 0xe9, 0x1f, 0xcd, 0xcb, 0xd2, 0x64, 0x5f, 0x8f, 0xa4, 0xf6, 0x68, 0xb5,
+	0x50, 0x8f, 0xc2, 0x4f, 0xce, 0xa3, 0xf4, 0x27, 0x67, 0x70, 0x7e, 0x3e, 0x13, 0xce, 0xaf, 0xfc,
+	0x77, 0x00, 0x64, 0x69, 0xee, 0xd7, 0xbe, 0x0e, 0x00, 0x00,
 }
@@ -54,12 +54,14 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 }
 
 type FlushRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID         int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// only the segments on these virtual channels are sealed if it's not empty
+	Channels             []string `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
@@ -108,6 +110,13 @@ func (m *FlushRequest) GetCollectionID() int64 {
 	return 0
 }
 
+func (m *FlushRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
 type FlushResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DbID                 int64            `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -1303,6 +1312,46 @@ func (m *SegmentFieldBinlogMeta) GetBinlogPath() string {
 	return ""
 }
 
+// key: ${prefix}/${segmentID}/${idx}
+type SegmentDeltalogMeta struct {
+	DeltalogPath         string   `protobuf:"bytes,1,opt,name=deltalog_path,json=deltalogPath,proto3" json:"deltalog_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentDeltalogMeta) Reset()         { *m = SegmentDeltalogMeta{} }
+func (m *SegmentDeltalogMeta) String() string { return proto.CompactTextString(m) }
+func (*SegmentDeltalogMeta) ProtoMessage()    {}
+func (*SegmentDeltalogMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{23}
+}
+
+func (m *SegmentDeltalogMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentDeltalogMeta.Unmarshal(m, b)
+}
+func (m *SegmentDeltalogMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentDeltalogMeta.Marshal(b, m, deterministic)
+}
+func (m *SegmentDeltalogMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentDeltalogMeta.Merge(m, src)
+}
+func (m *SegmentDeltalogMeta) XXX_Size() int {
+	return xxx_messageInfo_SegmentDeltalogMeta.Size(m)
+}
+func (m *SegmentDeltalogMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentDeltalogMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentDeltalogMeta proto.InternalMessageInfo
+
+func (m *SegmentDeltalogMeta) GetDeltalogPath() string {
+	if m != nil {
+		return m.DeltalogPath
+	}
+	return ""
+}
+
 // key: ${prefix}/${collectionID}/${idx}
 type DDLBinlogMeta struct {
 	DdlBinlogPath        string   `protobuf:"bytes,1,opt,name=ddl_binlog_path,json=ddlBinlogPath,proto3" json:"ddl_binlog_path,omitempty"`
@@ -1316,7 +1365,7 @@ func (m *DDLBinlogMeta) Reset()         { *m = DDLBinlogMeta{} }
 func (m *DDLBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*DDLBinlogMeta) ProtoMessage()    {}
 func (*DDLBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{23}
+	return fileDescriptor_82cd95f524594f49, []int{24}
 }
 
 func (m *DDLBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -1363,7 +1412,7 @@ func (m *FieldFlushMeta) Reset()         { *m = FieldFlushMeta{} }
 func (m *FieldFlushMeta) String() string { return proto.CompactTextString(m) }
 func (*FieldFlushMeta) ProtoMessage()    {}
 func (*FieldFlushMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{24}
+	return fileDescriptor_82cd95f524594f49, []int{25}
 }
 
 func (m *FieldFlushMeta) XXX_Unmarshal(b []byte) error {
@@ -1411,7 +1460,7 @@ func (m *SegmentFlushMeta) Reset()         { *m = SegmentFlushMeta{} }
 func (m *SegmentFlushMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushMeta) ProtoMessage()    {}
 func (*SegmentFlushMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{25}
+	return fileDescriptor_82cd95f524594f49, []int{26}
 }
 
 func (m *SegmentFlushMeta) XXX_Unmarshal(b []byte) error {
@@ -1465,7 +1514,7 @@ func (m *DDLFlushMeta) Reset()         { *m = DDLFlushMeta{} }
 func (m *DDLFlushMeta) String() string { return proto.CompactTextString(m) }
 func (*DDLFlushMeta) ProtoMessage()    {}
 func (*DDLFlushMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{26}
+	return fileDescriptor_82cd95f524594f49, []int{27}
 }
 
 func (m *DDLFlushMeta) XXX_Unmarshal(b []byte) error {
@@ -1513,7 +1562,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{27}
+	return fileDescriptor_82cd95f524594f49, []int{28}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -1566,6 +1615,9 @@ type SegmentInfo struct {
 	MaxRowNum            int64                   `protobuf:"varint,8,opt,name=max_row_num,json=maxRowNum,proto3" json:"max_row_num,omitempty"`
 	LastExpireTime       uint64                  `protobuf:"varint,9,opt,name=last_expire_time,json=lastExpireTime,proto3" json:"last_expire_time,omitempty"`
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,10,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	BinlogSize           int64                   `protobuf:"varint,11,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	RawBinlogSize        int64                   `protobuf:"varint,12,opt,name=raw_binlog_size,json=rawBinlogSize,proto3" json:"raw_binlog_size,omitempty"`
+	IsCold               bool                    `protobuf:"varint,13,opt,name=is_cold,json=isCold,proto3" json:"is_cold,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1575,7 +1627,7 @@ func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{28}
+	return fileDescriptor_82cd95f524594f49, []int{29}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1666,6 +1718,27 @@ func (m *SegmentInfo) GetStartPosition() *internalpb.MsgPosition {
 	return nil
 }
 
+func (m *SegmentInfo) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetRawBinlogSize() int64 {
+	if m != nil {
+		return m.RawBinlogSize
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetIsCold() bool {
+	if m != nil {
+		return m.IsCold
+	}
+	return false
+}
+
 type ID2PathList struct {
 	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Paths                []string `protobuf:"bytes,2,rep,name=Paths,proto3" json:"Paths,omitempty"`
@@ -1678,7 +1751,7 @@ func (m *ID2PathList) Reset()         { *m = ID2PathList{} }
 func (m *ID2PathList) String() string { return proto.CompactTextString(m) }
 func (*ID2PathList) ProtoMessage()    {}
 func (*ID2PathList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *ID2PathList) XXX_Unmarshal(b []byte) error {
@@ -1725,7 +1798,7 @@ func (m *SegmentStartPosition) Reset()         { *m = SegmentStartPosition{} }
 func (m *SegmentStartPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentStartPosition) ProtoMessage()    {}
 func (*SegmentStartPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *SegmentStartPosition) XXX_Unmarshal(b []byte) error {
@@ -1768,6 +1841,7 @@ type SaveBinlogPathsRequest struct {
 	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
 	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Deltalogs            []string                `protobuf:"bytes,8,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1777,7 +1851,7 @@ func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{}
 func (m *SaveBinlogPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveBinlogPathsRequest) ProtoMessage()    {}
 func (*SaveBinlogPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *SaveBinlogPathsRequest) XXX_Unmarshal(b []byte) error {
@@ -1847,6 +1921,13 @@ func (m *SaveBinlogPathsRequest) GetFlushed() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetDeltalogs() []string {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -1860,7 +1941,7 @@ func (m *CheckPoint) Reset()         { *m = CheckPoint{} }
 func (m *CheckPoint) String() string { return proto.CompactTextString(m) }
 func (*CheckPoint) ProtoMessage()    {}
 func (*CheckPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *CheckPoint) XXX_Unmarshal(b []byte) error {
@@ -1915,7 +1996,7 @@ func (m *DataNodeTtMsg) Reset()         { *m = DataNodeTtMsg{} }
 func (m *DataNodeTtMsg) String() string { return proto.CompactTextString(m) }
 func (*DataNodeTtMsg) ProtoMessage()    {}
 func (*DataNodeTtMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *DataNodeTtMsg) XXX_Unmarshal(b []byte) error {
@@ -1970,7 +2051,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -2025,7 +2106,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -2070,6 +2151,8 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 type SegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	Deltalogs            []string       `protobuf:"bytes,3,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	NumOfRows            int64          `protobuf:"varint,4,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2079,7 +2162,7 @@ func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2114,6 +2197,20 @@ func (m *SegmentBinlogs) GetFieldBinlogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentBinlogs) GetDeltalogs() []string {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentBinlogs) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
 type FieldBinlog struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []string `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -2126,7 +2223,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2174,7 +2271,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2229,7 +2326,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2284,7 +2381,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2338,7 +2435,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2373,6 +2470,362 @@ func (m *GetFlushedSegmentsResponse) GetSegments() []int64 {
 	return nil
 }
 
+type ExportRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64           `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Timestamp            uint64            `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OutputPath           string            `protobuf:"bytes,5,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{43}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetOutputPath() string {
+	if m != nil {
+		return m.OutputPath
+	}
+	return ""
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{44}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type GetExportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskID               int64             `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{45}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *GetExportStateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetExportStateResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.ExportState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ExportState" json:"state,omitempty"`
+	Files                []string             `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	NumRows              int64                `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	FailReason           string               `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{46}
+}
+
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
+}
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
+}
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
+}
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
+
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetState() commonpb.ExportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ExportState_ExportStateNone
+}
+
+func (m *GetExportStateResponse) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+// ExportTaskInfo is the state of an export task saved in the meta
+type ExportTaskInfo struct {
+	TaskID               int64                `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID         int64                `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64              `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Timestamp            uint64               `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OutputPath           string               `protobuf:"bytes,5,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	State                commonpb.ExportState `protobuf:"varint,6,opt,name=state,proto3,enum=milvus.proto.common.ExportState" json:"state,omitempty"`
+	Files                []string             `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
+	NumRows              int64                `protobuf:"varint,8,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	FailReason           string               `protobuf:"bytes,9,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	FinishTime           int64                `protobuf:"varint,10,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExportTaskInfo) Reset()         { *m = ExportTaskInfo{} }
+func (m *ExportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskInfo) ProtoMessage()    {}
+func (*ExportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{47}
+}
+
+func (m *ExportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskInfo.Unmarshal(m, b)
+}
+func (m *ExportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskInfo.Merge(m, src)
+}
+func (m *ExportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskInfo.Size(m)
+}
+func (m *ExportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskInfo proto.InternalMessageInfo
+
+func (m *ExportTaskInfo) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetOutputPath() string {
+	if m != nil {
+		return m.OutputPath
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetState() commonpb.ExportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ExportState_ExportStateNone
+}
+
+func (m *ExportTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetFinishTime() int64 {
+	if m != nil {
+		return m.FinishTime
+	}
+	return 0
+}
+
 type SegmentFlushCompletedMsg struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Segment              *SegmentInfo      `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
@@ -2385,7 +2838,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{48}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2433,7 +2886,7 @@ func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{49}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2500,6 +2953,7 @@ func init() {
 	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
 	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
 	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*SegmentDeltalogMeta)(nil), "milvus.proto.data.SegmentDeltalogMeta")
 	proto.RegisterType((*DDLBinlogMeta)(nil), "milvus.proto.data.DDLBinlogMeta")
 	proto.RegisterType((*FieldFlushMeta)(nil), "milvus.proto.data.FieldFlushMeta")
 	proto.RegisterType((*SegmentFlushMeta)(nil), "milvus.proto.data.SegmentFlushMeta")
@@ -2519,6 +2973,11 @@ func init() {
 	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
 	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
 	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.data.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.data.ExportResponse")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.data.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.data.GetExportStateResponse")
+	proto.RegisterType((*ExportTaskInfo)(nil), "milvus.proto.data.ExportTaskInfo")
 	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
 	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
 }
@@ -2526,136 +2985,157 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2060 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x5b, 0x6f, 0x1b, 0x59,
-	0xb9, 0xe3, 0x89, 0x13, 0xfb, 0xf3, 0x25, 0xc9, 0x21, 0x64, 0x8d, 0xdb, 0xa6, 0xe9, 0xb0, 0xdb,
-	0xcd, 0x16, 0x36, 0xd9, 0xba, 0x20, 0x2e, 0x65, 0x41, 0xdb, 0xb8, 0x8d, 0x2c, 0x92, 0x12, 0x4e,
-	0xba, 0xbb, 0x12, 0x2b, 0x64, 0x4d, 0x3c, 0x27, 0xce, 0x90, 0xb9, 0x78, 0xe7, 0x8c, 0xd3, 0xf4,
-	0x69, 0x57, 0x8b, 0x04, 0x02, 0x21, 0x2e, 0x42, 0xbc, 0x21, 0x71, 0x91, 0x90, 0x90, 0x78, 0xe1,
-	0x1f, 0xf0, 0xca, 0xcf, 0x42, 0xe7, 0x32, 0xf7, 0xb1, 0x3d, 0x71, 0x68, 0xf3, 0x96, 0x73, 0xe6,
-	0xbb, 0x9d, 0xef, 0xfe, 0x7d, 0x0e, 0xac, 0x18, 0xba, 0xaf, 0xf7, 0x07, 0xae, 0xeb, 0x19, 0xdb,
-	0x23, 0xcf, 0xf5, 0x5d, 0xb4, 0x6a, 0x9b, 0xd6, 0xf9, 0x98, 0x8a, 0xd3, 0x36, 0xfb, 0xdc, 0xae,
-	0x0f, 0x5c, 0xdb, 0x76, 0x1d, 0x71, 0xd5, 0x6e, 0x9a, 0x8e, 0x4f, 0x3c, 0x47, 0xb7, 0xe4, 0xb9,
-	0x1e, 0x47, 0x68, 0xd7, 0xe9, 0xe0, 0x94, 0xd8, 0xba, 0x38, 0x69, 0x17, 0x50, 0x7f, 0x6a, 0x8d,
-	0xe9, 0x29, 0x26, 0x9f, 0x8e, 0x09, 0xf5, 0xd1, 0x7b, 0xb0, 0x70, 0xac, 0x53, 0xd2, 0x52, 0x36,
-	0x95, 0xad, 0x5a, 0xe7, 0xd6, 0x76, 0x82, 0x97, 0xe4, 0x72, 0x40, 0x87, 0x8f, 0x75, 0x4a, 0x30,
-	0x87, 0x44, 0x08, 0x16, 0x8c, 0xe3, 0x5e, 0xb7, 0x55, 0xda, 0x54, 0xb6, 0x54, 0xcc, 0xff, 0x46,
-	0x1a, 0xd4, 0x07, 0xae, 0x65, 0x91, 0x81, 0x6f, 0xba, 0x4e, 0xaf, 0xdb, 0x5a, 0xe0, 0xdf, 0x12,
-	0x77, 0xda, 0x9f, 0x15, 0x68, 0x48, 0xd6, 0x74, 0xe4, 0x3a, 0x94, 0xa0, 0x87, 0xb0, 0x48, 0x7d,
-	0xdd, 0x1f, 0x53, 0xc9, 0xfd, 0x66, 0x2e, 0xf7, 0x23, 0x0e, 0x82, 0x25, 0x68, 0x21, 0xf6, 0x6a,
-	0x96, 0x3d, 0xda, 0x00, 0xa0, 0x64, 0x68, 0x13, 0xc7, 0xef, 0x75, 0x69, 0x6b, 0x61, 0x53, 0xdd,
-	0x52, 0x71, 0xec, 0x46, 0xfb, 0x83, 0x02, 0x2b, 0x47, 0xc1, 0x31, 0xd0, 0xce, 0x1a, 0x94, 0x07,
-	0xee, 0xd8, 0xf1, 0xb9, 0x80, 0x0d, 0x2c, 0x0e, 0xe8, 0x2e, 0xd4, 0x07, 0xa7, 0xba, 0xe3, 0x10,
-	0xab, 0xef, 0xe8, 0x36, 0xe1, 0xa2, 0x54, 0x71, 0x4d, 0xde, 0x3d, 0xd3, 0x6d, 0x52, 0x48, 0xa2,
-	0x4d, 0xa8, 0x8d, 0x74, 0xcf, 0x37, 0x13, 0x3a, 0x8b, 0x5f, 0x69, 0x7f, 0x55, 0x60, 0xfd, 0x03,
-	0x4a, 0xcd, 0xa1, 0x93, 0x91, 0x6c, 0x1d, 0x16, 0x1d, 0xd7, 0x20, 0xbd, 0x2e, 0x17, 0x4d, 0xc5,
-	0xf2, 0x84, 0x6e, 0x42, 0x75, 0x44, 0x88, 0xd7, 0xf7, 0x5c, 0x2b, 0x10, 0xac, 0xc2, 0x2e, 0xb0,
-	0x6b, 0x11, 0xf4, 0x63, 0x58, 0xa5, 0x29, 0x42, 0xb4, 0xa5, 0x6e, 0xaa, 0x5b, 0xb5, 0xce, 0x57,
-	0xb7, 0x33, 0x5e, 0xb6, 0x9d, 0x66, 0x8a, 0xb3, 0xd8, 0xda, 0xe7, 0x25, 0xf8, 0x52, 0x08, 0x27,
-	0x64, 0x65, 0x7f, 0x33, 0xcd, 0x51, 0x32, 0x0c, 0xc5, 0x13, 0x87, 0x22, 0x9a, 0x0b, 0x55, 0xae,
-	0xc6, 0x55, 0x5e, 0xc0, 0xc1, 0xd2, 0xfa, 0x2c, 0x67, 0xf4, 0x89, 0xee, 0x40, 0x8d, 0x5c, 0x8c,
-	0x4c, 0x8f, 0xf4, 0x7d, 0xd3, 0x26, 0xad, 0xc5, 0x4d, 0x65, 0x6b, 0x01, 0x83, 0xb8, 0x7a, 0x6e,
-	0xda, 0x71, 0x8f, 0x5c, 0x2a, 0xec, 0x91, 0xda, 0xdf, 0x15, 0x78, 0x23, 0x63, 0x25, 0xe9, 0xe2,
-	0x18, 0x56, 0xf8, 0xcb, 0x23, 0xcd, 0x30, 0x67, 0x67, 0x0a, 0xbf, 0x37, 0x4d, 0xe1, 0x11, 0x38,
-	0xce, 0xe0, 0xc7, 0x84, 0x2c, 0x15, 0x17, 0xf2, 0x0c, 0xde, 0xd8, 0x23, 0xbe, 0x64, 0xc0, 0xbe,
-	0x11, 0x3a, 0x7f, 0x0a, 0x48, 0xc6, 0x52, 0x29, 0x13, 0x4b, 0xff, 0x2e, 0x85, 0xb1, 0xc4, 0x59,
-	0xf5, 0x9c, 0x13, 0x17, 0xdd, 0x82, 0x6a, 0x08, 0x22, 0xbd, 0x22, 0xba, 0x40, 0xdf, 0x82, 0x32,
-	0x93, 0x54, 0xb8, 0x44, 0xb3, 0x73, 0x37, 0xff, 0x4d, 0x31, 0x9a, 0x58, 0xc0, 0xa3, 0x1e, 0x34,
-	0xa9, 0xaf, 0x7b, 0x7e, 0x7f, 0xe4, 0x52, 0x6e, 0x67, 0xee, 0x38, 0xb5, 0x8e, 0x96, 0xa4, 0x10,
-	0xa6, 0xc8, 0x03, 0x3a, 0x3c, 0x94, 0x90, 0xb8, 0xc1, 0x31, 0x83, 0x23, 0x7a, 0x02, 0x75, 0xe2,
-	0x18, 0x11, 0xa1, 0x85, 0xc2, 0x84, 0x6a, 0xc4, 0x31, 0x42, 0x32, 0x91, 0x7d, 0xca, 0xc5, 0xed,
-	0xf3, 0x1b, 0x05, 0x5a, 0x59, 0x03, 0x5d, 0x25, 0x51, 0x3e, 0x12, 0x48, 0x44, 0x18, 0x68, 0x6a,
-	0x84, 0x87, 0x46, 0xc2, 0x12, 0x45, 0x33, 0xe1, 0xcb, 0x91, 0x34, 0xfc, 0xcb, 0x2b, 0x73, 0x96,
-	0x9f, 0x2b, 0xb0, 0x9e, 0xe6, 0x75, 0x95, 0x77, 0x7f, 0x03, 0xca, 0xa6, 0x73, 0xe2, 0x06, 0xcf,
-	0xde, 0x98, 0x12, 0x67, 0x8c, 0x97, 0x00, 0xd6, 0x6c, 0xb8, 0xb9, 0x47, 0xfc, 0x9e, 0x43, 0x89,
-	0xe7, 0x3f, 0x36, 0x1d, 0xcb, 0x1d, 0x1e, 0xea, 0xfe, 0xe9, 0x15, 0x62, 0x24, 0xe1, 0xee, 0xa5,
-	0x94, 0xbb, 0x6b, 0xff, 0x54, 0xe0, 0x56, 0x3e, 0x3f, 0xf9, 0xf4, 0x36, 0x54, 0x4e, 0x4c, 0x62,
-	0x19, 0x4c, 0x67, 0x0a, 0xd7, 0x59, 0x78, 0x66, 0xb1, 0x32, 0x62, 0xc0, 0xf2, 0x85, 0x77, 0x27,
-	0x38, 0xe8, 0x91, 0xef, 0x99, 0xce, 0x70, 0xdf, 0xa4, 0x3e, 0x16, 0xf0, 0x31, 0x7d, 0xaa, 0xc5,
-	0x3d, 0xf3, 0xd7, 0x0a, 0x6c, 0xec, 0x11, 0x7f, 0x37, 0x4c, 0xb5, 0xec, 0xbb, 0x49, 0x7d, 0x73,
-	0x40, 0x5f, 0x6d, 0x13, 0x91, 0x53, 0x33, 0xb5, 0xdf, 0x29, 0x70, 0x67, 0xa2, 0x30, 0x52, 0x75,
-	0x32, 0x95, 0x04, 0x89, 0x36, 0x3f, 0x95, 0xfc, 0x90, 0xbc, 0xfc, 0x48, 0xb7, 0xc6, 0xe4, 0x50,
-	0x37, 0x3d, 0x91, 0x4a, 0xe6, 0x4c, 0xac, 0xff, 0x52, 0xe0, 0xf6, 0x1e, 0xf1, 0x0f, 0x83, 0x32,
-	0x73, 0x8d, 0xda, 0x29, 0xd0, 0x51, 0xfc, 0x56, 0x18, 0x33, 0x57, 0xda, 0x6b, 0x51, 0xdf, 0x06,
-	0x8f, 0x83, 0x58, 0x40, 0xee, 0x8a, 0x5e, 0x40, 0x2a, 0x4f, 0xfb, 0x53, 0x09, 0xea, 0x1f, 0xc9,
-	0xfe, 0x80, 0x97, 0x91, 0xb4, 0x1e, 0x94, 0x7c, 0x3d, 0xc4, 0x5a, 0x8a, 0xbc, 0x2e, 0x63, 0x0f,
-	0x1a, 0x94, 0x90, 0xb3, 0x79, 0x8a, 0x46, 0x9d, 0x21, 0x86, 0xc9, 0x7e, 0x1f, 0x56, 0xc7, 0xce,
-	0x09, 0x6b, 0x6b, 0x89, 0x21, 0x5f, 0x21, 0xba, 0xcb, 0xd9, 0x99, 0x27, 0x8b, 0x88, 0xb6, 0x60,
-	0x39, 0x4d, 0xab, 0xcc, 0x83, 0x3f, 0x7d, 0xad, 0xfd, 0x4a, 0x81, 0xf5, 0x8f, 0x75, 0x7f, 0x70,
-	0xda, 0xb5, 0xa5, 0xc6, 0xae, 0xe0, 0x6f, 0xef, 0x43, 0xf5, 0x5c, 0x6a, 0x27, 0x48, 0x2a, 0x77,
-	0x72, 0x84, 0x8f, 0xdb, 0x01, 0x47, 0x18, 0xac, 0x4d, 0x5d, 0xe3, 0x9d, 0x7d, 0x20, 0xdd, 0xeb,
-	0xf7, 0xfc, 0x59, 0xdd, 0xfd, 0x05, 0x80, 0x14, 0xee, 0x80, 0x0e, 0xe7, 0x90, 0xeb, 0xdb, 0xb0,
-	0x24, 0xa9, 0x49, 0xe7, 0x9e, 0x65, 0xdc, 0x00, 0x5c, 0x3b, 0x82, 0x75, 0x79, 0xff, 0x94, 0xe5,
-	0x6f, 0x91, 0xeb, 0x0f, 0x88, 0xaf, 0xa3, 0x16, 0x2c, 0xc9, 0x94, 0x2e, 0x9d, 0x38, 0x38, 0xb2,
-	0x3e, 0xf5, 0x98, 0xc3, 0xf5, 0x59, 0xde, 0x96, 0xfe, 0x0b, 0xc7, 0x61, 0x99, 0xd0, 0x7e, 0x0a,
-	0x8d, 0x6e, 0x77, 0x3f, 0x46, 0xeb, 0x1e, 0x2c, 0x1b, 0x86, 0xd5, 0x8f, 0x63, 0x29, 0x1c, 0xab,
-	0x61, 0x18, 0x56, 0x54, 0x5f, 0xd0, 0x9b, 0xd0, 0xf4, 0x69, 0x3f, 0x4b, 0xbc, 0xee, 0xd3, 0x08,
-	0x4a, 0x3b, 0x80, 0x26, 0x17, 0x96, 0x1b, 0x75, 0x86, 0xac, 0x77, 0xa1, 0x1e, 0x23, 0x27, 0xdc,
-	0xa7, 0x8a, 0x6b, 0x91, 0xb0, 0xbc, 0x82, 0x04, 0xed, 0x60, 0x44, 0x71, 0x7a, 0x3b, 0x78, 0x1b,
-	0xc0, 0xa4, 0x7d, 0xe9, 0xf4, 0x5c, 0xc6, 0x0a, 0xae, 0x9a, 0xf4, 0xa9, 0xb8, 0x40, 0xdf, 0x81,
-	0x45, 0xce, 0x5f, 0x84, 0x47, 0x26, 0x49, 0x71, 0x6b, 0x24, 0x5f, 0x80, 0x25, 0x82, 0xf6, 0x21,
-	0xd4, 0xbb, 0xdd, 0xfd, 0x48, 0x8e, 0x22, 0xf9, 0xa4, 0xc0, 0x1b, 0x3f, 0x83, 0x66, 0x54, 0x94,
-	0x78, 0xa2, 0x6a, 0x42, 0x29, 0x24, 0x57, 0xea, 0x75, 0xd1, 0xfb, 0xb0, 0x28, 0x26, 0x71, 0xe9,
-	0x41, 0x6f, 0x25, 0x65, 0x96, 0x53, 0x7a, 0xac, 0xb2, 0xf1, 0x0b, 0x2c, 0x91, 0x98, 0x87, 0x87,
-	0x89, 0x5c, 0x0c, 0x6d, 0x2a, 0x8e, 0xdd, 0x68, 0xff, 0x51, 0xa1, 0x16, 0x73, 0xc0, 0x0c, 0xfb,
-	0xf4, 0x3b, 0x4b, 0xb3, 0xeb, 0x87, 0x9a, 0x9d, 0xa0, 0xde, 0x82, 0xa6, 0xc9, 0x7b, 0x96, 0xbe,
-	0x8c, 0x7e, 0x5e, 0x64, 0xaa, 0xb8, 0x21, 0x6e, 0x65, 0x2a, 0x42, 0x1b, 0x50, 0x73, 0xc6, 0x76,
-	0xdf, 0x3d, 0xe9, 0x7b, 0xee, 0x0b, 0x2a, 0x47, 0xb1, 0xaa, 0x33, 0xb6, 0x7f, 0x74, 0x82, 0xdd,
-	0x17, 0x34, 0xea, 0xf6, 0x17, 0x2f, 0xd9, 0xed, 0x3f, 0x81, 0xba, 0x61, 0x5b, 0x51, 0xda, 0x5e,
-	0x2a, 0xde, 0xa2, 0x1b, 0xb6, 0x15, 0x66, 0xed, 0x0d, 0xa8, 0xd9, 0xfa, 0x05, 0x13, 0xae, 0xef,
-	0x8c, 0xed, 0x56, 0x45, 0xc8, 0x67, 0xeb, 0x17, 0xd8, 0x7d, 0xf1, 0x6c, 0x6c, 0xa3, 0x2d, 0x58,
-	0xb1, 0x74, 0xea, 0xf7, 0xe3, 0xd3, 0x62, 0x95, 0x4f, 0x8b, 0x4d, 0x76, 0xff, 0x24, 0x9a, 0x18,
-	0xb3, 0xe3, 0x07, 0xcc, 0x39, 0x7e, 0x68, 0x0f, 0xa1, 0xd6, 0xeb, 0x76, 0x98, 0x3b, 0xb1, 0x9e,
-	0x2d, 0x63, 0xc0, 0x35, 0x28, 0x1f, 0xc6, 0xbc, 0xaf, 0x1c, 0xf8, 0xdd, 0x5a, 0xa4, 0xa7, 0xd8,
-	0x2c, 0x93, 0x95, 0x4b, 0x99, 0x77, 0x2c, 0x9a, 0xde, 0xc9, 0xfe, 0x52, 0x85, 0xf5, 0x23, 0xfd,
-	0x9c, 0xbc, 0xfa, 0xa6, 0xb9, 0x50, 0x21, 0xd8, 0x87, 0x55, 0x1e, 0xe8, 0x9d, 0x98, 0x3c, 0x53,
-	0xea, 0x71, 0x4c, 0xe1, 0x38, 0x8b, 0x88, 0x7e, 0xc0, 0x1a, 0x09, 0x32, 0x38, 0x3b, 0x74, 0xcd,
-	0xa0, 0x16, 0xd7, 0x3a, 0xb7, 0x73, 0xe8, 0xec, 0x86, 0x50, 0x38, 0x8e, 0x81, 0x0e, 0x61, 0x39,
-	0x69, 0x06, 0xda, 0x5a, 0xe4, 0x44, 0xde, 0x9e, 0x3a, 0x8d, 0x45, 0xda, 0xc7, 0xcd, 0x84, 0x31,
-	0x28, 0xcf, 0xc4, 0x32, 0x2d, 0x2e, 0xf1, 0xb4, 0x18, 0x1c, 0x59, 0x9a, 0x85, 0x48, 0x8e, 0x19,
-	0x09, 0xf6, 0xfb, 0x50, 0x09, 0x3d, 0xa3, 0x54, 0xd8, 0x33, 0x42, 0x9c, 0x74, 0x84, 0xab, 0xa9,
-	0x08, 0xd7, 0xbe, 0x50, 0xa0, 0xd1, 0xd5, 0x7d, 0xfd, 0x99, 0x6b, 0x90, 0xe7, 0x73, 0x16, 0xdd,
-	0x02, 0xdb, 0xa2, 0x5b, 0x50, 0x65, 0xc1, 0x49, 0x7d, 0xdd, 0x1e, 0x71, 0x21, 0x16, 0x70, 0x74,
-	0xc1, 0x46, 0xcb, 0x86, 0x4c, 0x49, 0x47, 0xe1, 0xf6, 0x90, 0x93, 0x12, 0xc5, 0x91, 0xff, 0x8d,
-	0xbe, 0x9b, 0x5c, 0x3d, 0xbc, 0x99, 0x6b, 0x5e, 0x4e, 0x84, 0x37, 0x5c, 0x89, 0x7c, 0x54, 0x64,
-	0x66, 0xf9, 0x5c, 0x81, 0x7a, 0xa0, 0x0a, 0x9e, 0x9a, 0x5b, 0xb0, 0xa4, 0x1b, 0x86, 0x47, 0x28,
-	0x95, 0x72, 0x04, 0x47, 0xf6, 0xe5, 0x9c, 0x78, 0x34, 0x30, 0x8a, 0x8a, 0x83, 0x23, 0xfa, 0x1e,
-	0x54, 0xc2, 0x0e, 0x4d, 0x6c, 0xec, 0x36, 0x27, 0xcb, 0x29, 0x7b, 0xec, 0x10, 0x43, 0xf3, 0xa0,
-	0x29, 0x9d, 0x4b, 0x78, 0x37, 0x9d, 0xe1, 0x1d, 0x8f, 0xa1, 0x7e, 0x12, 0x75, 0x2b, 0xd3, 0x46,
-	0xe9, 0x58, 0x53, 0x83, 0x13, 0x38, 0xda, 0x07, 0x50, 0x8b, 0x7d, 0x9c, 0xd2, 0x41, 0xb4, 0x60,
-	0xe9, 0x38, 0xc6, 0xa7, 0x8a, 0x83, 0xa3, 0xf6, 0x5f, 0x85, 0x6f, 0xad, 0x30, 0x19, 0xb8, 0xe7,
-	0xc4, 0x7b, 0x79, 0xf5, 0xdd, 0xc0, 0xa3, 0x98, 0x16, 0x0b, 0xf6, 0xb9, 0x21, 0x02, 0x7a, 0x14,
-	0xc9, 0xa9, 0x4e, 0xec, 0x3a, 0x92, 0x6a, 0x8e, 0x9e, 0xf2, 0x7b, 0xb1, 0xe5, 0x48, 0x3e, 0x65,
-	0xde, 0x34, 0xf9, 0x7f, 0xa9, 0xe5, 0xda, 0x1f, 0x15, 0xf8, 0xca, 0x1e, 0xf1, 0x9f, 0x26, 0x27,
-	0x8b, 0xeb, 0x96, 0xca, 0x86, 0x76, 0x9e, 0x50, 0x57, 0xb1, 0x7a, 0x1b, 0x2a, 0x34, 0x18, 0xa7,
-	0xc4, 0xfe, 0x29, 0x3c, 0x6b, 0xbf, 0x50, 0xa0, 0x15, 0xef, 0x4d, 0x77, 0x5d, 0x7b, 0x64, 0x11,
-	0x9f, 0x18, 0xaf, 0x7b, 0x4e, 0xf8, 0x8b, 0x02, 0x2b, 0xf1, 0x34, 0xc3, 0x33, 0xc5, 0x37, 0xa1,
-	0xcc, 0xc7, 0x2c, 0x29, 0xc1, 0x4c, 0x67, 0x15, 0xd0, 0x2c, 0xa2, 0x78, 0xd5, 0x78, 0x4e, 0x83,
-	0x34, 0x22, 0x8f, 0x51, 0xae, 0x53, 0x2f, 0x9d, 0xeb, 0xee, 0x3f, 0x80, 0xd5, 0xcc, 0x37, 0xd4,
-	0x04, 0xf8, 0xd0, 0x19, 0x48, 0xa5, 0xad, 0xdc, 0x40, 0x75, 0xa8, 0x04, 0x2a, 0x5c, 0x51, 0x3a,
-	0x7f, 0xab, 0x43, 0x95, 0xa5, 0xbe, 0x5d, 0xd7, 0xf5, 0x0c, 0x34, 0x02, 0xc4, 0x77, 0x37, 0xf6,
-	0xc8, 0x75, 0xc2, 0x25, 0x27, 0x7a, 0x6f, 0x42, 0xdd, 0xc9, 0x82, 0x4a, 0xd7, 0x6c, 0xdf, 0x9b,
-	0x80, 0x91, 0x02, 0xd7, 0x6e, 0x20, 0x9b, 0x73, 0x64, 0x8d, 0xda, 0x73, 0x73, 0x70, 0x16, 0x74,
-	0xa7, 0x53, 0x38, 0xa6, 0x40, 0x03, 0x8e, 0xa9, 0xdd, 0xa9, 0x3c, 0x88, 0x05, 0x5b, 0xe0, 0x9b,
-	0xda, 0x0d, 0xf4, 0x29, 0xac, 0xed, 0x11, 0x3f, 0xda, 0xa9, 0x04, 0x0c, 0x3b, 0x93, 0x19, 0x66,
-	0x80, 0x2f, 0xc9, 0x72, 0x1f, 0xca, 0xdc, 0x6f, 0x51, 0x9e, 0x6f, 0xc4, 0x7f, 0xe9, 0x6b, 0x6f,
-	0x4e, 0x06, 0x08, 0xa9, 0xfd, 0x0c, 0x96, 0x53, 0xbf, 0x64, 0xa0, 0x77, 0x72, 0xd0, 0xf2, 0x7f,
-	0x93, 0x6a, 0xdf, 0x2f, 0x02, 0x1a, 0xf2, 0x1a, 0x42, 0x33, 0xb9, 0xf9, 0x41, 0x5b, 0x39, 0xf8,
-	0xb9, 0x5b, 0xe8, 0xf6, 0x3b, 0x05, 0x20, 0x43, 0x46, 0x36, 0xac, 0xa4, 0x37, 0xeb, 0xe8, 0xfe,
-	0x54, 0x02, 0x49, 0x77, 0xfb, 0x5a, 0x21, 0xd8, 0x90, 0xdd, 0x4b, 0xee, 0x04, 0x99, 0xcd, 0x2e,
-	0xda, 0xce, 0x27, 0x33, 0x69, 0xe5, 0xdc, 0xde, 0x29, 0x0c, 0x1f, 0xb2, 0xfe, 0x42, 0xd4, 0xcb,
-	0xbc, 0xed, 0x28, 0x7a, 0x90, 0x4f, 0x6e, 0xca, 0x5a, 0xb7, 0xdd, 0xb9, 0x0c, 0x4a, 0x28, 0xc4,
-	0x67, 0xbc, 0xd0, 0xe5, 0x6c, 0x18, 0xd3, 0x71, 0x17, 0xd0, 0x9b, 0xbc, 0x3a, 0x6d, 0x3f, 0xb8,
-	0x04, 0x46, 0x28, 0x80, 0x9b, 0xfe, 0xed, 0x22, 0x08, 0xc3, 0x9d, 0x99, 0x5e, 0x33, 0x5f, 0x0c,
-	0x7e, 0x02, 0xcb, 0xa9, 0x09, 0x28, 0x37, 0x6a, 0xf2, 0xa7, 0xa4, 0xf6, 0xb4, 0x12, 0x26, 0x42,
-	0x32, 0xd5, 0x37, 0xa0, 0x09, 0xde, 0x9f, 0xd3, 0x5b, 0xb4, 0xef, 0x17, 0x01, 0x0d, 0x1f, 0x42,
-	0x79, 0xba, 0x4c, 0xd5, 0x5e, 0xf4, 0xf5, 0x7c, 0x1a, 0xf9, 0x7d, 0x43, 0xfb, 0xdd, 0x82, 0xd0,
-	0x01, 0xd3, 0xce, 0x3f, 0x54, 0xa8, 0x04, 0xed, 0xf1, 0x35, 0x94, 0x88, 0x6b, 0xc8, 0xd9, 0x9f,
-	0xc0, 0x72, 0x6a, 0x75, 0x9b, 0x6b, 0xd2, 0xfc, 0xf5, 0xee, 0x2c, 0x7f, 0xf9, 0x58, 0xfe, 0x97,
-	0x45, 0x68, 0xbe, 0xb7, 0x27, 0xe5, 0xfd, 0xb4, 0xe5, 0xa6, 0x13, 0x7e, 0xfc, 0xf0, 0x27, 0x0f,
-	0x86, 0xa6, 0x7f, 0x3a, 0x3e, 0x66, 0x5f, 0x76, 0x04, 0xe8, 0xbb, 0xa6, 0x2b, 0xff, 0xda, 0x09,
-	0x14, 0xb4, 0xc3, 0xb1, 0x77, 0x18, 0x9b, 0xd1, 0xf1, 0xf1, 0x22, 0x3f, 0x3d, 0xfc, 0x5f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x91, 0x15, 0x9b, 0xbf, 0xd6, 0x22, 0x00, 0x00,
+	// 2393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0x72, 0xc5, 0xdb, 0xe1, 0x45, 0xf2, 0xfc, 0xf5, 0x97, 0x19, 0xfa, 0x46, 0x6f, 0x12,
+	0x47, 0x76, 0x1b, 0xc9, 0xa6, 0xdb, 0xb4, 0x8d, 0x9b, 0x16, 0xb1, 0x68, 0x0b, 0x44, 0x65, 0x47,
+	0x5d, 0x39, 0x09, 0xd0, 0xa0, 0x20, 0x56, 0xdc, 0x11, 0xb5, 0xf5, 0x5e, 0x18, 0xce, 0xd2, 0x97,
+	0xbc, 0x24, 0x48, 0xd1, 0x02, 0x2d, 0x8a, 0x5e, 0xd0, 0xf6, 0xad, 0x40, 0x2f, 0x40, 0x80, 0x02,
+	0x7d, 0xc9, 0xc7, 0xe8, 0x37, 0x28, 0xd0, 0x7e, 0x99, 0x62, 0x6e, 0x7b, 0x27, 0xb9, 0xa2, 0xea,
+	0xe8, 0x8d, 0x33, 0x7b, 0xce, 0x9c, 0x33, 0x67, 0x7e, 0x73, 0xe6, 0x77, 0x66, 0x08, 0x6b, 0xa6,
+	0xe1, 0x1b, 0x83, 0xa1, 0xe7, 0x4d, 0xcc, 0xad, 0xf1, 0xc4, 0xf3, 0x3d, 0x74, 0xde, 0xb1, 0xec,
+	0xa7, 0x53, 0xc2, 0x5b, 0x5b, 0xf4, 0x73, 0xbb, 0x3e, 0xf4, 0x1c, 0xc7, 0x73, 0x79, 0x57, 0xbb,
+	0x69, 0xb9, 0x3e, 0x9e, 0xb8, 0x86, 0x2d, 0xda, 0xf5, 0xa8, 0x42, 0xbb, 0x4e, 0x86, 0xc7, 0xd8,
+	0x31, 0x78, 0x4b, 0xfb, 0x83, 0x02, 0xf5, 0x07, 0xf6, 0x94, 0x1c, 0xeb, 0xf8, 0xe3, 0x29, 0x26,
+	0x3e, 0xba, 0x05, 0x2b, 0x87, 0x06, 0xc1, 0x2d, 0xa5, 0xa3, 0x6c, 0xd6, 0xba, 0x97, 0xb6, 0x62,
+	0xc6, 0x84, 0x99, 0x87, 0x64, 0x74, 0xcf, 0x20, 0x58, 0x67, 0x92, 0x08, 0xc1, 0x8a, 0x79, 0xd8,
+	0xef, 0xb5, 0x0a, 0x1d, 0x65, 0x53, 0xd5, 0xd9, 0x6f, 0xa4, 0x41, 0x7d, 0xe8, 0xd9, 0x36, 0x1e,
+	0xfa, 0x96, 0xe7, 0xf6, 0x7b, 0xad, 0x15, 0xf6, 0x2d, 0xd6, 0x87, 0xda, 0x50, 0x19, 0x1e, 0x1b,
+	0xae, 0x8b, 0x6d, 0xd2, 0x2a, 0x76, 0xd4, 0xcd, 0xaa, 0x1e, 0xb4, 0xb5, 0x3f, 0x29, 0xd0, 0x10,
+	0x6e, 0x91, 0xb1, 0xe7, 0x12, 0x8c, 0xee, 0x40, 0x89, 0xf8, 0x86, 0x3f, 0x25, 0xc2, 0xb3, 0x8b,
+	0x99, 0x9e, 0x1d, 0x30, 0x11, 0x5d, 0x88, 0xe6, 0x72, 0x4d, 0xcd, 0x70, 0xed, 0x0a, 0x00, 0xc1,
+	0x23, 0x07, 0xbb, 0x7e, 0xbf, 0x47, 0x5a, 0x2b, 0x1d, 0x75, 0x53, 0xd5, 0x23, 0x3d, 0xda, 0xef,
+	0x14, 0x58, 0x3b, 0x90, 0x4d, 0x19, 0xb9, 0x75, 0x28, 0x0e, 0xbd, 0xa9, 0xeb, 0x33, 0x07, 0x1b,
+	0x3a, 0x6f, 0xa0, 0x6b, 0x50, 0x17, 0xb3, 0x1a, 0xb8, 0x86, 0x83, 0x99, 0x2b, 0x55, 0xbd, 0x26,
+	0xfa, 0x1e, 0x19, 0x0e, 0xce, 0xe5, 0x51, 0x07, 0x6a, 0x63, 0x63, 0xe2, 0x5b, 0xb1, 0x78, 0x46,
+	0xbb, 0xb4, 0xbf, 0x28, 0xb0, 0xf1, 0x2e, 0x21, 0xd6, 0xc8, 0x4d, 0x79, 0xb6, 0x01, 0x25, 0xd7,
+	0x33, 0x71, 0xbf, 0xc7, 0x5c, 0x53, 0x75, 0xd1, 0x42, 0x17, 0xa1, 0x3a, 0xc6, 0x78, 0x32, 0x98,
+	0x78, 0xb6, 0x74, 0xac, 0x42, 0x3b, 0x74, 0xcf, 0xc6, 0xe8, 0x87, 0x70, 0x9e, 0x24, 0x06, 0x22,
+	0x2d, 0xb5, 0xa3, 0x6e, 0xd6, 0xba, 0xaf, 0x6e, 0xa5, 0x20, 0xb8, 0x95, 0x34, 0xaa, 0xa7, 0xb5,
+	0xb5, 0xcf, 0x0a, 0xf0, 0x7f, 0x81, 0x1c, 0xf7, 0x95, 0xfe, 0xa6, 0x91, 0x23, 0x78, 0x14, 0xb8,
+	0xc7, 0x1b, 0x79, 0x22, 0x17, 0x84, 0x5c, 0x8d, 0x86, 0x3c, 0x0f, 0xf8, 0x12, 0xf1, 0x2c, 0xa6,
+	0xe2, 0x89, 0xae, 0x42, 0x0d, 0x3f, 0x1f, 0x5b, 0x13, 0x3c, 0xf0, 0x2d, 0x07, 0xb7, 0x4a, 0x1d,
+	0x65, 0x73, 0x45, 0x07, 0xde, 0xf5, 0xd8, 0x72, 0xa2, 0x88, 0x2c, 0xe7, 0x46, 0xa4, 0xf6, 0x37,
+	0x05, 0x2e, 0xa4, 0x56, 0x49, 0x40, 0x5c, 0x87, 0x35, 0x36, 0xf3, 0x30, 0x32, 0x14, 0xec, 0x34,
+	0xe0, 0xd7, 0xe7, 0x05, 0x3c, 0x14, 0xd7, 0x53, 0xfa, 0x11, 0x27, 0x0b, 0xf9, 0x9d, 0x7c, 0x02,
+	0x17, 0x76, 0xb1, 0x2f, 0x0c, 0xd0, 0x6f, 0x98, 0x2c, 0x9f, 0x1e, 0xe2, 0x7b, 0xa9, 0x90, 0xda,
+	0x4b, 0x5f, 0x16, 0x60, 0x2d, 0x6a, 0xaa, 0xef, 0x1e, 0x79, 0xe8, 0x12, 0x54, 0x03, 0x11, 0x81,
+	0x8a, 0xb0, 0x03, 0x7d, 0x0b, 0x8a, 0xd4, 0x53, 0x0e, 0x89, 0x66, 0xf7, 0x5a, 0xf6, 0x9c, 0x22,
+	0x63, 0xea, 0x5c, 0x1e, 0xf5, 0xa1, 0x49, 0x7c, 0x63, 0xe2, 0x0f, 0xc6, 0x1e, 0x61, 0xeb, 0xcc,
+	0x80, 0x53, 0xeb, 0x6a, 0xf1, 0x11, 0x82, 0xfc, 0xf9, 0x90, 0x8c, 0xf6, 0x85, 0xa4, 0xde, 0x60,
+	0x9a, 0xb2, 0x89, 0xee, 0x43, 0x1d, 0xbb, 0x66, 0x38, 0xd0, 0x4a, 0xee, 0x81, 0x6a, 0xd8, 0x35,
+	0x83, 0x61, 0xc2, 0xf5, 0x29, 0xe6, 0x5f, 0x9f, 0x5f, 0x29, 0xd0, 0x4a, 0x2f, 0xd0, 0x69, 0x12,
+	0xe5, 0x5d, 0xae, 0x84, 0xf9, 0x02, 0xcd, 0xdd, 0xe1, 0xc1, 0x22, 0xe9, 0x42, 0x45, 0xb3, 0xe0,
+	0xff, 0x43, 0x6f, 0xd8, 0x97, 0x97, 0x06, 0x96, 0x9f, 0x2a, 0xb0, 0x91, 0xb4, 0x75, 0x9a, 0x79,
+	0x7f, 0x03, 0x8a, 0x96, 0x7b, 0xe4, 0xc9, 0x69, 0x5f, 0x99, 0xb3, 0xcf, 0xa8, 0x2d, 0x2e, 0xac,
+	0x39, 0x70, 0x71, 0x17, 0xfb, 0x7d, 0x97, 0xe0, 0x89, 0x7f, 0xcf, 0x72, 0x6d, 0x6f, 0xb4, 0x6f,
+	0xf8, 0xc7, 0xa7, 0xd8, 0x23, 0x31, 0xb8, 0x17, 0x12, 0x70, 0xd7, 0xfe, 0xae, 0xc0, 0xa5, 0x6c,
+	0x7b, 0x62, 0xea, 0x6d, 0xa8, 0x1c, 0x59, 0xd8, 0x36, 0xfb, 0x3d, 0x9e, 0x30, 0x54, 0x3d, 0x68,
+	0xd3, 0xbd, 0x32, 0xa6, 0xc2, 0x62, 0x86, 0xd7, 0x66, 0x00, 0xf4, 0xc0, 0x9f, 0x58, 0xee, 0x68,
+	0xcf, 0x22, 0xbe, 0xce, 0xe5, 0x23, 0xf1, 0x54, 0xf3, 0x23, 0xf3, 0x97, 0x0a, 0x5c, 0xd9, 0xc5,
+	0xfe, 0x4e, 0x90, 0x6a, 0xe9, 0x77, 0x8b, 0xf8, 0xd6, 0x90, 0xbc, 0x5c, 0x82, 0x91, 0x71, 0x66,
+	0x6a, 0xbf, 0x51, 0xe0, 0xea, 0x4c, 0x67, 0x44, 0xe8, 0x44, 0x2a, 0x91, 0x89, 0x36, 0x3b, 0x95,
+	0xfc, 0x00, 0xbf, 0xf8, 0xc0, 0xb0, 0xa7, 0x78, 0xdf, 0xb0, 0x26, 0x3c, 0x95, 0x2c, 0x99, 0x58,
+	0xff, 0xa1, 0xc0, 0xe5, 0x5d, 0xec, 0xef, 0xcb, 0x63, 0xe6, 0x0c, 0xa3, 0x93, 0x83, 0x51, 0xfc,
+	0x9a, 0x2f, 0x66, 0xa6, 0xb7, 0x67, 0x12, 0xbe, 0x2b, 0x6c, 0x1f, 0x44, 0x36, 0xe4, 0x0e, 0xe7,
+	0x02, 0x22, 0x78, 0xda, 0x1f, 0x0b, 0x50, 0xff, 0x40, 0xf0, 0x03, 0xfa, 0x39, 0x15, 0x07, 0x25,
+	0x3b, 0x0e, 0x11, 0x4a, 0x91, 0xc5, 0x32, 0x76, 0xa1, 0x41, 0x30, 0x7e, 0xb2, 0xcc, 0xa1, 0x51,
+	0xa7, 0x8a, 0xb2, 0x85, 0xf6, 0xe0, 0xfc, 0xd4, 0x3d, 0xa2, 0xb4, 0x16, 0x9b, 0x62, 0x16, 0x9c,
+	0x5d, 0x2e, 0xce, 0x3c, 0x69, 0x45, 0xb4, 0x09, 0xab, 0xc9, 0xb1, 0x8a, 0x6c, 0xf3, 0x27, 0xbb,
+	0xb5, 0x5f, 0x28, 0xb0, 0xf1, 0xa1, 0xe1, 0x0f, 0x8f, 0x7b, 0x8e, 0x88, 0xd8, 0x29, 0xf0, 0xf6,
+	0x0e, 0x54, 0x9f, 0x06, 0xbc, 0x9d, 0x27, 0x95, 0xab, 0x19, 0xce, 0x47, 0xd7, 0x41, 0x0f, 0x35,
+	0x28, 0x4d, 0x5d, 0x67, 0xcc, 0x5e, 0x7a, 0xf7, 0xd5, 0x23, 0x7f, 0x11, 0xbb, 0x7f, 0x0e, 0x20,
+	0x9c, 0x7b, 0x48, 0x46, 0x4b, 0xf8, 0xf5, 0x6d, 0x28, 0x8b, 0xd1, 0x04, 0xb8, 0x17, 0x2d, 0xae,
+	0x14, 0xd7, 0x0e, 0x60, 0x43, 0xf4, 0x3f, 0xa0, 0xf9, 0x9b, 0xe7, 0xfa, 0x87, 0xd8, 0x37, 0x50,
+	0x0b, 0xca, 0x22, 0xa5, 0x0b, 0x10, 0xcb, 0x26, 0xe5, 0xa9, 0x87, 0x4c, 0x6e, 0x40, 0xf3, 0xb6,
+	0xc0, 0x2f, 0x1c, 0x06, 0xc7, 0x84, 0xf6, 0x76, 0x40, 0xba, 0x7b, 0xd8, 0xf6, 0x0d, 0x39, 0xe2,
+	0xab, 0xd0, 0x30, 0x45, 0x9b, 0x6b, 0x2a, 0x4c, 0xb3, 0x2e, 0x3b, 0x99, 0xee, 0x8f, 0xa1, 0xd1,
+	0xeb, 0xed, 0x45, 0xfc, 0xb8, 0x0e, 0xab, 0xa6, 0x69, 0x0f, 0xa2, 0x16, 0xb9, 0x5e, 0xc3, 0x34,
+	0xed, 0xf0, 0x6c, 0x42, 0xaf, 0x41, 0xd3, 0x27, 0x83, 0xb4, 0x63, 0x75, 0x9f, 0x84, 0x52, 0xda,
+	0x43, 0x68, 0xb2, 0x89, 0x32, 0x40, 0x2c, 0x98, 0xe7, 0x35, 0xa8, 0x47, 0x86, 0xe3, 0xd0, 0xab,
+	0xea, 0xb5, 0x70, 0xa2, 0xec, 0xf4, 0x91, 0x54, 0x32, 0x1c, 0x71, 0x3e, 0x95, 0xbc, 0x0c, 0x60,
+	0x91, 0x81, 0xd8, 0x30, 0xcc, 0xc7, 0x8a, 0x5e, 0xb5, 0xc8, 0x03, 0xde, 0x81, 0xbe, 0x03, 0x25,
+	0x66, 0x9f, 0x6f, 0xad, 0x54, 0x82, 0x63, 0x2b, 0x19, 0x9f, 0x81, 0x2e, 0x14, 0xb4, 0xf7, 0xa1,
+	0xde, 0xeb, 0xed, 0x85, 0x7e, 0xe4, 0xc9, 0x45, 0x39, 0xe6, 0xf8, 0x29, 0x34, 0xc3, 0x03, 0x8d,
+	0x25, 0xb9, 0x26, 0x14, 0x82, 0xe1, 0x0a, 0xfd, 0x1e, 0x7a, 0x07, 0x4a, 0xbc, 0xc4, 0x17, 0xe8,
+	0x7b, 0x3d, 0xee, 0x33, 0xff, 0xb6, 0x15, 0x39, 0x15, 0x59, 0x87, 0x2e, 0x94, 0xe8, 0xee, 0x08,
+	0x0e, 0x01, 0x5e, 0xf0, 0xa9, 0x7a, 0xa4, 0x47, 0xfb, 0xeb, 0x0a, 0xd4, 0x22, 0xe0, 0x4d, 0x99,
+	0x4f, 0xce, 0xb3, 0xb0, 0xf8, 0xec, 0x51, 0xd3, 0xd5, 0xd7, 0xeb, 0xd0, 0xb4, 0x18, 0xdf, 0x19,
+	0x88, 0xcc, 0xc1, 0x0e, 0xa8, 0xaa, 0xde, 0xe0, 0xbd, 0x22, 0x8d, 0xa1, 0x2b, 0x50, 0x73, 0xa7,
+	0xce, 0xc0, 0x3b, 0x1a, 0x4c, 0xbc, 0x67, 0x44, 0x94, 0x71, 0x55, 0x77, 0xea, 0xbc, 0x77, 0xa4,
+	0x7b, 0xcf, 0x48, 0x58, 0x29, 0x94, 0x4e, 0x58, 0x29, 0xdc, 0x87, 0xba, 0xe9, 0xd8, 0x61, 0xca,
+	0x2f, 0xe7, 0xa7, 0xf7, 0xa6, 0x63, 0xcb, 0x06, 0xf5, 0xcf, 0x31, 0x9e, 0x53, 0xe7, 0x06, 0xee,
+	0xd4, 0x69, 0x55, 0xb8, 0x7f, 0x8e, 0xf1, 0x5c, 0xf7, 0x9e, 0x3d, 0x9a, 0x3a, 0x68, 0x13, 0xd6,
+	0x6c, 0x83, 0xf8, 0x83, 0x68, 0xa5, 0x59, 0x65, 0x95, 0x66, 0x93, 0xf6, 0xdf, 0x0f, 0xab, 0xcd,
+	0x74, 0xe9, 0x02, 0xcb, 0x96, 0x2e, 0x61, 0xc6, 0x20, 0xd6, 0x27, 0xb8, 0x55, 0x63, 0x4e, 0x89,
+	0x8c, 0x71, 0x60, 0x7d, 0x82, 0xe9, 0x26, 0x9f, 0x18, 0xcf, 0x06, 0x51, 0xa1, 0x3a, 0x13, 0x6a,
+	0x4c, 0x8c, 0x67, 0xf7, 0x42, 0xb9, 0x0b, 0x50, 0xb6, 0xc8, 0x60, 0xe8, 0xd9, 0x66, 0xab, 0xc1,
+	0x76, 0x4e, 0xc9, 0x22, 0x3b, 0x9e, 0x6d, 0x6a, 0x77, 0xa0, 0xd6, 0xef, 0x75, 0x29, 0x60, 0x29,
+	0xa3, 0x4c, 0x41, 0x64, 0x1d, 0x8a, 0xfb, 0x11, 0x7c, 0x17, 0x25, 0xb2, 0xd7, 0xc3, 0x95, 0x88,
+	0xb8, 0x9b, 0x9e, 0xb9, 0xb2, 0xec, 0xcc, 0xe7, 0xf3, 0xec, 0x2f, 0x54, 0xd8, 0x38, 0x30, 0x9e,
+	0xe2, 0x97, 0x4f, 0xe9, 0x73, 0x1d, 0x53, 0x7b, 0x70, 0x9e, 0xa5, 0x92, 0x6e, 0xc4, 0x9f, 0x39,
+	0x6c, 0x21, 0x12, 0x70, 0x3d, 0xad, 0x88, 0xbe, 0x4f, 0x69, 0x0e, 0x1e, 0x3e, 0xd9, 0xf7, 0x2c,
+	0xc9, 0x14, 0x6a, 0xdd, 0xcb, 0x19, 0xe3, 0xec, 0x04, 0x52, 0x7a, 0x54, 0x03, 0xed, 0xc3, 0x6a,
+	0x7c, 0x19, 0x48, 0xab, 0xc4, 0x06, 0x79, 0x63, 0x6e, 0xad, 0x18, 0x46, 0x5f, 0x6f, 0xc6, 0x16,
+	0x83, 0xb0, 0x5c, 0x2f, 0x12, 0x6f, 0x99, 0xc1, 0x47, 0x36, 0x69, 0xf0, 0xe4, 0x31, 0x44, 0x5a,
+	0x15, 0x06, 0x92, 0xb0, 0x83, 0xa6, 0x79, 0x08, 0xbd, 0x5c, 0x90, 0xe0, 0xbf, 0x07, 0x95, 0x00,
+	0x37, 0x85, 0xdc, 0xb8, 0xa9, 0x8c, 0x23, 0x3b, 0x38, 0x9a, 0x61, 0xd4, 0x44, 0x86, 0xd1, 0x3e,
+	0x57, 0xa0, 0xd1, 0x33, 0x7c, 0xe3, 0x91, 0x67, 0xe2, 0xc7, 0x4b, 0x12, 0x86, 0x1c, 0x37, 0x5d,
+	0x97, 0xa0, 0x4a, 0x93, 0x03, 0xf1, 0x0d, 0x67, 0xcc, 0x9c, 0x58, 0xd1, 0xc3, 0x0e, 0x5a, 0x16,
+	0x37, 0x44, 0x4a, 0x3c, 0x08, 0x6e, 0x3e, 0xd9, 0x50, 0xfc, 0x70, 0x66, 0xbf, 0xd1, 0xdb, 0xf1,
+	0x6b, 0x93, 0xd7, 0x32, 0x17, 0x9f, 0x0d, 0xc2, 0xc8, 0x62, 0x2c, 0x1f, 0xe6, 0xa9, 0xb7, 0x3e,
+	0x53, 0xa0, 0x2e, 0x43, 0xc1, 0x8e, 0x86, 0x16, 0x94, 0x0d, 0xd3, 0x9c, 0x60, 0x42, 0x84, 0x1f,
+	0xb2, 0x49, 0xbf, 0x3c, 0xc5, 0x13, 0x22, 0x17, 0x45, 0xd5, 0x65, 0x13, 0x7d, 0x37, 0x72, 0x2b,
+	0xcc, 0x6f, 0x1b, 0x3b, 0xb3, 0xfd, 0x14, 0xf5, 0x41, 0xa0, 0xa1, 0x7d, 0xa9, 0x40, 0x53, 0x60,
+	0x8f, 0x83, 0x9f, 0x2c, 0x80, 0xc7, 0x3d, 0xa8, 0x1f, 0x85, 0x54, 0x6b, 0xde, 0x3d, 0x40, 0x84,
+	0x91, 0xe9, 0x31, 0x9d, 0x38, 0x5a, 0xd5, 0x04, 0x5a, 0x93, 0x00, 0x5a, 0x49, 0x02, 0xe8, 0x5d,
+	0xa8, 0x45, 0x86, 0x9e, 0x43, 0x80, 0x5a, 0x50, 0x3e, 0x8c, 0x78, 0x59, 0xd5, 0x65, 0x53, 0xfb,
+	0xa7, 0xc2, 0x2e, 0xec, 0x74, 0x3c, 0xf4, 0x9e, 0xe2, 0xc9, 0x8b, 0xd3, 0x5f, 0x8b, 0xdc, 0x8d,
+	0x2c, 0x42, 0x4e, 0x8a, 0x1f, 0x28, 0xa0, 0xbb, 0xa1, 0x9f, 0xea, 0x4c, 0xd2, 0x14, 0x5f, 0xa4,
+	0x70, 0x2a, 0xbf, 0xe5, 0x17, 0x3c, 0xf1, 0xa9, 0x2c, 0x9b, 0x83, 0xff, 0x27, 0x54, 0x44, 0xfb,
+	0xbd, 0x02, 0xaf, 0xec, 0x62, 0xff, 0x41, 0xbc, 0xa8, 0x3a, 0x6b, 0xaf, 0x1c, 0x68, 0x67, 0x39,
+	0x75, 0x9a, 0x55, 0x6f, 0x43, 0x85, 0xc8, 0x4a, 0x92, 0x5f, 0xbd, 0x05, 0x6d, 0x0a, 0xb1, 0xc6,
+	0xfd, 0xe7, 0x63, 0x6f, 0xe2, 0xbf, 0xdc, 0x89, 0x6b, 0x50, 0x8f, 0xcc, 0x52, 0xf2, 0xcf, 0x58,
+	0x5f, 0x3c, 0x17, 0xae, 0x24, 0x72, 0x21, 0x65, 0x37, 0xde, 0xd4, 0x1f, 0x4f, 0x7d, 0x5e, 0x76,
+	0x14, 0x79, 0x3d, 0xc4, 0xbb, 0x44, 0x4d, 0xd3, 0x94, 0x33, 0x39, 0x4d, 0xb4, 0x36, 0xa0, 0xe4,
+	0x1b, 0xe4, 0x49, 0x30, 0x0f, 0xd1, 0xd2, 0x7e, 0xa6, 0xb0, 0xeb, 0x50, 0x6e, 0x82, 0xe7, 0xd0,
+	0xa5, 0x23, 0x36, 0xc3, 0x46, 0xae, 0x6c, 0xfc, 0x2f, 0xbe, 0x93, 0x62, 0x7e, 0x9c, 0x66, 0xbe,
+	0x6f, 0xc5, 0x4f, 0x8f, 0x4e, 0xa6, 0x4e, 0xd4, 0x9a, 0x38, 0x39, 0xd6, 0xa1, 0x78, 0x64, 0xd9,
+	0x58, 0x66, 0x46, 0xde, 0x40, 0xaf, 0x40, 0x85, 0x66, 0xc5, 0x48, 0x4a, 0x2c, 0xbb, 0x53, 0x87,
+	0x71, 0xf6, 0xab, 0x50, 0x3b, 0x32, 0x2c, 0x7b, 0x30, 0xc1, 0x06, 0xf1, 0x5c, 0xb9, 0x80, 0xb4,
+	0x4b, 0x67, 0x3d, 0xda, 0xbf, 0x0b, 0x72, 0x05, 0x1f, 0xd3, 0x70, 0xd0, 0x93, 0x26, 0x0c, 0x94,
+	0x32, 0x37, 0x50, 0x67, 0x03, 0x39, 0xf4, 0x56, 0xbc, 0x0c, 0x39, 0x79, 0xec, 0xca, 0xb3, 0x62,
+	0x57, 0x99, 0x1b, 0xbb, 0x6a, 0x32, 0x76, 0x4c, 0xc0, 0x72, 0x2d, 0x72, 0xcc, 0x6b, 0x0d, 0x60,
+	0xea, 0xc0, 0xbb, 0x68, 0x9d, 0xa1, 0xfd, 0x5c, 0x81, 0x56, 0xb4, 0x86, 0xde, 0xf1, 0x9c, 0xb1,
+	0x8d, 0x7d, 0x6c, 0x7e, 0xd5, 0x77, 0x21, 0x7f, 0x56, 0x60, 0x2d, 0x4a, 0x47, 0xd8, 0x3a, 0x7f,
+	0x13, 0x8a, 0xec, 0x2a, 0x49, 0x78, 0xb0, 0xf0, 0x54, 0xe2, 0xd2, 0xf4, 0xe8, 0x64, 0xdc, 0xf3,
+	0x31, 0x91, 0x74, 0x43, 0x34, 0x43, 0x4e, 0xa4, 0x9e, 0x98, 0x13, 0xdd, 0xbc, 0x0d, 0xe7, 0x53,
+	0xdf, 0x50, 0x13, 0xe0, 0x7d, 0x77, 0x28, 0x82, 0xb6, 0x76, 0x0e, 0xd5, 0xa1, 0x22, 0x43, 0xb8,
+	0xa6, 0x74, 0xff, 0xd3, 0x80, 0x2a, 0xa5, 0x48, 0x3b, 0xf4, 0x3d, 0x1f, 0x8d, 0x01, 0xb1, 0xfb,
+	0x69, 0x67, 0xec, 0xb9, 0xc1, 0x43, 0x0e, 0xba, 0x35, 0x83, 0x9f, 0xa6, 0x45, 0x45, 0x62, 0x69,
+	0x5f, 0x9f, 0xa1, 0x91, 0x10, 0xd7, 0xce, 0x21, 0x87, 0x59, 0xa4, 0x0b, 0xfd, 0xd8, 0x1a, 0x3e,
+	0x91, 0x55, 0xf4, 0x1c, 0x8b, 0x09, 0x51, 0x69, 0x31, 0xf1, 0x3e, 0x24, 0x1a, 0xfc, 0x11, 0x41,
+	0xa6, 0x19, 0xed, 0x1c, 0xfa, 0x18, 0xd6, 0xe9, 0x85, 0x6d, 0x70, 0x6f, 0x2c, 0x0d, 0x76, 0x67,
+	0x1b, 0x4c, 0x09, 0x9f, 0xd0, 0xe4, 0x1e, 0x14, 0x19, 0x6e, 0x51, 0x16, 0x36, 0xa2, 0xff, 0x74,
+	0x68, 0x77, 0x66, 0x0b, 0x04, 0xa3, 0xfd, 0x04, 0x56, 0x13, 0xaf, 0xb5, 0xe8, 0x46, 0x86, 0x5a,
+	0xf6, 0xbb, 0x7b, 0xfb, 0x66, 0x1e, 0xd1, 0xc0, 0xd6, 0x08, 0x9a, 0xf1, 0xdb, 0x6d, 0xb4, 0x99,
+	0xa1, 0x9f, 0xf9, 0xd2, 0xd6, 0xbe, 0x91, 0x43, 0x32, 0x30, 0xe4, 0xc0, 0x5a, 0xf2, 0xf5, 0x10,
+	0xdd, 0x9c, 0x3b, 0x40, 0x1c, 0x6e, 0x5f, 0xcb, 0x25, 0x1b, 0x98, 0x7b, 0x01, 0xeb, 0x59, 0xaf,
+	0x57, 0x68, 0x2b, 0x7b, 0x98, 0x59, 0xcf, 0x6a, 0xed, 0xed, 0xdc, 0xf2, 0x81, 0xe9, 0xcf, 0x39,
+	0x31, 0xce, 0x7a, 0x01, 0x42, 0xb7, 0xb3, 0x87, 0x9b, 0xf3, 0x74, 0xd5, 0xee, 0x9e, 0x44, 0x25,
+	0x70, 0xe2, 0x53, 0xd8, 0xc8, 0x7e, 0x45, 0x41, 0xb7, 0xb2, 0xc7, 0x9b, 0xfd, 0x3c, 0xd4, 0xbe,
+	0x7d, 0x02, 0x8d, 0xc0, 0x01, 0x2f, 0xf9, 0x3e, 0x2b, 0xb7, 0xe1, 0xf6, 0x42, 0xd4, 0x2c, 0xb7,
+	0x07, 0x3f, 0x82, 0xd5, 0xc4, 0x3d, 0x4a, 0xe6, 0xae, 0xc9, 0xbe, 0x6b, 0x69, 0xcf, 0x63, 0x23,
+	0x7c, 0x4b, 0x26, 0x0a, 0x04, 0x34, 0x03, 0xfd, 0x19, 0x45, 0x44, 0xfb, 0x66, 0x1e, 0xd1, 0x60,
+	0x22, 0x84, 0xa5, 0xcb, 0x04, 0xc9, 0x46, 0x5f, 0xcf, 0x1e, 0x23, 0xbb, 0x40, 0x68, 0xbf, 0x99,
+	0x53, 0x3a, 0x30, 0xfa, 0x1e, 0x94, 0x38, 0x15, 0x40, 0x59, 0x19, 0x2a, 0x46, 0xc2, 0xdb, 0xd7,
+	0xe6, 0x48, 0x24, 0x12, 0x4b, 0x84, 0x5e, 0xcc, 0x4a, 0x2c, 0x69, 0xce, 0xda, 0xbe, 0x91, 0x43,
+	0x52, 0x1a, 0xea, 0x7e, 0xa1, 0x42, 0x45, 0x5e, 0x00, 0x9c, 0xc1, 0xe1, 0x76, 0x06, 0xa7, 0xcd,
+	0x47, 0xb0, 0x9a, 0x78, 0x58, 0xcb, 0x04, 0x63, 0xf6, 0xe3, 0xdb, 0x22, 0xa4, 0x7f, 0x28, 0xfe,
+	0x03, 0x17, 0x00, 0xef, 0x8d, 0x59, 0x27, 0x56, 0x12, 0x73, 0xf3, 0x07, 0xbe, 0x77, 0xe7, 0x47,
+	0xb7, 0x47, 0x96, 0x7f, 0x3c, 0x3d, 0xa4, 0x5f, 0xb6, 0xb9, 0xe8, 0x9b, 0x96, 0x27, 0x7e, 0x6d,
+	0xcb, 0x00, 0x6d, 0x33, 0xed, 0x6d, 0x6a, 0x66, 0x7c, 0x78, 0x58, 0x62, 0xad, 0x3b, 0xff, 0x1d,
+	0x00, 0x9c, 0x1f, 0xb6, 0x78, 0x91, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2684,6 +3164,8 @@ type DataCoordClient interface {
 	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
 	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
 }
 
 type dataCoordClient struct {
@@ -2820,6 +3302,24 @@ func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushed
 	return out, nil
 }
 
+func (c *dataCoordClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2836,6 +3336,8 @@ type DataCoordServer interface {
 	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
 	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
 	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -2884,6 +3386,12 @@ func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *G
 func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
 }
+func (*UnimplementedDataCoordServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedDataCoordServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -3141,6 +3649,42 @@ func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -3201,6 +3745,14 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFlushedSegments",
 			Handler:    _DataCoord_GetFlushedSegments_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _DataCoord_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _DataCoord_GetExportState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -252,9 +252,22 @@ type CollectionInfo struct {
 	VirtualChannelNames        []string                   `protobuf:"bytes,7,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
 	PhysicalChannelNames       []string                   `protobuf:"bytes,8,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
 	PartitionCreatedTimestamps []uint64                   `protobuf:"varint,9,rep,packed,name=partition_created_timestamps,json=partitionCreatedTimestamps,proto3" json:"partition_created_timestamps,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
-	XXX_unrecognized           []byte                     `json:"-"`
-	XXX_sizecache              int32                      `json:"-"`
+	ConsistencyLevel           commonpb.ConsistencyLevel  `protobuf:"varint,10,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	DbID                       int64                      `protobuf:"varint,11,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	SchemaVersion              int32                      `protobuf:"varint,12,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	DeletionProtection         bool                       `protobuf:"varint,13,opt,name=deletion_protection,json=deletionProtection,proto3" json:"deletion_protection,omitempty"`
+	DropTime                   uint64                     `protobuf:"varint,14,opt,name=drop_time,json=dropTime,proto3" json:"drop_time,omitempty"`
+	// partitions which are soft dropped and waiting to be purged
+	DroppedPartitionIDs        []int64  `protobuf:"varint,15,rep,packed,name=dropped_partitionIDs,json=droppedPartitionIDs,proto3" json:"dropped_partitionIDs,omitempty"`
+	DroppedPartitionNames      []string `protobuf:"bytes,16,rep,name=dropped_partition_names,json=droppedPartitionNames,proto3" json:"dropped_partition_names,omitempty"`
+	PartitionDroppedTimestamps []uint64 `protobuf:"varint,17,rep,packed,name=partition_dropped_timestamps,json=partitionDroppedTimestamps,proto3" json:"partition_dropped_timestamps,omitempty"`
+	// channels replaced by ReshardCollection, they are kept until the segments sealed on them are flushed
+	RetiredVirtualChannelNames  []string `protobuf:"bytes,18,rep,name=retired_virtual_channel_names,json=retiredVirtualChannelNames,proto3" json:"retired_virtual_channel_names,omitempty"`
+	RetiredPhysicalChannelNames []string `protobuf:"bytes,19,rep,name=retired_physical_channel_names,json=retiredPhysicalChannelNames,proto3" json:"retired_physical_channel_names,omitempty"`
+	RetiredSegmentIDs           []int64  `protobuf:"varint,20,rep,packed,name=retired_segmentIDs,json=retiredSegmentIDs,proto3" json:"retired_segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
 }
 
 func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
@@ -345,6 +358,185 @@ func (m *CollectionInfo) GetPartitionCreatedTimestamps() []uint64 {
 	return nil
 }
 
+func (m *CollectionInfo) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CollectionInfo) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetDeletionProtection() bool {
+	if m != nil {
+		return m.DeletionProtection
+	}
+	return false
+}
+
+func (m *CollectionInfo) GetDropTime() uint64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetDroppedPartitionIDs() []int64 {
+	if m != nil {
+		return m.DroppedPartitionIDs
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetDroppedPartitionNames() []string {
+	if m != nil {
+		return m.DroppedPartitionNames
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetPartitionDroppedTimestamps() []uint64 {
+	if m != nil {
+		return m.PartitionDroppedTimestamps
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetRetiredVirtualChannelNames() []string {
+	if m != nil {
+		return m.RetiredVirtualChannelNames
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetRetiredPhysicalChannelNames() []string {
+	if m != nil {
+		return m.RetiredPhysicalChannelNames
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetRetiredSegmentIDs() []int64 {
+	if m != nil {
+		return m.RetiredSegmentIDs
+	}
+	return nil
+}
+
+type DatabaseInfo struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type CredentialInfo struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	EncryptedPassword    string   `protobuf:"bytes,2,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{6}
+}
+
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialInfo.Unmarshal(m, b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return xxx_messageInfo_CredentialInfo.Size(m)
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+func (m *CredentialInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -362,7 +554,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{7}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -448,7 +640,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{8}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -517,6 +709,8 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
+	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.etcd.CredentialInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -524,49 +718,65 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x96, 0xeb, 0x34, 0xa9, 0x27, 0x69, 0xda, 0xee, 0xef, 0x8f, 0xac, 0xaa, 0x80, 0x6b, 0xa9,
-	0x25, 0x12, 0x22, 0x11, 0x2d, 0xe2, 0x86, 0x04, 0xd4, 0xaa, 0x14, 0x21, 0xaa, 0xe2, 0x46, 0x1c,
-	0xb8, 0x58, 0x1b, 0x7b, 0x92, 0xac, 0x64, 0xaf, 0x83, 0x77, 0x5d, 0x35, 0x37, 0xce, 0x3c, 0x02,
-	0x4f, 0xc3, 0xdb, 0x70, 0xe0, 0x25, 0x90, 0x77, 0x6d, 0x27, 0x69, 0xc3, 0x91, 0x9b, 0xe7, 0x9b,
-	0x99, 0xdd, 0x6f, 0xbe, 0xf9, 0xbc, 0xb0, 0x87, 0x32, 0x8c, 0x82, 0x04, 0x25, 0xed, 0xcf, 0xb3,
-	0x54, 0xa6, 0xe4, 0x20, 0x61, 0xf1, 0x6d, 0x2e, 0x74, 0xd4, 0x2f, 0xb2, 0x87, 0x9d, 0x30, 0x4d,
-	0x92, 0x94, 0x6b, 0xe8, 0xb0, 0x23, 0xc2, 0x19, 0x26, 0x65, 0xb9, 0xfb, 0xdd, 0x00, 0x18, 0x21,
-	0xa7, 0x5c, 0x7e, 0x40, 0x49, 0x49, 0x17, 0xb6, 0x86, 0x9e, 0x6d, 0x38, 0x46, 0xcf, 0xf4, 0xb7,
-	0x86, 0x1e, 0x39, 0x85, 0x3d, 0x9e, 0x27, 0xc1, 0x97, 0x1c, 0xb3, 0x45, 0xc0, 0xd3, 0x08, 0x85,
-	0xbd, 0xa5, 0x92, 0xbb, 0x3c, 0x4f, 0x3e, 0x16, 0xe8, 0x55, 0x01, 0x92, 0x67, 0x70, 0xc0, 0xb8,
-	0xc0, 0x4c, 0x06, 0xe1, 0x8c, 0x72, 0x8e, 0xf1, 0xd0, 0x13, 0xb6, 0xe9, 0x98, 0x3d, 0xcb, 0xdf,
-	0xd7, 0x89, 0x8b, 0x1a, 0x27, 0x4f, 0x61, 0x4f, 0x1f, 0x58, 0xd7, 0xda, 0x0d, 0xc7, 0xe8, 0x59,
-	0x7e, 0x57, 0xc1, 0x75, 0xa5, 0xfb, 0xd5, 0x00, 0xeb, 0x3a, 0x4b, 0xef, 0x16, 0x1b, 0xb9, 0xbd,
-	0x82, 0x16, 0x8d, 0xa2, 0x0c, 0x85, 0xe6, 0xd4, 0x3e, 0x3b, 0xea, 0xaf, 0xcd, 0x5e, 0x4e, 0xfd,
-	0x56, 0xd7, 0xf8, 0x55, 0x71, 0xc1, 0x35, 0x43, 0x91, 0xc7, 0x9b, 0xb8, 0xea, 0xc4, 0x92, 0xab,
-	0xfb, 0xcd, 0x00, 0x6b, 0xc8, 0x23, 0xbc, 0x1b, 0xf2, 0x49, 0x4a, 0x1e, 0x01, 0xb0, 0x22, 0x08,
-	0x38, 0x4d, 0x50, 0x51, 0xb1, 0x7c, 0x4b, 0x21, 0x57, 0x34, 0x41, 0x62, 0x43, 0x4b, 0x05, 0x43,
-	0xaf, 0x54, 0xa9, 0x0a, 0x89, 0x07, 0x1d, 0xdd, 0x38, 0xa7, 0x19, 0x4d, 0xf4, 0x75, 0xed, 0xb3,
-	0xe3, 0x8d, 0x84, 0xdf, 0xe3, 0xe2, 0x13, 0x8d, 0x73, 0xbc, 0xa6, 0x2c, 0xf3, 0xdb, 0xaa, 0xed,
-	0x5a, 0x75, 0xb9, 0x1e, 0x74, 0x2f, 0x19, 0xc6, 0xd1, 0x92, 0x90, 0x0d, 0xad, 0x09, 0x8b, 0x31,
-	0xaa, 0x85, 0xa9, 0xc2, 0x3f, 0x73, 0x71, 0x7f, 0x98, 0xd0, 0xbd, 0x48, 0xe3, 0x18, 0x43, 0xc9,
-	0x52, 0xae, 0x8e, 0xb9, 0x2f, 0xed, 0x6b, 0x68, 0x6a, 0x97, 0x94, 0xca, 0x9e, 0xac, 0x13, 0x2d,
-	0x1d, 0xb4, 0x3c, 0xe4, 0x46, 0x01, 0x7e, 0xd9, 0x44, 0x9e, 0x40, 0x3b, 0xcc, 0x90, 0x4a, 0x0c,
-	0x24, 0x4b, 0xd0, 0x36, 0x1d, 0xa3, 0xd7, 0xf0, 0x41, 0x43, 0x23, 0x96, 0x20, 0x71, 0xa1, 0x33,
-	0xa7, 0x99, 0x64, 0x8a, 0x80, 0x27, 0xec, 0x86, 0x63, 0xf6, 0x4c, 0x7f, 0x0d, 0x23, 0xa7, 0xd0,
-	0xad, 0xe3, 0x42, 0x5d, 0x61, 0x6f, 0xab, 0x1d, 0xdd, 0x43, 0xc9, 0x25, 0xec, 0x4e, 0x0a, 0x51,
-	0x02, 0x35, 0x1f, 0x0a, 0xbb, 0xb9, 0x49, 0xdb, 0xe2, 0x47, 0xe8, 0xaf, 0x8b, 0xe7, 0x77, 0x26,
-	0x75, 0x8c, 0x82, 0x9c, 0xc1, 0x7f, 0xb7, 0x2c, 0x93, 0x39, 0x8d, 0x2b, 0x5f, 0xa8, 0x2d, 0x0b,
-	0xbb, 0xa5, 0xae, 0xfd, 0xa7, 0x4c, 0x96, 0xde, 0xd0, 0x77, 0xbf, 0x84, 0xff, 0xe7, 0xb3, 0x85,
-	0x60, 0xe1, 0x83, 0xa6, 0x1d, 0xd5, 0xf4, 0x6f, 0x95, 0x5d, 0xeb, 0x7a, 0x03, 0x47, 0xf5, 0x0c,
-	0x81, 0x56, 0x25, 0x52, 0x4a, 0x09, 0x49, 0x93, 0xb9, 0xb0, 0x2d, 0xc7, 0xec, 0x35, 0xfc, 0xc3,
-	0xba, 0xe6, 0x42, 0x97, 0x8c, 0xea, 0x0a, 0xf7, 0xa7, 0x01, 0xfb, 0x37, 0x38, 0x4d, 0x90, 0xcb,
-	0xa5, 0x17, 0x5c, 0xe8, 0x84, 0xcb, 0xb5, 0x56, 0xeb, 0x5c, 0xc3, 0x88, 0x03, 0xed, 0x15, 0x91,
-	0x4b, 0x67, 0xac, 0x42, 0xe4, 0x08, 0x2c, 0x51, 0x9e, 0xec, 0xa9, 0xcd, 0x99, 0xfe, 0x12, 0xd0,
-	0x7e, 0x2b, 0x44, 0xd3, 0xbf, 0xac, 0xf2, 0x9b, 0x0a, 0x57, 0xfd, 0xb6, 0xbd, 0xee, 0x7d, 0x1b,
-	0x5a, 0xe3, 0x9c, 0xa9, 0x9e, 0xa6, 0xce, 0x94, 0x21, 0x39, 0x86, 0x0e, 0x72, 0x3a, 0x8e, 0x51,
-	0xef, 0xce, 0x6e, 0x39, 0x46, 0x6f, 0xc7, 0x6f, 0x6b, 0x4c, 0x0d, 0xe6, 0xfe, 0x32, 0x56, 0xcd,
-	0xba, 0xf1, 0x1d, 0xf8, 0xdb, 0x66, 0x7d, 0x0c, 0x50, 0x0b, 0x50, 0x59, 0x75, 0x05, 0x21, 0x27,
-	0x2b, 0x46, 0x0d, 0x24, 0x9d, 0x56, 0x46, 0xdd, 0xad, 0xd1, 0x11, 0x9d, 0x8a, 0x07, 0x9e, 0x6f,
-	0x3e, 0xf4, 0xfc, 0xbb, 0xf3, 0xcf, 0x2f, 0xa6, 0x4c, 0xce, 0xf2, 0x71, 0xf1, 0x16, 0x0c, 0xf4,
-	0x18, 0xcf, 0x59, 0x5a, 0x7e, 0x0d, 0x18, 0x97, 0x98, 0x71, 0x1a, 0x0f, 0xd4, 0x64, 0x83, 0xc2,
-	0xd3, 0xf3, 0xf1, 0xb8, 0xa9, 0xa2, 0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xeb, 0x22,
-	0x81, 0x0b, 0x06, 0x00, 0x00,
+	// 960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x06, 0x2d, 0xd9, 0xb2, 0x46, 0x3f, 0xb6, 0xd6, 0x4e, 0x4b, 0x28, 0x4e, 0xca, 0x10, 0x70,
+	0x2a, 0xa0, 0x88, 0x85, 0x38, 0x45, 0x6e, 0x05, 0x9a, 0x8a, 0x08, 0x20, 0xb4, 0x0d, 0x54, 0xda,
+	0xf0, 0xa1, 0x3d, 0x10, 0x2b, 0x72, 0x6c, 0x2f, 0x40, 0x2e, 0x55, 0xee, 0xd2, 0x8d, 0x6e, 0xed,
+	0xb5, 0x8f, 0xd0, 0x17, 0xec, 0xa1, 0x2f, 0x51, 0xec, 0x2e, 0x49, 0x51, 0x32, 0x83, 0x9e, 0x7a,
+	0xe3, 0x7c, 0x33, 0xb3, 0x3b, 0x3f, 0xdf, 0x7e, 0x84, 0x23, 0x94, 0x61, 0x14, 0x24, 0x28, 0xe9,
+	0xc5, 0x2a, 0x4b, 0x65, 0x4a, 0x46, 0x09, 0x8b, 0x1f, 0x72, 0x61, 0xac, 0x0b, 0xe5, 0x1d, 0xf7,
+	0xc3, 0x34, 0x49, 0x52, 0x6e, 0xa0, 0x71, 0x5f, 0x84, 0xf7, 0x98, 0x14, 0xe1, 0xee, 0x5f, 0x16,
+	0xc0, 0x35, 0x72, 0xca, 0xe5, 0x8f, 0x28, 0x29, 0x19, 0xc2, 0xde, 0xdc, 0xb3, 0x2d, 0xc7, 0x9a,
+	0xb4, 0xfc, 0xbd, 0xb9, 0x47, 0x5e, 0xc2, 0x11, 0xcf, 0x93, 0xe0, 0xd7, 0x1c, 0xb3, 0x75, 0xc0,
+	0xd3, 0x08, 0x85, 0xbd, 0xa7, 0x9d, 0x03, 0x9e, 0x27, 0x3f, 0x29, 0xf4, 0x83, 0x02, 0xc9, 0x57,
+	0x30, 0x62, 0x5c, 0x60, 0x26, 0x83, 0xf0, 0x9e, 0x72, 0x8e, 0xf1, 0xdc, 0x13, 0x76, 0xcb, 0x69,
+	0x4d, 0xba, 0xfe, 0xb1, 0x71, 0xcc, 0x2a, 0x9c, 0x7c, 0x09, 0x47, 0xe6, 0xc0, 0x2a, 0xd6, 0x6e,
+	0x3b, 0xd6, 0xa4, 0xeb, 0x0f, 0x35, 0x5c, 0x45, 0xba, 0xbf, 0x5b, 0xd0, 0x5d, 0x64, 0xe9, 0xc7,
+	0x75, 0x63, 0x6d, 0x6f, 0xa1, 0x43, 0xa3, 0x28, 0x43, 0x61, 0x6a, 0xea, 0x5d, 0x9e, 0x5d, 0x6c,
+	0xf5, 0x5e, 0x74, 0xfd, 0xce, 0xc4, 0xf8, 0x65, 0xb0, 0xaa, 0x35, 0x43, 0x91, 0xc7, 0x4d, 0xb5,
+	0x1a, 0xc7, 0xa6, 0x56, 0xf7, 0x4f, 0x0b, 0xba, 0x73, 0x1e, 0xe1, 0xc7, 0x39, 0xbf, 0x4d, 0xc9,
+	0x33, 0x00, 0xa6, 0x8c, 0x80, 0xd3, 0x04, 0x75, 0x29, 0x5d, 0xbf, 0xab, 0x91, 0x0f, 0x34, 0x41,
+	0x62, 0x43, 0x47, 0x1b, 0x73, 0xaf, 0x98, 0x52, 0x69, 0x12, 0x0f, 0xfa, 0x26, 0x71, 0x45, 0x33,
+	0x9a, 0x98, 0xeb, 0x7a, 0x97, 0x2f, 0x1a, 0x0b, 0xfe, 0x1e, 0xd7, 0x37, 0x34, 0xce, 0x71, 0x41,
+	0x59, 0xe6, 0xf7, 0x74, 0xda, 0x42, 0x67, 0xb9, 0x1e, 0x0c, 0xdf, 0x33, 0x8c, 0xa3, 0x4d, 0x41,
+	0x36, 0x74, 0x6e, 0x59, 0x8c, 0x51, 0x35, 0x98, 0xd2, 0xfc, 0x74, 0x2d, 0xee, 0x1f, 0x87, 0x30,
+	0x9c, 0xa5, 0x71, 0x8c, 0xa1, 0x64, 0x29, 0xd7, 0xc7, 0xec, 0x8e, 0xf6, 0x1b, 0x38, 0x30, 0x2c,
+	0x29, 0x26, 0x7b, 0xbe, 0x5d, 0x68, 0xc1, 0xa0, 0xcd, 0x21, 0x57, 0x1a, 0xf0, 0x8b, 0x24, 0xf2,
+	0x05, 0xf4, 0xc2, 0x0c, 0xa9, 0xc4, 0x40, 0xb2, 0x04, 0xed, 0x96, 0x63, 0x4d, 0xda, 0x3e, 0x18,
+	0xe8, 0x9a, 0x25, 0x48, 0x5c, 0xe8, 0xaf, 0x68, 0x26, 0x99, 0x2e, 0xc0, 0x13, 0x76, 0xdb, 0x69,
+	0x4d, 0x5a, 0xfe, 0x16, 0x46, 0x5e, 0xc2, 0xb0, 0xb2, 0xd5, 0x74, 0x85, 0xbd, 0xaf, 0x77, 0xb4,
+	0x83, 0x92, 0xf7, 0x30, 0xb8, 0x55, 0x43, 0x09, 0x74, 0x7f, 0x28, 0xec, 0x83, 0xa6, 0xd9, 0xaa,
+	0x87, 0x70, 0xb1, 0x3d, 0x3c, 0xbf, 0x7f, 0x5b, 0xd9, 0x28, 0xc8, 0x25, 0x3c, 0x79, 0x60, 0x99,
+	0xcc, 0x69, 0x5c, 0xf2, 0x42, 0x6f, 0x59, 0xd8, 0x1d, 0x7d, 0xed, 0x49, 0xe1, 0x2c, 0xb8, 0x61,
+	0xee, 0xfe, 0x1a, 0x3e, 0x5b, 0xdd, 0xaf, 0x05, 0x0b, 0x1f, 0x25, 0x1d, 0xea, 0xa4, 0xd3, 0xd2,
+	0xbb, 0x95, 0xf5, 0x2d, 0x9c, 0x55, 0x3d, 0x04, 0x66, 0x2a, 0x91, 0x9e, 0x94, 0x90, 0x34, 0x59,
+	0x09, 0xbb, 0xeb, 0xb4, 0x26, 0x6d, 0x7f, 0x5c, 0xc5, 0xcc, 0x4c, 0xc8, 0x75, 0x15, 0x41, 0x7c,
+	0x18, 0x85, 0x29, 0x17, 0x4c, 0x48, 0xe4, 0xe1, 0x3a, 0x88, 0xf1, 0x01, 0x63, 0x1b, 0x1c, 0x6b,
+	0x32, 0xbc, 0x3c, 0x6f, 0xe4, 0xd4, 0x6c, 0x13, 0xfd, 0x83, 0x0a, 0xf6, 0x8f, 0xc3, 0x1d, 0x84,
+	0x10, 0x68, 0x47, 0xcb, 0xb9, 0x67, 0xf7, 0x34, 0x0b, 0xf4, 0x37, 0x39, 0x87, 0xa1, 0x59, 0x69,
+	0xf0, 0x80, 0x99, 0x60, 0x29, 0xb7, 0xfb, 0x8e, 0x35, 0xd9, 0xf7, 0x07, 0x06, 0xbd, 0x31, 0x20,
+	0x99, 0xc2, 0x49, 0x84, 0x31, 0xea, 0x7e, 0xd4, 0xb5, 0x86, 0x14, 0xf6, 0xc0, 0xb1, 0x26, 0x87,
+	0x3e, 0x29, 0x5d, 0x8b, 0xca, 0x43, 0x9e, 0x42, 0x37, 0xca, 0xd2, 0x95, 0xa1, 0xc7, 0x50, 0xd3,
+	0xe3, 0x50, 0x01, 0x9a, 0x1c, 0xaf, 0xe1, 0x54, 0x7d, 0xaf, 0x30, 0x0a, 0xb6, 0x48, 0x72, 0xa4,
+	0x49, 0x72, 0x52, 0xf8, 0x16, 0x35, 0x17, 0x79, 0x0b, 0x9f, 0x3f, 0x4a, 0x29, 0x16, 0x71, 0xac,
+	0x17, 0xf1, 0x64, 0x37, 0xab, 0x61, 0x13, 0xe5, 0x09, 0xb5, 0x4d, 0x8c, 0x76, 0x36, 0xe1, 0x99,
+	0x90, 0xda, 0x26, 0xde, 0xc1, 0xb3, 0x0c, 0x25, 0xcb, 0x30, 0x0a, 0x9a, 0xd9, 0x43, 0xf4, 0xfd,
+	0xe3, 0x22, 0xe8, 0xa6, 0x81, 0x44, 0x33, 0x78, 0x5e, 0x1e, 0xf1, 0x09, 0x32, 0x9d, 0xe8, 0x33,
+	0x9e, 0x16, 0x51, 0x8b, 0x26, 0x4e, 0xbd, 0x02, 0x52, 0x1e, 0x22, 0xf0, 0x2e, 0x41, 0x2e, 0xd5,
+	0xc8, 0x4e, 0xf5, 0xc8, 0x46, 0x85, 0xe7, 0xaa, 0x72, 0xb8, 0x57, 0xd0, 0xf7, 0xa8, 0xa4, 0x4b,
+	0x2a, 0xb0, 0x51, 0x00, 0x08, 0xb4, 0xb5, 0xc4, 0xed, 0x69, 0x89, 0xd3, 0xdf, 0xff, 0xf9, 0xaa,
+	0xdd, 0x5f, 0x60, 0x38, 0xcb, 0x30, 0x42, 0x2e, 0x19, 0x8d, 0xf5, 0xb1, 0x63, 0x38, 0xcc, 0x05,
+	0x66, 0x35, 0xb5, 0xac, 0x6c, 0x55, 0x31, 0xf2, 0x30, 0x5b, 0xaf, 0xa4, 0xde, 0x9a, 0x10, 0xbf,
+	0xa5, 0x59, 0x54, 0x5c, 0x38, 0xaa, 0x3c, 0x8b, 0xc2, 0xe1, 0xfe, 0x6d, 0xc1, 0x71, 0xd9, 0x40,
+	0x25, 0x7f, 0x2e, 0xf4, 0xc3, 0x8d, 0x92, 0x95, 0x0d, 0x6c, 0x61, 0xc4, 0x81, 0x5e, 0x8d, 0x46,
+	0x85, 0x18, 0xd6, 0x21, 0x72, 0x06, 0xdd, 0x6a, 0x66, 0xba, 0xad, 0x96, 0xbf, 0x01, 0x8c, 0xc4,
+	0x2a, 0x9d, 0x30, 0x7f, 0xa9, 0x96, 0x5f, 0x9a, 0x75, 0x89, 0xdd, 0xdf, 0x96, 0x7b, 0x1b, 0x3a,
+	0xcb, 0x9c, 0xe9, 0x9c, 0x03, 0xe3, 0x29, 0x4c, 0xf2, 0x02, 0xfa, 0xc8, 0xe9, 0x32, 0x46, 0x23,
+	0x57, 0x76, 0x47, 0xbf, 0x91, 0x9e, 0xc1, 0x74, 0x63, 0xee, 0x3f, 0x56, 0x5d, 0x9f, 0x1b, 0x7f,
+	0x7d, 0xff, 0xb7, 0x3e, 0x3f, 0x07, 0xa8, 0xb1, 0xc8, 0xa8, 0x73, 0x0d, 0x51, 0xba, 0xb0, 0x79,
+	0x37, 0x92, 0xde, 0x95, 0xda, 0x3c, 0xa8, 0xd0, 0x6b, 0x7a, 0x27, 0x1e, 0xc9, 0xfc, 0xc1, 0x63,
+	0x99, 0xff, 0xee, 0xcd, 0xcf, 0xaf, 0xef, 0x98, 0xbc, 0xcf, 0x97, 0x4a, 0xaa, 0xa6, 0xa6, 0x8d,
+	0x57, 0x2c, 0x2d, 0xbe, 0xa6, 0x8c, 0x4b, 0xc5, 0x97, 0x78, 0xaa, 0x3b, 0x9b, 0x2a, 0x19, 0x5f,
+	0x2d, 0x97, 0x07, 0xda, 0x7a, 0xf3, 0xef, 0x00, 0x71, 0x38, 0x71, 0x1f, 0xfe, 0x08, 0x00, 0x00,
 }
@@ -655,6 +655,173 @@ func (m *DropCollectionRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type AddFieldRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	DbID           int64             `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID   int64             `protobuf:"varint,5,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// `schema` is the serialized `schema.CollectionSchema` after the field is added
+	Schema               []byte   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	SchemaVersion        int32    `protobuf:"varint,7,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{12}
+}
+
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
+}
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
+}
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
+}
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
+
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *AddFieldRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AddFieldRequest) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+type RenameCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	OldName              string            `protobuf:"bytes,3,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string            `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	DbID                 int64             `protobuf:"varint,5,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RenameCollectionRequest) Reset()         { *m = RenameCollectionRequest{} }
+func (m *RenameCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameCollectionRequest) ProtoMessage()    {}
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{13}
+}
+
+func (m *RenameCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameCollectionRequest.Unmarshal(m, b)
+}
+func (m *RenameCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameCollectionRequest.Merge(m, src)
+}
+func (m *RenameCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameCollectionRequest.Size(m)
+}
+func (m *RenameCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameCollectionRequest proto.InternalMessageInfo
+
+func (m *RenameCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RenameCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *RenameCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
 type CreatePartitionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -672,7 +839,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{12}
+	return fileDescriptor_41f4a519b878ee3b, []int{14}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -759,7 +926,7 @@ func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
 func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropPartitionRequest) ProtoMessage()    {}
 func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{13}
+	return fileDescriptor_41f4a519b878ee3b, []int{15}
 }
 
 func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -847,7 +1014,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{14}
+	return fileDescriptor_41f4a519b878ee3b, []int{16}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -946,7 +1113,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{15}
+	return fileDescriptor_41f4a519b878ee3b, []int{17}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -1074,7 +1241,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{16}
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1201,7 +1368,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{17}
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1318,7 +1485,7 @@ func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{18}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1420,7 +1587,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1503,6 +1670,7 @@ type DeleteRequest struct {
 	ChannelID            string            `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
 	Timestamps           []uint64          `protobuf:"varint,4,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
 	PrimaryKeys          []int64           `protobuf:"varint,5,rep,packed,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	CollectionID         int64             `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1512,7 +1680,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1568,6 +1736,13 @@ func (m *DeleteRequest) GetPrimaryKeys() []int64 {
 	return nil
 }
 
+func (m *DeleteRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
 type LoadBalanceSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentIDs           []int64           `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
@@ -1580,7 +1755,7 @@ func (m *LoadBalanceSegmentsRequest) Reset()         { *m = LoadBalanceSegmentsR
 func (m *LoadBalanceSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentsRequest) ProtoMessage()    {}
 func (*LoadBalanceSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *LoadBalanceSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1631,7 +1806,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1702,6 +1877,8 @@ type SegmentStatisticsUpdates struct {
 	EndTime              uint64       `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	StartPosition        *MsgPosition `protobuf:"bytes,6,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	EndPosition          *MsgPosition `protobuf:"bytes,7,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	BinlogSize           int64        `protobuf:"varint,8,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	RawBinlogSize        int64        `protobuf:"varint,9,opt,name=raw_binlog_size,json=rawBinlogSize,proto3" json:"raw_binlog_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1711,7 +1888,7 @@ func (m *SegmentStatisticsUpdates) Reset()         { *m = SegmentStatisticsUpdat
 func (m *SegmentStatisticsUpdates) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatisticsUpdates) ProtoMessage()    {}
 func (*SegmentStatisticsUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *SegmentStatisticsUpdates) XXX_Unmarshal(b []byte) error {
@@ -1781,6 +1958,20 @@ func (m *SegmentStatisticsUpdates) GetEndPosition() *MsgPosition {
 	return nil
 }
 
+func (m *SegmentStatisticsUpdates) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *SegmentStatisticsUpdates) GetRawBinlogSize() int64 {
+	if m != nil {
+		return m.RawBinlogSize
+	}
+	return 0
+}
+
 type SegmentStatistics struct {
 	Base                 *commonpb.MsgBase           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegStats             []*SegmentStatisticsUpdates `protobuf:"bytes,2,rep,name=SegStats,proto3" json:"SegStats,omitempty"`
@@ -1793,7 +1984,7 @@ func (m *SegmentStatistics) Reset()         { *m = SegmentStatistics{} }
 func (m *SegmentStatistics) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatistics) ProtoMessage()    {}
 func (*SegmentStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *SegmentStatistics) XXX_Unmarshal(b []byte) error {
@@ -1840,7 +2031,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -1888,7 +2079,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -1935,6 +2126,7 @@ type SegmentStats struct {
 	MemorySize           int64    `protobuf:"varint,2,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
 	NumRows              int64    `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
 	RecentlyModified     bool     `protobuf:"varint,4,opt,name=recently_modified,json=recentlyModified,proto3" json:"recently_modified,omitempty"`
+	LastAccessTime       int64    `protobuf:"varint,5,opt,name=last_access_time,json=lastAccessTime,proto3" json:"last_access_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1944,7 +2136,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -1993,6 +2185,13 @@ func (m *SegmentStats) GetRecentlyModified() bool {
 	return false
 }
 
+func (m *SegmentStats) GetLastAccessTime() int64 {
+	if m != nil {
+		return m.LastAccessTime
+	}
+	return 0
+}
+
 type QueryNodeStats struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegStats             []*SegmentStats   `protobuf:"bytes,2,rep,name=seg_stats,json=segStats,proto3" json:"seg_stats,omitempty"`
@@ -2006,7 +2205,7 @@ func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2062,7 +2261,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2125,7 +2324,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2188,6 +2387,8 @@ func init() {
 	proto.RegisterType((*TimeTickMsg)(nil), "milvus.proto.internal.TimeTickMsg")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.internal.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.internal.DropCollectionRequest")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.internal.AddFieldRequest")
+	proto.RegisterType((*RenameCollectionRequest)(nil), "milvus.proto.internal.RenameCollectionRequest")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.internal.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.internal.DropPartitionRequest")
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.internal.CreateIndexRequest")
@@ -2212,126 +2413,134 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5b, 0x73, 0x23, 0x47,
-	0xf5, 0xff, 0x8f, 0x46, 0xb6, 0xa4, 0x23, 0xd9, 0xd6, 0xf6, 0x7a, 0x37, 0x63, 0xef, 0x66, 0xe3,
-	0x4c, 0xf2, 0x07, 0x93, 0x2d, 0xd6, 0x8b, 0x03, 0x24, 0x45, 0x51, 0x6c, 0x62, 0x2b, 0x2c, 0xaa,
-	0x8d, 0x8d, 0x69, 0x6f, 0x52, 0x05, 0x2f, 0x53, 0xad, 0x99, 0xb6, 0x3c, 0x64, 0x6e, 0x4c, 0xb7,
-	0xbc, 0xab, 0x3c, 0xf1, 0xc0, 0x13, 0x14, 0x3c, 0x50, 0xc5, 0xd7, 0xe0, 0x95, 0x27, 0x2e, 0xc5,
-	0x13, 0x55, 0x7c, 0x02, 0x3e, 0x05, 0xaf, 0x14, 0xc5, 0x03, 0xd5, 0xa7, 0x7b, 0x2e, 0x92, 0x65,
-	0xe3, 0xf5, 0x16, 0x10, 0x0a, 0xde, 0xa6, 0x7f, 0xe7, 0xf4, 0xe5, 0xfc, 0xce, 0xa5, 0x8f, 0x5a,
-	0xb0, 0x1a, 0x26, 0x92, 0xe7, 0x09, 0x8b, 0x1e, 0x64, 0x79, 0x2a, 0x53, 0x72, 0x2b, 0x0e, 0xa3,
-	0xb3, 0x89, 0xd0, 0xa3, 0x07, 0x85, 0x70, 0xb3, 0xe7, 0xa7, 0x71, 0x9c, 0x26, 0x1a, 0xde, 0xec,
-	0x09, 0xff, 0x94, 0xc7, 0x4c, 0x8f, 0xdc, 0xdf, 0x58, 0xb0, 0xb2, 0x9f, 0xc6, 0x59, 0x9a, 0xf0,
-	0x44, 0x0e, 0x93, 0x93, 0x94, 0xdc, 0x86, 0xe5, 0x24, 0x0d, 0xf8, 0x70, 0xe0, 0x58, 0x5b, 0xd6,
-	0xb6, 0x4d, 0xcd, 0x88, 0x10, 0x68, 0xe6, 0x69, 0xc4, 0x9d, 0xc6, 0x96, 0xb5, 0xdd, 0xa1, 0xf8,
-	0x4d, 0x1e, 0x01, 0x08, 0xc9, 0x24, 0xf7, 0xfc, 0x34, 0xe0, 0x8e, 0xbd, 0x65, 0x6d, 0xaf, 0xee,
-	0x6e, 0x3d, 0x58, 0x78, 0x8a, 0x07, 0xc7, 0x4a, 0x71, 0x3f, 0x0d, 0x38, 0xed, 0x88, 0xe2, 0x93,
-	0xbc, 0x07, 0xc0, 0x9f, 0xcb, 0x9c, 0x79, 0x61, 0x72, 0x92, 0x3a, 0xcd, 0x2d, 0x7b, 0xbb, 0xbb,
-	0xfb, 0xfa, 0xec, 0x02, 0xe6, 0xf0, 0x4f, 0xf8, 0xf4, 0x63, 0x16, 0x4d, 0xf8, 0x11, 0x0b, 0x73,
-	0xda, 0xc1, 0x49, 0xea, 0xb8, 0xee, 0x9f, 0x2c, 0x58, 0x2b, 0x0d, 0xc0, 0x3d, 0x04, 0xf9, 0x1a,
-	0x2c, 0xe1, 0x16, 0x68, 0x41, 0x77, 0xf7, 0xcd, 0x0b, 0x4e, 0x34, 0x63, 0x37, 0xd5, 0x53, 0xc8,
-	0x47, 0x70, 0x53, 0x4c, 0x46, 0x7e, 0x21, 0xf2, 0x10, 0x15, 0x4e, 0x03, 0x8f, 0x76, 0xb5, 0x95,
-	0x48, 0x7d, 0x01, 0x73, 0xa4, 0xb7, 0x61, 0x59, 0xad, 0x34, 0x11, 0xc8, 0x52, 0x77, 0xf7, 0xce,
-	0x42, 0x23, 0x8f, 0x51, 0x85, 0x1a, 0x55, 0xf7, 0x0e, 0x6c, 0x3c, 0xe6, 0x72, 0xce, 0x3a, 0xca,
-	0x7f, 0x30, 0xe1, 0x42, 0x1a, 0xe1, 0xd3, 0x30, 0xe6, 0x4f, 0x43, 0xff, 0x93, 0xfd, 0x53, 0x96,
-	0x24, 0x3c, 0x2a, 0x84, 0xaf, 0xc2, 0x9d, 0xc7, 0x1c, 0x27, 0x84, 0x42, 0x86, 0xbe, 0x98, 0x13,
-	0xdf, 0x82, 0x9b, 0x8f, 0xb9, 0x1c, 0x04, 0x73, 0xf0, 0xc7, 0xd0, 0x3e, 0x54, 0xce, 0x56, 0x61,
-	0xf0, 0x55, 0x68, 0xb1, 0x20, 0xc8, 0xb9, 0x10, 0x86, 0xc5, 0xbb, 0x0b, 0x4f, 0xfc, 0xbe, 0xd6,
-	0xa1, 0x85, 0xf2, 0xa2, 0x30, 0x71, 0xbf, 0x0f, 0x30, 0x4c, 0x42, 0x79, 0xc4, 0x72, 0x16, 0x8b,
-	0x0b, 0x03, 0x6c, 0x00, 0x3d, 0x21, 0x59, 0x2e, 0xbd, 0x0c, 0xf5, 0x0c, 0xe5, 0x57, 0x88, 0x86,
-	0x2e, 0x4e, 0xd3, 0xab, 0xbb, 0xdf, 0x05, 0x38, 0x96, 0x79, 0x98, 0x8c, 0x3f, 0x0c, 0x85, 0x54,
-	0x7b, 0x9d, 0x29, 0x3d, 0x65, 0x84, 0xbd, 0xdd, 0xa1, 0x66, 0x54, 0x73, 0x47, 0xe3, 0xea, 0xee,
-	0x78, 0x04, 0xdd, 0x82, 0xee, 0x03, 0x31, 0x26, 0x0f, 0xa1, 0x39, 0x62, 0x82, 0x5f, 0x4a, 0xcf,
-	0x81, 0x18, 0xef, 0x31, 0xc1, 0x29, 0x6a, 0xba, 0x3f, 0xb6, 0xe1, 0x95, 0xfd, 0x9c, 0x63, 0xf0,
-	0x47, 0x11, 0xf7, 0x65, 0x98, 0x26, 0x86, 0xfb, 0x17, 0x5f, 0x8d, 0xbc, 0x02, 0xad, 0x60, 0xe4,
-	0x25, 0x2c, 0x2e, 0xc8, 0x5e, 0x0e, 0x46, 0x87, 0x2c, 0xe6, 0xe4, 0x73, 0xb0, 0xea, 0x97, 0xeb,
-	0x2b, 0x04, 0x63, 0xae, 0x43, 0xe7, 0x50, 0xf2, 0x26, 0xac, 0x64, 0x2c, 0x97, 0x61, 0xa9, 0xd6,
-	0x44, 0xb5, 0x59, 0x50, 0x39, 0x34, 0x18, 0x0d, 0x07, 0xce, 0x12, 0x3a, 0x0b, 0xbf, 0x89, 0x0b,
-	0xbd, 0x6a, 0xad, 0xe1, 0xc0, 0x59, 0x46, 0xd9, 0x0c, 0x46, 0xb6, 0xa0, 0x5b, 0x2e, 0x34, 0x1c,
-	0x38, 0x2d, 0x54, 0xa9, 0x43, 0xca, 0x39, 0xba, 0x16, 0x39, 0xed, 0x2d, 0x6b, 0xbb, 0x47, 0xcd,
-	0x88, 0x3c, 0x84, 0x9b, 0x67, 0x61, 0x2e, 0x27, 0x2c, 0x32, 0xf1, 0xa9, 0xce, 0x21, 0x9c, 0x0e,
-	0x7a, 0x70, 0x91, 0x88, 0xec, 0xc2, 0x7a, 0x76, 0x3a, 0x15, 0xa1, 0x3f, 0x37, 0x05, 0x70, 0xca,
-	0x42, 0x99, 0xfb, 0x7b, 0x0b, 0x6e, 0x0d, 0xf2, 0x34, 0xfb, 0x4c, 0xb8, 0xa2, 0x20, 0xb9, 0x79,
-	0x09, 0xc9, 0x4b, 0xe7, 0x49, 0x76, 0x7f, 0xda, 0x80, 0xdb, 0x3a, 0xa2, 0x8e, 0x0a, 0x62, 0xff,
-	0x09, 0x56, 0x7c, 0x1e, 0xd6, 0xaa, 0x5d, 0xb5, 0xc2, 0x62, 0x33, 0xfe, 0x1f, 0x56, 0x4b, 0x07,
-	0x6b, 0xbd, 0x7f, 0x6d, 0x48, 0xb9, 0x3f, 0x69, 0xc0, 0xba, 0x72, 0xea, 0xff, 0xd8, 0x50, 0x6c,
-	0xfc, 0xb6, 0x01, 0x44, 0x47, 0xc7, 0x30, 0x09, 0xf8, 0xf3, 0x7f, 0x27, 0x17, 0xaf, 0x02, 0x9c,
-	0x84, 0x3c, 0x0a, 0xea, 0x3c, 0x74, 0x10, 0x79, 0x29, 0x0e, 0x1c, 0x68, 0xe1, 0x22, 0xa5, 0xfd,
-	0xc5, 0x50, 0xdd, 0x26, 0xba, 0xb3, 0x30, 0xb7, 0x49, 0xfb, 0xca, 0xb7, 0x09, 0x4e, 0x33, 0xb7,
-	0xc9, 0x2f, 0x6d, 0x58, 0x19, 0x26, 0x82, 0xe7, 0xf2, 0xbf, 0x39, 0x90, 0xc8, 0x5d, 0xe8, 0x08,
-	0x3e, 0x8e, 0x55, 0x83, 0x33, 0xc0, 0x62, 0x6d, 0xd3, 0x0a, 0x50, 0x52, 0x5f, 0x57, 0xd6, 0xe1,
-	0xc0, 0xe9, 0x68, 0xd7, 0x96, 0x00, 0xb9, 0x07, 0x20, 0xc3, 0x98, 0x0b, 0xc9, 0xe2, 0x4c, 0x57,
-	0xe4, 0x26, 0xad, 0x21, 0xea, 0x16, 0xc8, 0xd3, 0x67, 0xc3, 0x81, 0x70, 0xba, 0x5b, 0xb6, 0x6a,
-	0x07, 0xf4, 0x88, 0x7c, 0x19, 0xda, 0x79, 0xfa, 0xcc, 0x0b, 0x98, 0x64, 0x4e, 0x0f, 0x9d, 0xb7,
-	0xb1, 0x90, 0xec, 0xbd, 0x28, 0x1d, 0xd1, 0x56, 0x9e, 0x3e, 0x1b, 0x30, 0xc9, 0xdc, 0xbf, 0xd8,
-	0xb0, 0x72, 0xcc, 0x59, 0xee, 0x9f, 0x5e, 0xdf, 0x61, 0x5f, 0x80, 0x7e, 0xce, 0xc5, 0x24, 0x92,
-	0x5e, 0x65, 0x96, 0xf6, 0xdc, 0x9a, 0xc6, 0xf7, 0x4b, 0xe3, 0x0a, 0xca, 0xed, 0x4b, 0x28, 0x6f,
-	0x2e, 0xa0, 0xdc, 0x85, 0x5e, 0x8d, 0x5f, 0xe1, 0x2c, 0xa1, 0xe9, 0x33, 0x18, 0xe9, 0x83, 0x1d,
-	0x88, 0x08, 0x3d, 0xd6, 0xa1, 0xea, 0x93, 0xdc, 0x87, 0x1b, 0x59, 0xc4, 0x7c, 0x7e, 0x9a, 0x46,
-	0x01, 0xcf, 0xbd, 0x71, 0x9e, 0x4e, 0x32, 0x74, 0x57, 0x8f, 0xf6, 0x6b, 0x82, 0xc7, 0x0a, 0x27,
-	0xef, 0x40, 0x3b, 0x10, 0x91, 0x27, 0xa7, 0x19, 0x47, 0x97, 0xad, 0x5e, 0x60, 0xfb, 0x40, 0x44,
-	0x4f, 0xa7, 0x19, 0xa7, 0xad, 0x40, 0x7f, 0x90, 0x87, 0xb0, 0x2e, 0x78, 0x1e, 0xb2, 0x28, 0xfc,
-	0x94, 0x07, 0x1e, 0x7f, 0x9e, 0xe5, 0x5e, 0x16, 0xb1, 0x04, 0x3d, 0xdb, 0xa3, 0xa4, 0x92, 0x7d,
-	0xf0, 0x3c, 0xcb, 0x8f, 0x22, 0x96, 0x90, 0x6d, 0xe8, 0xa7, 0x13, 0x99, 0x4d, 0xa4, 0x87, 0xd9,
-	0x27, 0xbc, 0x30, 0x40, 0x47, 0xdb, 0x74, 0x55, 0xe3, 0xdf, 0x44, 0x78, 0x18, 0x28, 0x6a, 0x65,
-	0xce, 0xce, 0x78, 0xe4, 0x95, 0x11, 0xe0, 0x74, 0xb7, 0xac, 0xed, 0x26, 0x5d, 0xd3, 0xf8, 0xd3,
-	0x02, 0x26, 0x3b, 0x70, 0x73, 0x3c, 0x61, 0x39, 0x4b, 0x24, 0xe7, 0x35, 0xed, 0x1e, 0x6a, 0x93,
-	0x52, 0x54, 0x4e, 0x70, 0xff, 0x5c, 0x73, 0xbd, 0xf2, 0x92, 0xb8, 0x86, 0xeb, 0xaf, 0xd3, 0x17,
-	0x2e, 0x8c, 0x17, 0x7b, 0x71, 0xbc, 0xbc, 0x06, 0xdd, 0x98, 0xcb, 0x3c, 0xf4, 0xb5, 0x5f, 0x74,
-	0x1a, 0x83, 0x86, 0x90, 0x7c, 0x02, 0xcd, 0xd3, 0x50, 0xea, 0x80, 0xe8, 0x51, 0xfc, 0x56, 0x93,
-	0x44, 0x14, 0xfa, 0x3c, 0xf0, 0x46, 0x51, 0x3a, 0x32, 0x7e, 0x00, 0x0d, 0xa9, 0xe8, 0x57, 0xfc,
-	0x1b, 0x85, 0x64, 0x12, 0x7b, 0x7e, 0x3a, 0x49, 0xa4, 0x03, 0x18, 0x75, 0xab, 0x1a, 0x3f, 0x9c,
-	0xc4, 0xfb, 0x0a, 0x25, 0x6f, 0xc0, 0x8a, 0xd1, 0x4c, 0x4f, 0x4e, 0x04, 0x97, 0x48, 0xbe, 0x4d,
-	0x7b, 0x1a, 0xfc, 0x36, 0x62, 0xe4, 0xeb, 0xb0, 0x29, 0x38, 0x8b, 0x78, 0xe0, 0x95, 0x39, 0x2e,
-	0x3c, 0x81, 0xcc, 0xf2, 0xc0, 0x59, 0x46, 0xc7, 0x3a, 0x5a, 0xe3, 0xb8, 0x54, 0x38, 0x36, 0x72,
-	0xe5, 0xb7, 0x92, 0x86, 0xda, 0xb4, 0x16, 0xb6, 0x62, 0xa4, 0x12, 0x95, 0x13, 0xde, 0x05, 0x67,
-	0x1c, 0xa5, 0x23, 0x16, 0x79, 0xe7, 0x76, 0xc5, 0xaa, 0x6d, 0xd3, 0xdb, 0x5a, 0x7e, 0x3c, 0xb7,
-	0xa5, 0xfb, 0xb7, 0x06, 0xac, 0x51, 0xc5, 0x1d, 0x3f, 0xe3, 0xff, 0xf1, 0xe9, 0xfe, 0x16, 0xd8,
-	0x61, 0x20, 0x30, 0xdd, 0xbb, 0xbb, 0xce, 0xec, 0xb9, 0xcd, 0x4f, 0xf6, 0xe1, 0x40, 0x50, 0xa5,
-	0xb4, 0x30, 0xe1, 0x5a, 0x57, 0x4e, 0xb8, 0xf6, 0x0b, 0x25, 0x5c, 0xe7, 0xc2, 0x84, 0xfb, 0xb5,
-	0x5d, 0xa7, 0xff, 0xb3, 0x9a, 0x72, 0x86, 0xd7, 0xe6, 0x55, 0x78, 0x7d, 0x04, 0x5d, 0x43, 0x28,
-	0x5e, 0x3b, 0x4b, 0x78, 0xed, 0xdc, 0x5b, 0x38, 0x07, 0x19, 0x56, 0x57, 0x0e, 0xd5, 0x8d, 0x8d,
-	0x50, 0xdf, 0xe4, 0x1b, 0x70, 0xe7, 0x7c, 0xea, 0xe4, 0x86, 0xa3, 0x22, 0x77, 0x36, 0xe6, 0x73,
-	0xa7, 0x20, 0x31, 0x20, 0x5f, 0x82, 0xf5, 0x5a, 0xf2, 0x54, 0x13, 0x75, 0xf6, 0xd4, 0x12, 0xab,
-	0x9a, 0x72, 0xfd, 0xf4, 0xf9, 0xa3, 0x05, 0x2b, 0x03, 0x1e, 0x71, 0xf9, 0x12, 0xc9, 0xb3, 0xa0,
-	0x87, 0x69, 0x2c, 0xec, 0x61, 0x66, 0x9a, 0x04, 0xfb, 0xf2, 0x26, 0xa1, 0x79, 0xae, 0x49, 0x78,
-	0x1d, 0x7a, 0x59, 0x1e, 0xc6, 0x2c, 0x9f, 0x7a, 0x9f, 0xf0, 0x69, 0x91, 0x40, 0x5d, 0x83, 0x3d,
-	0xe1, 0x53, 0xe1, 0x26, 0xb0, 0xf9, 0x61, 0xca, 0x82, 0x3d, 0x16, 0xb1, 0xc4, 0xe7, 0xc6, 0x4c,
-	0x71, 0x7d, 0xcb, 0xee, 0x01, 0xd4, 0x98, 0x6c, 0xe0, 0x86, 0x35, 0xc4, 0xfd, 0xab, 0x05, 0x1d,
-	0xb5, 0x21, 0xb6, 0xd6, 0xd7, 0x58, 0x7f, 0xa6, 0xa7, 0x6a, 0x2c, 0xe8, 0xa9, 0xca, 0xee, 0xb8,
-	0xa0, 0xab, 0x6a, 0x97, 0x6b, 0x6d, 0x6f, 0x73, 0xb6, 0xed, 0x7d, 0x0d, 0xba, 0xa1, 0x3a, 0x90,
-	0x97, 0x31, 0x79, 0xaa, 0x79, 0xea, 0x50, 0x40, 0xe8, 0x48, 0x21, 0xaa, 0x2f, 0x2e, 0x14, 0xb0,
-	0x2f, 0x5e, 0xbe, 0x72, 0x5f, 0x6c, 0x16, 0xc1, 0xbe, 0xf8, 0x77, 0x0d, 0x70, 0x0c, 0xc5, 0xd5,
-	0x23, 0xd3, 0x47, 0x59, 0x80, 0x6f, 0x5d, 0x77, 0xa1, 0x53, 0x46, 0x99, 0x79, 0xe3, 0xa9, 0x00,
-	0xc5, 0xeb, 0x01, 0x8f, 0xd3, 0x7c, 0x7a, 0x1c, 0x7e, 0xca, 0x8d, 0xe1, 0x35, 0x44, 0xd9, 0x76,
-	0x38, 0x89, 0x69, 0xfa, 0x4c, 0x98, 0x32, 0x5b, 0x0c, 0x95, 0x6d, 0x3e, 0xfe, 0x9a, 0xc1, 0xea,
-	0x84, 0x96, 0x37, 0x29, 0x68, 0x48, 0x55, 0x25, 0xb2, 0x01, 0x6d, 0x9e, 0x04, 0x5a, 0xba, 0x84,
-	0xd2, 0x16, 0x4f, 0x02, 0x14, 0x0d, 0x61, 0xd5, 0x3c, 0x2e, 0xa5, 0x02, 0x4b, 0xae, 0x29, 0xb4,
-	0xee, 0x05, 0x2f, 0x7a, 0x07, 0x62, 0x7c, 0x64, 0x34, 0xe9, 0x8a, 0x7e, 0x5f, 0x32, 0x43, 0xf2,
-	0x01, 0xf4, 0xd4, 0x2e, 0xe5, 0x42, 0xad, 0x2b, 0x2f, 0xd4, 0xe5, 0x49, 0x50, 0x0c, 0xdc, 0x9f,
-	0x5b, 0x70, 0xe3, 0x1c, 0x85, 0xd7, 0x88, 0xa3, 0x27, 0xd0, 0x3e, 0xe6, 0x63, 0xb5, 0x44, 0xf1,
-	0x64, 0xb6, 0x73, 0xd1, 0x0b, 0xec, 0x05, 0x0e, 0xa3, 0xe5, 0x02, 0xee, 0x8f, 0x2c, 0x00, 0x0c,
-	0x68, 0x1c, 0x9e, 0x0b, 0x16, 0xeb, 0x3a, 0xc1, 0xa2, 0x1a, 0x4a, 0xd5, 0x97, 0xe4, 0x3c, 0x62,
-	0xb2, 0xaa, 0x4f, 0xc2, 0xf8, 0x9e, 0x24, 0x93, 0x98, 0x6a, 0x51, 0x91, 0xb4, 0xee, 0xcf, 0x2c,
-	0x00, 0x2c, 0xb0, 0xfa, 0x18, 0xf3, 0x57, 0xac, 0x75, 0xf9, 0x2f, 0xc1, 0xc6, 0x6c, 0x4a, 0xec,
-	0x15, 0x29, 0x21, 0x90, 0x23, 0x7b, 0x91, 0x0d, 0x25, 0x47, 0x95, 0xf1, 0x26, 0x6b, 0x34, 0x2f,
-	0xbf, 0xb0, 0xa0, 0x57, 0xa3, 0x4f, 0xcc, 0x66, 0xaf, 0x35, 0x9f, 0xbd, 0xd8, 0xe6, 0xa9, 0x88,
-	0xf6, 0x44, 0x2d, 0xc8, 0xe3, 0x2a, 0xc8, 0x37, 0xa0, 0x8d, 0x94, 0xd4, 0xa2, 0x3c, 0x31, 0x51,
-	0x7e, 0x1f, 0x6e, 0xe4, 0xdc, 0xe7, 0x89, 0x8c, 0xa6, 0x5e, 0x9c, 0x06, 0xe1, 0x49, 0xc8, 0x03,
-	0x8c, 0xf5, 0x36, 0xed, 0x17, 0x82, 0x03, 0x83, 0xbb, 0x7f, 0xb0, 0x60, 0xf5, 0x3b, 0x13, 0x9e,
-	0x4f, 0x0f, 0xd3, 0x80, 0xeb, 0x93, 0xbd, 0x78, 0x04, 0xbd, 0x87, 0xb6, 0x18, 0x7a, 0x74, 0x08,
-	0xbd, 0xf1, 0x8f, 0x43, 0x48, 0xd0, 0xb6, 0x30, 0x61, 0xa3, 0x28, 0xd6, 0xbf, 0xee, 0xaf, 0x42,
-	0x71, 0xe5, 0x58, 0x73, 0x75, 0x6a, 0x8a, 0x7f, 0x68, 0x41, 0xb7, 0x96, 0x2c, 0xaa, 0xe4, 0x9b,
-	0xfb, 0x41, 0x5f, 0x2b, 0x16, 0x16, 0xc1, 0xae, 0x5f, 0xbd, 0xe1, 0x91, 0x75, 0x58, 0x8a, 0xc5,
-	0xd8, 0x78, 0xbc, 0x47, 0xf5, 0x80, 0x6c, 0x42, 0x3b, 0x16, 0x63, 0xfc, 0x11, 0x64, 0x2a, 0x67,
-	0x39, 0x56, 0x6e, 0xab, 0x3a, 0x1b, 0x5d, 0x40, 0x2a, 0xc0, 0xfd, 0x95, 0x05, 0xc4, 0x34, 0x0e,
-	0x2f, 0xf5, 0xd0, 0x8b, 0x01, 0x5b, 0x7f, 0x87, 0x6c, 0x60, 0x19, 0x9e, 0xc1, 0xe6, 0xae, 0x3c,
-	0xfb, 0xdc, 0x95, 0x77, 0x1f, 0x6e, 0x04, 0xfc, 0x84, 0xa9, 0x1e, 0x67, 0xfe, 0xc8, 0x7d, 0x23,
-	0x28, 0x5b, 0xb1, 0xb7, 0xde, 0x85, 0x4e, 0xf9, 0xff, 0x0a, 0xe9, 0x43, 0x6f, 0x98, 0x84, 0x12,
-	0x7f, 0xa5, 0x85, 0xc9, 0xb8, 0xff, 0x7f, 0xa4, 0x0b, 0xad, 0x6f, 0x71, 0x16, 0xc9, 0xd3, 0x69,
-	0xdf, 0x22, 0x3d, 0x68, 0xbf, 0x3f, 0x4a, 0xd2, 0x3c, 0x66, 0x51, 0xbf, 0xb1, 0xf7, 0xce, 0xf7,
-	0xbe, 0x32, 0x0e, 0xe5, 0xe9, 0x64, 0xa4, 0x2c, 0xd9, 0xd1, 0xa6, 0x7d, 0x31, 0x4c, 0xcd, 0xd7,
-	0x4e, 0xe1, 0xb5, 0x1d, 0xb4, 0xb6, 0x1c, 0x66, 0xa3, 0xd1, 0x32, 0x22, 0x6f, 0xff, 0x3d, 0x00,
-	0x00, 0xff, 0xff, 0x7d, 0x5a, 0x47, 0x9f, 0x85, 0x1a, 0x00, 0x00,
+	// 2058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x73, 0x23, 0x47,
+	0x15, 0x67, 0x34, 0xb2, 0x25, 0x3d, 0xc9, 0xb2, 0xb6, 0xf7, 0x6b, 0xf6, 0x23, 0xbb, 0xca, 0xe4,
+	0x03, 0x93, 0x2d, 0x76, 0x17, 0x07, 0x48, 0x8a, 0xa2, 0xd8, 0xac, 0xad, 0xb0, 0xa8, 0x36, 0x5e,
+	0x4c, 0xdb, 0xd9, 0x2a, 0xb8, 0x4c, 0xb5, 0x66, 0xda, 0xf2, 0x90, 0xf9, 0x10, 0xd3, 0x2d, 0xdb,
+	0xca, 0x89, 0x03, 0x17, 0xa0, 0xe0, 0xc0, 0x1f, 0xc2, 0x95, 0x13, 0xa4, 0x8a, 0x13, 0x67, 0x6e,
+	0xfc, 0x15, 0xb9, 0x52, 0x54, 0x0e, 0x54, 0xbf, 0xee, 0x19, 0x8d, 0x64, 0xd9, 0x68, 0xbd, 0x84,
+	0x84, 0x82, 0xdb, 0xf4, 0xef, 0xbd, 0xee, 0xe9, 0xf7, 0x7b, 0x1f, 0xfd, 0xa6, 0x07, 0xda, 0x61,
+	0x22, 0x79, 0x96, 0xb0, 0xe8, 0xfe, 0x28, 0x4b, 0x65, 0x4a, 0xae, 0xc6, 0x61, 0x74, 0x34, 0x16,
+	0x7a, 0x74, 0x3f, 0x17, 0xde, 0x6c, 0xf9, 0x69, 0x1c, 0xa7, 0x89, 0x86, 0x6f, 0xb6, 0x84, 0x7f,
+	0xc8, 0x63, 0xa6, 0x47, 0xee, 0x9f, 0x2c, 0x58, 0xdb, 0x4e, 0xe3, 0x51, 0x9a, 0xf0, 0x44, 0xf6,
+	0x93, 0x83, 0x94, 0x5c, 0x83, 0xd5, 0x24, 0x0d, 0x78, 0xbf, 0xe7, 0x58, 0x5d, 0x6b, 0xc3, 0xa6,
+	0x66, 0x44, 0x08, 0x54, 0xb3, 0x34, 0xe2, 0x4e, 0xa5, 0x6b, 0x6d, 0x34, 0x28, 0x3e, 0x93, 0x47,
+	0x00, 0x42, 0x32, 0xc9, 0x3d, 0x3f, 0x0d, 0xb8, 0x63, 0x77, 0xad, 0x8d, 0xf6, 0x66, 0xf7, 0xfe,
+	0xc2, 0x5d, 0xdc, 0xdf, 0x53, 0x8a, 0xdb, 0x69, 0xc0, 0x69, 0x43, 0xe4, 0x8f, 0xe4, 0x3d, 0x00,
+	0x7e, 0x22, 0x33, 0xe6, 0x85, 0xc9, 0x41, 0xea, 0x54, 0xbb, 0xf6, 0x46, 0x73, 0xf3, 0xd5, 0xd9,
+	0x05, 0xcc, 0xe6, 0x9f, 0xf2, 0xc9, 0x73, 0x16, 0x8d, 0xf9, 0x2e, 0x0b, 0x33, 0xda, 0xc0, 0x49,
+	0x6a, 0xbb, 0xee, 0xdf, 0x2c, 0x58, 0x2f, 0x0c, 0xc0, 0x77, 0x08, 0xf2, 0x1d, 0x58, 0xc1, 0x57,
+	0xa0, 0x05, 0xcd, 0xcd, 0xd7, 0xcf, 0xd8, 0xd1, 0x8c, 0xdd, 0x54, 0x4f, 0x21, 0x1f, 0xc2, 0x65,
+	0x31, 0x1e, 0xf8, 0xb9, 0xc8, 0x43, 0x54, 0x38, 0x95, 0xae, 0xbd, 0xf4, 0x4a, 0xa4, 0xbc, 0x80,
+	0xd9, 0xd2, 0xdb, 0xb0, 0xaa, 0x56, 0x1a, 0x0b, 0x64, 0xa9, 0xb9, 0x79, 0x6b, 0xa1, 0x91, 0x7b,
+	0xa8, 0x42, 0x8d, 0xaa, 0x7b, 0x0b, 0x6e, 0x3c, 0xe1, 0x72, 0xce, 0x3a, 0xca, 0x7f, 0x36, 0xe6,
+	0x42, 0x1a, 0xe1, 0x7e, 0x18, 0xf3, 0xfd, 0xd0, 0xff, 0x68, 0xfb, 0x90, 0x25, 0x09, 0x8f, 0x72,
+	0xe1, 0x2b, 0x70, 0xeb, 0x09, 0xc7, 0x09, 0xa1, 0x90, 0xa1, 0x2f, 0xe6, 0xc4, 0x57, 0xe1, 0xf2,
+	0x13, 0x2e, 0x7b, 0xc1, 0x1c, 0xfc, 0x1c, 0xea, 0xcf, 0x94, 0xb3, 0x55, 0x18, 0x7c, 0x1b, 0x6a,
+	0x2c, 0x08, 0x32, 0x2e, 0x84, 0x61, 0xf1, 0xf6, 0xc2, 0x1d, 0x3f, 0xd6, 0x3a, 0x34, 0x57, 0x5e,
+	0x14, 0x26, 0xee, 0x4f, 0x01, 0xfa, 0x49, 0x28, 0x77, 0x59, 0xc6, 0x62, 0x71, 0x66, 0x80, 0xf5,
+	0xa0, 0x25, 0x24, 0xcb, 0xa4, 0x37, 0x42, 0x3d, 0xa7, 0xb2, 0x6c, 0x34, 0x34, 0x71, 0x9a, 0x5e,
+	0xdd, 0xfd, 0x31, 0xc0, 0x9e, 0xcc, 0xc2, 0x64, 0xf8, 0x41, 0x28, 0xa4, 0x7a, 0xd7, 0x91, 0xd2,
+	0x53, 0x46, 0xd8, 0x1b, 0x0d, 0x6a, 0x46, 0x25, 0x77, 0x54, 0x96, 0x77, 0xc7, 0x23, 0x68, 0xe6,
+	0x74, 0xef, 0x88, 0x21, 0x79, 0x08, 0xd5, 0x01, 0x13, 0xfc, 0x5c, 0x7a, 0x76, 0xc4, 0x70, 0x8b,
+	0x09, 0x4e, 0x51, 0xd3, 0xfd, 0x95, 0x0d, 0xd7, 0xb7, 0x33, 0x8e, 0xc1, 0x1f, 0x45, 0xdc, 0x97,
+	0x61, 0x9a, 0x18, 0xee, 0x5f, 0x7c, 0x35, 0x72, 0x1d, 0x6a, 0xc1, 0xc0, 0x4b, 0x58, 0x9c, 0x93,
+	0xbd, 0x1a, 0x0c, 0x9e, 0xb1, 0x98, 0x93, 0x37, 0xa1, 0xed, 0x17, 0xeb, 0x2b, 0x04, 0x63, 0xae,
+	0x41, 0xe7, 0x50, 0xf2, 0x3a, 0xac, 0x8d, 0x58, 0x26, 0xc3, 0x42, 0xad, 0x8a, 0x6a, 0xb3, 0xa0,
+	0x72, 0x68, 0x30, 0xe8, 0xf7, 0x9c, 0x15, 0x74, 0x16, 0x3e, 0x13, 0x17, 0x5a, 0xd3, 0xb5, 0xfa,
+	0x3d, 0x67, 0x15, 0x65, 0x33, 0x18, 0xe9, 0x42, 0xb3, 0x58, 0xa8, 0xdf, 0x73, 0x6a, 0xa8, 0x52,
+	0x86, 0x94, 0x73, 0x74, 0x2d, 0x72, 0xea, 0x5d, 0x6b, 0xa3, 0x45, 0xcd, 0x88, 0x3c, 0x84, 0xcb,
+	0x47, 0x61, 0x26, 0xc7, 0x2c, 0x32, 0xf1, 0xa9, 0xf6, 0x21, 0x9c, 0x06, 0x7a, 0x70, 0x91, 0x88,
+	0x6c, 0xc2, 0x95, 0xd1, 0xe1, 0x44, 0x84, 0xfe, 0xdc, 0x14, 0xc0, 0x29, 0x0b, 0x65, 0xee, 0x9f,
+	0x2d, 0xb8, 0xda, 0xcb, 0xd2, 0xd1, 0x97, 0xc2, 0x15, 0x39, 0xc9, 0xd5, 0x73, 0x48, 0x5e, 0x39,
+	0x4d, 0xb2, 0xfb, 0x99, 0x05, 0xeb, 0x8f, 0x83, 0xe0, 0xfb, 0x21, 0x8f, 0x82, 0xcf, 0x61, 0xfb,
+	0x5f, 0x85, 0xf5, 0xe9, 0xeb, 0xbc, 0xe4, 0xdf, 0xbe, 0xff, 0x52, 0x08, 0xac, 0xce, 0x84, 0xc0,
+	0x1b, 0xd0, 0xd6, 0x4f, 0xde, 0x11, 0xcf, 0x44, 0x98, 0x26, 0x18, 0x3f, 0x2b, 0x74, 0x4d, 0xa3,
+	0xcf, 0x35, 0xe8, 0xfe, 0xd5, 0x82, 0xeb, 0x94, 0xab, 0x7d, 0x7d, 0xae, 0x5e, 0xbc, 0x01, 0xf5,
+	0x34, 0x0a, 0xca, 0xf6, 0xd7, 0xd2, 0x28, 0xc8, 0x45, 0x09, 0x3f, 0xd6, 0x22, 0x9d, 0x3e, 0xb5,
+	0x84, 0x1f, 0xbf, 0x4c, 0xe2, 0xb8, 0xbf, 0xa9, 0xc0, 0x35, 0x5d, 0x25, 0x76, 0xf3, 0x64, 0xf9,
+	0x22, 0x5d, 0xfb, 0x06, 0xb4, 0x8b, 0xa4, 0xf5, 0x92, 0xff, 0x7c, 0x99, 0x70, 0x7f, 0x5d, 0x81,
+	0x2b, 0x2a, 0x51, 0xff, 0xcf, 0x86, 0x62, 0xe3, 0x93, 0x0a, 0x10, 0x1d, 0x1d, 0xfd, 0x24, 0xe0,
+	0x27, 0x5f, 0x24, 0x17, 0xaf, 0x00, 0x1c, 0xa8, 0xc2, 0x53, 0xe6, 0xa1, 0x81, 0xc8, 0x4b, 0x71,
+	0xe0, 0x40, 0x0d, 0x17, 0x29, 0xec, 0xcf, 0x87, 0xaa, 0x43, 0xd0, 0xdd, 0xa2, 0xe9, 0x10, 0xea,
+	0x4b, 0x77, 0x08, 0x38, 0xcd, 0x74, 0x08, 0xbf, 0xb7, 0x61, 0xad, 0x9f, 0x08, 0x9e, 0xc9, 0xff,
+	0xe5, 0x40, 0x22, 0xb7, 0xa1, 0x21, 0xf8, 0x30, 0x56, 0x4d, 0x6b, 0x0f, 0x0f, 0x60, 0x9b, 0x4e,
+	0x01, 0x25, 0xf5, 0xf5, 0x69, 0xd9, 0xef, 0x39, 0x0d, 0xed, 0xda, 0x02, 0x20, 0x77, 0x00, 0x64,
+	0x18, 0x73, 0x21, 0x59, 0x3c, 0xd2, 0xa7, 0x6c, 0x95, 0x96, 0x10, 0x55, 0xd6, 0xb3, 0xf4, 0xb8,
+	0xdf, 0x13, 0x4e, 0xb3, 0x6b, 0xab, 0x16, 0x4f, 0x8f, 0xc8, 0x37, 0xa1, 0x9e, 0xa5, 0xc7, 0x5e,
+	0xc0, 0x24, 0x73, 0x5a, 0xe8, 0xbc, 0x1b, 0x0b, 0xc9, 0xde, 0x8a, 0xd2, 0x01, 0xad, 0x65, 0xe9,
+	0x71, 0x8f, 0x49, 0xe6, 0xfe, 0xdd, 0x86, 0xb5, 0x3d, 0xce, 0x32, 0xff, 0xf0, 0xe2, 0x0e, 0xfb,
+	0x1a, 0x74, 0x32, 0x2e, 0xc6, 0x91, 0xf4, 0xa6, 0x66, 0x69, 0xcf, 0xad, 0x6b, 0x7c, 0xbb, 0x30,
+	0x2e, 0xa7, 0xdc, 0x3e, 0x87, 0xf2, 0xea, 0x02, 0xca, 0x5d, 0x68, 0x95, 0xf8, 0x15, 0xce, 0x0a,
+	0x9a, 0x3e, 0x83, 0x91, 0x0e, 0xd8, 0x81, 0x88, 0xd0, 0x63, 0x0d, 0xaa, 0x1e, 0xc9, 0x3d, 0xb8,
+	0x34, 0x8a, 0x98, 0xcf, 0x0f, 0xd3, 0x28, 0xe0, 0x99, 0x37, 0xcc, 0xd2, 0xf1, 0x08, 0xdd, 0xd5,
+	0xa2, 0x9d, 0x92, 0xe0, 0x89, 0xc2, 0xc9, 0x3b, 0x50, 0x0f, 0x44, 0xe4, 0xc9, 0xc9, 0x88, 0xa3,
+	0xcb, 0xda, 0x67, 0xd8, 0xde, 0x13, 0xd1, 0xfe, 0x64, 0xc4, 0x69, 0x2d, 0xd0, 0x0f, 0xe4, 0x21,
+	0x5c, 0x11, 0x3c, 0x0b, 0x59, 0x14, 0x7e, 0xcc, 0x03, 0x8f, 0x9f, 0x8c, 0x32, 0x6f, 0x14, 0xb1,
+	0x04, 0x3d, 0xdb, 0xa2, 0x64, 0x2a, 0x7b, 0xff, 0x64, 0x94, 0xed, 0x46, 0x2c, 0x21, 0x1b, 0xd0,
+	0x49, 0xc7, 0x72, 0x34, 0x96, 0x1e, 0x66, 0x9f, 0xf0, 0xc2, 0x00, 0x1d, 0x6d, 0xd3, 0xb6, 0xc6,
+	0xb1, 0xe7, 0x10, 0xfd, 0x40, 0x51, 0x2b, 0x33, 0x76, 0xc4, 0x23, 0xaf, 0x88, 0x00, 0xa7, 0xd9,
+	0xb5, 0x36, 0xaa, 0x74, 0x5d, 0xe3, 0xfb, 0x39, 0x4c, 0x1e, 0xc0, 0xe5, 0xe1, 0x98, 0x65, 0x2c,
+	0x91, 0x9c, 0x97, 0xb4, 0x5b, 0xa8, 0x4d, 0x0a, 0x51, 0x31, 0xc1, 0xfd, 0xb4, 0xe4, 0x7a, 0xe5,
+	0x25, 0x71, 0x01, 0xd7, 0x5f, 0xa4, 0xd7, 0x5f, 0x18, 0x2f, 0xf6, 0xe2, 0x78, 0xb9, 0x0b, 0xcd,
+	0x98, 0xcb, 0x2c, 0xf4, 0xb5, 0x5f, 0x74, 0x1a, 0x83, 0x86, 0x90, 0x7c, 0x02, 0xd5, 0xc3, 0x50,
+	0xea, 0x80, 0x68, 0x51, 0x7c, 0x56, 0x93, 0x44, 0x14, 0xfa, 0x3c, 0xf0, 0x06, 0x51, 0x3a, 0x30,
+	0x7e, 0x00, 0x0d, 0xa9, 0xe8, 0x57, 0xfc, 0x1b, 0x85, 0x64, 0x1c, 0x7b, 0x7e, 0x3a, 0x4e, 0xa4,
+	0x03, 0x18, 0x75, 0x6d, 0x8d, 0x3f, 0x1b, 0xc7, 0xdb, 0x0a, 0x25, 0xaf, 0xc1, 0x9a, 0xd1, 0x4c,
+	0x0f, 0x0e, 0x04, 0x97, 0x48, 0xbe, 0x4d, 0x5b, 0x1a, 0xfc, 0x21, 0x62, 0xe4, 0xbb, 0x70, 0x53,
+	0x70, 0x16, 0xf1, 0xc0, 0x2b, 0x72, 0x5c, 0x78, 0x02, 0x99, 0xe5, 0x81, 0xb3, 0x8a, 0x8e, 0x75,
+	0xb4, 0xc6, 0x5e, 0xa1, 0xb0, 0x67, 0xe4, 0xca, 0x6f, 0x05, 0x0d, 0xa5, 0x69, 0x35, 0x6c, 0xaf,
+	0xc9, 0x54, 0x54, 0x4c, 0x78, 0x17, 0x9c, 0x61, 0x94, 0x0e, 0x58, 0xe4, 0x9d, 0x7a, 0x2b, 0x56,
+	0x6d, 0x9b, 0x5e, 0xd3, 0xf2, 0xbd, 0xb9, 0x57, 0xba, 0x9f, 0x55, 0x60, 0x9d, 0x2a, 0xee, 0xf8,
+	0x11, 0xff, 0xaf, 0x4f, 0xf7, 0xb7, 0xc0, 0x0e, 0x03, 0x81, 0xe9, 0xde, 0xdc, 0x74, 0x66, 0xf7,
+	0x6d, 0xae, 0x61, 0xfa, 0x3d, 0x41, 0x95, 0xd2, 0xc2, 0x84, 0xab, 0x2d, 0x9d, 0x70, 0xf5, 0x17,
+	0x4a, 0xb8, 0xc6, 0x99, 0x09, 0xf7, 0x47, 0xbb, 0x4c, 0xff, 0x97, 0x35, 0xe5, 0x0c, 0xaf, 0xd5,
+	0x65, 0x78, 0x7d, 0x04, 0x4d, 0x43, 0x28, 0x1e, 0x3b, 0x2b, 0x78, 0xec, 0xdc, 0x59, 0x38, 0x07,
+	0x19, 0x56, 0x47, 0x0e, 0xd5, 0x8d, 0x8d, 0x50, 0xcf, 0xe4, 0x7b, 0x70, 0xeb, 0x74, 0xea, 0x64,
+	0x86, 0xa3, 0x3c, 0x77, 0x6e, 0xcc, 0xe7, 0x4e, 0x4e, 0x62, 0x40, 0xbe, 0x01, 0x57, 0x4a, 0xc9,
+	0x33, 0x9d, 0xa8, 0xb3, 0xa7, 0x94, 0x58, 0xd3, 0x29, 0x17, 0x4f, 0x9f, 0x4f, 0x2d, 0x58, 0xeb,
+	0xf1, 0x88, 0xcb, 0x97, 0x48, 0x9e, 0x05, 0x3d, 0x4c, 0x65, 0x61, 0x0f, 0x33, 0xd3, 0x24, 0xd8,
+	0xe7, 0x37, 0x09, 0xd5, 0x53, 0x4d, 0xc2, 0xab, 0xd0, 0x1a, 0x65, 0x61, 0xcc, 0xb2, 0x89, 0xf7,
+	0x11, 0x9f, 0xe4, 0x09, 0xd4, 0x34, 0xd8, 0x53, 0x3e, 0x11, 0x4b, 0x7d, 0x2e, 0x25, 0x70, 0xf3,
+	0x83, 0x94, 0x05, 0x5b, 0x2c, 0x62, 0x89, 0xcf, 0x0d, 0x15, 0xe2, 0xe2, 0xd6, 0xdf, 0x01, 0x28,
+	0xb1, 0x5d, 0xc1, 0x4d, 0x95, 0x10, 0xf7, 0x1f, 0x16, 0x34, 0xd4, 0x0b, 0xb1, 0xfd, 0xbe, 0xc0,
+	0xfa, 0x33, 0x7d, 0x57, 0x65, 0x41, 0xdf, 0x55, 0x74, 0xd0, 0x39, 0xa5, 0x05, 0x50, 0x6e, 0x8d,
+	0xab, 0xb3, 0xad, 0xf1, 0x5d, 0x68, 0x86, 0x6a, 0x43, 0xde, 0x88, 0xc9, 0x43, 0xcd, 0x65, 0x83,
+	0x02, 0x42, 0xbb, 0x0a, 0x51, 0xbd, 0x73, 0xae, 0x80, 0xbd, 0xf3, 0xea, 0xd2, 0xbd, 0xb3, 0x59,
+	0x04, 0x7b, 0xe7, 0x5f, 0xda, 0xe0, 0x18, 0x8a, 0xa7, 0x97, 0x8b, 0x1f, 0x8e, 0x02, 0xbc, 0xe3,
+	0xbc, 0x0d, 0x8d, 0x22, 0x12, 0xcd, 0xdd, 0xde, 0x14, 0x50, 0xbc, 0xee, 0xf0, 0x38, 0xcd, 0x26,
+	0x7b, 0xe1, 0xc7, 0xdc, 0x18, 0x5e, 0x42, 0x94, 0x6d, 0xcf, 0xc6, 0x31, 0x4d, 0x8f, 0x85, 0x29,
+	0xc5, 0xf9, 0x50, 0xd9, 0xe6, 0xe3, 0x17, 0x0f, 0x56, 0x30, 0xb4, 0xbc, 0x4a, 0x41, 0x43, 0xfb,
+	0xa1, 0xfe, 0x08, 0xe7, 0x49, 0xa0, 0xa5, 0x2b, 0x28, 0xad, 0xf1, 0x24, 0x40, 0x51, 0x1f, 0xda,
+	0xe6, 0x52, 0x31, 0x15, 0x58, 0x96, 0x4d, 0x31, 0x76, 0xcf, 0xb8, 0xc9, 0xdd, 0x11, 0xc3, 0x5d,
+	0xa3, 0x49, 0xd7, 0xf4, 0xbd, 0xa2, 0x19, 0x92, 0xf7, 0xa1, 0xa5, 0xde, 0x52, 0x2c, 0x54, 0x5b,
+	0x7a, 0xa1, 0x26, 0x4f, 0x82, 0x62, 0x99, 0xbb, 0xd0, 0x1c, 0x84, 0x49, 0x94, 0x0e, 0x3d, 0xa1,
+	0x88, 0xd0, 0x9d, 0x37, 0x68, 0x08, 0x89, 0x78, 0x13, 0xd6, 0x33, 0x76, 0xec, 0x95, 0x95, 0x1a,
+	0xa8, 0xb4, 0x96, 0xb1, 0xe3, 0xad, 0x42, 0xcf, 0xfd, 0x9d, 0x05, 0x97, 0x4e, 0xf9, 0xe2, 0x02,
+	0x01, 0xf9, 0x14, 0xea, 0x7b, 0x7c, 0xa8, 0x96, 0xc8, 0xef, 0x5c, 0x1f, 0x9c, 0x75, 0x85, 0x7f,
+	0x86, 0xe7, 0x69, 0xb1, 0x80, 0xfb, 0x0b, 0x4b, 0xdd, 0xf5, 0x06, 0xfc, 0x04, 0x87, 0xa7, 0xa2,
+	0xce, 0xba, 0x48, 0xd4, 0xa9, 0xee, 0x55, 0x35, 0x41, 0x19, 0x8f, 0x98, 0x9c, 0x16, 0x43, 0x61,
+	0x82, 0x88, 0x24, 0xe3, 0x98, 0x6a, 0x51, 0x9e, 0xfd, 0xee, 0x6f, 0x2d, 0x00, 0xac, 0xe6, 0x7a,
+	0x1b, 0xf3, 0x75, 0xc4, 0x3a, 0xff, 0xb3, 0xb3, 0x32, 0x9b, 0x5b, 0x5b, 0x79, 0x6e, 0x09, 0xe4,
+	0xc8, 0x5e, 0x64, 0x43, 0xc1, 0xd1, 0xd4, 0x78, 0x93, 0x7e, 0x9a, 0x97, 0x4f, 0x2c, 0x68, 0x95,
+	0xe8, 0x13, 0xb3, 0x65, 0xc0, 0x9a, 0x2f, 0x03, 0xd8, 0x53, 0xaa, 0xd4, 0xd0, 0xfe, 0x37, 0xd9,
+	0x12, 0x4f, 0xb3, 0x45, 0xdd, 0x3b, 0x29, 0x4a, 0x4a, 0xe9, 0x92, 0x98, 0x74, 0xb9, 0x07, 0x97,
+	0x32, 0xee, 0xf3, 0x44, 0x46, 0x13, 0x2f, 0x4e, 0x83, 0xf0, 0x20, 0xe4, 0x01, 0x26, 0x4d, 0x9d,
+	0x76, 0x72, 0xc1, 0x8e, 0xc1, 0x55, 0xd7, 0x11, 0x31, 0x21, 0x3d, 0xe6, 0xfb, 0x5c, 0x88, 0x69,
+	0x0a, 0xd9, 0xb4, 0xad, 0xf0, 0xc7, 0x08, 0xab, 0x4c, 0x72, 0xff, 0x62, 0x41, 0xfb, 0x47, 0x63,
+	0x9e, 0x4d, 0xd4, 0x2f, 0x02, 0x6d, 0xc3, 0x8b, 0xc7, 0xda, 0x7b, 0x68, 0xb5, 0x27, 0x4a, 0xc1,
+	0xf6, 0xda, 0xbf, 0x0e, 0x36, 0x41, 0xeb, 0xc2, 0x04, 0x98, 0x72, 0x86, 0xbe, 0x74, 0x58, 0xc6,
+	0x19, 0xd3, 0x10, 0x30, 0x27, 0xba, 0x76, 0xc6, 0xcf, 0x2d, 0x68, 0x96, 0xf2, 0x53, 0x9d, 0x44,
+	0xe6, 0xd8, 0xd2, 0xa7, 0x9d, 0x85, 0x75, 0xb7, 0xe9, 0x4f, 0xaf, 0x8b, 0xc9, 0x15, 0x58, 0x89,
+	0xc5, 0xd0, 0xc4, 0x46, 0x8b, 0xea, 0x01, 0xb9, 0x09, 0xf5, 0x58, 0x0c, 0xf1, 0xdb, 0xcc, 0x14,
+	0xeb, 0x62, 0xac, 0x1c, 0x3c, 0x6d, 0xb8, 0x74, 0xcd, 0x9a, 0x02, 0xee, 0x1f, 0x2c, 0x20, 0xa6,
+	0x9f, 0x79, 0xa9, 0x7f, 0x0a, 0x18, 0xda, 0xe5, 0x2b, 0xef, 0x0a, 0x56, 0xfe, 0x19, 0x6c, 0xee,
+	0x24, 0xb6, 0x4f, 0x9d, 0xc4, 0xf7, 0xe0, 0x52, 0xc0, 0x0f, 0x98, 0x6a, 0xbd, 0xe6, 0xb7, 0xdc,
+	0x31, 0x82, 0xa2, 0x43, 0x7c, 0xeb, 0x5d, 0x68, 0x14, 0xbf, 0xf2, 0x48, 0x07, 0x5a, 0xea, 0xcf,
+	0x0e, 0x7e, 0x3c, 0x86, 0xc9, 0xb0, 0xf3, 0x15, 0xd2, 0x84, 0xda, 0x0f, 0x38, 0x8b, 0xe4, 0xe1,
+	0xa4, 0x63, 0x91, 0x16, 0xd4, 0x1f, 0x0f, 0x92, 0x34, 0x8b, 0x59, 0xd4, 0xa9, 0x6c, 0xbd, 0xf3,
+	0x93, 0x6f, 0x0d, 0x43, 0x79, 0x38, 0x1e, 0x28, 0x4b, 0x1e, 0x68, 0xd3, 0xbe, 0x1e, 0xa6, 0xe6,
+	0xe9, 0x41, 0xee, 0xb5, 0x07, 0x68, 0x6d, 0x31, 0x1c, 0x0d, 0x06, 0xab, 0x88, 0xbc, 0xfd, 0xcf,
+	0x01, 0x00, 0x31, 0x44, 0x54, 0xac, 0xf0, 0x1c, 0x00, 0x00,
 }
@@ -51,6 +51,56 @@ func (ShowType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{0}
 }
 
+type OperateUserRoleType int32
+
+const (
+	OperateUserRoleType_AddUserToRole      OperateUserRoleType = 0
+	OperateUserRoleType_RemoveUserFromRole OperateUserRoleType = 1
+)
+
+var OperateUserRoleType_name = map[int32]string{
+	0: "AddUserToRole",
+	1: "RemoveUserFromRole",
+}
+
+var OperateUserRoleType_value = map[string]int32{
+	"AddUserToRole":      0,
+	"RemoveUserFromRole": 1,
+}
+
+func (x OperateUserRoleType) String() string {
+	return proto.EnumName(OperateUserRoleType_name, int32(x))
+}
+
+func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{1}
+}
+
+type OperatePrivilegeType int32
+
+const (
+	OperatePrivilegeType_Grant  OperatePrivilegeType = 0
+	OperatePrivilegeType_Revoke OperatePrivilegeType = 1
+)
+
+var OperatePrivilegeType_name = map[int32]string{
+	0: "Grant",
+	1: "Revoke",
+}
+
+var OperatePrivilegeType_value = map[string]int32{
+	"Grant":  0,
+	"Revoke": 1,
+}
+
+func (x OperatePrivilegeType) String() string {
+	return proto.EnumName(OperatePrivilegeType_name, int32(x))
+}
+
+func (OperatePrivilegeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
 type PlaceholderType int32
 
 const (
@@ -76,7 +126,7 @@ func (x PlaceholderType) String() string {
 }
 
 func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{1}
+	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
 type CreateCollectionRequest struct {
@@ -84,11 +134,14 @@ type CreateCollectionRequest struct {
 	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	// `schema` is the serialized `schema.CollectionSchema`
-	Schema               []byte   `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	ShardsNum            int32    `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Schema               []byte                    `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ShardsNum            int32                     `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	NumPartitions        int64                     `protobuf:"varint,7,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
+	DeletionProtection   bool                      `protobuf:"varint,8,opt,name=deletion_protection,json=deletionProtection,proto3" json:"deletion_protection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
@@ -151,6 +204,27 @@ func (m *CreateCollectionRequest) GetShardsNum() int32 {
 	return 0
 }
 
+func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CreateCollectionRequest) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
+func (m *CreateCollectionRequest) GetDeletionProtection() bool {
+	if m != nil {
+		return m.DeletionProtection
+	}
+	return false
+}
+
 type DropCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -442,6 +516,9 @@ type DescribeCollectionResponse struct {
 	PhysicalChannelNames []string                   `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
 	CreatedTimestamp     uint64                     `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
 	CreatedUtcTimestamp  uint64                     `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel  `protobuf:"varint,8,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	DeletionProtection   bool                       `protobuf:"varint,9,opt,name=deletion_protection,json=deletionProtection,proto3" json:"deletion_protection,omitempty"`
+	ShardsNum            int32                      `protobuf:"varint,10,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -521,10 +598,32 @@ func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
 	return 0
 }
 
+func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *DescribeCollectionResponse) GetDeletionProtection() bool {
+	if m != nil {
+		return m.DeletionProtection
+	}
+	return false
+}
+
+func (m *DescribeCollectionResponse) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
 type LoadCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	LoadFields           []string          `protobuf:"bytes,4,rep,name=load_fields,json=loadFields,proto3" json:"load_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -576,6 +675,13 @@ func (m *LoadCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *LoadCollectionRequest) GetLoadFields() []string {
+	if m != nil {
+		return m.LoadFields
+	}
+	return nil
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -883,585 +989,2036 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
-type CreatePartitionRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// Add a new scalar field to an existing collection, the field must be nullable or have a default value
+type AddFieldRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Field                *schemapb.FieldSchema `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
-func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePartitionRequest) ProtoMessage()    {}
-func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
 
-func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
 }
-func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
 }
-func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
 }
-func (m *CreatePartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePartitionRequest.Size(m)
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
 }
-func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
 
-func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreatePartitionRequest) GetDbName() string {
+func (m *AddFieldRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetCollectionName() string {
+func (m *AddFieldRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetPartitionName() string {
+func (m *AddFieldRequest) GetField() *schemapb.FieldSchema {
 	if m != nil {
-		return m.PartitionName
+		return m.Field
 	}
-	return ""
+	return nil
 }
 
-type DropPartitionRequest struct {
+// Rename a collection in its database, the collection id is kept
+type RenameCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	OldName              string            `protobuf:"bytes,3,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string            `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
-func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropPartitionRequest) ProtoMessage()    {}
-func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+func (m *RenameCollectionRequest) Reset()         { *m = RenameCollectionRequest{} }
+func (m *RenameCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameCollectionRequest) ProtoMessage()    {}
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
 
-func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+func (m *RenameCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameCollectionRequest.Unmarshal(m, b)
 }
-func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+func (m *RenameCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+func (m *RenameCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameCollectionRequest.Merge(m, src)
 }
-func (m *DropPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropPartitionRequest.Size(m)
+func (m *RenameCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameCollectionRequest.Size(m)
 }
-func (m *DropPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+func (m *RenameCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_RenameCollectionRequest proto.InternalMessageInfo
 
-func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *RenameCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropPartitionRequest) GetDbName() string {
+func (m *RenameCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetCollectionName() string {
+func (m *RenameCollectionRequest) GetOldName() string {
 	if m != nil {
-		return m.CollectionName
+		return m.OldName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetPartitionName() string {
+func (m *RenameCollectionRequest) GetNewName() string {
 	if m != nil {
-		return m.PartitionName
+		return m.NewName
 	}
 	return ""
 }
 
-type HasPartitionRequest struct {
+type ListDDLHistoryRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	SinceTs              uint64            `protobuf:"varint,4,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
-func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasPartitionRequest) ProtoMessage()    {}
-func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+func (m *ListDDLHistoryRequest) Reset()         { *m = ListDDLHistoryRequest{} }
+func (m *ListDDLHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDDLHistoryRequest) ProtoMessage()    {}
+func (*ListDDLHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
-func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+func (m *ListDDLHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDDLHistoryRequest.Unmarshal(m, b)
 }
-func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+func (m *ListDDLHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDDLHistoryRequest.Marshal(b, m, deterministic)
 }
-func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+func (m *ListDDLHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDDLHistoryRequest.Merge(m, src)
 }
-func (m *HasPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasPartitionRequest.Size(m)
+func (m *ListDDLHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDDLHistoryRequest.Size(m)
 }
-func (m *HasPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+func (m *ListDDLHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDDLHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListDDLHistoryRequest proto.InternalMessageInfo
 
-func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *ListDDLHistoryRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasPartitionRequest) GetDbName() string {
+func (m *ListDDLHistoryRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetCollectionName() string {
+func (m *ListDDLHistoryRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetPartitionName() string {
+func (m *ListDDLHistoryRequest) GetSinceTs() uint64 {
 	if m != nil {
-		return m.PartitionName
+		return m.SinceTs
 	}
-	return ""
+	return 0
 }
 
-type LoadPartitionsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// audit record of a DDL executed by root coordinator
+type DDLHistoryRecord struct {
+	Timestamp            uint64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DdlType              string   `protobuf:"bytes,2,opt,name=ddl_type,json=ddlType,proto3" json:"ddl_type,omitempty"`
+	ProxyID              int64    `protobuf:"varint,3,opt,name=proxyID,proto3" json:"proxyID,omitempty"`
+	Caller               string   `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	DbName               string   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string   `protobuf:"bytes,6,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Request              string   `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
-func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadPartitionsRequest) ProtoMessage()    {}
-func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+func (m *DDLHistoryRecord) Reset()         { *m = DDLHistoryRecord{} }
+func (m *DDLHistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*DDLHistoryRecord) ProtoMessage()    {}
+func (*DDLHistoryRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
-func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+func (m *DDLHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DDLHistoryRecord.Unmarshal(m, b)
 }
-func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+func (m *DDLHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DDLHistoryRecord.Marshal(b, m, deterministic)
 }
-func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+func (m *DDLHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DDLHistoryRecord.Merge(m, src)
 }
-func (m *LoadPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+func (m *DDLHistoryRecord) XXX_Size() int {
+	return xxx_messageInfo_DDLHistoryRecord.Size(m)
 }
-func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+func (m *DDLHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DDLHistoryRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DDLHistoryRecord proto.InternalMessageInfo
 
-func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *DDLHistoryRecord) GetTimestamp() uint64 {
 	if m != nil {
-		return m.Base
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *LoadPartitionsRequest) GetDbName() string {
+func (m *DDLHistoryRecord) GetDdlType() string {
+	if m != nil {
+		return m.DdlType
+	}
+	return ""
+}
+
+func (m *DDLHistoryRecord) GetProxyID() int64 {
+	if m != nil {
+		return m.ProxyID
+	}
+	return 0
+}
+
+func (m *DDLHistoryRecord) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *DDLHistoryRecord) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *LoadPartitionsRequest) GetCollectionName() string {
+func (m *DDLHistoryRecord) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+func (m *DDLHistoryRecord) GetRequest() string {
 	if m != nil {
-		return m.PartitionNames
+		return m.Request
+	}
+	return ""
+}
+
+type ListDDLHistoryResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Records              []*DDLHistoryRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListDDLHistoryResponse) Reset()         { *m = ListDDLHistoryResponse{} }
+func (m *ListDDLHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDDLHistoryResponse) ProtoMessage()    {}
+func (*ListDDLHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{17}
+}
+
+func (m *ListDDLHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDDLHistoryResponse.Unmarshal(m, b)
+}
+func (m *ListDDLHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDDLHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDDLHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDDLHistoryResponse.Merge(m, src)
+}
+func (m *ListDDLHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDDLHistoryResponse.Size(m)
+}
+func (m *ListDDLHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDDLHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDDLHistoryResponse proto.InternalMessageInfo
+
+func (m *ListDDLHistoryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
 	return nil
 }
 
-type ReleasePartitionsRequest struct {
+func (m *ListDDLHistoryResponse) GetRecords() []*DDLHistoryRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type AlterCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	DeletionProtection   bool              `protobuf:"varint,4,opt,name=deletion_protection,json=deletionProtection,proto3" json:"deletion_protection,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
-func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleasePartitionsRequest) ProtoMessage()    {}
-func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{} }
+func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterCollectionRequest) ProtoMessage()    {}
+func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
-func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterCollectionRequest.Unmarshal(m, b)
 }
-func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+func (m *AlterCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+func (m *AlterCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterCollectionRequest.Merge(m, src)
 }
-func (m *ReleasePartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+func (m *AlterCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterCollectionRequest.Size(m)
 }
-func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+func (m *AlterCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AlterCollectionRequest proto.InternalMessageInfo
 
-func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *AlterCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ReleasePartitionsRequest) GetDbName() string {
+func (m *AlterCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ReleasePartitionsRequest) GetCollectionName() string {
+func (m *AlterCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
+func (m *AlterCollectionRequest) GetDeletionProtection() bool {
 	if m != nil {
-		return m.PartitionNames
+		return m.DeletionProtection
 	}
-	return nil
+	return false
 }
 
-type GetPartitionStatisticsRequest struct {
+type ListDroppedCollectionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
-func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
-func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+func (m *ListDroppedCollectionsRequest) Reset()         { *m = ListDroppedCollectionsRequest{} }
+func (m *ListDroppedCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDroppedCollectionsRequest) ProtoMessage()    {}
+func (*ListDroppedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
-func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
+func (m *ListDroppedCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Unmarshal(m, b)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
+func (m *ListDroppedCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
+func (m *ListDroppedCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDroppedCollectionsRequest.Merge(m, src)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
+func (m *ListDroppedCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDroppedCollectionsRequest.Size(m)
 }
-func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
+func (m *ListDroppedCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDroppedCollectionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListDroppedCollectionsRequest proto.InternalMessageInfo
 
-func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
+func (m *ListDroppedCollectionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetDbName() string {
+func (m *ListDroppedCollectionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
+// collections which are soft dropped and can be recovered before they are purged
+type ListDroppedCollectionsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionNames      []string         `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	CollectionIds        []int64          `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	DroppedTimestamps    []uint64         `protobuf:"varint,4,rep,packed,name=dropped_timestamps,json=droppedTimestamps,proto3" json:"dropped_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListDroppedCollectionsResponse) Reset()         { *m = ListDroppedCollectionsResponse{} }
+func (m *ListDroppedCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDroppedCollectionsResponse) ProtoMessage()    {}
+func (*ListDroppedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{20}
+}
+
+func (m *ListDroppedCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Unmarshal(m, b)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDroppedCollectionsResponse.Merge(m, src)
+}
+func (m *ListDroppedCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDroppedCollectionsResponse.Size(m)
+}
+func (m *ListDroppedCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDroppedCollectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDroppedCollectionsResponse proto.InternalMessageInfo
+
+func (m *ListDroppedCollectionsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.CollectionName
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
+func (m *ListDroppedCollectionsResponse) GetCollectionNames() []string {
 	if m != nil {
-		return m.PartitionName
+		return m.CollectionNames
 	}
-	return ""
+	return nil
 }
 
-type GetPartitionStatisticsResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *ListDroppedCollectionsResponse) GetCollectionIds() []int64 {
+	if m != nil {
+		return m.CollectionIds
+	}
+	return nil
 }
 
-func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
-func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
-func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+func (m *ListDroppedCollectionsResponse) GetDroppedTimestamps() []uint64 {
+	if m != nil {
+		return m.DroppedTimestamps
+	}
+	return nil
 }
 
-func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
+type RecoverCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
+
+func (m *RecoverCollectionRequest) Reset()         { *m = RecoverCollectionRequest{} }
+func (m *RecoverCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoverCollectionRequest) ProtoMessage()    {}
+func (*RecoverCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
-func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
+
+func (m *RecoverCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoverCollectionRequest.Unmarshal(m, b)
 }
-func (m *GetPartitionStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
+func (m *RecoverCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoverCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
+func (m *RecoverCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoverCollectionRequest.Merge(m, src)
+}
+func (m *RecoverCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RecoverCollectionRequest.Size(m)
+}
+func (m *RecoverCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoverCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecoverCollectionRequest proto.InternalMessageInfo
 
-func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
+func (m *RecoverCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+func (m *RecoverCollectionRequest) GetDbName() string {
 	if m != nil {
-		return m.Stats
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-type ShowPartitionsRequest struct {
+func (m *RecoverCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ReshardCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	Type                 ShowType          `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	ShardsNum            int32             `protobuf:"varint,4,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
-func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsRequest) ProtoMessage()    {}
-func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+func (m *ReshardCollectionRequest) Reset()         { *m = ReshardCollectionRequest{} }
+func (m *ReshardCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReshardCollectionRequest) ProtoMessage()    {}
+func (*ReshardCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
-func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
+func (m *ReshardCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardCollectionRequest.Unmarshal(m, b)
 }
-func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
+func (m *ReshardCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
+func (m *ReshardCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardCollectionRequest.Merge(m, src)
 }
-func (m *ShowPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
+func (m *ReshardCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReshardCollectionRequest.Size(m)
 }
-func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
+func (m *ReshardCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReshardCollectionRequest proto.InternalMessageInfo
 
-func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *ReshardCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsRequest) GetDbName() string {
+func (m *ReshardCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ShowPartitionsRequest) GetCollectionName() string {
+func (m *ReshardCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *ShowPartitionsRequest) GetCollectionID() int64 {
+func (m *ReshardCollectionRequest) GetShardsNum() int32 {
 	if m != nil {
-		return m.CollectionID
+		return m.ShardsNum
 	}
 	return 0
 }
 
-func (m *ShowPartitionsRequest) GetPartitionNames() []string {
+type CreatePartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
+func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePartitionRequest) ProtoMessage()    {}
+func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{23}
+}
+
+func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
+}
+func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
+}
+func (m *CreatePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePartitionRequest.Size(m)
+}
+func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+
+func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.PartitionNames
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsRequest) GetType() ShowType {
+func (m *CreatePartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.Type
+		return m.DbName
 	}
-	return ShowType_All
+	return ""
 }
 
-type ShowPartitionsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	PartitionNames       []string         `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	PartitionIDs         []int64          `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	CreatedTimestamps    []uint64         `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
-	CreatedUtcTimestamps []uint64         `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	InMemoryPercentages  []int64          `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *CreatePartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
-func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsResponse) ProtoMessage()    {}
-func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+func (m *CreatePartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
 }
 
-func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
+type DropPartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
+
+func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
+func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionRequest) ProtoMessage()    {}
+func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
 }
-func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+
+func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
 }
-func (m *ShowPartitionsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
+func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+}
+func (m *DropPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionRequest.Size(m)
+}
+func (m *DropPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
 
-func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
+func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetPartitionNames() []string {
+func (m *DropPartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.PartitionNames
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+func (m *DropPartitionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.PartitionIDs
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
+func (m *DropPartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.CreatedTimestamps
+		return m.PartitionName
+	}
+	return ""
+}
+
+type HasPartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
+func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasPartitionRequest) ProtoMessage()    {}
+func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{25}
+}
+
+func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+}
+func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+}
+func (m *HasPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasPartitionRequest.Size(m)
+}
+func (m *HasPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+
+func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HasPartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HasPartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HasPartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+type LoadPartitionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	LoadFields           []string          `protobuf:"bytes,5,rep,name=load_fields,json=loadFields,proto3" json:"load_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
+func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadPartitionsRequest) ProtoMessage()    {}
+func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{26}
+}
+
+func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+}
+func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+}
+func (m *LoadPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+}
+func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+
+func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetLoadFields() []string {
+	if m != nil {
+		return m.LoadFields
+	}
+	return nil
+}
+
+type ReleasePartitionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
+func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleasePartitionsRequest) ProtoMessage()    {}
+func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{27}
+}
+
+func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+}
+func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+}
+func (m *ReleasePartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+}
+func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+
+func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReleasePartitionsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ReleasePartitionsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+type GetPartitionStatisticsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
+func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
+func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{28}
+}
+
+func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
+}
+func (m *GetPartitionStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
+}
+func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetPartitionStatisticsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+type GetPartitionStatisticsResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
+func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
+func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{29}
+}
+
+func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
+}
+func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
+}
+func (m *GetPartitionStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
+}
+func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
+
+func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type ShowPartitionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Type                 ShowType          `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	TimeStamp            uint64            `protobuf:"varint,7,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
+func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsRequest) ProtoMessage()    {}
+func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{30}
+}
+
+func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
+}
+func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
+}
+func (m *ShowPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
+}
+func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
+
+func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ShowPartitionsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ShowPartitionsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ShowPartitionsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ShowPartitionsRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ShowPartitionsRequest) GetType() ShowType {
+	if m != nil {
+		return m.Type
+	}
+	return ShowType_All
+}
+
+func (m *ShowPartitionsRequest) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
+}
+
+type ShowPartitionsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PartitionNames       []string         `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	PartitionIDs         []int64          `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	CreatedTimestamps    []uint64         `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	CreatedUtcTimestamps []uint64         `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	InMemoryPercentages  []int64          `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
+func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsResponse) ProtoMessage()    {}
+func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{31}
+}
+
+func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
+}
+func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+}
+func (m *ShowPartitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+}
+func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
+
+func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamps
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamps
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+	if m != nil {
+		return m.InMemoryPercentages
+	}
+	return nil
+}
+
+type CreateDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{32}
+}
+
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
+}
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
+}
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
+}
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
+
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DropDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{33}
+}
+
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
+}
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
+}
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
+
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{34}
+}
+
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListDatabasesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames              []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	CreatedTimestamp     []uint64         `protobuf:"varint,3,rep,packed,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{35}
+}
+
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetDbNames() []string {
+	if m != nil {
+		return m.DbNames
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetCreatedTimestamp() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamp
+	}
+	return nil
+}
+
+type CreateCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string            `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{36}
+}
+
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
+}
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
+}
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
+}
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
+
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CreateCredentialRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type UpdateCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	OldPassword          string            `protobuf:"bytes,3,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword          string            `protobuf:"bytes,4,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
+func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCredentialRequest) ProtoMessage()    {}
+func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{37}
+}
+
+func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
+}
+func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
+}
+func (m *UpdateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
+}
+func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
+
+func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpdateCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UpdateCredentialRequest) GetOldPassword() string {
+	if m != nil {
+		return m.OldPassword
+	}
+	return ""
+}
+
+func (m *UpdateCredentialRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type DeleteCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{38}
+}
+
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
+}
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
+}
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
+}
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
+
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{39}
+}
+
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListUsersResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Usernames            []string         `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{40}
+}
+
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
+}
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
+}
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
+}
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
+
+func (m *ListUsersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListUsersResponse) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
+	}
+	return nil
+}
+
+type CreateRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{41}
+}
+
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
+
+func (m *CreateRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type DropRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{42}
+}
+
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
+}
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
+}
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
+}
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
+
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type OperateUserRoleRequest struct {
+	Base                 *commonpb.MsgBase   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string              `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	RoleName             string              `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Type                 OperateUserRoleType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.OperateUserRoleType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{} }
+func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateUserRoleRequest) ProtoMessage()    {}
+func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{43}
+}
+
+func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateUserRoleRequest.Unmarshal(m, b)
+}
+func (m *OperateUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateUserRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateUserRoleRequest.Merge(m, src)
+}
+func (m *OperateUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateUserRoleRequest.Size(m)
+}
+func (m *OperateUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateUserRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateUserRoleRequest proto.InternalMessageInfo
+
+func (m *OperateUserRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateUserRoleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetType() OperateUserRoleType {
+	if m != nil {
+		return m.Type
+	}
+	return OperateUserRoleType_AddUserToRole
+}
+
+// GrantEntity grants a privilege on an object to a role, object name "*" stands for all objects of the type
+type GrantEntity struct {
+	RoleName             string                   `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	ObjectType           commonpb.ObjectType      `protobuf:"varint,2,opt,name=object_type,json=objectType,proto3,enum=milvus.proto.common.ObjectType" json:"object_type,omitempty"`
+	DbName               string                   `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ObjectName           string                   `protobuf:"bytes,4,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Privilege            commonpb.ObjectPrivilege `protobuf:"varint,5,opt,name=privilege,proto3,enum=milvus.proto.common.ObjectPrivilege" json:"privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{44}
+}
+
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
+}
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
+}
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
+}
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
+}
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
+
+func (m *GrantEntity) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetObjectType() commonpb.ObjectType {
+	if m != nil {
+		return m.ObjectType
+	}
+	return commonpb.ObjectType_Global
+}
+
+func (m *GrantEntity) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetPrivilege() commonpb.ObjectPrivilege {
+	if m != nil {
+		return m.Privilege
+	}
+	return commonpb.ObjectPrivilege_PrivilegeAll
+}
+
+type OperatePrivilegeRequest struct {
+	Base                 *commonpb.MsgBase    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Entity               *GrantEntity         `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
+func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePrivilegeRequest) ProtoMessage()    {}
+func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{45}
+}
+
+func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+}
+func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+}
+func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+}
+func (m *OperatePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+}
+func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+
+func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
+	if m != nil {
+		return m.Type
+	}
+	return OperatePrivilegeType_Grant
+}
+
+type SelectGrantRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
+func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantRequest) ProtoMessage()    {}
+func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{46}
+}
+
+func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantRequest.Unmarshal(m, b)
+}
+func (m *SelectGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantRequest.Merge(m, src)
+}
+func (m *SelectGrantRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantRequest.Size(m)
+}
+func (m *SelectGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantRequest proto.InternalMessageInfo
+
+func (m *SelectGrantRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *SelectGrantRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type SelectGrantResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entities             []*GrantEntity   `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
+func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantResponse) ProtoMessage()    {}
+func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{47}
+}
+
+func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
+}
+func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+}
+func (m *SelectGrantResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantResponse.Size(m)
+}
+func (m *SelectGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+
+func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.Status
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
 	if m != nil {
-		return m.InMemoryPercentages
+		return m.Entities
 	}
 	return nil
 }
@@ -1479,7 +3036,7 @@ func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{}
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -1535,7 +3092,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -1597,7 +3154,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1651,7 +3208,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -1701,7 +3258,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1772,7 +3329,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1842,7 +3399,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -1903,7 +3460,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -1953,7 +3510,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2022,7 +3579,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2079,7 +3636,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +3705,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2205,7 +3762,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2278,7 +3835,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2367,7 +3924,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -2465,7 +4022,7 @@ func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
 func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderValue) ProtoMessage()    {}
 func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
 func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
@@ -2518,7 +4075,7 @@ func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
 func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderGroup) ProtoMessage()    {}
 func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
 
 func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
@@ -2553,22 +4110,25 @@ type SearchRequest struct {
 	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	PlaceholderGroup      []byte                    `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType               commonpb.DslType          `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields          []string                  `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams          []*commonpb.KeyValuePair  `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp       uint64                    `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp    uint64                    `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel      commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	UseDefaultConsistency bool                      `protobuf:"varint,13,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	GracefulTime          uint64                    `protobuf:"varint,14,opt,name=graceful_time,json=gracefulTime,proto3" json:"graceful_time,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
+	XXX_unrecognized      []byte                    `json:"-"`
+	XXX_sizecache         int32                     `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -2666,6 +4226,27 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *SearchRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
+}
+
+func (m *SearchRequest) GetGracefulTime() uint64 {
+	if m != nil {
+		return m.GracefulTime
+	}
+	return 0
+}
+
 type Hits struct {
 	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
@@ -2679,7 +4260,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -2729,174 +4310,438 @@ type SearchResults struct {
 	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *SearchResults) Reset()         { *m = SearchResults{} }
-func (m *SearchResults) String() string { return proto.CompactTextString(m) }
-func (*SearchResults) ProtoMessage()    {}
-func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+func (m *SearchResults) Reset()         { *m = SearchResults{} }
+func (m *SearchResults) String() string { return proto.CompactTextString(m) }
+func (*SearchResults) ProtoMessage()    {}
+func (*SearchResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{67}
+}
+
+func (m *SearchResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+}
+func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+}
+func (m *SearchResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResults.Merge(m, src)
+}
+func (m *SearchResults) XXX_Size() int {
+	return xxx_messageInfo_SearchResults.Size(m)
+}
+func (m *SearchResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+
+func (m *SearchResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type FlushRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
+func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushRequest) ProtoMessage()    {}
+func (*FlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{68}
+}
+
+func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+}
+func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+}
+func (m *FlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushRequest.Merge(m, src)
+}
+func (m *FlushRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushRequest.Size(m)
+}
+func (m *FlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+
+func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *FlushRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *FlushRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
+}
+
+type FlushResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
+func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushResponse) ProtoMessage()    {}
+func (*FlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{69}
+}
+
+func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+}
+func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+}
+func (m *FlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResponse.Merge(m, src)
+}
+func (m *FlushResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushResponse.Size(m)
+}
+func (m *FlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+
+func (m *FlushResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FlushResponse) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+	if m != nil {
+		return m.CollSegIDs
+	}
+	return nil
+}
+
+// Export writes the flushed data of a collection as parquet files, a file per segment with a column per field
+type ExportRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64            `protobuf:"varint,5,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	OutputPath           string            `protobuf:"bytes,6,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{70}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetOutputPath() string {
+	if m != nil {
+		return m.OutputPath
+	}
+	return ""
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SearchResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
-func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
 }
-func (m *SearchResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResults.Merge(m, src)
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
 }
-func (m *SearchResults) XXX_Size() int {
-	return xxx_messageInfo_SearchResults.Size(m)
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
 }
-func (m *SearchResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
 
-func (m *SearchResults) GetStatus() *commonpb.Status {
+func (m *ExportResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+func (m *ExportResponse) GetTaskID() int64 {
 	if m != nil {
-		return m.Results
+		return m.TaskID
 	}
-	return nil
+	return 0
 }
 
-type FlushRequest struct {
+type GetExportStateRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	TaskID               int64             `protobuf:"varint,4,opt,name=taskID,proto3" json:"taskID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
-func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
-func (*FlushRequest) ProtoMessage()    {}
-func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
-func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
 }
-func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
 }
-func (m *FlushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushRequest.Merge(m, src)
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
 }
-func (m *FlushRequest) XXX_Size() int {
-	return xxx_messageInfo_FlushRequest.Size(m)
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
 }
-func (m *FlushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
 
-func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *FlushRequest) GetDbName() string {
+func (m *GetExportStateRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *FlushRequest) GetCollectionNames() []string {
+func (m *GetExportStateRequest) GetCollectionName() string {
 	if m != nil {
-		return m.CollectionNames
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-type FlushResponse struct {
-	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+func (m *GetExportStateRequest) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
 }
 
-func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
-func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
-func (*FlushResponse) ProtoMessage()    {}
-func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+type GetExportStateResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.ExportState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ExportState" json:"state,omitempty"`
+	Files                []string             `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	NumRows              int64                `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	FailReason           string               `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
-func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
 }
-func (m *FlushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushResponse.Merge(m, src)
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
 }
-func (m *FlushResponse) XXX_Size() int {
-	return xxx_messageInfo_FlushResponse.Size(m)
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
 }
-func (m *FlushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
+}
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
 
-func (m *FlushResponse) GetStatus() *commonpb.Status {
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *FlushResponse) GetDbName() string {
+func (m *GetExportStateResponse) GetState() commonpb.ExportState {
 	if m != nil {
-		return m.DbName
+		return m.State
 	}
-	return ""
+	return commonpb.ExportState_ExportStateNone
 }
 
-func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+func (m *GetExportStateResponse) GetFiles() []string {
 	if m != nil {
-		return m.CollSegIDs
+		return m.Files
 	}
 	return nil
 }
 
+func (m *GetExportStateResponse) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
 type QueryRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base                  *commonpb.MsgBase         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName                string                    `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName        string                    `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                  string                    `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields          []string                  `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames        []string                  `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp       uint64                    `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp    uint64                    `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel      commonpb.ConsistencyLevel `protobuf:"varint,9,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	UseDefaultConsistency bool                      `protobuf:"varint,10,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	GracefulTime          uint64                    `protobuf:"varint,11,opt,name=graceful_time,json=gracefulTime,proto3" json:"graceful_time,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
+	XXX_unrecognized      []byte                    `json:"-"`
+	XXX_sizecache         int32                     `json:"-"`
 }
 
 func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -2973,6 +4818,27 @@ func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *QueryRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *QueryRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
+}
+
+func (m *QueryRequest) GetGracefulTime() uint64 {
+	if m != nil {
+		return m.GracefulTime
+	}
+	return 0
+}
+
 type QueryResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
@@ -2985,7 +4851,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3034,7 +4900,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3097,7 +4963,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3168,6 +5034,7 @@ type CalcDistanceRequest struct {
 	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
 	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
 	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	DbName               string                   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -3177,7 +5044,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3226,6 +5093,13 @@ func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *CalcDistanceRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
 type CalcDistanceResults struct {
 	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
@@ -3243,7 +5117,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3331,7 +5205,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{80}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3400,7 +5274,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+	return fileDescriptor_02345ba45cc0e303, []int{81}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3454,7 +5328,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3506,7 +5380,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3589,7 +5463,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3643,7 +5517,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{85}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3689,7 +5563,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{86}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -3728,7 +5602,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{87}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -3766,7 +5640,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{88}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -3799,7 +5673,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{89}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -3846,7 +5720,7 @@ func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
 func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsRequest) ProtoMessage()    {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{90}
 }
 
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -3894,7 +5768,7 @@ func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
 func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsResponse) ProtoMessage()    {}
 func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{91}
 }
 
 func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -3938,6 +5812,8 @@ func (m *GetMetricsResponse) GetComponentName() string {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperatePrivilegeType", OperatePrivilegeType_name, OperatePrivilegeType_value)
 	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
@@ -3952,6 +5828,16 @@ func init() {
 	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.milvus.AddFieldRequest")
+	proto.RegisterType((*RenameCollectionRequest)(nil), "milvus.proto.milvus.RenameCollectionRequest")
+	proto.RegisterType((*ListDDLHistoryRequest)(nil), "milvus.proto.milvus.ListDDLHistoryRequest")
+	proto.RegisterType((*DDLHistoryRecord)(nil), "milvus.proto.milvus.DDLHistoryRecord")
+	proto.RegisterType((*ListDDLHistoryResponse)(nil), "milvus.proto.milvus.ListDDLHistoryResponse")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.milvus.AlterCollectionRequest")
+	proto.RegisterType((*ListDroppedCollectionsRequest)(nil), "milvus.proto.milvus.ListDroppedCollectionsRequest")
+	proto.RegisterType((*ListDroppedCollectionsResponse)(nil), "milvus.proto.milvus.ListDroppedCollectionsResponse")
+	proto.RegisterType((*RecoverCollectionRequest)(nil), "milvus.proto.milvus.RecoverCollectionRequest")
+	proto.RegisterType((*ReshardCollectionRequest)(nil), "milvus.proto.milvus.ReshardCollectionRequest")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
 	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
@@ -3961,6 +5847,22 @@ func init() {
 	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
 	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
 	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
+	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
+	proto.RegisterType((*UpdateCredentialRequest)(nil), "milvus.proto.milvus.UpdateCredentialRequest")
+	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "milvus.proto.milvus.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "milvus.proto.milvus.ListUsersResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "milvus.proto.milvus.CreateRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*OperateUserRoleRequest)(nil), "milvus.proto.milvus.OperateUserRoleRequest")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*OperatePrivilegeRequest)(nil), "milvus.proto.milvus.OperatePrivilegeRequest")
+	proto.RegisterType((*SelectGrantRequest)(nil), "milvus.proto.milvus.SelectGrantRequest")
+	proto.RegisterType((*SelectGrantResponse)(nil), "milvus.proto.milvus.SelectGrantResponse")
 	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
 	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
 	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
@@ -3984,6 +5886,10 @@ func init() {
 	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
 	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.milvus.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.milvus.ExportResponse")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.milvus.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.milvus.GetExportStateResponse")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
 	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
 	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
@@ -4007,195 +5913,275 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 2993 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x73, 0x1c, 0x47,
-	0xf5, 0x9a, 0x5d, 0xed, 0xd7, 0xdb, 0x59, 0x69, 0xdd, 0xfa, 0xf0, 0x66, 0x63, 0xc7, 0xd2, 0xe4,
-	0xe7, 0x44, 0xb6, 0x13, 0x3b, 0x96, 0x93, 0x5f, 0x42, 0x02, 0x24, 0xb6, 0x45, 0x6c, 0x55, 0xec,
-	0xa0, 0x8c, 0x92, 0x54, 0x85, 0x54, 0x6a, 0x6a, 0xb4, 0xd3, 0x5a, 0x4d, 0x69, 0x76, 0x66, 0x99,
-	0xee, 0xb5, 0xbc, 0x39, 0x41, 0x05, 0xa8, 0xa2, 0x12, 0x92, 0xa2, 0xa0, 0xa0, 0xb8, 0x02, 0x39,
-	0x70, 0x23, 0x40, 0x15, 0x14, 0x27, 0x0e, 0x39, 0x70, 0xa0, 0x8a, 0x8f, 0xbf, 0x80, 0x0b, 0xc7,
-	0xfc, 0x07, 0x54, 0x41, 0xf5, 0xc7, 0xcc, 0xce, 0xac, 0x7a, 0x56, 0x2b, 0x6f, 0x82, 0xa4, 0xdb,
-	0xcc, 0xeb, 0xf7, 0xba, 0x5f, 0xbf, 0x7e, 0xfd, 0x5e, 0xbf, 0x0f, 0xd0, 0x3b, 0xae, 0x77, 0xaf,
-	0x47, 0x2e, 0x77, 0xc3, 0x80, 0x06, 0x68, 0x2e, 0xf9, 0x77, 0x59, 0xfc, 0x34, 0xf5, 0x56, 0xd0,
-	0xe9, 0x04, 0xbe, 0x00, 0x36, 0x75, 0xd2, 0xda, 0xc1, 0x1d, 0x5b, 0xfc, 0x19, 0x9f, 0x6a, 0x70,
-	0xfa, 0x66, 0x88, 0x6d, 0x8a, 0x6f, 0x06, 0x9e, 0x87, 0x5b, 0xd4, 0x0d, 0x7c, 0x13, 0x7f, 0xb3,
-	0x87, 0x09, 0x45, 0x4f, 0xc1, 0xf4, 0x96, 0x4d, 0x70, 0x43, 0x5b, 0xd2, 0x56, 0xaa, 0xab, 0x67,
-	0x2e, 0xa7, 0xe6, 0x96, 0x73, 0xde, 0x25, 0xed, 0x1b, 0x36, 0xc1, 0x26, 0xc7, 0x44, 0xa7, 0xa1,
-	0xe4, 0x6c, 0x59, 0xbe, 0xdd, 0xc1, 0x8d, 0xdc, 0x92, 0xb6, 0x52, 0x31, 0x8b, 0xce, 0xd6, 0xab,
-	0x76, 0x07, 0xa3, 0xc7, 0x61, 0xb6, 0x15, 0xcf, 0x2f, 0x10, 0xf2, 0x1c, 0x61, 0x66, 0x00, 0xe6,
-	0x88, 0x8b, 0x50, 0x14, 0xfc, 0x35, 0xa6, 0x97, 0xb4, 0x15, 0xdd, 0x94, 0x7f, 0xe8, 0x2c, 0x00,
-	0xd9, 0xb1, 0x43, 0x87, 0x58, 0x7e, 0xaf, 0xd3, 0x28, 0x2c, 0x69, 0x2b, 0x05, 0xb3, 0x22, 0x20,
-	0xaf, 0xf6, 0x3a, 0xc6, 0xfb, 0x1a, 0x2c, 0xac, 0x85, 0x41, 0xf7, 0x58, 0x6c, 0xc2, 0xf8, 0x95,
-	0x06, 0xf3, 0xb7, 0x6d, 0x72, 0x3c, 0x24, 0x7a, 0x16, 0x80, 0xba, 0x1d, 0x6c, 0x11, 0x6a, 0x77,
-	0xba, 0x5c, 0xaa, 0xd3, 0x66, 0x85, 0x41, 0x36, 0x19, 0xc0, 0x78, 0x0b, 0xf4, 0x1b, 0x41, 0xe0,
-	0x99, 0x98, 0x74, 0x03, 0x9f, 0x60, 0x74, 0x0d, 0x8a, 0x84, 0xda, 0xb4, 0x47, 0x24, 0x93, 0x0f,
-	0x2b, 0x99, 0xdc, 0xe4, 0x28, 0xa6, 0x44, 0x45, 0xf3, 0x50, 0xb8, 0x67, 0x7b, 0x3d, 0xc1, 0x63,
-	0xd9, 0x14, 0x3f, 0xc6, 0xdb, 0x30, 0xb3, 0x49, 0x43, 0xd7, 0x6f, 0x7f, 0x8e, 0x93, 0x57, 0xa2,
-	0xc9, 0xff, 0xa1, 0xc1, 0x43, 0x6b, 0x98, 0xb4, 0x42, 0x77, 0xeb, 0x98, 0xa8, 0xae, 0x01, 0xfa,
-	0x00, 0xb2, 0xbe, 0xc6, 0x45, 0x9d, 0x37, 0x53, 0xb0, 0xa1, 0xc3, 0x28, 0x0c, 0x1f, 0xc6, 0x7f,
-	0x72, 0xd0, 0x54, 0x6d, 0x6a, 0x12, 0xf1, 0x7d, 0x25, 0xbe, 0x51, 0x39, 0x4e, 0x74, 0x3e, 0x4d,
-	0x24, 0xad, 0xc1, 0x60, 0xb5, 0x4d, 0x0e, 0x88, 0x2f, 0xde, 0xf0, 0xae, 0xf2, 0x8a, 0x5d, 0xad,
-	0xc2, 0xc2, 0x3d, 0x37, 0xa4, 0x3d, 0xdb, 0xb3, 0x5a, 0x3b, 0xb6, 0xef, 0x63, 0x8f, 0xcb, 0x89,
-	0x34, 0xa6, 0x97, 0xf2, 0x2b, 0x15, 0x73, 0x4e, 0x0e, 0xde, 0x14, 0x63, 0x4c, 0x58, 0x04, 0x3d,
-	0x0d, 0x8b, 0xdd, 0x9d, 0x3e, 0x71, 0x5b, 0xfb, 0x88, 0x0a, 0x9c, 0x68, 0x3e, 0x1a, 0x4d, 0x51,
-	0x5d, 0x82, 0x53, 0x2d, 0x6e, 0xad, 0x1c, 0x8b, 0x49, 0x4d, 0x88, 0xb1, 0xc8, 0xc5, 0x58, 0x97,
-	0x03, 0xaf, 0x47, 0x70, 0xc6, 0x56, 0x84, 0xdc, 0xa3, 0xad, 0x04, 0x41, 0x89, 0x13, 0xcc, 0xc9,
-	0xc1, 0x37, 0x68, 0x2b, 0xa6, 0xe1, 0x86, 0xe4, 0x4e, 0x60, 0x3b, 0xc7, 0xc3, 0x90, 0x7c, 0xa8,
-	0x41, 0xc3, 0xc4, 0x1e, 0xb6, 0xc9, 0xf1, 0xd0, 0x71, 0xe3, 0xc7, 0x1a, 0x3c, 0x72, 0x0b, 0xd3,
-	0x84, 0xb6, 0x50, 0x9b, 0xba, 0x84, 0xba, 0x2d, 0x72, 0x94, 0x6c, 0x7d, 0xa4, 0xc1, 0xb9, 0x4c,
-	0xb6, 0x26, 0xb9, 0x3c, 0xcf, 0x42, 0x81, 0x7d, 0x91, 0x46, 0x6e, 0x29, 0xbf, 0x52, 0x5d, 0x5d,
-	0x56, 0xd2, 0xbc, 0x82, 0xfb, 0x6f, 0x32, 0x9b, 0xb4, 0x61, 0xbb, 0xa1, 0x29, 0xf0, 0x8d, 0x7f,
-	0x6a, 0xb0, 0xb8, 0xb9, 0x13, 0xec, 0x0d, 0x58, 0xfa, 0x22, 0x04, 0x94, 0x36, 0x27, 0xf9, 0x21,
-	0x73, 0x82, 0xae, 0xc2, 0x34, 0xed, 0x77, 0x31, 0xb7, 0x44, 0x33, 0xab, 0x67, 0x2f, 0x2b, 0x1e,
-	0x07, 0x97, 0x19, 0x93, 0xaf, 0xf7, 0xbb, 0xd8, 0xe4, 0xa8, 0xe8, 0x02, 0xd4, 0x87, 0x44, 0x1e,
-	0x5d, 0xc8, 0xd9, 0xb4, 0xcc, 0x89, 0xf1, 0x87, 0x1c, 0x9c, 0xde, 0xb7, 0xc5, 0x49, 0x84, 0xad,
-	0x5a, 0x3b, 0xa7, 0x5c, 0x1b, 0x9d, 0x87, 0x84, 0x0a, 0x58, 0xae, 0x43, 0x1a, 0xf9, 0xa5, 0xfc,
-	0x4a, 0xde, 0xac, 0x25, 0xec, 0x92, 0x43, 0xd0, 0x93, 0x80, 0xf6, 0x99, 0x0b, 0x61, 0x95, 0xa6,
-	0xcd, 0x53, 0xc3, 0xf6, 0x82, 0xdb, 0x24, 0xa5, 0xc1, 0x10, 0x22, 0x98, 0x36, 0xe7, 0x15, 0x16,
-	0x83, 0xa0, 0xab, 0x30, 0xef, 0xfa, 0x77, 0x71, 0x27, 0x08, 0xfb, 0x56, 0x17, 0x87, 0x2d, 0xec,
-	0x53, 0xbb, 0x8d, 0x49, 0xa3, 0xc8, 0x39, 0x9a, 0x8b, 0xc6, 0x36, 0x06, 0x43, 0xc6, 0x6f, 0x35,
-	0x58, 0x14, 0xaf, 0xae, 0x0d, 0x3b, 0xa4, 0xee, 0x51, 0x7b, 0xae, 0xf3, 0x30, 0xd3, 0x8d, 0xf8,
-	0x10, 0x78, 0xd3, 0x1c, 0xaf, 0x16, 0x43, 0xf9, 0x2d, 0xfb, 0x44, 0x83, 0x79, 0xf6, 0xc8, 0x3a,
-	0x49, 0x3c, 0xff, 0x5a, 0x83, 0xb9, 0xdb, 0x36, 0x39, 0x49, 0x2c, 0xff, 0x4e, 0xba, 0xa0, 0x98,
-	0xe7, 0xa3, 0x34, 0xad, 0x0c, 0x31, 0xcd, 0x74, 0xe4, 0xd5, 0x67, 0x52, 0x5c, 0x13, 0xe3, 0xf7,
-	0x03, 0x5f, 0x75, 0xc2, 0x38, 0xff, 0xa3, 0x06, 0x67, 0x6f, 0x61, 0x1a, 0x73, 0x7d, 0x2c, 0x7c,
-	0xda, 0xb8, 0xda, 0xf2, 0xa1, 0xf0, 0xc8, 0x4a, 0xe6, 0x8f, 0xc4, 0xf3, 0xbd, 0x9f, 0x83, 0x05,
-	0xe6, 0x16, 0x8e, 0x87, 0x12, 0x8c, 0xf3, 0x28, 0x57, 0x28, 0x4a, 0x41, 0xa5, 0x28, 0xb1, 0x3f,
-	0x2d, 0x8e, 0xed, 0x4f, 0x8d, 0xdf, 0xe4, 0xc4, 0x3b, 0x20, 0x29, 0x8d, 0x49, 0x8e, 0x45, 0xc1,
-	0x6b, 0x4e, 0xc9, 0xab, 0x01, 0x7a, 0x0c, 0x59, 0x5f, 0x8b, 0xfc, 0x63, 0x0a, 0x76, 0x6c, 0xdd,
-	0xe3, 0x07, 0x1a, 0x2c, 0x46, 0x61, 0xd0, 0x26, 0x6e, 0x77, 0xb0, 0x4f, 0x1f, 0x5c, 0x87, 0x86,
-	0x35, 0x20, 0xa7, 0xd0, 0x80, 0x33, 0x50, 0x21, 0x62, 0x9d, 0x38, 0xc2, 0x19, 0x00, 0x8c, 0x8f,
-	0x35, 0x38, 0xbd, 0x8f, 0x9d, 0x49, 0x0e, 0xb1, 0x01, 0x25, 0xd7, 0x77, 0xf0, 0xfd, 0x98, 0x9b,
-	0xe8, 0x97, 0x8d, 0x6c, 0xf5, 0x5c, 0xcf, 0x89, 0xd9, 0x88, 0x7e, 0xd1, 0x32, 0xe8, 0xd8, 0xb7,
-	0xb7, 0x3c, 0x6c, 0x71, 0x5c, 0xae, 0xc8, 0x65, 0xb3, 0x2a, 0x60, 0xeb, 0x0c, 0x64, 0xfc, 0x40,
-	0x83, 0x39, 0xa6, 0x6b, 0x92, 0x47, 0xf2, 0xc5, 0xca, 0x6c, 0x09, 0xaa, 0x09, 0x65, 0x92, 0xec,
-	0x26, 0x41, 0xc6, 0x2e, 0xcc, 0xa7, 0xd9, 0x99, 0x44, 0x66, 0x8f, 0x00, 0xc4, 0x27, 0x22, 0x74,
-	0x3e, 0x6f, 0x26, 0x20, 0xc6, 0x67, 0x1a, 0x20, 0xf1, 0xa4, 0xe2, 0xc2, 0x38, 0xe2, 0x8c, 0xcb,
-	0xb6, 0x8b, 0x3d, 0x27, 0x69, 0xb5, 0x2b, 0x1c, 0xc2, 0x87, 0xd7, 0x40, 0xc7, 0xf7, 0x69, 0x68,
-	0x5b, 0x5d, 0x3b, 0xb4, 0x3b, 0xe2, 0xf2, 0x8c, 0x65, 0x60, 0xab, 0x9c, 0x6c, 0x83, 0x53, 0x19,
-	0x7f, 0x66, 0x8f, 0x31, 0xa9, 0x94, 0xc7, 0x7d, 0xc7, 0x67, 0x01, 0xb8, 0xd2, 0x8a, 0xe1, 0x82,
-	0x18, 0xe6, 0x10, 0xee, 0xc2, 0x3e, 0xd6, 0xa0, 0xce, 0xb7, 0x20, 0xf6, 0xd3, 0x65, 0xd3, 0x0e,
-	0xd1, 0x68, 0x43, 0x34, 0x23, 0xae, 0xd0, 0x97, 0xa0, 0x28, 0x05, 0x9b, 0x1f, 0x57, 0xb0, 0x92,
-	0xe0, 0x80, 0x6d, 0x18, 0x3f, 0xd7, 0x60, 0x61, 0x48, 0xe4, 0x93, 0x68, 0xf4, 0xeb, 0x80, 0xc4,
-	0x0e, 0x9d, 0xc1, 0xb6, 0x23, 0x77, 0x7b, 0x5e, 0xe9, 0x5b, 0x86, 0x85, 0x64, 0x9e, 0x72, 0x87,
-	0x20, 0xc4, 0xf8, 0x9b, 0x06, 0x67, 0x6e, 0x61, 0xca, 0x51, 0x6f, 0x30, 0xdb, 0xb1, 0x11, 0x06,
-	0xed, 0x10, 0x13, 0x72, 0x72, 0xf5, 0xe3, 0x27, 0xe2, 0x7d, 0xa6, 0xda, 0xd2, 0x24, 0xf2, 0x5f,
-	0x06, 0x9d, 0xaf, 0x81, 0x1d, 0x2b, 0x0c, 0xf6, 0x88, 0xd4, 0xa3, 0xaa, 0x84, 0x99, 0xc1, 0x1e,
-	0x57, 0x08, 0x1a, 0x50, 0xdb, 0x13, 0x08, 0xd2, 0x31, 0x70, 0x08, 0x1b, 0xe6, 0x77, 0x30, 0x62,
-	0x8c, 0x4d, 0x8e, 0x4f, 0xae, 0x8c, 0x7f, 0xa9, 0xc1, 0xc2, 0xd0, 0x56, 0x26, 0x91, 0xed, 0x33,
-	0xe2, 0xf5, 0x28, 0x36, 0x33, 0xb3, 0x7a, 0x4e, 0x49, 0x93, 0x58, 0x4c, 0x60, 0xa3, 0x73, 0x50,
-	0xdd, 0xb6, 0x5d, 0xcf, 0x0a, 0xb1, 0x4d, 0x02, 0x5f, 0x6e, 0x14, 0x18, 0xc8, 0xe4, 0x10, 0xe3,
-	0x53, 0x0d, 0xea, 0x2c, 0x04, 0x3d, 0xe1, 0x16, 0xef, 0x17, 0x39, 0xa8, 0xad, 0xfb, 0x04, 0x87,
-	0xf4, 0xf8, 0x47, 0x18, 0xe8, 0x45, 0xa8, 0xf2, 0x8d, 0x11, 0xcb, 0xb1, 0xa9, 0x2d, 0xdd, 0xd5,
-	0x23, 0xca, 0x2c, 0xf2, 0xcb, 0x0c, 0x6f, 0xcd, 0xa6, 0xb6, 0x29, 0xa4, 0x43, 0xd8, 0x37, 0x7a,
-	0x18, 0x2a, 0x3b, 0x36, 0xd9, 0xb1, 0x76, 0x71, 0x5f, 0x3c, 0xfb, 0x6a, 0x66, 0x99, 0x01, 0x5e,
-	0xc1, 0x7d, 0x82, 0x1e, 0x82, 0xb2, 0xdf, 0xeb, 0x88, 0x0b, 0x56, 0x5a, 0xd2, 0x56, 0x6a, 0x66,
-	0xc9, 0xef, 0x75, 0xf8, 0xf5, 0xfa, 0x4b, 0x0e, 0x66, 0xee, 0xf6, 0x58, 0x3c, 0xc3, 0x73, 0xe0,
-	0x3d, 0x8f, 0x3e, 0x98, 0x32, 0x5e, 0x84, 0xbc, 0x78, 0x33, 0x30, 0x8a, 0x86, 0x92, 0xf1, 0xf5,
-	0x35, 0x62, 0x32, 0x24, 0x5e, 0x67, 0xea, 0xb5, 0x5a, 0xf2, 0x91, 0x95, 0xe7, 0xcc, 0x56, 0x18,
-	0x84, 0x6b, 0x1c, 0xdb, 0x0a, 0x0e, 0xc3, 0xf8, 0x09, 0xc6, 0xb7, 0x82, 0xc3, 0x50, 0x0c, 0x1a,
-	0xa0, 0xdb, 0xad, 0x5d, 0x3f, 0xd8, 0xf3, 0xb0, 0xd3, 0xc6, 0x0e, 0x3f, 0xf6, 0xb2, 0x99, 0x82,
-	0x09, 0xc5, 0x60, 0x07, 0x6f, 0xb5, 0x7c, 0xca, 0x03, 0x89, 0x3c, 0x53, 0x0c, 0x06, 0xb9, 0xe9,
-	0x53, 0x36, 0xec, 0x60, 0x0f, 0x53, 0xcc, 0x87, 0x4b, 0x62, 0x58, 0x40, 0xe4, 0x70, 0xaf, 0x1b,
-	0x53, 0x97, 0xc5, 0xb0, 0x80, 0xb0, 0xe1, 0x33, 0x50, 0x19, 0x24, 0xb9, 0x2b, 0x83, 0x6c, 0xa0,
-	0x48, 0x6d, 0xdf, 0x83, 0xfa, 0x86, 0x67, 0xb7, 0xf0, 0x4e, 0xe0, 0x39, 0x38, 0xe4, 0xde, 0x0f,
-	0xd5, 0x21, 0x4f, 0xed, 0xb6, 0x74, 0xaf, 0xec, 0x13, 0x3d, 0x27, 0x63, 0x1c, 0x71, 0x71, 0xff,
-	0x4f, 0xe9, 0x87, 0x12, 0xd3, 0x24, 0x52, 0x87, 0x8b, 0x50, 0xe4, 0xa5, 0x19, 0xe1, 0x78, 0x75,
-	0x53, 0xfe, 0x19, 0xef, 0xa4, 0xd6, 0xbd, 0x15, 0x06, 0xbd, 0x2e, 0x5a, 0x07, 0xbd, 0x3b, 0x80,
-	0xb1, 0xd3, 0xcc, 0xf6, 0x7a, 0xc3, 0x4c, 0x9b, 0x29, 0x52, 0xe3, 0xb3, 0x3c, 0xd4, 0x36, 0xb1,
-	0x1d, 0xb6, 0x76, 0x4e, 0x42, 0xb2, 0x81, 0x49, 0xdc, 0x21, 0x9e, 0x34, 0x09, 0xec, 0x13, 0x5d,
-	0x82, 0x53, 0x89, 0x0d, 0x59, 0x6d, 0x26, 0x20, 0xae, 0x19, 0xba, 0x59, 0xef, 0x0e, 0x0b, 0xee,
-	0x59, 0x28, 0x3b, 0xc4, 0xb3, 0xf8, 0x11, 0x95, 0xf8, 0x11, 0xa9, 0xf7, 0xb7, 0x46, 0x3c, 0x7e,
-	0x34, 0x25, 0x47, 0x7c, 0xa0, 0x47, 0xa1, 0x16, 0xf4, 0x68, 0xb7, 0x47, 0x2d, 0x71, 0x33, 0x1b,
-	0x65, 0xce, 0x9e, 0x2e, 0x80, 0xfc, 0xe2, 0x12, 0xf4, 0x32, 0xd4, 0x08, 0x17, 0x65, 0xf4, 0x36,
-	0xad, 0x8c, 0xfb, 0x84, 0xd2, 0x05, 0x9d, 0x78, 0x9c, 0xa2, 0x0b, 0x50, 0xa7, 0xa1, 0x7d, 0x0f,
-	0x7b, 0x89, 0xa2, 0x0b, 0x70, 0x7d, 0x9c, 0x15, 0xf0, 0x41, 0x91, 0xe6, 0x0a, 0xcc, 0xb5, 0x7b,
-	0x76, 0x68, 0xfb, 0x14, 0xe3, 0x04, 0x76, 0x95, 0x63, 0xa3, 0x78, 0x68, 0x50, 0xa1, 0x79, 0x05,
-	0xa6, 0x6f, 0xbb, 0x94, 0x0b, 0x92, 0xdd, 0x6a, 0x8d, 0x47, 0x02, 0xfc, 0xee, 0x3e, 0x04, 0xe5,
-	0x30, 0xd8, 0x13, 0x56, 0x2a, 0xc7, 0x55, 0xb0, 0x14, 0x06, 0x7b, 0xdc, 0x04, 0xf1, 0xb2, 0x72,
-	0x10, 0x4a, 0xdd, 0xcc, 0x99, 0xf2, 0xcf, 0xf8, 0xae, 0x36, 0x50, 0x1e, 0x66, 0x60, 0xc8, 0x83,
-	0x59, 0x98, 0x17, 0xa1, 0x14, 0x0a, 0xfa, 0x91, 0x45, 0xb6, 0xe4, 0x4a, 0xdc, 0x4a, 0x46, 0x54,
-	0xc6, 0x77, 0x34, 0xd0, 0x5f, 0xf6, 0x7a, 0xe4, 0x8b, 0xd0, 0x61, 0x55, 0x5a, 0x3d, 0xaf, 0x4e,
-	0xe9, 0xff, 0x30, 0x07, 0x35, 0xc9, 0xc6, 0x24, 0xde, 0x3f, 0x93, 0x95, 0x4d, 0xa8, 0xb2, 0x25,
-	0x2d, 0x82, 0xdb, 0x51, 0x4e, 0xa2, 0xba, 0xba, 0xaa, 0xbc, 0xf5, 0x29, 0x36, 0x78, 0x79, 0x72,
-	0x93, 0x13, 0x7d, 0xcd, 0xa7, 0x61, 0xdf, 0x84, 0x56, 0x0c, 0x68, 0xbe, 0x03, 0xb3, 0x43, 0xc3,
-	0x4c, 0x37, 0x76, 0x71, 0x3f, 0x32, 0x6b, 0xbb, 0xb8, 0x8f, 0x9e, 0x4e, 0x16, 0x91, 0xb3, 0xdc,
-	0xd7, 0x9d, 0xc0, 0x6f, 0x5f, 0x0f, 0x43, 0xbb, 0x2f, 0x8b, 0xcc, 0xcf, 0xe7, 0x9e, 0xd3, 0x8c,
-	0x3f, 0xe5, 0x40, 0x7f, 0xad, 0x87, 0xc3, 0xfe, 0x51, 0x9a, 0x17, 0x04, 0xd3, 0xf8, 0x7e, 0x37,
-	0x94, 0x0e, 0x9a, 0x7f, 0xef, 0xbf, 0xd1, 0x05, 0xc5, 0x8d, 0x56, 0xd8, 0xa5, 0xa2, 0xd2, 0x2e,
-	0xa9, 0xae, 0x6c, 0xe9, 0x50, 0x57, 0xb6, 0x9c, 0x79, 0x65, 0x99, 0x76, 0x4b, 0x11, 0x4e, 0x74,
-	0xc9, 0x52, 0xef, 0x90, 0xdc, 0x61, 0xdf, 0x21, 0xc6, 0x27, 0x1a, 0x54, 0xde, 0xc4, 0x2d, 0x1a,
-	0x84, 0xcc, 0x5a, 0x28, 0x64, 0xaf, 0x8d, 0xf1, 0xd4, 0xcb, 0x0d, 0x3f, 0xf5, 0xae, 0x41, 0xd9,
-	0x75, 0x2c, 0x9b, 0xa9, 0x0d, 0x3f, 0xbc, 0x51, 0x4f, 0x8c, 0x92, 0xeb, 0x70, 0xfd, 0x1a, 0x3f,
-	0x37, 0xfd, 0x53, 0x0d, 0x74, 0xc1, 0x33, 0x11, 0x94, 0x2f, 0x24, 0x96, 0xd3, 0x54, 0xba, 0x2c,
-	0x7f, 0xe2, 0x8d, 0xde, 0x9e, 0x1a, 0x2c, 0x7b, 0x1d, 0x80, 0xc9, 0x4e, 0x92, 0x8b, 0xab, 0xb0,
-	0xa4, 0xe4, 0x56, 0x90, 0x73, 0x39, 0xde, 0x9e, 0x32, 0x2b, 0x8c, 0x8a, 0x4f, 0x71, 0xa3, 0x04,
-	0x05, 0x4e, 0x6d, 0xfc, 0x5b, 0x83, 0xb9, 0x9b, 0xb6, 0xd7, 0x5a, 0x73, 0x09, 0xb5, 0xfd, 0xd6,
-	0x04, 0xb1, 0xcf, 0xf3, 0x50, 0x0a, 0xba, 0x96, 0x87, 0xb7, 0xa9, 0x64, 0x69, 0x79, 0xc4, 0x8e,
-	0x84, 0x18, 0xcc, 0x62, 0xd0, 0xbd, 0x83, 0xb7, 0x29, 0xfa, 0x32, 0x94, 0x83, 0xae, 0x15, 0xba,
-	0xed, 0x1d, 0x2a, 0xa5, 0x3f, 0x06, 0x71, 0x29, 0xe8, 0x9a, 0x8c, 0x22, 0x91, 0x2b, 0x98, 0x3e,
-	0x64, 0xae, 0xc0, 0xf8, 0xfb, 0xbe, 0xed, 0x4f, 0xa0, 0xda, 0xcf, 0x43, 0xd9, 0xf5, 0xa9, 0xe5,
-	0xb8, 0x24, 0x12, 0xc1, 0x59, 0xb5, 0x0e, 0xf9, 0x94, 0xef, 0x80, 0x9f, 0xa9, 0x4f, 0xd9, 0xda,
-	0xe8, 0x25, 0x80, 0x6d, 0x2f, 0xb0, 0x25, 0xb5, 0x90, 0xc1, 0x39, 0xf5, 0xad, 0x60, 0x68, 0x11,
-	0x7d, 0x85, 0x13, 0xb1, 0x19, 0x06, 0x47, 0xfa, 0x57, 0x0d, 0x16, 0x36, 0x70, 0x48, 0x5c, 0x42,
-	0xb1, 0x4f, 0x65, 0xde, 0x6e, 0xdd, 0xdf, 0x0e, 0xd2, 0x09, 0x52, 0x6d, 0x28, 0x41, 0xfa, 0xf9,
-	0xa4, 0x0b, 0x53, 0x91, 0x80, 0x48, 0xd3, 0x47, 0x91, 0x40, 0x54, 0x8c, 0x10, 0x91, 0xd4, 0x4c,
-	0xc6, 0x31, 0x49, 0x7e, 0x93, 0x01, 0xa5, 0xf1, 0x23, 0xd1, 0x18, 0xa0, 0xdc, 0xd4, 0x83, 0x2b,
-	0xec, 0x22, 0x48, 0x03, 0x3e, 0x64, 0xce, 0x1f, 0x83, 0x21, 0xdb, 0x91, 0xd1, 0xae, 0xf0, 0x33,
-	0x0d, 0x96, 0xb2, 0xb9, 0x9a, 0xc4, 0xf3, 0xbe, 0x04, 0x05, 0xd7, 0xdf, 0x0e, 0xa2, 0x34, 0xd2,
-	0x45, 0xf5, 0x83, 0x5a, 0xb9, 0xae, 0x20, 0x34, 0xfe, 0xa5, 0x41, 0x9d, 0xdb, 0xea, 0x23, 0x38,
-	0xfe, 0x0e, 0xee, 0x58, 0xc4, 0x7d, 0x17, 0x47, 0xc7, 0xdf, 0xc1, 0x9d, 0x4d, 0xf7, 0x5d, 0x9c,
-	0xd2, 0x8c, 0x42, 0x5a, 0x33, 0xd2, 0x81, 0x76, 0x71, 0x44, 0x9a, 0xb0, 0x94, 0x4a, 0x13, 0x1a,
-	0x1f, 0x6a, 0xd0, 0xbc, 0x85, 0xe9, 0xf0, 0x56, 0x8f, 0x4e, 0x29, 0x3e, 0xd2, 0xe0, 0x61, 0x25,
-	0x43, 0x93, 0xe8, 0xc3, 0x0b, 0x69, 0x7d, 0x50, 0x07, 0x58, 0xfb, 0x96, 0x94, 0xaa, 0x70, 0x15,
-	0xf4, 0xb5, 0x5e, 0xa7, 0x13, 0x3f, 0x7c, 0x96, 0x41, 0x0f, 0xc5, 0xa7, 0x88, 0x3f, 0x84, 0xbb,
-	0xac, 0x4a, 0x18, 0x8b, 0x32, 0x8c, 0x4b, 0x50, 0x93, 0x24, 0x92, 0xeb, 0x26, 0x94, 0x43, 0xf9,
-	0x2d, 0xf1, 0xe3, 0x7f, 0x63, 0x01, 0xe6, 0x4c, 0xdc, 0x66, 0x9a, 0x18, 0xde, 0x71, 0xfd, 0x5d,
-	0xb9, 0x8c, 0xf1, 0x9e, 0x06, 0xf3, 0x69, 0xb8, 0x9c, 0xeb, 0xff, 0xa1, 0x64, 0x3b, 0x4e, 0x88,
-	0x09, 0x19, 0x79, 0x2c, 0xd7, 0x05, 0x8e, 0x19, 0x21, 0x27, 0x24, 0x97, 0x1b, 0x5b, 0x72, 0x86,
-	0x05, 0xa7, 0x6e, 0x61, 0x7a, 0x17, 0xd3, 0x70, 0xa2, 0x3a, 0x70, 0x83, 0x45, 0x06, 0x9c, 0x58,
-	0xaa, 0x45, 0xf4, 0x6b, 0x7c, 0xa0, 0x01, 0x4a, 0xae, 0x30, 0xc9, 0x31, 0x27, 0xa5, 0x9c, 0x4b,
-	0x4b, 0x59, 0xb4, 0xca, 0x74, 0xba, 0x81, 0x8f, 0x7d, 0x9a, 0x7c, 0x62, 0xd6, 0x62, 0x28, 0x53,
-	0xbf, 0x8b, 0xcb, 0x50, 0x8e, 0x4a, 0x97, 0xa8, 0x04, 0xf9, 0xeb, 0x9e, 0x57, 0x9f, 0x42, 0x3a,
-	0x94, 0xd7, 0x65, 0x7d, 0xae, 0xae, 0x5d, 0xfc, 0x2a, 0xcc, 0x0e, 0x45, 0xfe, 0xa8, 0x0c, 0xd3,
-	0xaf, 0x06, 0x3e, 0xae, 0x4f, 0xa1, 0x3a, 0xe8, 0x37, 0x5c, 0xdf, 0x0e, 0xfb, 0xc2, 0xd3, 0xd6,
-	0x1d, 0x34, 0x0b, 0x55, 0xee, 0x71, 0x24, 0x00, 0xaf, 0x7e, 0xbb, 0x01, 0xb5, 0xbb, 0x7c, 0x33,
-	0x9b, 0x38, 0xbc, 0xe7, 0xb6, 0x30, 0xb2, 0xa0, 0x3e, 0xdc, 0x7c, 0x8c, 0x9e, 0x50, 0xea, 0x68,
-	0x46, 0x8f, 0x72, 0x73, 0x94, 0x78, 0x8c, 0x29, 0xf4, 0x36, 0xcc, 0xa4, 0xdb, 0x82, 0x91, 0xda,
-	0x24, 0x2a, 0x7b, 0x87, 0x0f, 0x9a, 0xdc, 0x82, 0x5a, 0xaa, 0xcb, 0x17, 0x5d, 0x50, 0xce, 0xad,
-	0xea, 0x04, 0x6e, 0xaa, 0x5f, 0x29, 0xc9, 0x4e, 0x5c, 0xc1, 0x7d, 0xba, 0x17, 0x31, 0x83, 0x7b,
-	0x65, 0xc3, 0xe2, 0x41, 0xdc, 0xdb, 0x70, 0x6a, 0x5f, 0x6b, 0x21, 0x7a, 0x52, 0x39, 0x7f, 0x56,
-	0x0b, 0xe2, 0x41, 0x4b, 0xec, 0x01, 0xda, 0xdf, 0xcd, 0x8a, 0x2e, 0xab, 0x4f, 0x20, 0xab, 0x97,
-	0xb7, 0x79, 0x65, 0x6c, 0xfc, 0x58, 0x70, 0xdf, 0xd3, 0xe0, 0x74, 0x46, 0x3f, 0x20, 0xba, 0xa6,
-	0x9c, 0x6e, 0x74, 0x53, 0x63, 0xf3, 0xe9, 0xc3, 0x11, 0xc5, 0x8c, 0xf8, 0x30, 0x3b, 0xd4, 0x22,
-	0x87, 0x2e, 0x65, 0xb6, 0x0d, 0xec, 0xef, 0x15, 0x6c, 0x3e, 0x31, 0x1e, 0x72, 0xbc, 0x1e, 0x8b,
-	0x85, 0xd3, 0x7d, 0x65, 0x19, 0xeb, 0xa9, 0xbb, 0xcf, 0x0e, 0x3a, 0xd0, 0xb7, 0xa0, 0x96, 0x6a,
-	0x00, 0xcb, 0xd0, 0x78, 0x55, 0x93, 0xd8, 0x41, 0x53, 0xbf, 0x03, 0x7a, 0xb2, 0x4f, 0x0b, 0xad,
-	0x64, 0xdd, 0xa5, 0x7d, 0x13, 0x1f, 0xe6, 0x2a, 0x0d, 0xda, 0x30, 0x46, 0x5c, 0xa5, 0x7d, 0x9d,
-	0x2b, 0xe3, 0x5f, 0xa5, 0xc4, 0xfc, 0x23, 0xaf, 0xd2, 0xa1, 0x97, 0x78, 0x4f, 0x83, 0x45, 0x75,
-	0x9b, 0x0f, 0x5a, 0xcd, 0xd2, 0xcd, 0xec, 0x86, 0xa6, 0xe6, 0xb5, 0x43, 0xd1, 0xc4, 0x52, 0xdc,
-	0x85, 0x99, 0x74, 0x33, 0x4b, 0x86, 0x14, 0x95, 0xfd, 0x3f, 0xcd, 0x4b, 0x63, 0xe1, 0xc6, 0x8b,
-	0xbd, 0x01, 0xd5, 0x44, 0x41, 0x1f, 0x3d, 0x3e, 0x42, 0x8f, 0x93, 0xe5, 0xa0, 0x83, 0x24, 0xb9,
-	0x03, 0xb5, 0x54, 0x11, 0x37, 0x4b, 0x87, 0x15, 0xb5, 0xf5, 0xe6, 0xc5, 0x71, 0x50, 0xe3, 0x0d,
-	0xec, 0x40, 0x2d, 0x55, 0x52, 0xcb, 0x58, 0x49, 0x55, 0x41, 0xcc, 0x58, 0x49, 0x59, 0xa1, 0x33,
-	0xa6, 0xd0, 0xb7, 0x12, 0xd5, 0xbb, 0x54, 0x85, 0x14, 0x5d, 0x1d, 0x39, 0x8f, 0xaa, 0x40, 0xdc,
-	0x5c, 0x3d, 0x0c, 0x49, 0xcc, 0xc2, 0x6b, 0x50, 0x89, 0x0b, 0x73, 0xe8, 0x7c, 0xa6, 0x59, 0x38,
-	0xcc, 0x49, 0x6d, 0x42, 0x51, 0x14, 0xc9, 0x90, 0x91, 0x51, 0x0e, 0x4f, 0x54, 0xd0, 0x9a, 0x8f,
-	0x2a, 0x71, 0xd2, 0xf5, 0x23, 0x63, 0x0a, 0x99, 0x50, 0x14, 0x69, 0xd8, 0x8c, 0x49, 0x53, 0xa5,
-	0x84, 0xe6, 0x68, 0x1c, 0x91, 0xbb, 0x9d, 0x42, 0x1b, 0x50, 0xe0, 0xe9, 0x4a, 0xb4, 0x3c, 0x2a,
-	0x95, 0x39, 0x6a, 0xc6, 0x54, 0xb6, 0xd3, 0x98, 0x42, 0x5f, 0x87, 0x02, 0x7f, 0x95, 0x67, 0xcc,
-	0x98, 0xcc, 0x47, 0x36, 0x47, 0xa2, 0x44, 0x2c, 0x3a, 0xa0, 0x27, 0xb3, 0x15, 0x19, 0xe6, 0x55,
-	0x91, 0xcf, 0x69, 0x8e, 0x83, 0x19, 0xad, 0xf2, 0x7d, 0x0d, 0x1a, 0x59, 0x81, 0x2d, 0xca, 0xf4,
-	0xa1, 0xa3, 0xa2, 0xf3, 0xe6, 0x33, 0x87, 0xa4, 0x8a, 0x45, 0xf8, 0x2e, 0xcc, 0x29, 0xc2, 0x29,
-	0x74, 0x25, 0x6b, 0xbe, 0x8c, 0x48, 0xb0, 0xf9, 0xd4, 0xf8, 0x04, 0xf1, 0xda, 0x1b, 0x50, 0xe0,
-	0x61, 0x50, 0xc6, 0xf1, 0x25, 0xa3, 0xaa, 0x0c, 0x85, 0x48, 0x45, 0x51, 0xc6, 0x14, 0xc2, 0xa0,
-	0x27, 0x63, 0xa2, 0x8c, 0xf3, 0x53, 0x84, 0x53, 0xcd, 0x0b, 0x63, 0x60, 0xc6, 0xcb, 0x58, 0x00,
-	0x83, 0x98, 0x04, 0x3d, 0x96, 0xb5, 0xf5, 0x74, 0x58, 0xd4, 0x7c, 0xfc, 0x40, 0xbc, 0x68, 0x81,
-	0xd5, 0x1e, 0xe8, 0x1b, 0x61, 0x70, 0xbf, 0x1f, 0x45, 0x00, 0xff, 0x9b, 0x7d, 0xdd, 0x78, 0xe6,
-	0x1b, 0xd7, 0xda, 0x2e, 0xdd, 0xe9, 0x6d, 0x31, 0x23, 0x73, 0x45, 0xe0, 0x3e, 0xe9, 0x06, 0xf2,
-	0xeb, 0x8a, 0xeb, 0x53, 0x1c, 0xfa, 0xb6, 0x77, 0x85, 0xcf, 0x25, 0xa1, 0xdd, 0xad, 0xad, 0x22,
-	0xff, 0xbf, 0xf6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x17, 0x33, 0xc5, 0x65, 0x39, 0x00,
-	0x00,
+	// 4275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4b, 0x6c, 0x24, 0x49,
+	0x56, 0x9d, 0x55, 0xae, 0xdf, 0xab, 0x2a, 0xbb, 0x1c, 0xfe, 0xd5, 0xe4, 0x74, 0x4f, 0xbb, 0x73,
+	0xe8, 0x19, 0x4f, 0xf7, 0x4e, 0xf7, 0x8e, 0x7b, 0x7e, 0xcc, 0xee, 0xb2, 0xd3, 0xdd, 0x9e, 0xe9,
+	0xb6, 0xa6, 0x7b, 0xc6, 0x9b, 0xee, 0x59, 0xb4, 0x2c, 0xad, 0x22, 0x9d, 0x19, 0xb6, 0x73, 0x9d,
+	0x95, 0x59, 0x64, 0x44, 0xd9, 0xed, 0x39, 0x21, 0xcd, 0x82, 0x40, 0x2c, 0xbb, 0x42, 0x2c, 0x20,
+	0x38, 0x70, 0x60, 0x58, 0x24, 0x10, 0x48, 0x7c, 0x0e, 0x20, 0x24, 0x0e, 0x48, 0x1c, 0x38, 0x20,
+	0xf1, 0x91, 0x00, 0x71, 0xe3, 0xc2, 0x05, 0x09, 0x09, 0x6e, 0x7b, 0x5c, 0xc5, 0x27, 0xb3, 0x32,
+	0xb3, 0x22, 0xab, 0xca, 0x5d, 0xeb, 0xb1, 0xfb, 0x56, 0xf9, 0xe2, 0x45, 0xbc, 0x17, 0x2f, 0x5e,
+	0xbc, 0x78, 0xf1, 0xe2, 0xbd, 0x82, 0x46, 0xd7, 0xf5, 0x0e, 0xfb, 0xe4, 0x46, 0x2f, 0x0c, 0x68,
+	0x80, 0x16, 0x92, 0x5f, 0x37, 0xc4, 0x87, 0xde, 0xb0, 0x83, 0x6e, 0x37, 0xf0, 0x05, 0x50, 0x6f,
+	0x10, 0x7b, 0x1f, 0x77, 0x2d, 0xf1, 0x65, 0xfc, 0x5f, 0x01, 0x56, 0xee, 0x86, 0xd8, 0xa2, 0xf8,
+	0x6e, 0xe0, 0x79, 0xd8, 0xa6, 0x6e, 0xe0, 0x9b, 0xf8, 0xe7, 0xfb, 0x98, 0x50, 0xf4, 0x45, 0x98,
+	0xd9, 0xb1, 0x08, 0x6e, 0x6b, 0xab, 0xda, 0x5a, 0x7d, 0xfd, 0xe2, 0x8d, 0xd4, 0xd8, 0x72, 0xcc,
+	0x87, 0x64, 0xef, 0x8e, 0x45, 0xb0, 0xc9, 0x31, 0xd1, 0x0a, 0x54, 0x9c, 0x9d, 0x8e, 0x6f, 0x75,
+	0x71, 0xbb, 0xb0, 0xaa, 0xad, 0xd5, 0xcc, 0xb2, 0xb3, 0xf3, 0xa1, 0xd5, 0xc5, 0xe8, 0x65, 0x98,
+	0xb3, 0xe3, 0xf1, 0x05, 0x42, 0x91, 0x23, 0xcc, 0x0e, 0xc0, 0x1c, 0x71, 0x19, 0xca, 0x82, 0xbf,
+	0xf6, 0xcc, 0xaa, 0xb6, 0xd6, 0x30, 0xe5, 0x17, 0xba, 0x04, 0x40, 0xf6, 0xad, 0xd0, 0x21, 0x1d,
+	0xbf, 0xdf, 0x6d, 0x97, 0x56, 0xb5, 0xb5, 0x92, 0x59, 0x13, 0x90, 0x0f, 0xfb, 0x5d, 0x64, 0xc2,
+	0xbc, 0x1d, 0xf8, 0xc4, 0x25, 0x14, 0xfb, 0xf6, 0x71, 0xc7, 0xc3, 0x87, 0xd8, 0x6b, 0x97, 0x57,
+	0xb5, 0xb5, 0xd9, 0xf5, 0xab, 0x4a, 0xbe, 0xef, 0x0e, 0xb0, 0x1f, 0x30, 0x64, 0xb3, 0x65, 0x67,
+	0x20, 0xe8, 0x2a, 0xcc, 0xfa, 0xfd, 0x6e, 0xa7, 0x67, 0x85, 0xd4, 0x65, 0xfc, 0x91, 0x76, 0x65,
+	0x55, 0x5b, 0x2b, 0x9a, 0x4d, 0xbf, 0xdf, 0xdd, 0x8a, 0x81, 0xe8, 0x26, 0x2c, 0x38, 0xd8, 0xc3,
+	0x7c, 0x62, 0x8c, 0x84, 0x98, 0x4c, 0xbb, 0xba, 0xaa, 0xad, 0x55, 0x4d, 0x14, 0x35, 0x6d, 0xc5,
+	0x2d, 0xc6, 0xaf, 0x6a, 0xb0, 0xb4, 0x11, 0x06, 0xbd, 0x73, 0x21, 0x70, 0xe3, 0x8f, 0x34, 0x58,
+	0xbc, 0x6f, 0x91, 0xf3, 0xb1, 0xfa, 0x97, 0x00, 0xa8, 0xdb, 0xc5, 0x1d, 0x42, 0xad, 0x6e, 0x8f,
+	0x6b, 0xc0, 0x8c, 0x59, 0x63, 0x90, 0x6d, 0x06, 0x30, 0xbe, 0x01, 0x8d, 0x3b, 0x41, 0xe0, 0x99,
+	0x98, 0xf4, 0x02, 0x9f, 0x60, 0x74, 0x0b, 0xca, 0x84, 0x5a, 0xb4, 0x4f, 0x24, 0x93, 0xcf, 0x2b,
+	0x99, 0xdc, 0xe6, 0x28, 0xa6, 0x44, 0x45, 0x8b, 0x50, 0x3a, 0xb4, 0xbc, 0xbe, 0xe0, 0xb1, 0x6a,
+	0x8a, 0x0f, 0xe3, 0x9b, 0x30, 0xbb, 0x4d, 0x43, 0xd7, 0xdf, 0xfb, 0x31, 0x0e, 0x5e, 0x8b, 0x06,
+	0xff, 0x57, 0x0d, 0x9e, 0xdb, 0xc0, 0xc4, 0x0e, 0xdd, 0x9d, 0x73, 0xb2, 0xcd, 0x0c, 0x68, 0x0c,
+	0x20, 0x9b, 0x1b, 0x5c, 0xd4, 0x45, 0x33, 0x05, 0xcb, 0x2c, 0x46, 0x29, 0xbb, 0x18, 0x9f, 0xcd,
+	0x80, 0xae, 0x9a, 0xd4, 0x34, 0xe2, 0xfb, 0x4a, 0xbc, 0xfb, 0x0b, 0xbc, 0x53, 0x66, 0xef, 0x8a,
+	0xb6, 0x1b, 0x03, 0x6a, 0xdb, 0x1c, 0x10, 0x1b, 0x89, 0xec, 0xac, 0x8a, 0x8a, 0x59, 0xad, 0xc3,
+	0xd2, 0xa1, 0x1b, 0xd2, 0xbe, 0xe5, 0x75, 0xec, 0x7d, 0xcb, 0xf7, 0xb1, 0xc7, 0xe5, 0x44, 0xda,
+	0x33, 0xab, 0xc5, 0xb5, 0x9a, 0xb9, 0x20, 0x1b, 0xef, 0x8a, 0x36, 0x26, 0x2c, 0x82, 0x5e, 0x87,
+	0xe5, 0xde, 0xfe, 0x31, 0x71, 0xed, 0xa1, 0x4e, 0x25, 0xde, 0x69, 0x31, 0x6a, 0x4d, 0xf5, 0xba,
+	0x0e, 0xf3, 0x36, 0xb7, 0xac, 0x4e, 0x87, 0x49, 0x4d, 0x88, 0xb1, 0xcc, 0xc5, 0xd8, 0x92, 0x0d,
+	0x8f, 0x22, 0x38, 0x63, 0x2b, 0x42, 0xee, 0x53, 0x3b, 0xd1, 0xa1, 0xc2, 0x3b, 0x2c, 0xc8, 0xc6,
+	0x8f, 0xa9, 0x3d, 0xe8, 0xa3, 0x34, 0x7a, 0xd5, 0xe9, 0x8c, 0x5e, 0x8e, 0x35, 0xab, 0xe5, 0x59,
+	0xb3, 0x8c, 0x61, 0x86, 0x8c, 0x61, 0x36, 0xfe, 0x44, 0x83, 0xa5, 0x07, 0x81, 0xe5, 0x9c, 0x0f,
+	0xb5, 0xbf, 0x0c, 0x75, 0x2f, 0xb0, 0x9c, 0xce, 0xae, 0x8b, 0x3d, 0x27, 0x5a, 0x72, 0x60, 0xa0,
+	0xf7, 0x39, 0xc4, 0xf8, 0xae, 0x06, 0x6d, 0x13, 0x7b, 0xd8, 0x22, 0xe7, 0x63, 0xa3, 0x1a, 0xdf,
+	0xd7, 0xe0, 0x85, 0x7b, 0x98, 0x26, 0x54, 0x9e, 0x5a, 0xd4, 0x25, 0xd4, 0xb5, 0xc9, 0x59, 0xb2,
+	0xf5, 0x3d, 0x0d, 0x2e, 0xe7, 0xb2, 0x35, 0x8d, 0x05, 0x78, 0x0b, 0x4a, 0xec, 0x17, 0x69, 0x17,
+	0x56, 0x8b, 0x6b, 0xf5, 0xf5, 0x2b, 0xca, 0x3e, 0x1f, 0xe0, 0xe3, 0xaf, 0x33, 0xc3, 0xba, 0x65,
+	0xb9, 0xa1, 0x29, 0xf0, 0x8d, 0xff, 0xd2, 0x60, 0x79, 0x7b, 0x3f, 0x38, 0x1a, 0xb0, 0x74, 0x1a,
+	0x02, 0x4a, 0xdb, 0xc4, 0x62, 0xc6, 0x26, 0xa2, 0xd7, 0x60, 0x86, 0x1e, 0xf7, 0x30, 0x37, 0xa7,
+	0xb3, 0xeb, 0x97, 0x6e, 0x28, 0xbc, 0xb1, 0x1b, 0x8c, 0xc9, 0x47, 0xc7, 0x3d, 0x6c, 0x72, 0x54,
+	0xf4, 0x0a, 0xb4, 0x32, 0x22, 0x8f, 0xac, 0xca, 0x5c, 0x5a, 0xe6, 0xc4, 0xf8, 0xeb, 0x02, 0xac,
+	0x0c, 0x4d, 0x71, 0x1a, 0x61, 0xab, 0x68, 0x17, 0x94, 0xb4, 0x99, 0x33, 0x94, 0x40, 0x75, 0x1d,
+	0xd2, 0x2e, 0xae, 0x16, 0x99, 0x33, 0x94, 0x30, 0xae, 0x0e, 0x41, 0xaf, 0x02, 0x1a, 0xb2, 0x79,
+	0x62, 0x9f, 0xcd, 0x98, 0xf3, 0x59, 0xa3, 0xc7, 0x0d, 0xab, 0xd2, 0xea, 0x09, 0x11, 0xcc, 0x98,
+	0x8b, 0x0a, 0xb3, 0x47, 0xd0, 0x6b, 0xb0, 0xe8, 0xfa, 0x0f, 0x71, 0x37, 0x08, 0x8f, 0x3b, 0x3d,
+	0x1c, 0xda, 0xd8, 0xa7, 0xd6, 0x1e, 0x26, 0xed, 0x32, 0xe7, 0x68, 0x21, 0x6a, 0xdb, 0x1a, 0x34,
+	0x19, 0x7f, 0xab, 0xc1, 0xdc, 0x6d, 0x47, 0xec, 0xf2, 0xb3, 0x34, 0x40, 0x6f, 0x42, 0x89, 0xdb,
+	0x1e, 0xae, 0x21, 0xf5, 0xf5, 0x55, 0xe5, 0xf9, 0xc6, 0xb9, 0x94, 0x47, 0x9b, 0x40, 0x37, 0x7e,
+	0x57, 0x83, 0x15, 0x13, 0xb3, 0x81, 0x4f, 0xd5, 0x2c, 0x3d, 0x07, 0xd5, 0xc0, 0x73, 0x92, 0x13,
+	0xa8, 0x04, 0x9e, 0x13, 0x35, 0xf9, 0xf8, 0x48, 0x34, 0xcd, 0x88, 0x26, 0x1f, 0x1f, 0x71, 0x63,
+	0xf0, 0x87, 0xcc, 0xc6, 0xbb, 0x84, 0x6e, 0x6c, 0x3c, 0xb8, 0xef, 0x12, 0x1a, 0x84, 0xc7, 0x67,
+	0x29, 0xe2, 0xe7, 0xa0, 0x4a, 0x5c, 0xdf, 0xc6, 0x1d, 0x4a, 0xa4, 0x07, 0x59, 0xe1, 0xdf, 0x8f,
+	0x88, 0xf1, 0x9f, 0x1a, 0xb4, 0x92, 0x4c, 0xda, 0x41, 0xe8, 0xa0, 0x8b, 0x50, 0x1b, 0x9c, 0xb6,
+	0xda, 0x60, 0x47, 0x73, 0x00, 0x1b, 0xcd, 0x71, 0xbc, 0x0e, 0xdf, 0xd5, 0x82, 0xa1, 0x8a, 0xe3,
+	0x78, 0x6c, 0xff, 0xa2, 0x36, 0x54, 0x7a, 0x61, 0xf0, 0xe4, 0x38, 0x76, 0x34, 0xa2, 0x4f, 0x76,
+	0x89, 0xb1, 0x2d, 0xcf, 0xc3, 0xa1, 0x94, 0x94, 0xfc, 0x4a, 0x4e, 0xae, 0x34, 0x6e, 0x72, 0x65,
+	0xe5, 0xe4, 0xda, 0x50, 0x09, 0x85, 0x6c, 0xb9, 0x63, 0x50, 0x33, 0xa3, 0x4f, 0x76, 0x72, 0x2d,
+	0x67, 0x17, 0x61, 0x1a, 0xdb, 0xf0, 0x55, 0x46, 0x89, 0x09, 0x28, 0x32, 0xc5, 0x57, 0x95, 0xd6,
+	0x2c, 0x2b, 0x4e, 0x33, 0xea, 0xc5, 0xb6, 0xdc, 0xf2, 0x6d, 0x8f, 0xe2, 0xf0, 0x7c, 0x1c, 0xfd,
+	0x39, 0x8e, 0xcd, 0x4c, 0xee, 0x35, 0xed, 0x5b, 0x70, 0x89, 0xcb, 0x33, 0x0c, 0x7a, 0x3d, 0xec,
+	0x9c, 0xea, 0xb1, 0x62, 0xfc, 0xbb, 0x06, 0x2f, 0xe4, 0x11, 0x3b, 0x77, 0x06, 0xde, 0x11, 0x4c,
+	0x2a, 0x0c, 0xbc, 0x6c, 0x19, 0x98, 0x6a, 0xe3, 0x57, 0xb8, 0x3f, 0x65, 0x07, 0x87, 0xa7, 0xac,
+	0x06, 0x13, 0x78, 0xfe, 0xc6, 0x9f, 0x72, 0x5e, 0xb8, 0x6b, 0x7a, 0x6e, 0x6e, 0xbb, 0x09, 0xd7,
+	0x79, 0x26, 0xeb, 0x3a, 0xff, 0xa5, 0x06, 0xcb, 0x22, 0x34, 0x13, 0x47, 0x1b, 0xce, 0x92, 0xdb,
+	0xab, 0x30, 0x1b, 0x87, 0x42, 0x92, 0xc7, 0x40, 0x33, 0x86, 0x72, 0x55, 0xfe, 0x73, 0x0d, 0x16,
+	0x99, 0x1a, 0x3f, 0x4b, 0x3c, 0xff, 0x99, 0x06, 0x0b, 0xf7, 0x2d, 0xf2, 0x2c, 0xb1, 0xfc, 0x6f,
+	0xf2, 0x5e, 0x15, 0xf3, 0x7c, 0x96, 0xd7, 0x01, 0x86, 0x98, 0x66, 0x3a, 0xba, 0x5b, 0xcd, 0xa6,
+	0xb8, 0x26, 0xd9, 0x0b, 0x58, 0x69, 0xe8, 0x02, 0xf6, 0x57, 0x83, 0x0b, 0xd8, 0xb3, 0x35, 0x35,
+	0xe3, 0x6f, 0x34, 0xb8, 0x74, 0x0f, 0xd3, 0x98, 0xeb, 0x73, 0x71, 0x51, 0x9b, 0x54, 0x9d, 0xbe,
+	0x2b, 0xae, 0x99, 0x4a, 0xe6, 0xcf, 0xe4, 0x3a, 0xf7, 0xc7, 0x05, 0x58, 0x62, 0x77, 0x9d, 0xf3,
+	0xa1, 0x04, 0x93, 0x84, 0xcb, 0x14, 0x8a, 0x52, 0x52, 0xee, 0x81, 0xe8, 0x92, 0x58, 0x9e, 0xfc,
+	0x92, 0x98, 0xbe, 0x76, 0x56, 0xb2, 0xa1, 0xb8, 0xbf, 0x28, 0xc0, 0x72, 0x56, 0x58, 0xd3, 0xac,
+	0x9a, 0x62, 0x2a, 0x05, 0xe5, 0x54, 0x0c, 0x68, 0xc4, 0x90, 0xcd, 0x8d, 0xc8, 0x65, 0x48, 0xc1,
+	0xce, 0xed, 0x95, 0x70, 0x07, 0x96, 0xc4, 0xe9, 0xba, 0x61, 0x51, 0x8b, 0xe9, 0xc9, 0x29, 0xf8,
+	0x75, 0x3f, 0x07, 0x0b, 0xec, 0x2c, 0x3c, 0x45, 0x0a, 0xf7, 0x61, 0x91, 0x3b, 0x8e, 0x92, 0xc2,
+	0xd3, 0xef, 0x12, 0xe3, 0xfb, 0xd1, 0x2d, 0x6e, 0x30, 0xd4, 0x34, 0x3a, 0xc4, 0x2e, 0x4e, 0x3b,
+	0x29, 0xe5, 0xa9, 0x38, 0x3b, 0x23, 0x02, 0xa3, 0xc5, 0xd5, 0xa2, 0x2a, 0x30, 0x6a, 0x7c, 0xaa,
+	0xc5, 0x0f, 0x54, 0x21, 0x76, 0xb0, 0x4f, 0x5d, 0xcb, 0x7b, 0x7a, 0x39, 0xea, 0x50, 0xed, 0x13,
+	0x1c, 0x26, 0x04, 0x19, 0x7f, 0xb3, 0xb6, 0x9e, 0x45, 0xc8, 0x51, 0x10, 0x3a, 0xd2, 0x0c, 0xc4,
+	0xdf, 0xcc, 0x77, 0x5c, 0xf9, 0xb8, 0xe7, 0x7c, 0x0e, 0x5c, 0x5c, 0x81, 0x06, 0xbb, 0x82, 0x67,
+	0x38, 0xa9, 0x07, 0x9e, 0xb3, 0x25, 0x41, 0x0c, 0x85, 0x5d, 0xc5, 0x63, 0x14, 0x61, 0xd1, 0xeb,
+	0x3e, 0x3e, 0x8a, 0x50, 0x8c, 0x3d, 0x58, 0xd9, 0xc0, 0x1e, 0x3e, 0x75, 0x76, 0x8d, 0x0d, 0x68,
+	0x31, 0xa5, 0xf9, 0x98, 0xe0, 0x70, 0x0a, 0xdd, 0xdb, 0x85, 0xf9, 0xc4, 0x28, 0xd3, 0xa8, 0xdd,
+	0x45, 0xa8, 0x45, 0xbc, 0x45, 0x7a, 0x37, 0x00, 0x18, 0x3b, 0x30, 0x2f, 0x74, 0xc9, 0x0c, 0xbc,
+	0x29, 0x76, 0xe3, 0xf3, 0x50, 0x0b, 0x03, 0x0f, 0x27, 0xf7, 0x63, 0x95, 0x01, 0xe4, 0x9e, 0x9f,
+	0x63, 0x7b, 0xfe, 0x14, 0x29, 0xfc, 0x9d, 0x06, 0xcb, 0x1f, 0xf5, 0x70, 0x68, 0x51, 0xcc, 0x24,
+	0x36, 0x1d, 0xa5, 0x51, 0xba, 0x98, 0xe2, 0xa2, 0x98, 0xe6, 0x02, 0x7d, 0x39, 0x15, 0xeb, 0x5c,
+	0x53, 0x1e, 0x63, 0x19, 0x2e, 0x07, 0x27, 0x9a, 0xf1, 0x3f, 0x1a, 0xd4, 0xef, 0x85, 0x96, 0x4f,
+	0xdf, 0xf3, 0xa9, 0x4b, 0x8f, 0xd3, 0xa4, 0xb4, 0x0c, 0xa9, 0x77, 0xa1, 0x1e, 0xec, 0x7c, 0x0b,
+	0xdb, 0x74, 0x10, 0x87, 0x99, 0x5d, 0xbf, 0xac, 0x9c, 0xdc, 0x47, 0x1c, 0x8f, 0x13, 0x82, 0x20,
+	0xfe, 0x9d, 0xb4, 0x9f, 0xc5, 0x94, 0x0b, 0x70, 0x39, 0x1e, 0x3a, 0xe1, 0x1c, 0xc9, 0x9e, 0x1c,
+	0xe1, 0x0e, 0xd4, 0x7a, 0xa1, 0x7b, 0xe8, 0x7a, 0x78, 0x4f, 0x44, 0x6d, 0x66, 0xd7, 0x7f, 0x62,
+	0x04, 0xe5, 0xad, 0x08, 0xd7, 0x1c, 0x74, 0x33, 0xfe, 0x5e, 0x83, 0x15, 0x29, 0x8a, 0x41, 0xfb,
+	0x53, 0xaf, 0xd8, 0xdb, 0x50, 0xc6, 0x5c, 0x68, 0xed, 0x82, 0x2a, 0x88, 0x28, 0x3f, 0x12, 0xc2,
+	0x35, 0x25, 0x3e, 0xfa, 0x8a, 0x5c, 0xb2, 0x22, 0x9f, 0xc6, 0x2b, 0xa3, 0x96, 0x2c, 0xe6, 0x33,
+	0xb1, 0x66, 0x36, 0xa0, 0x6d, 0xcc, 0x1c, 0x1e, 0x3e, 0xf6, 0x29, 0x29, 0xf7, 0x2f, 0x6b, 0xb0,
+	0x90, 0xa2, 0x32, 0x8d, 0x35, 0xf8, 0x32, 0x54, 0xf9, 0xd4, 0x5d, 0x1c, 0x79, 0xa0, 0xe3, 0x85,
+	0x15, 0xf7, 0x30, 0xbe, 0xa3, 0xc1, 0x72, 0xf4, 0xc2, 0xb9, 0x8d, 0xf7, 0xba, 0x78, 0x9a, 0x49,
+	0x67, 0x5d, 0xc8, 0x82, 0xc2, 0x85, 0xbc, 0x08, 0x35, 0x22, 0xe8, 0xc4, 0x21, 0x8c, 0x01, 0xc0,
+	0xf8, 0x81, 0x06, 0x2b, 0x43, 0xec, 0x4c, 0x23, 0x9d, 0x36, 0x54, 0x5c, 0xdf, 0xc1, 0x4f, 0x62,
+	0x6e, 0xa2, 0x4f, 0xd6, 0xb2, 0xd3, 0x77, 0x3d, 0x67, 0x10, 0xda, 0x94, 0x9f, 0xec, 0xec, 0xc1,
+	0xbe, 0xb5, 0xe3, 0xe1, 0x0e, 0xc7, 0x95, 0xf1, 0xb3, 0xba, 0x80, 0x6d, 0x32, 0x90, 0xf1, 0x6b,
+	0x6c, 0x05, 0xf7, 0x83, 0x23, 0xc9, 0x23, 0x39, 0x5d, 0x99, 0xad, 0x42, 0x3d, 0xe1, 0x6e, 0x4a,
+	0x76, 0x93, 0x20, 0xe3, 0x00, 0x16, 0xd3, 0xec, 0x4c, 0x23, 0xb3, 0x17, 0x00, 0xe2, 0x15, 0x11,
+	0x3a, 0x55, 0x34, 0x13, 0x10, 0xe3, 0x7f, 0x35, 0x40, 0xe2, 0x88, 0xe1, 0xc2, 0x38, 0xe3, 0xf0,
+	0x12, 0xbf, 0x66, 0x27, 0x2d, 0x5b, 0x8d, 0x43, 0x78, 0xf3, 0x06, 0x34, 0xf0, 0x13, 0x1a, 0x5a,
+	0x2c, 0xc1, 0xc5, 0xea, 0x0a, 0xf7, 0x7a, 0xa2, 0x1b, 0x5a, 0x9d, 0x77, 0xdb, 0xe2, 0xbd, 0x8c,
+	0x7f, 0x60, 0xe1, 0x1e, 0xa9, 0x94, 0xe7, 0x7d, 0xc6, 0x97, 0x00, 0xb8, 0xd2, 0x26, 0x23, 0xf0,
+	0x35, 0x0e, 0xe1, 0x96, 0xe7, 0x07, 0x1a, 0xb4, 0xf8, 0x14, 0xc4, 0x7c, 0x7a, 0xd1, 0xf3, 0x76,
+	0xa2, 0x8f, 0x96, 0xe9, 0x33, 0x62, 0x0b, 0xfd, 0x24, 0x94, 0xa5, 0x60, 0x8b, 0x93, 0x0a, 0x56,
+	0x76, 0x18, 0x33, 0x0d, 0xe3, 0xf7, 0x59, 0xfe, 0x50, 0x5a, 0xe4, 0xd3, 0x68, 0xf4, 0x23, 0x40,
+	0x62, 0x86, 0xce, 0x60, 0xda, 0xa3, 0x63, 0xfe, 0x59, 0x21, 0x99, 0xf3, 0x6e, 0x06, 0x42, 0x8c,
+	0x7f, 0xd6, 0xe0, 0xe2, 0x3d, 0x4c, 0x39, 0xea, 0x1d, 0x66, 0x3b, 0xb6, 0xc2, 0x60, 0x2f, 0xc4,
+	0x84, 0x3c, 0xbb, 0xfa, 0xf1, 0x5b, 0x22, 0xc0, 0xa3, 0x9a, 0xd2, 0x34, 0xf2, 0xbf, 0x02, 0x0d,
+	0x4e, 0x03, 0x3b, 0x9d, 0x30, 0x38, 0x22, 0x52, 0x8f, 0xea, 0x12, 0x66, 0x06, 0x47, 0x5c, 0x21,
+	0x68, 0x40, 0x2d, 0x4f, 0x20, 0xc8, 0x83, 0x81, 0x43, 0x58, 0x33, 0xdf, 0x83, 0x11, 0x63, 0x6c,
+	0x70, 0xfc, 0xec, 0xca, 0xf8, 0x0f, 0x34, 0x58, 0xca, 0x4c, 0x65, 0x1a, 0xd9, 0xbe, 0x21, 0xc2,
+	0x4f, 0xa3, 0x5d, 0xc6, 0x04, 0x31, 0x81, 0xcd, 0x9c, 0xc2, 0x5d, 0xcb, 0xf5, 0x3a, 0x21, 0xb6,
+	0x48, 0xe0, 0xcb, 0x89, 0x02, 0x03, 0x99, 0x1c, 0xc2, 0x1c, 0xba, 0x16, 0x73, 0xf2, 0x9f, 0x71,
+	0x8b, 0xf7, 0x59, 0x01, 0x9a, 0x9b, 0x3e, 0xc1, 0x21, 0x3d, 0xff, 0x21, 0x4a, 0xf4, 0x55, 0xa8,
+	0xf3, 0x89, 0x91, 0x8e, 0x63, 0x51, 0x4b, 0x1e, 0x57, 0x2f, 0xe4, 0x3f, 0xa0, 0xb3, 0x38, 0x86,
+	0x29, 0xa4, 0x43, 0xd8, 0x6f, 0xe6, 0x76, 0xee, 0x5b, 0x64, 0xbf, 0x73, 0x80, 0x8f, 0x45, 0x60,
+	0xa8, 0x69, 0x56, 0x19, 0xe0, 0x03, 0x7c, 0xcc, 0xc3, 0x15, 0x2c, 0xd9, 0x93, 0x6f, 0x30, 0x16,
+	0x5f, 0x6b, 0x9a, 0x15, 0xbf, 0xdf, 0xe5, 0xdb, 0xeb, 0x1f, 0x0b, 0x30, 0xfb, 0xb0, 0x4f, 0x2d,
+	0x99, 0xde, 0xd6, 0xf7, 0xe8, 0xd3, 0x29, 0xe3, 0x35, 0x28, 0x0a, 0x9f, 0x81, 0xf5, 0x68, 0x2b,
+	0x19, 0xdf, 0xdc, 0x20, 0x26, 0x43, 0x62, 0x0b, 0x47, 0xfa, 0xb6, 0x2d, 0x9d, 0xac, 0x22, 0x67,
+	0xb6, 0xc6, 0x20, 0x5c, 0xe3, 0xd8, 0x54, 0x70, 0x18, 0xc6, 0x2e, 0x18, 0x9f, 0x0a, 0x0e, 0x43,
+	0xd1, 0x68, 0x40, 0xc3, 0xb2, 0x0f, 0xfc, 0xe0, 0xc8, 0xc3, 0xce, 0x1e, 0x76, 0xf8, 0xb2, 0x57,
+	0xcd, 0x14, 0x4c, 0x28, 0x06, 0x5b, 0xf8, 0x8e, 0xed, 0x53, 0x1e, 0x89, 0x2c, 0x9a, 0x35, 0x01,
+	0xb9, 0xeb, 0x53, 0xd6, 0xcc, 0x5f, 0x44, 0x31, 0x6f, 0x16, 0x69, 0xaf, 0x35, 0x01, 0x91, 0xcd,
+	0xfd, 0x5e, 0xdc, 0xbb, 0x2a, 0x9a, 0x05, 0x84, 0x35, 0xa7, 0x5e, 0xd4, 0x6b, 0x99, 0x17, 0x75,
+	0xe3, 0x10, 0x5a, 0x5b, 0x9e, 0x65, 0xe3, 0xfd, 0xc0, 0x73, 0x70, 0xc8, 0x4f, 0x3f, 0xd4, 0x82,
+	0x22, 0xb5, 0xf6, 0xe4, 0xf1, 0xca, 0x7e, 0xa2, 0xb7, 0xe5, 0x55, 0xa5, 0xa0, 0xba, 0x71, 0xc9,
+	0x8f, 0xc4, 0x30, 0x89, 0x58, 0xe9, 0x32, 0x94, 0x79, 0xd6, 0xa5, 0x38, 0x78, 0x1b, 0xa6, 0xfc,
+	0x32, 0x1e, 0xa7, 0xe8, 0xde, 0x0b, 0x83, 0x7e, 0x0f, 0x6d, 0x42, 0xa3, 0x37, 0x80, 0xb1, 0xd5,
+	0xcc, 0x3f, 0xf5, 0xb2, 0x4c, 0x9b, 0xa9, 0xae, 0xc6, 0xef, 0x95, 0xa0, 0xb9, 0x8d, 0xad, 0xd0,
+	0xde, 0x7f, 0x26, 0x1e, 0x62, 0x5a, 0x50, 0x74, 0x88, 0x27, 0x4d, 0x02, 0xfb, 0xc9, 0xa2, 0x72,
+	0x89, 0x09, 0x75, 0xf6, 0x98, 0x80, 0xb8, 0x66, 0x34, 0xcc, 0x56, 0x2f, 0x2b, 0xb8, 0xb7, 0xa0,
+	0xea, 0x10, 0x99, 0x16, 0x51, 0xe1, 0x4b, 0xa4, 0x9e, 0xdf, 0x06, 0xe1, 0xb9, 0x12, 0x66, 0xc5,
+	0x11, 0x3f, 0xd0, 0x8b, 0xd0, 0x0c, 0xfa, 0xb4, 0xd7, 0xa7, 0xd1, 0x13, 0x50, 0x95, 0xb3, 0xd7,
+	0x10, 0x40, 0xf1, 0x08, 0x84, 0xde, 0x87, 0x26, 0xe1, 0xa2, 0x8c, 0x7c, 0xd3, 0xda, 0xa4, 0x2e,
+	0x54, 0x43, 0xf4, 0x13, 0xce, 0x29, 0x7b, 0xfe, 0xa6, 0xa1, 0x75, 0x88, 0xbd, 0x44, 0x9c, 0x11,
+	0xb8, 0x3e, 0xce, 0x09, 0xf8, 0x20, 0x97, 0xf2, 0x26, 0x2c, 0xec, 0xf5, 0x2d, 0x76, 0x0d, 0xc4,
+	0x38, 0x81, 0x5d, 0xe7, 0xd8, 0x28, 0x6e, 0x1a, 0x93, 0x7c, 0xd9, 0x98, 0x2e, 0xf9, 0xf2, 0x4d,
+	0x58, 0xe9, 0x13, 0xdc, 0x71, 0xf0, 0xae, 0xd5, 0xf7, 0x68, 0x27, 0xd1, 0xde, 0x6e, 0xf2, 0x4d,
+	0xbc, 0xd4, 0x27, 0x78, 0x43, 0xb4, 0x26, 0x86, 0x63, 0x42, 0xdd, 0x0b, 0x2d, 0x1b, 0xef, 0xf6,
+	0xc5, 0x4c, 0xdb, 0xb3, 0x9c, 0xed, 0x46, 0x04, 0x64, 0x5c, 0x1b, 0x1f, 0xc0, 0xcc, 0x7d, 0x97,
+	0xf2, 0x95, 0xdf, 0xdc, 0x10, 0xaa, 0x5e, 0x14, 0xc6, 0xe6, 0x39, 0xa8, 0x86, 0xc1, 0x91, 0x30,
+	0xab, 0x05, 0xbe, 0x67, 0x2a, 0x61, 0x70, 0xc4, 0x6d, 0x26, 0x4f, 0xc7, 0x0f, 0x42, 0xb9, 0x99,
+	0x0a, 0xa6, 0xfc, 0x32, 0x7e, 0x51, 0x1b, 0x68, 0x3b, 0xb3, 0x88, 0x64, 0x8a, 0x24, 0x13, 0xde,
+	0x7f, 0x64, 0xc2, 0x6f, 0x92, 0x12, 0x37, 0xeb, 0x51, 0x2f, 0xe3, 0xdb, 0x1a, 0x34, 0xde, 0xf7,
+	0xfa, 0xe4, 0x34, 0x36, 0x9d, 0x2a, 0x79, 0xa2, 0xa8, 0xce, 0xcc, 0xfb, 0xf5, 0x02, 0x34, 0x25,
+	0x1b, 0xd3, 0xb8, 0x2b, 0xb9, 0xac, 0x6c, 0x43, 0x9d, 0x91, 0xec, 0x10, 0xbc, 0x17, 0x3d, 0xb3,
+	0xd4, 0xd7, 0xd7, 0x95, 0x66, 0x2a, 0xc5, 0x06, 0x4f, 0x95, 0xde, 0xe6, 0x9d, 0xde, 0xf3, 0x69,
+	0x78, 0x6c, 0x82, 0x1d, 0x03, 0xf4, 0xc7, 0x30, 0x97, 0x69, 0x66, 0xba, 0x71, 0x80, 0x8f, 0x23,
+	0x3b, 0x7c, 0x80, 0x8f, 0xd1, 0xeb, 0xc9, 0x84, 0xf6, 0xbc, 0xf3, 0xf6, 0x41, 0xe0, 0xef, 0xdd,
+	0x0e, 0x43, 0xeb, 0x58, 0x26, 0xbc, 0xbf, 0x53, 0x78, 0x5b, 0x33, 0x7e, 0xa8, 0x41, 0xf3, 0xbd,
+	0x27, 0xbd, 0x20, 0xa4, 0xcf, 0x84, 0x41, 0x54, 0xd9, 0x8a, 0x92, 0xda, 0x56, 0xb0, 0x98, 0xa1,
+	0xb0, 0x61, 0x3d, 0x8b, 0xee, 0xcb, 0x4c, 0x2d, 0x10, 0xa0, 0x2d, 0x8b, 0xee, 0x1b, 0x8f, 0x61,
+	0x36, 0x9a, 0xf9, 0x34, 0xea, 0xb0, 0x0c, 0x65, 0x6a, 0x91, 0x83, 0xf8, 0x6e, 0x29, 0xbf, 0x8c,
+	0xcf, 0x84, 0x93, 0x2c, 0x48, 0x9c, 0xb9, 0xc3, 0x3f, 0xe0, 0x72, 0x26, 0xc5, 0xe5, 0x7f, 0x68,
+	0xb0, 0x9c, 0xe5, 0x72, 0x1a, 0x69, 0xbc, 0x99, 0xf6, 0xe5, 0x57, 0x95, 0x7d, 0x92, 0xd4, 0x04,
+	0x3a, 0x2b, 0xc9, 0xd8, 0x75, 0xbd, 0x78, 0xef, 0x8a, 0x8f, 0x94, 0xbf, 0x27, 0xf8, 0x8e, 0xfc,
+	0xbd, 0xac, 0xf7, 0x5f, 0x1a, 0xf2, 0xfe, 0xff, 0xbf, 0x08, 0x8d, 0xaf, 0xf5, 0xf1, 0xd9, 0xa6,
+	0x39, 0x22, 0x98, 0xc1, 0x4f, 0x7a, 0x51, 0x86, 0x21, 0xff, 0x3d, 0x7c, 0xb8, 0x96, 0x14, 0x87,
+	0xab, 0x62, 0x47, 0x94, 0x27, 0xde, 0x11, 0x95, 0x13, 0x9d, 0x9e, 0xd5, 0x93, 0x9d, 0x9e, 0xb5,
+	0x53, 0x3b, 0x3d, 0xe1, 0x44, 0xa7, 0x67, 0x5d, 0x71, 0x7a, 0x7e, 0x5b, 0x8b, 0xd7, 0x7c, 0xaa,
+	0xf3, 0x2e, 0x75, 0x87, 0x29, 0x9c, 0xf4, 0x0e, 0xc3, 0xb2, 0xab, 0x6a, 0x5f, 0xc7, 0x36, 0x0d,
+	0x42, 0x76, 0x70, 0x2b, 0x94, 0x45, 0x9b, 0xe0, 0x9a, 0x58, 0xc8, 0x5e, 0x13, 0x6f, 0x41, 0xd5,
+	0x75, 0x3a, 0x16, 0xb3, 0xe0, 0xed, 0xe2, 0x98, 0xeb, 0x49, 0xc5, 0x75, 0xb8, 0xa9, 0x9f, 0x3c,
+	0x31, 0xe6, 0xb7, 0x35, 0x68, 0x08, 0x9e, 0x89, 0xe8, 0xf9, 0xa5, 0x04, 0x39, 0x4d, 0x75, 0xac,
+	0xc8, 0x8f, 0x78, 0xa2, 0xf7, 0x2f, 0x0c, 0xc8, 0xde, 0x06, 0x60, 0xb2, 0x93, 0xdd, 0x0b, 0x23,
+	0xd2, 0xa8, 0x45, 0x77, 0x2e, 0xc7, 0xfb, 0x17, 0xcc, 0x1a, 0xeb, 0xc5, 0x87, 0xb8, 0x53, 0x81,
+	0x12, 0xef, 0x6d, 0xfc, 0x66, 0x01, 0x16, 0xee, 0x5a, 0x9e, 0xbd, 0xe1, 0x12, 0x6a, 0xf9, 0xf6,
+	0x14, 0x66, 0xf4, 0x1d, 0xa8, 0x04, 0xbd, 0x8e, 0x87, 0x77, 0xa9, 0x64, 0xe9, 0xca, 0x88, 0x19,
+	0x09, 0x31, 0x98, 0xe5, 0xa0, 0xf7, 0x00, 0xef, 0x52, 0xf6, 0x48, 0x11, 0xf4, 0x3a, 0xa1, 0xbb,
+	0xb7, 0x4f, 0xdb, 0xc5, 0x49, 0x3b, 0x57, 0x82, 0x9e, 0xc9, 0x7a, 0x24, 0xe2, 0x8c, 0x33, 0x27,
+	0x8d, 0x33, 0xe6, 0xa5, 0x23, 0x1b, 0xff, 0xa2, 0x65, 0xe5, 0x32, 0x85, 0xce, 0xbf, 0x03, 0x55,
+	0xd7, 0xa7, 0x1d, 0xc7, 0x25, 0x91, 0x6c, 0x2e, 0xa9, 0x95, 0xcb, 0xa7, 0x7c, 0x6a, 0x7c, 0xb1,
+	0x7d, 0xca, 0x68, 0xa3, 0x77, 0x01, 0x76, 0xbd, 0xc0, 0x92, 0xbd, 0x85, 0x70, 0x2e, 0xab, 0xb7,
+	0x0b, 0x43, 0x8b, 0xfa, 0xd7, 0x78, 0x27, 0x36, 0xc2, 0x60, 0xad, 0xff, 0x49, 0x83, 0xa5, 0x2d,
+	0x1c, 0x8a, 0x5d, 0x4f, 0xe5, 0x63, 0xc0, 0xa6, 0xbf, 0x1b, 0xa4, 0x5f, 0x5d, 0xb4, 0xcc, 0xab,
+	0xcb, 0x8f, 0xe7, 0x0d, 0x62, 0xd4, 0x71, 0xf3, 0x56, 0x74, 0xae, 0x89, 0xc7, 0x45, 0xf5, 0xfa,
+	0x49, 0x7e, 0x93, 0x07, 0x9b, 0xf1, 0x1b, 0xa2, 0x06, 0x47, 0x39, 0xa9, 0xa7, 0xd7, 0xe4, 0x65,
+	0x90, 0x5a, 0x90, 0x39, 0x98, 0x5e, 0x82, 0x8c, 0x51, 0xc9, 0xa9, 0x0c, 0xfa, 0x1d, 0x0d, 0x56,
+	0xf3, 0xb9, 0x9a, 0xc6, 0x01, 0x78, 0x17, 0x4a, 0xae, 0xbf, 0x1b, 0x44, 0xb1, 0xe9, 0x6b, 0xea,
+	0x5b, 0xba, 0x92, 0xae, 0xe8, 0x68, 0xfc, 0xb7, 0x06, 0x2d, 0x6e, 0xc4, 0xcf, 0x60, 0xf9, 0xbb,
+	0xb8, 0xdb, 0x21, 0xee, 0x27, 0x38, 0x5a, 0xfe, 0x2e, 0xee, 0x6e, 0xbb, 0x9f, 0xe0, 0x94, 0x66,
+	0x94, 0xd2, 0x9a, 0x91, 0x8e, 0xde, 0x95, 0x47, 0xbc, 0x3d, 0x54, 0x52, 0x6f, 0x0f, 0x2c, 0x9b,
+	0x4f, 0xbf, 0x87, 0x69, 0x76, 0xaa, 0x67, 0xa7, 0x14, 0xdf, 0xd3, 0xe0, 0x79, 0x25, 0x43, 0xd3,
+	0xe8, 0xc3, 0x97, 0xd2, 0xfa, 0xa0, 0x8e, 0xda, 0x0c, 0x91, 0x94, 0xaa, 0xf0, 0x1a, 0x34, 0x36,
+	0xfa, 0xdd, 0x6e, 0xec, 0xc2, 0x5d, 0x81, 0x86, 0xac, 0xa4, 0x10, 0x41, 0x0d, 0x71, 0x8e, 0xd6,
+	0x25, 0x8c, 0x85, 0x2e, 0x8c, 0xeb, 0xd0, 0x94, 0x5d, 0x24, 0xd7, 0x3a, 0x54, 0x43, 0xf9, 0x3b,
+	0x4e, 0x59, 0x90, 0xdf, 0xc6, 0x12, 0x2c, 0x98, 0x78, 0x8f, 0x69, 0x62, 0xf8, 0xc0, 0xf5, 0x0f,
+	0x24, 0x19, 0x96, 0xcd, 0xb4, 0x98, 0x86, 0xcb, 0xb1, 0xde, 0x84, 0x8a, 0xe5, 0x38, 0x21, 0x26,
+	0x64, 0xe4, 0xb2, 0xdc, 0x16, 0x38, 0x66, 0x84, 0x9c, 0x90, 0x5c, 0x61, 0x62, 0xc9, 0x19, 0x1d,
+	0x98, 0xbf, 0x87, 0xe9, 0x43, 0x4c, 0xc3, 0xa9, 0xb2, 0x53, 0x13, 0xc5, 0x28, 0x85, 0x74, 0x31,
+	0xca, 0x77, 0x34, 0x40, 0x49, 0x0a, 0xd3, 0x2c, 0x73, 0x52, 0xca, 0x85, 0xb4, 0x94, 0x45, 0xd1,
+	0x42, 0xb7, 0x17, 0xf8, 0xd8, 0xa7, 0x49, 0x67, 0xb9, 0x19, 0x43, 0x99, 0xfa, 0x5d, 0xbb, 0x02,
+	0xd5, 0x28, 0xa1, 0x12, 0x55, 0xa0, 0x78, 0xdb, 0xf3, 0x5a, 0x17, 0x50, 0x03, 0xaa, 0x9b, 0x32,
+	0x2d, 0xb0, 0xa5, 0x5d, 0x7b, 0x17, 0x16, 0x14, 0xc9, 0x2a, 0x68, 0x1e, 0x9a, 0xb7, 0x1d, 0x87,
+	0x81, 0x1e, 0x05, 0x0c, 0xd8, 0xba, 0x80, 0x96, 0x01, 0x99, 0xb8, 0x1b, 0x1c, 0x72, 0xc4, 0xf7,
+	0xc3, 0xa0, 0xcb, 0xe1, 0xda, 0xb5, 0x57, 0x61, 0x51, 0x95, 0x3b, 0x81, 0x6a, 0x50, 0xe2, 0xe9,
+	0x05, 0xad, 0x0b, 0x08, 0xa0, 0x6c, 0xe2, 0xc3, 0xe0, 0x80, 0xa1, 0xff, 0x14, 0xcc, 0x65, 0xe2,
+	0x97, 0xa8, 0x0a, 0x33, 0x1f, 0x06, 0x3e, 0xa3, 0xd1, 0x82, 0xc6, 0x1d, 0xd7, 0xb7, 0xc2, 0x63,
+	0x71, 0xe6, 0xb7, 0x1c, 0x34, 0x07, 0x75, 0x7e, 0xc4, 0x49, 0x00, 0x5e, 0xff, 0xe1, 0xcb, 0xd0,
+	0x7c, 0xc8, 0xa5, 0xb7, 0x8d, 0xc3, 0x43, 0xd7, 0xc6, 0xa8, 0x03, 0xad, 0xec, 0x3f, 0x39, 0xa0,
+	0x2f, 0x28, 0x37, 0x45, 0xce, 0x1f, 0x3e, 0xe8, 0xa3, 0xd6, 0xc3, 0xb8, 0x80, 0xbe, 0x09, 0xb3,
+	0xe9, 0xff, 0x2d, 0x40, 0x6a, 0x1b, 0xac, 0xfc, 0x73, 0x83, 0x71, 0x83, 0x77, 0xa0, 0x99, 0xfa,
+	0x1b, 0x02, 0xa4, 0x4e, 0x4f, 0x51, 0xfd, 0x55, 0x81, 0xae, 0xf6, 0x97, 0x92, 0x7f, 0x15, 0x20,
+	0xb8, 0x4f, 0x17, 0x22, 0xe7, 0x70, 0xaf, 0xac, 0x56, 0x1e, 0xc7, 0xbd, 0x05, 0xf3, 0x43, 0x65,
+	0xc3, 0xe8, 0x55, 0xe5, 0xf8, 0x79, 0xe5, 0xc5, 0xe3, 0x48, 0x1c, 0x01, 0x1a, 0x2e, 0xb7, 0x47,
+	0x37, 0xd4, 0x2b, 0x90, 0xf7, 0x67, 0x03, 0xfa, 0xcd, 0x89, 0xf1, 0x63, 0xc1, 0xfd, 0x92, 0x06,
+	0x2b, 0x39, 0xb5, 0xbe, 0xe8, 0x96, 0x3a, 0x9d, 0x66, 0x64, 0xc1, 0xb2, 0xfe, 0xfa, 0xc9, 0x3a,
+	0xc5, 0x8c, 0xf8, 0x30, 0x97, 0x29, 0x7f, 0x45, 0xd7, 0x73, 0xb3, 0xa7, 0x87, 0x0b, 0xb6, 0xf4,
+	0x2f, 0x4c, 0x86, 0x1c, 0xd3, 0xfb, 0x08, 0xaa, 0x51, 0xcd, 0x28, 0x52, 0xbf, 0x40, 0x64, 0x4a,
+	0x4a, 0xc7, 0xeb, 0x78, 0x2b, 0x5b, 0xc4, 0x99, 0xb3, 0x43, 0x73, 0x6a, 0x3d, 0xc7, 0x11, 0x38,
+	0x80, 0xd9, 0x74, 0x0d, 0x60, 0x9e, 0x8e, 0xab, 0xaa, 0x35, 0xf5, 0xeb, 0x13, 0xe1, 0xc6, 0xe2,
+	0x79, 0x0c, 0x73, 0x99, 0xfa, 0xbe, 0x9c, 0xe5, 0x50, 0x57, 0x01, 0x8e, 0x9b, 0xcb, 0xa7, 0x51,
+	0x41, 0xe3, 0x50, 0x4d, 0x1c, 0x5a, 0xcf, 0x67, 0x34, 0xaf, 0x5a, 0x4f, 0xbf, 0x75, 0xa2, 0x3e,
+	0xf1, 0x24, 0xf9, 0xc6, 0xce, 0xd4, 0xaf, 0xe5, 0x6e, 0x6c, 0x75, 0x9d, 0xdb, 0x44, 0xb6, 0x23,
+	0x53, 0x96, 0x96, 0x4b, 0x42, 0x5d, 0xbe, 0x36, 0x8e, 0x04, 0x0b, 0xf5, 0xa6, 0x2b, 0xc9, 0x72,
+	0x96, 0x4a, 0x5d, 0x6f, 0x36, 0x6e, 0xf8, 0x6f, 0x40, 0x33, 0x55, 0xf2, 0x95, 0x63, 0xbb, 0x55,
+	0x65, 0x61, 0xe3, 0x39, 0x6f, 0x24, 0x2b, 0xb3, 0xd0, 0x5a, 0xde, 0xa9, 0x30, 0x34, 0xf0, 0x49,
+	0x0e, 0x85, 0xad, 0xc1, 0xdf, 0xf9, 0xe4, 0x1f, 0x0a, 0x43, 0xa5, 0x28, 0x93, 0x1f, 0x0a, 0x89,
+	0xf1, 0x47, 0x1e, 0x0a, 0x27, 0x26, 0xf1, 0xa9, 0x08, 0xb2, 0x2a, 0xea, 0x76, 0x72, 0x36, 0xc9,
+	0xc8, 0x0a, 0x25, 0xfd, 0xd6, 0x89, 0xfa, 0xc4, 0x52, 0x3c, 0x80, 0xd9, 0x74, 0xf9, 0x49, 0x8e,
+	0x14, 0x95, 0x05, 0x3d, 0xfa, 0xf5, 0x89, 0x70, 0x93, 0x4b, 0x96, 0xae, 0xdb, 0xc8, 0x21, 0xa6,
+	0x2c, 0xee, 0x18, 0x27, 0xcf, 0x9f, 0x86, 0x46, 0xb2, 0x60, 0x23, 0x47, 0xdd, 0x14, 0x35, 0x1d,
+	0xe3, 0x06, 0xde, 0x87, 0x66, 0xaa, 0xb8, 0x22, 0x67, 0x8b, 0xa8, 0x6a, 0x39, 0xf4, 0x6b, 0x93,
+	0xa0, 0xc6, 0xf2, 0x19, 0xb8, 0x81, 0x71, 0xea, 0xff, 0x68, 0x37, 0x30, 0x5b, 0x21, 0x30, 0xc1,
+	0x29, 0x96, 0x2d, 0x85, 0xc8, 0x21, 0x90, 0x53, 0x31, 0x31, 0x01, 0x81, 0x6c, 0xf1, 0x42, 0x0e,
+	0x81, 0x9c, 0x1a, 0x87, 0x71, 0x04, 0x7e, 0x16, 0x6a, 0x71, 0xb9, 0x01, 0xba, 0x9a, 0x2b, 0xdd,
+	0x64, 0x51, 0x83, 0xfe, 0xd2, 0x38, 0xb4, 0x78, 0x01, 0xb6, 0x01, 0x06, 0x45, 0x06, 0xe8, 0xa5,
+	0x11, 0xa2, 0x4f, 0x64, 0xee, 0x8f, 0x63, 0xf9, 0x23, 0xa8, 0x46, 0x55, 0x05, 0x39, 0xbe, 0x48,
+	0xa6, 0xe8, 0x60, 0x82, 0x23, 0x21, 0x73, 0xe1, 0xc9, 0x39, 0x12, 0xd4, 0x95, 0x06, 0x13, 0xac,
+	0x61, 0xf6, 0x36, 0x94, 0xb3, 0x86, 0x39, 0x89, 0xf1, 0xe3, 0x08, 0xec, 0x40, 0x3d, 0x91, 0x26,
+	0x8e, 0x5e, 0x56, 0x1b, 0x91, 0xa1, 0x74, 0x75, 0x7d, 0x6d, 0x3c, 0x62, 0xbc, 0x92, 0x1f, 0x43,
+	0x3d, 0x91, 0xcb, 0x9b, 0x43, 0x63, 0x38, 0xdb, 0x77, 0x02, 0x5b, 0x90, 0xca, 0xdf, 0xcc, 0x3b,
+	0x2e, 0x15, 0x69, 0xb5, 0xfa, 0xb5, 0x49, 0x50, 0xe3, 0x09, 0xec, 0x43, 0x33, 0x95, 0x4d, 0x97,
+	0x43, 0x49, 0x95, 0x3c, 0xa8, 0x5f, 0x9b, 0x04, 0x35, 0xa6, 0xf4, 0x0b, 0x89, 0xc4, 0xbd, 0x54,
+	0x72, 0x24, 0x7a, 0x6d, 0xe4, 0x38, 0xaa, 0xdc, 0x50, 0x7d, 0xfd, 0x24, 0x5d, 0x62, 0x16, 0xbe,
+	0x06, 0xb5, 0x38, 0x27, 0x2f, 0x67, 0x57, 0x67, 0x73, 0xf6, 0xc6, 0xad, 0xd4, 0x36, 0x94, 0x45,
+	0x7e, 0x1c, 0x32, 0x72, 0x32, 0x61, 0x13, 0xc9, 0x73, 0xfa, 0x8b, 0x4a, 0x9c, 0x74, 0xea, 0x98,
+	0x71, 0x01, 0x99, 0x50, 0x16, 0x09, 0x0d, 0x39, 0x83, 0xa6, 0xb2, 0x88, 0xf4, 0xd1, 0x38, 0x22,
+	0x0b, 0xe2, 0x02, 0xda, 0x82, 0x12, 0x7f, 0xf8, 0x47, 0x57, 0x46, 0x25, 0x05, 0x8c, 0x1a, 0x31,
+	0x95, 0x37, 0x20, 0xa6, 0x2e, 0x1e, 0x53, 0x73, 0xb8, 0x4c, 0x3d, 0xed, 0xeb, 0x2f, 0x8e, 0xc4,
+	0x49, 0x3a, 0x0a, 0xe9, 0x27, 0x61, 0x94, 0xab, 0x65, 0xc3, 0xaf, 0xdb, 0xfa, 0xf5, 0x89, 0x70,
+	0x13, 0xd7, 0xb7, 0x12, 0x8f, 0xfe, 0xe5, 0xc8, 0x24, 0xf9, 0x82, 0xab, 0x8f, 0x44, 0x89, 0x84,
+	0xec, 0x40, 0x23, 0xf9, 0x2a, 0x92, 0xe3, 0x1c, 0x28, 0x1e, 0x94, 0xf4, 0x49, 0x30, 0x23, 0x2a,
+	0xec, 0x2f, 0x33, 0xf2, 0x02, 0xe8, 0x28, 0xf7, 0xea, 0x3c, 0xea, 0x15, 0x40, 0x7f, 0xe3, 0x84,
+	0xbd, 0x62, 0x11, 0x7e, 0x02, 0x0b, 0x8a, 0xb0, 0x2d, 0xba, 0x99, 0x37, 0x5e, 0x4e, 0xc4, 0x59,
+	0xff, 0xe2, 0xe4, 0x1d, 0x62, 0xda, 0x5b, 0x50, 0xe2, 0xe1, 0xd6, 0x9c, 0xe5, 0x4b, 0x46, 0x6f,
+	0x75, 0x63, 0x14, 0x4a, 0x3c, 0x22, 0x86, 0x46, 0x32, 0xf6, 0x9a, 0xb3, 0x7e, 0x8a, 0xb0, 0xad,
+	0xfe, 0xca, 0x04, 0x98, 0x09, 0x07, 0x0c, 0x06, 0xb1, 0xcf, 0x9c, 0xf3, 0x7f, 0x28, 0xfc, 0xaa,
+	0xbf, 0x3c, 0x16, 0x2f, 0x22, 0xb0, 0xde, 0x87, 0xc6, 0x16, 0xfb, 0xa7, 0xa1, 0x28, 0xf0, 0xf7,
+	0xf9, 0xcc, 0xeb, 0xce, 0x1b, 0x3f, 0x73, 0x6b, 0xcf, 0xa5, 0xfb, 0xfd, 0x1d, 0x66, 0x26, 0x6f,
+	0x0a, 0xdc, 0x57, 0xdd, 0x40, 0xfe, 0xba, 0xe9, 0xfa, 0x14, 0x87, 0xbe, 0xe5, 0xdd, 0xe4, 0x63,
+	0x49, 0x68, 0x6f, 0x67, 0xa7, 0xcc, 0xbf, 0x6f, 0xfd, 0x68, 0x00, 0xd2, 0xaa, 0x5f, 0xbc, 0xa9,
+	0x56, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4218,6 +6204,13 @@ type MilvusServiceClient interface {
 	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDDLHistory(ctx context.Context, in *ListDDLHistoryRequest, opts ...grpc.CallOption) (*ListDDLHistoryResponse, error)
+	AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDroppedCollections(ctx context.Context, in *ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*ListDroppedCollectionsResponse, error)
+	RecoverCollection(ctx context.Context, in *RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReshardCollection(ctx context.Context, in *ReshardCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
@@ -4225,6 +6218,18 @@ type MilvusServiceClient interface {
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
 	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
 	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
@@ -4233,6 +6238,8 @@ type MilvusServiceClient interface {
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
@@ -4244,143 +6251,314 @@ type MilvusServiceClient interface {
 	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
 }
 
-type milvusServiceClient struct {
-	cc *grpc.ClientConn
+type milvusServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMilvusServiceClient(cc *grpc.ClientConn) MilvusServiceClient {
+	return &milvusServiceClient{cc}
+}
+
+func (c *milvusServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleaseCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
+	out := new(DescribeCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
+	out := new(GetCollectionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCollectionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error) {
+	out := new(ShowCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDDLHistory(ctx context.Context, in *ListDDLHistoryRequest, opts ...grpc.CallOption) (*ListDDLHistoryResponse, error) {
+	out := new(ListDDLHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDDLHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDroppedCollections(ctx context.Context, in *ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*ListDroppedCollectionsResponse, error) {
+	out := new(ListDroppedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDroppedCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RecoverCollection(ctx context.Context, in *RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RecoverCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewMilvusServiceClient(cc *grpc.ClientConn) MilvusServiceClient {
-	return &milvusServiceClient{cc}
+func (c *milvusServiceClient) ReshardCollection(ctx context.Context, in *ReshardCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReshardCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *milvusServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCollection", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropCollection", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropPartition", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
 	out := new(BoolResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadCollection", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadPartitions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleaseCollection", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleasePartitions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
-	out := new(DescribeCollectionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeCollection", in, out, opts...)
+func (c *milvusServiceClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
+	out := new(GetPartitionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPartitionStatistics", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
-	out := new(GetCollectionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCollectionStatistics", in, out, opts...)
+func (c *milvusServiceClient) ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error) {
+	out := new(ShowPartitionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowPartitions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error) {
-	out := new(ShowCollectionsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowCollections", in, out, opts...)
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropPartition", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
-	out := new(BoolResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
+func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadPartitions", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *milvusServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleasePartitions", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
-	out := new(GetPartitionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPartitionStatistics", in, out, opts...)
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *milvusServiceClient) ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error) {
-	out := new(ShowPartitionsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowPartitions", in, out, opts...)
+func (c *milvusServiceClient) OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperatePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error) {
+	out := new(SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectGrant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4459,6 +6637,24 @@ func (c *milvusServiceClient) Flush(ctx context.Context, in *FlushRequest, opts
 	return out, nil
 }
 
+func (c *milvusServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error) {
 	out := new(QueryResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Query", in, out, opts...)
@@ -4532,6 +6728,13 @@ type MilvusServiceServer interface {
 	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
+	AddField(context.Context, *AddFieldRequest) (*commonpb.Status, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*commonpb.Status, error)
+	ListDDLHistory(context.Context, *ListDDLHistoryRequest) (*ListDDLHistoryResponse, error)
+	AlterCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
+	ListDroppedCollections(context.Context, *ListDroppedCollectionsRequest) (*ListDroppedCollectionsResponse, error)
+	RecoverCollection(context.Context, *RecoverCollectionRequest) (*commonpb.Status, error)
+	ReshardCollection(context.Context, *ReshardCollectionRequest) (*commonpb.Status, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(context.Context, *DropPartitionRequest) (*commonpb.Status, error)
 	HasPartition(context.Context, *HasPartitionRequest) (*BoolResponse, error)
@@ -4539,6 +6742,18 @@ type MilvusServiceServer interface {
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
 	ShowPartitions(context.Context, *ShowPartitionsRequest) (*ShowPartitionsResponse, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *OperateUserRoleRequest) (*commonpb.Status, error)
+	OperatePrivilege(context.Context, *OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *SelectGrantRequest) (*SelectGrantResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
@@ -4547,6 +6762,8 @@ type MilvusServiceServer interface {
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
@@ -4586,6 +6803,27 @@ func (*UnimplementedMilvusServiceServer) GetCollectionStatistics(ctx context.Con
 func (*UnimplementedMilvusServiceServer) ShowCollections(ctx context.Context, req *ShowCollectionsRequest) (*ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AddField(ctx context.Context, req *AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RenameCollection(ctx context.Context, req *RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDDLHistory(ctx context.Context, req *ListDDLHistoryRequest) (*ListDDLHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDDLHistory not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AlterCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDroppedCollections(ctx context.Context, req *ListDroppedCollectionsRequest) (*ListDroppedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDroppedCollections not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RecoverCollection(ctx context.Context, req *RecoverCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ReshardCollection(ctx context.Context, req *ReshardCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshardCollection not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreatePartition(ctx context.Context, req *CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -4607,6 +6845,42 @@ func (*UnimplementedMilvusServiceServer) GetPartitionStatistics(ctx context.Cont
 func (*UnimplementedMilvusServiceServer) ShowPartitions(ctx context.Context, req *ShowPartitionsRequest) (*ShowPartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowPartitions not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) UpdateCredential(ctx context.Context, req *UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, req *DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperateUserRole(ctx context.Context, req *OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperatePrivilege(ctx context.Context, req *OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectGrant(ctx context.Context, req *SelectGrantRequest) (*SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -4631,6 +6905,12 @@ func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *Search
 func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
@@ -4685,118 +6965,244 @@ func _MilvusService_DropCollection_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddField",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+		return srv.(MilvusServiceServer).AddField(ctx, req.(*AddFieldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HasCollectionRequest)
+func _MilvusService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+		return srv.(MilvusServiceServer).RenameCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RenameCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+		return srv.(MilvusServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadCollectionRequest)
+func _MilvusService_ListDDLHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDDLHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+		return srv.(MilvusServiceServer).ListDDLHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDDLHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+		return srv.(MilvusServiceServer).ListDDLHistory(ctx, req.(*ListDDLHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseCollectionRequest)
+func _MilvusService_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+		return srv.(MilvusServiceServer).AlterCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+		return srv.(MilvusServiceServer).AlterCollection(ctx, req.(*AlterCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeCollectionRequest)
+func _MilvusService_ListDroppedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDroppedCollectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+		return srv.(MilvusServiceServer).ListDroppedCollections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDroppedCollections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+		return srv.(MilvusServiceServer).ListDroppedCollections(ctx, req.(*ListDroppedCollectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionStatisticsRequest)
+func _MilvusService_RecoverCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).RecoverCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RecoverCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+		return srv.(MilvusServiceServer).RecoverCollection(ctx, req.(*RecoverCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowCollectionsRequest)
+func _MilvusService_ReshardCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReshardCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+		return srv.(MilvusServiceServer).ReshardCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReshardCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+		return srv.(MilvusServiceServer).ReshardCollection(ctx, req.(*ReshardCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4927,6 +7333,222 @@ func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, req.(*UpdateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, req.(*OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperatePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperatePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, req.(*OperatePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SelectGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SelectGrant(ctx, req.(*SelectGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateIndexRequest)
 	if err := dec(in); err != nil {
@@ -5071,6 +7693,42 @@ func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRequest)
 	if err := dec(in); err != nil {
@@ -5233,6 +7891,34 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _MilvusService_ShowCollections_Handler,
 		},
+		{
+			MethodName: "AddField",
+			Handler:    _MilvusService_AddField_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _MilvusService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "ListDDLHistory",
+			Handler:    _MilvusService_ListDDLHistory_Handler,
+		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _MilvusService_AlterCollection_Handler,
+		},
+		{
+			MethodName: "ListDroppedCollections",
+			Handler:    _MilvusService_ListDroppedCollections_Handler,
+		},
+		{
+			MethodName: "RecoverCollection",
+			Handler:    _MilvusService_RecoverCollection_Handler,
+		},
+		{
+			MethodName: "ReshardCollection",
+			Handler:    _MilvusService_ReshardCollection_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _MilvusService_CreatePartition_Handler,
@@ -5261,6 +7947,54 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowPartitions",
 			Handler:    _MilvusService_ShowPartitions_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _MilvusService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _MilvusService_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _MilvusService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _MilvusService_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _MilvusService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _MilvusService_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _MilvusService_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _MilvusService_SelectGrant_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _MilvusService_CreateIndex_Handler,
@@ -5293,6 +8027,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Flush",
 			Handler:    _MilvusService_Flush_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _MilvusService_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _MilvusService_GetExportState_Handler,
+		},
 		{
 			MethodName: "Query",
 			Handler:    _MilvusService_Query_Handler,
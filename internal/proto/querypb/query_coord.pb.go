@@ -220,6 +220,7 @@ type ShowCollectionsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	CollectionIDs        []int64          `protobuf:"varint,2,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
 	InMemoryPercentages  []int64          `protobuf:"varint,3,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	LoadedFields         []*LoadedFields  `protobuf:"bytes,4,rep,name=loaded_fields,json=loadedFields,proto3" json:"loaded_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -271,6 +272,54 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
+func (m *ShowCollectionsResponse) GetLoadedFields() []*LoadedFields {
+	if m != nil {
+		return m.LoadedFields
+	}
+	return nil
+}
+
+// LoadedFields lists the fields of a collection resident in query nodes,
+// an empty fieldIDs means every field is loaded
+type LoadedFields struct {
+	FieldIDs             []int64  `protobuf:"varint,1,rep,packed,name=fieldIDs,proto3" json:"fieldIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadedFields) Reset()         { *m = LoadedFields{} }
+func (m *LoadedFields) String() string { return proto.CompactTextString(m) }
+func (*LoadedFields) ProtoMessage()    {}
+func (*LoadedFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
+}
+
+func (m *LoadedFields) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadedFields.Unmarshal(m, b)
+}
+func (m *LoadedFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadedFields.Marshal(b, m, deterministic)
+}
+func (m *LoadedFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadedFields.Merge(m, src)
+}
+func (m *LoadedFields) XXX_Size() int {
+	return xxx_messageInfo_LoadedFields.Size(m)
+}
+func (m *LoadedFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadedFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadedFields proto.InternalMessageInfo
+
+func (m *LoadedFields) GetFieldIDs() []int64 {
+	if m != nil {
+		return m.FieldIDs
+	}
+	return nil
+}
+
 type ShowPartitionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -285,7 +334,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -347,7 +396,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -394,6 +443,7 @@ type LoadCollectionRequest struct {
 	DbID                 int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,5,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -403,7 +453,7 @@ func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
 func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadCollectionRequest) ProtoMessage()    {}
 func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
 }
 
 func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -452,6 +502,13 @@ func (m *LoadCollectionRequest) GetSchema() *schemapb.CollectionSchema {
 	return nil
 }
 
+func (m *LoadCollectionRequest) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -466,7 +523,7 @@ func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionReque
 func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseCollectionRequest) ProtoMessage()    {}
 func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
 }
 
 func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -515,12 +572,78 @@ func (m *ReleaseCollectionRequest) GetNodeID() int64 {
 	return 0
 }
 
+// the channels of a loaded collection are watched again after it is resharded, the retired
+// channels are handed off and removed once the segments on them are flushed
+type ReshardCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	RetiredChannels      []string          `protobuf:"bytes,4,rep,name=retired_channels,json=retiredChannels,proto3" json:"retired_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReshardCollectionRequest) Reset()         { *m = ReshardCollectionRequest{} }
+func (m *ReshardCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReshardCollectionRequest) ProtoMessage()    {}
+func (*ReshardCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+}
+
+func (m *ReshardCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReshardCollectionRequest.Unmarshal(m, b)
+}
+func (m *ReshardCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReshardCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *ReshardCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshardCollectionRequest.Merge(m, src)
+}
+func (m *ReshardCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReshardCollectionRequest.Size(m)
+}
+func (m *ReshardCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshardCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshardCollectionRequest proto.InternalMessageInfo
+
+func (m *ReshardCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReshardCollectionRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *ReshardCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReshardCollectionRequest) GetRetiredChannels() []string {
+	if m != nil {
+		return m.RetiredChannels
+	}
+	return nil
+}
+
 type LoadPartitionsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionIDs         []int64                    `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,6,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -530,7 +653,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -586,6 +709,13 @@ func (m *LoadPartitionsRequest) GetSchema() *schemapb.CollectionSchema {
 	return nil
 }
 
+func (m *LoadPartitionsRequest) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
+}
+
 type ReleasePartitionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -601,7 +731,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -669,7 +799,7 @@ func (m *CreateQueryChannelRequest) Reset()         { *m = CreateQueryChannelReq
 func (m *CreateQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateQueryChannelRequest) ProtoMessage()    {}
 func (*CreateQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
 }
 
 func (m *CreateQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -717,7 +847,7 @@ func (m *CreateQueryChannelResponse) Reset()         { *m = CreateQueryChannelRe
 func (m *CreateQueryChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateQueryChannelResponse) ProtoMessage()    {}
 func (*CreateQueryChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
 }
 
 func (m *CreateQueryChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -773,7 +903,7 @@ func (m *GetPartitionStatesRequest) Reset()         { *m = GetPartitionStatesReq
 func (m *GetPartitionStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesRequest) ProtoMessage()    {}
 func (*GetPartitionStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
 }
 
 func (m *GetPartitionStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -835,7 +965,7 @@ func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
 func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
 func (*PartitionStates) ProtoMessage()    {}
 func (*PartitionStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
 }
 
 func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
@@ -889,7 +1019,7 @@ func (m *GetPartitionStatesResponse) Reset()         { *m = GetPartitionStatesRe
 func (m *GetPartitionStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesResponse) ProtoMessage()    {}
 func (*GetPartitionStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
 }
 
 func (m *GetPartitionStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -936,7 +1066,7 @@ func (m *GetSegmentInfoRequest) Reset()         { *m = GetSegmentInfoRequest{} }
 func (m *GetSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoRequest) ProtoMessage()    {}
 func (*GetSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
 }
 
 func (m *GetSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -991,7 +1121,7 @@ func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1094,7 +1224,7 @@ func (m *GetSegmentInfoResponse) Reset()         { *m = GetSegmentInfoResponse{}
 func (m *GetSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoResponse) ProtoMessage()    {}
 func (*GetSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
 }
 
 func (m *GetSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1145,7 +1275,7 @@ func (m *AddQueryChannelRequest) Reset()         { *m = AddQueryChannelRequest{}
 func (m *AddQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AddQueryChannelRequest) ProtoMessage()    {}
 func (*AddQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
 }
 
 func (m *AddQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1216,7 +1346,7 @@ func (m *RemoveQueryChannelRequest) Reset()         { *m = RemoveQueryChannelReq
 func (m *RemoveQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveQueryChannelRequest) ProtoMessage()    {}
 func (*RemoveQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
 }
 
 func (m *RemoveQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1289,7 +1419,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1367,6 +1497,8 @@ type SegmentLoadInfo struct {
 	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
 	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	Deltalogs            []string              `protobuf:"bytes,7,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	NumOfRows            int64                 `protobuf:"varint,8,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1376,7 +1508,7 @@ func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1439,12 +1571,27 @@ func (m *SegmentLoadInfo) GetBinlogPaths() []*datapb.FieldBinlog {
 	return nil
 }
 
+func (m *SegmentLoadInfo) GetDeltalogs() []string {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                      `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Infos                []*SegmentLoadInfo         `protobuf:"bytes,3,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	LoadCondition        TriggerCondition           `protobuf:"varint,5,opt,name=load_condition,json=loadCondition,proto3,enum=milvus.proto.query.TriggerCondition" json:"load_condition,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,6,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1454,7 +1601,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1510,6 +1657,13 @@ func (m *LoadSegmentsRequest) GetLoadCondition() TriggerCondition {
 	return TriggerCondition_handoff
 }
 
+func (m *LoadSegmentsRequest) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
+}
+
 type ReleaseSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1526,7 +1680,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1589,6 +1743,108 @@ func (m *ReleaseSegmentsRequest) GetSegmentIDs() []int64 {
 	return nil
 }
 
+type DrainRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DrainRequest) Reset()         { *m = DrainRequest{} }
+func (m *DrainRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()    {}
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+}
+
+func (m *DrainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainRequest.Unmarshal(m, b)
+}
+func (m *DrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainRequest.Marshal(b, m, deterministic)
+}
+func (m *DrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainRequest.Merge(m, src)
+}
+func (m *DrainRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainRequest.Size(m)
+}
+func (m *DrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainRequest proto.InternalMessageInfo
+
+func (m *DrainRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type RemoveDmChannelsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channels             []string          `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RemoveDmChannelsRequest) Reset()         { *m = RemoveDmChannelsRequest{} }
+func (m *RemoveDmChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDmChannelsRequest) ProtoMessage()    {}
+func (*RemoveDmChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+}
+
+func (m *RemoveDmChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDmChannelsRequest.Unmarshal(m, b)
+}
+func (m *RemoveDmChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDmChannelsRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveDmChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDmChannelsRequest.Merge(m, src)
+}
+func (m *RemoveDmChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveDmChannelsRequest.Size(m)
+}
+func (m *RemoveDmChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDmChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDmChannelsRequest proto.InternalMessageInfo
+
+func (m *RemoveDmChannelsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RemoveDmChannelsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *RemoveDmChannelsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RemoveDmChannelsRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
 type DmChannelInfo struct {
 	NodeIDLoaded         int64    `protobuf:"varint,1,opt,name=nodeID_loaded,json=nodeIDLoaded,proto3" json:"nodeID_loaded,omitempty"`
 	ChannelIDs           []string `protobuf:"bytes,2,rep,name=channelIDs,proto3" json:"channelIDs,omitempty"`
@@ -1601,7 +1857,7 @@ func (m *DmChannelInfo) Reset()         { *m = DmChannelInfo{} }
 func (m *DmChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelInfo) ProtoMessage()    {}
 func (*DmChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
 }
 
 func (m *DmChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1649,7 +1905,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1700,6 +1956,7 @@ type CollectionInfo struct {
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 	ReleasedPartitionIDs []int64                    `protobuf:"varint,7,rep,packed,name=released_partitionIDs,json=releasedPartitionIDs,proto3" json:"released_partitionIDs,omitempty"`
 	InMemoryPercentage   int64                      `protobuf:"varint,8,opt,name=inMemory_percentage,json=inMemoryPercentage,proto3" json:"inMemory_percentage,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,9,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1709,7 +1966,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -1786,6 +2043,13 @@ func (m *CollectionInfo) GetInMemoryPercentage() int64 {
 	return 0
 }
 
+func (m *CollectionInfo) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
+}
+
 type HandoffSegments struct {
 	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Infos                []*SegmentLoadInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
@@ -1798,7 +2062,7 @@ func (m *HandoffSegments) Reset()         { *m = HandoffSegments{} }
 func (m *HandoffSegments) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegments) ProtoMessage()    {}
 func (*HandoffSegments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *HandoffSegments) XXX_Unmarshal(b []byte) error {
@@ -1851,7 +2115,7 @@ func (m *LoadBalanceSegmentInfo) Reset()         { *m = LoadBalanceSegmentInfo{}
 func (m *LoadBalanceSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentInfo) ProtoMessage()    {}
 func (*LoadBalanceSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *LoadBalanceSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1941,7 +2205,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -1990,10 +2254,12 @@ func init() {
 	proto.RegisterEnum("milvus.proto.query.LoadType", LoadType_name, LoadType_value)
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.query.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.query.ShowCollectionsResponse")
+	proto.RegisterType((*LoadedFields)(nil), "milvus.proto.query.LoadedFields")
 	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.query.ShowPartitionsRequest")
 	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.query.ShowPartitionsResponse")
 	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.query.LoadCollectionRequest")
 	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.query.ReleaseCollectionRequest")
+	proto.RegisterType((*ReshardCollectionRequest)(nil), "milvus.proto.query.ReshardCollectionRequest")
 	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.query.LoadPartitionsRequest")
 	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.query.ReleasePartitionsRequest")
 	proto.RegisterType((*CreateQueryChannelRequest)(nil), "milvus.proto.query.CreateQueryChannelRequest")
@@ -2010,6 +2276,8 @@ func init() {
 	proto.RegisterType((*SegmentLoadInfo)(nil), "milvus.proto.query.SegmentLoadInfo")
 	proto.RegisterType((*LoadSegmentsRequest)(nil), "milvus.proto.query.LoadSegmentsRequest")
 	proto.RegisterType((*ReleaseSegmentsRequest)(nil), "milvus.proto.query.ReleaseSegmentsRequest")
+	proto.RegisterType((*DrainRequest)(nil), "milvus.proto.query.DrainRequest")
+	proto.RegisterType((*RemoveDmChannelsRequest)(nil), "milvus.proto.query.RemoveDmChannelsRequest")
 	proto.RegisterType((*DmChannelInfo)(nil), "milvus.proto.query.DmChannelInfo")
 	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
@@ -2021,130 +2289,143 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 1958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x48, 0xb2, 0x2c, 0x3d, 0xeb, 0xcf, 0xa4, 0x13, 0x1b, 0x45, 0x24, 0xbb, 0x66, 0xb2,
-	0xd9, 0x64, 0xbd, 0xac, 0xbd, 0xab, 0x2c, 0x55, 0xe4, 0xc0, 0x61, 0x63, 0x6d, 0x8c, 0x60, 0xe3,
-	0x35, 0x63, 0xb3, 0x14, 0xa9, 0x54, 0x0d, 0xa3, 0x99, 0xb6, 0x34, 0xb5, 0x33, 0xd3, 0xca, 0xf4,
-	0x28, 0x8e, 0x73, 0xe0, 0xc4, 0x57, 0xe0, 0x04, 0x45, 0x15, 0x55, 0xfc, 0x29, 0x0e, 0x7c, 0x01,
-	0x4e, 0x7b, 0xe1, 0xce, 0x17, 0x80, 0x2a, 0x0a, 0x6e, 0x1c, 0xf8, 0x0a, 0x54, 0xf7, 0xf4, 0x8c,
-	0xe6, 0x4f, 0xcb, 0x56, 0x6c, 0x4c, 0x52, 0x29, 0x6e, 0xea, 0xd7, 0x6f, 0xde, 0xff, 0xfe, 0xf5,
-	0xeb, 0x27, 0xb8, 0xf2, 0x74, 0x8a, 0x83, 0x13, 0xc3, 0x22, 0x24, 0xb0, 0xb7, 0x26, 0x01, 0x09,
-	0x09, 0x42, 0x9e, 0xe3, 0x3e, 0x9b, 0xd2, 0x68, 0xb5, 0xc5, 0xf7, 0xbb, 0x0d, 0x8b, 0x78, 0x1e,
-	0xf1, 0x23, 0x5a, 0xb7, 0x91, 0xe6, 0xe8, 0xb6, 0x1c, 0x3f, 0xc4, 0x81, 0x6f, 0xba, 0xf1, 0x2e,
-	0xb5, 0xc6, 0xd8, 0x33, 0xc5, 0x4a, 0xb5, 0xcd, 0xd0, 0x4c, 0xcb, 0xd7, 0x7e, 0xa6, 0xc0, 0xfa,
-	0xc1, 0x98, 0x1c, 0xef, 0x10, 0xd7, 0xc5, 0x56, 0xe8, 0x10, 0x9f, 0xea, 0xf8, 0xe9, 0x14, 0xd3,
-	0x10, 0x7d, 0x08, 0x95, 0xa1, 0x49, 0x71, 0x47, 0xd9, 0x50, 0xee, 0xae, 0xf6, 0x6e, 0x6c, 0x65,
-	0x2c, 0x11, 0x26, 0x3c, 0xa2, 0xa3, 0x07, 0x26, 0xc5, 0x3a, 0xe7, 0x44, 0x08, 0x2a, 0xf6, 0x70,
-	0xd0, 0xef, 0x94, 0x36, 0x94, 0xbb, 0x65, 0x9d, 0xff, 0x46, 0xef, 0x40, 0xd3, 0x4a, 0x64, 0x0f,
-	0xfa, 0xb4, 0x53, 0xde, 0x28, 0xdf, 0x2d, 0xeb, 0x59, 0xa2, 0xf6, 0x7b, 0x05, 0xbe, 0x56, 0x30,
-	0x83, 0x4e, 0x88, 0x4f, 0x31, 0xba, 0x07, 0x55, 0x1a, 0x9a, 0xe1, 0x94, 0x0a, 0x4b, 0xbe, 0x2e,
-	0xb5, 0xe4, 0x80, 0xb3, 0xe8, 0x82, 0xb5, 0xa8, 0xb6, 0x24, 0x51, 0x8b, 0x3e, 0x82, 0x6b, 0x8e,
-	0xff, 0x08, 0x7b, 0x24, 0x38, 0x31, 0x26, 0x38, 0xb0, 0xb0, 0x1f, 0x9a, 0x23, 0x1c, 0xdb, 0x78,
-	0x35, 0xde, 0xdb, 0x9f, 0x6d, 0x69, 0xbf, 0x55, 0x60, 0x8d, 0x59, 0xba, 0x6f, 0x06, 0xa1, 0x73,
-	0x09, 0xf1, 0xd2, 0xa0, 0x91, 0xb6, 0xb1, 0x53, 0xe6, 0x7b, 0x19, 0x1a, 0xe3, 0x99, 0xc4, 0xea,
-	0x99, 0x6f, 0x15, 0x6e, 0x6e, 0x86, 0xa6, 0xfd, 0x46, 0x24, 0x36, 0x6d, 0xe7, 0x45, 0x02, 0x9a,
-	0xd7, 0x59, 0x2a, 0xea, 0x3c, 0x4f, 0x38, 0xbf, 0x52, 0x60, 0xed, 0x33, 0x62, 0xda, 0xb3, 0xc4,
-	0xff, 0xef, 0xc3, 0xf9, 0x1d, 0xa8, 0x46, 0xa7, 0xa4, 0x53, 0xe1, 0xba, 0x6e, 0x67, 0x75, 0x89,
-	0x13, 0x34, 0xb3, 0xf0, 0x80, 0x13, 0x74, 0xf1, 0x91, 0xf6, 0x4b, 0x05, 0x3a, 0x3a, 0x76, 0xb1,
-	0x49, 0xf1, 0xab, 0xf4, 0x62, 0x1d, 0xaa, 0x3e, 0xb1, 0xf1, 0xa0, 0xcf, 0xbd, 0x28, 0xeb, 0x62,
-	0xa5, 0xfd, 0x53, 0x44, 0xf8, 0x35, 0x2f, 0xd8, 0x54, 0x16, 0x96, 0xcf, 0x93, 0x85, 0xaf, 0x66,
-	0x59, 0x78, 0xdd, 0x3d, 0x9d, 0x65, 0x6a, 0x39, 0x93, 0xa9, 0x1f, 0xc3, 0xf5, 0x9d, 0x00, 0x9b,
-	0x21, 0xfe, 0x01, 0x83, 0xf9, 0x9d, 0xb1, 0xe9, 0xfb, 0xd8, 0x8d, 0x5d, 0xc8, 0x2b, 0x57, 0x24,
-	0xca, 0x3b, 0xb0, 0x32, 0x09, 0xc8, 0xf3, 0x93, 0xc4, 0xee, 0x78, 0xa9, 0xfd, 0x5a, 0x81, 0xae,
-	0x4c, 0xf6, 0x45, 0x10, 0xe1, 0x0e, 0xb4, 0x83, 0xc8, 0x38, 0xc3, 0x8a, 0xe4, 0x71, 0xad, 0x75,
-	0xbd, 0x25, 0xc8, 0x42, 0x0b, 0xba, 0x0d, 0xad, 0x00, 0xd3, 0xa9, 0x3b, 0xe3, 0x2b, 0x73, 0xbe,
-	0x66, 0x44, 0x15, 0x6c, 0xda, 0x1f, 0x14, 0xb8, 0xbe, 0x8b, 0xc3, 0x24, 0x7b, 0x4c, 0x1d, 0x7e,
-	0x4d, 0xd1, 0xf5, 0x57, 0x0a, 0xb4, 0x73, 0x86, 0xa2, 0x0d, 0x58, 0x4d, 0xf1, 0x88, 0x04, 0xa5,
-	0x49, 0xe8, 0xdb, 0xb0, 0xcc, 0x62, 0x87, 0xb9, 0x49, 0xad, 0x9e, 0xb6, 0x55, 0xbc, 0xdc, 0xb7,
-	0xb2, 0x52, 0xf5, 0xe8, 0x03, 0xb4, 0x0d, 0x57, 0x25, 0xc8, 0x2a, 0xcc, 0x47, 0x45, 0x60, 0xd5,
-	0xfe, 0xa8, 0x40, 0x57, 0x16, 0xcc, 0x8b, 0x24, 0xfc, 0x31, 0xac, 0x27, 0xde, 0x18, 0x36, 0xa6,
-	0x56, 0xe0, 0x4c, 0xf8, 0x31, 0xe3, 0x97, 0xc1, 0x6a, 0xef, 0xd6, 0xd9, 0xfe, 0x50, 0x7d, 0x2d,
-	0x11, 0xd1, 0x4f, 0x49, 0xd0, 0x1c, 0x58, 0xdb, 0xc5, 0xe1, 0x01, 0x1e, 0x79, 0xd8, 0x0f, 0x07,
-	0xfe, 0x11, 0x39, 0x7f, 0xde, 0xdf, 0x02, 0xa0, 0x42, 0x4e, 0x72, 0x4f, 0xa5, 0x28, 0xda, 0x5f,
-	0x4b, 0xb0, 0x9a, 0x52, 0x84, 0x6e, 0x40, 0x3d, 0xd9, 0x15, 0x59, 0x9b, 0x11, 0x0a, 0x15, 0x53,
-	0x92, 0x54, 0x4c, 0x2e, 0xf3, 0xe5, 0x62, 0xe6, 0xe7, 0x80, 0x33, 0xba, 0x0e, 0x35, 0x0f, 0x7b,
-	0x06, 0x75, 0x5e, 0x60, 0x01, 0x06, 0x2b, 0x1e, 0xf6, 0x0e, 0x9c, 0x17, 0x98, 0x6d, 0xf9, 0x53,
-	0xcf, 0x08, 0xc8, 0x31, 0xed, 0x54, 0xa3, 0x2d, 0x7f, 0xea, 0xe9, 0xe4, 0x98, 0xa2, 0x9b, 0x00,
-	0x8e, 0x6f, 0xe3, 0xe7, 0x86, 0x6f, 0x7a, 0xb8, 0xb3, 0xc2, 0x0f, 0x53, 0x9d, 0x53, 0xf6, 0x4c,
-	0x0f, 0x33, 0x18, 0xe0, 0x8b, 0x41, 0xbf, 0x53, 0x8b, 0x3e, 0x14, 0x4b, 0xe6, 0xaa, 0x38, 0x82,
-	0x83, 0x7e, 0xa7, 0x1e, 0x7d, 0x97, 0x10, 0xd0, 0xa7, 0xd0, 0x14, 0x7e, 0x1b, 0x51, 0x99, 0x02,
-	0x2f, 0xd3, 0x0d, 0x59, 0x5a, 0x45, 0x00, 0xa3, 0x22, 0x6d, 0xd0, 0xd4, 0x8a, 0xb7, 0x94, 0xf9,
-	0x5c, 0x5e, 0xa4, 0xec, 0xbe, 0x05, 0xcb, 0x8e, 0x7f, 0x44, 0xe2, 0x2a, 0x7b, 0xfb, 0x14, 0x73,
-	0xb8, 0xb2, 0x88, 0x5b, 0xfb, 0x9b, 0x02, 0xeb, 0x9f, 0xd8, 0xb6, 0x0c, 0x4b, 0x5f, 0xbe, 0xa6,
-	0x66, 0xf9, 0x2b, 0x65, 0xf2, 0xb7, 0x08, 0x9e, 0xbc, 0x0f, 0x57, 0x72, 0x38, 0x29, 0xca, 0xa0,
-	0xae, 0xab, 0x59, 0xa4, 0x1c, 0xf4, 0xd1, 0x7b, 0xa0, 0x66, 0xb1, 0x52, 0xdc, 0x12, 0x75, 0xbd,
-	0x9d, 0x41, 0xcb, 0x41, 0x5f, 0xfb, 0xbb, 0x02, 0xd7, 0x75, 0xec, 0x91, 0x67, 0xf8, 0xcd, 0xf5,
-	0xf1, 0x1f, 0x25, 0x58, 0xff, 0x91, 0x19, 0x5a, 0xe3, 0xbe, 0x27, 0x88, 0xf4, 0xd5, 0x38, 0x98,
-	0x3b, 0xe2, 0x95, 0xe2, 0x11, 0x4f, 0xca, 0x74, 0x59, 0x56, 0xa6, 0xec, 0xe1, 0xb5, 0xf5, 0x45,
-	0xec, 0xef, 0xac, 0x4c, 0x53, 0x6d, 0x4f, 0xf5, 0x1c, 0x6d, 0x0f, 0xda, 0x81, 0x26, 0x7e, 0x6e,
-	0xb9, 0x53, 0x1b, 0x1b, 0x91, 0xf6, 0x15, 0xae, 0xfd, 0x2d, 0x89, 0xf6, 0xf4, 0x19, 0x69, 0x88,
-	0x8f, 0x06, 0xfc, 0xa8, 0xfc, 0x4b, 0x81, 0xb6, 0xd8, 0x65, 0x9d, 0xe2, 0x02, 0xa8, 0x98, 0x0b,
-	0x47, 0xa9, 0x18, 0x8e, 0x45, 0x82, 0x1a, 0xdf, 0xd0, 0x95, 0xd4, 0x0d, 0x7d, 0x13, 0xe0, 0xc8,
-	0x9d, 0xd2, 0xb1, 0x11, 0x3a, 0x5e, 0x8c, 0x89, 0x75, 0x4e, 0x39, 0x74, 0x3c, 0x8c, 0x3e, 0x81,
-	0xc6, 0xd0, 0xf1, 0x5d, 0x32, 0x32, 0x26, 0x66, 0x38, 0x66, 0xc8, 0x38, 0xcf, 0xdd, 0x87, 0x0e,
-	0x76, 0xed, 0x07, 0x9c, 0x57, 0x5f, 0x8d, 0xbe, 0xd9, 0x67, 0x9f, 0x68, 0xbf, 0x2b, 0xc1, 0x55,
-	0xe6, 0xa6, 0xf0, 0xf8, 0x12, 0x0a, 0xea, 0x7e, 0x5c, 0x0a, 0xe5, 0xf9, 0xf7, 0x62, 0x2e, 0xde,
-	0xc5, 0x72, 0x38, 0xcf, 0x5b, 0x04, 0x7d, 0x1f, 0x5a, 0x2e, 0x31, 0x6d, 0xc3, 0x22, 0xbe, 0xcd,
-	0x33, 0xc1, 0x23, 0xd8, 0xea, 0xbd, 0x23, 0x33, 0xe1, 0x30, 0x70, 0x46, 0x23, 0x1c, 0xec, 0xc4,
-	0xbc, 0x7a, 0xd3, 0xe5, 0x2f, 0x31, 0xb1, 0xe4, 0x08, 0x2a, 0x5a, 0xea, 0xcb, 0x8b, 0x55, 0x5c,
-	0x03, 0xe5, 0x53, 0xba, 0xb4, 0xca, 0x02, 0x5d, 0xda, 0xb2, 0xa4, 0xd1, 0xce, 0x76, 0x02, 0xd5,
-	0x42, 0x27, 0x70, 0x08, 0xcd, 0x04, 0x57, 0x78, 0xd1, 0xdf, 0x82, 0x66, 0x64, 0x96, 0xc1, 0x22,
-	0x81, 0xed, 0xb8, 0xcb, 0x8e, 0x88, 0x9f, 0x71, 0x1a, 0x93, 0x9a, 0xe0, 0x56, 0x74, 0x29, 0xd5,
-	0xf5, 0x14, 0x45, 0xfb, 0xb9, 0x02, 0x6a, 0x1a, 0x91, 0xb9, 0xe4, 0x45, 0xda, 0xf7, 0x3b, 0xd0,
-	0x16, 0x03, 0xa0, 0x04, 0x16, 0x45, 0x43, 0xfd, 0x34, 0x2d, 0xae, 0x8f, 0x3e, 0x86, 0xf5, 0x88,
-	0xb1, 0x00, 0xa3, 0x51, 0x63, 0x7d, 0x8d, 0xef, 0xea, 0x39, 0x2c, 0xfd, 0x4b, 0x19, 0x5a, 0xb3,
-	0xc2, 0x59, 0xd8, 0xaa, 0x45, 0x1e, 0xfe, 0x7b, 0xa0, 0xce, 0x3a, 0x43, 0xde, 0x3b, 0x9c, 0x5a,
-	0xfb, 0xf9, 0x9e, 0xb0, 0x3d, 0xc9, 0xb5, 0xd2, 0x0f, 0xa1, 0x29, 0x7c, 0x12, 0xa8, 0x56, 0xe1,
-	0xc2, 0xbe, 0x21, 0x13, 0x96, 0xc9, 0xa0, 0xde, 0x48, 0x41, 0x2c, 0x45, 0xf7, 0xa1, 0xce, 0x8f,
-	0x43, 0x78, 0x32, 0xc1, 0xe2, 0x24, 0xdc, 0x90, 0xc9, 0x60, 0x99, 0x3d, 0x3c, 0x99, 0x60, 0xbd,
-	0xe6, 0x8a, 0x5f, 0x17, 0xc5, 0xe5, 0x7b, 0xb0, 0x16, 0x44, 0x47, 0xc7, 0x36, 0x32, 0xe1, 0x5b,
-	0xe1, 0xe1, 0xbb, 0x16, 0x6f, 0xee, 0xa7, 0xc3, 0x38, 0xa7, 0xcb, 0xaf, 0xcd, 0xed, 0xf2, 0x7f,
-	0x0a, 0xed, 0xef, 0x9a, 0xbe, 0x4d, 0x8e, 0x8e, 0xe2, 0x03, 0x7a, 0x8e, 0x93, 0x79, 0x3f, 0xdb,
-	0x5f, 0xbd, 0x04, 0x5a, 0x69, 0xbf, 0x28, 0xc1, 0x3a, 0xa3, 0x3d, 0x30, 0x5d, 0xd3, 0xb7, 0xf0,
-	0xe2, 0x5d, 0xf5, 0x7f, 0xe7, 0xfe, 0xb8, 0x05, 0x4d, 0x4a, 0xa6, 0x81, 0x85, 0x8d, 0x4c, 0x73,
-	0xdd, 0x88, 0x88, 0x7b, 0x11, 0xc0, 0xdc, 0x04, 0xb0, 0x69, 0x68, 0x64, 0x5e, 0xdc, 0x75, 0x9b,
-	0x86, 0x62, 0xfb, 0x6d, 0x58, 0x15, 0x32, 0x6c, 0xe2, 0x63, 0x9e, 0xec, 0x9a, 0x0e, 0x11, 0xa9,
-	0x4f, 0x7c, 0xde, 0x87, 0xb3, 0xef, 0xf9, 0xee, 0x0a, 0xdf, 0x5d, 0xb1, 0x69, 0xc8, 0xb7, 0x6e,
-	0x02, 0x3c, 0x33, 0x5d, 0xc7, 0xe6, 0x45, 0xca, 0xd3, 0x54, 0xd3, 0xeb, 0x9c, 0xc2, 0x42, 0xa0,
-	0xfd, 0x49, 0x01, 0x94, 0x8a, 0xce, 0xf9, 0xb1, 0xf3, 0x36, 0xb4, 0x32, 0x7e, 0x26, 0xd3, 0xcc,
-	0xb4, 0xa3, 0x94, 0x81, 0xff, 0x30, 0x52, 0x65, 0x04, 0xd8, 0xa4, 0xc4, 0xe7, 0x41, 0x5b, 0x18,
-	0xfc, 0x87, 0xb1, 0x99, 0xec, 0xd3, 0xcd, 0x17, 0xd0, 0xca, 0x1e, 0x53, 0xd4, 0x80, 0xda, 0x1e,
-	0x09, 0x3f, 0x7d, 0xee, 0xd0, 0x50, 0x5d, 0x42, 0x2d, 0x80, 0x3d, 0x12, 0xee, 0x07, 0x98, 0x62,
-	0x3f, 0x54, 0x15, 0x04, 0x50, 0xfd, 0xdc, 0xef, 0x3b, 0xf4, 0x4b, 0xb5, 0x84, 0xae, 0x8a, 0xc7,
-	0xb1, 0xe9, 0x0e, 0x44, 0xcd, 0xaa, 0x65, 0xf6, 0x79, 0xb2, 0xaa, 0x20, 0x15, 0x1a, 0x09, 0xcb,
-	0xee, 0xfe, 0x0f, 0xd5, 0x65, 0x54, 0x87, 0xe5, 0xe8, 0x67, 0x75, 0xf3, 0x73, 0x50, 0xf3, 0xe6,
-	0xa1, 0x55, 0x58, 0x19, 0x47, 0xa5, 0xae, 0x2e, 0xa1, 0x36, 0xac, 0xba, 0xb3, 0xc0, 0xaa, 0x0a,
-	0x23, 0x8c, 0x82, 0x89, 0x25, 0x42, 0xac, 0x96, 0x98, 0x36, 0x16, 0xab, 0x3e, 0x39, 0xf6, 0xd5,
-	0xf2, 0xe6, 0xf7, 0xa0, 0x91, 0x7e, 0xb0, 0xa0, 0x1a, 0x54, 0xf6, 0x88, 0x8f, 0xd5, 0x25, 0x26,
-	0x76, 0x37, 0x20, 0xc7, 0x8e, 0x3f, 0x8a, 0x7c, 0x78, 0x18, 0x90, 0x17, 0xd8, 0x57, 0x4b, 0x6c,
-	0x83, 0x62, 0xd3, 0x65, 0x1b, 0x65, 0xb6, 0xc1, 0x16, 0xd8, 0x56, 0x2b, 0x9b, 0x1f, 0x41, 0x2d,
-	0x86, 0x0b, 0x74, 0x05, 0x9a, 0x99, 0xd1, 0x9a, 0xba, 0x84, 0x50, 0x74, 0x03, 0xcf, 0x80, 0x41,
-	0x55, 0x7a, 0xff, 0x06, 0x80, 0xe8, 0x46, 0x20, 0x24, 0xb0, 0xd1, 0x04, 0xd0, 0x2e, 0x0e, 0x77,
-	0x88, 0x37, 0x21, 0x7e, 0x6c, 0x12, 0x45, 0x1f, 0x66, 0xb3, 0x94, 0xcc, 0xf1, 0x8b, 0xac, 0xc2,
-	0xcb, 0xee, 0xbb, 0x73, 0xbe, 0xc8, 0xb1, 0x6b, 0x4b, 0xc8, 0xe3, 0x1a, 0x59, 0x03, 0x75, 0xe8,
-	0x58, 0x5f, 0xc6, 0x73, 0x99, 0x53, 0x34, 0xe6, 0x58, 0x63, 0x8d, 0x39, 0x6c, 0x10, 0x8b, 0x83,
-	0x30, 0x70, 0xfc, 0x51, 0xfc, 0xc8, 0xd3, 0x96, 0xd0, 0x53, 0xb8, 0xc6, 0x1e, 0x80, 0xa1, 0x19,
-	0x3a, 0x34, 0x74, 0x2c, 0x1a, 0x2b, 0xec, 0xcd, 0x57, 0x58, 0x60, 0x7e, 0x49, 0x95, 0x2e, 0xb4,
-	0x73, 0xff, 0x1f, 0xa0, 0x4d, 0x29, 0x90, 0x49, 0xff, 0xeb, 0xe8, 0xbe, 0xbf, 0x10, 0x6f, 0xa2,
-	0xcd, 0x81, 0x56, 0x76, 0xb6, 0x8e, 0xde, 0x9b, 0x27, 0xa0, 0x30, 0x8c, 0xec, 0x6e, 0x2e, 0xc2,
-	0x9a, 0xa8, 0x7a, 0x0c, 0xad, 0xec, 0xf4, 0x56, 0xae, 0x4a, 0x3a, 0xe1, 0xed, 0x9e, 0xf6, 0xbe,
-	0xd6, 0x96, 0xd0, 0x4f, 0xe0, 0x4a, 0x61, 0x64, 0x8a, 0xbe, 0x29, 0x13, 0x3f, 0x6f, 0xb2, 0x7a,
-	0x96, 0x06, 0x61, 0xfd, 0x2c, 0x8a, 0xf3, 0xad, 0x2f, 0xcc, 0xce, 0x17, 0xb7, 0x3e, 0x25, 0xfe,
-	0x34, 0xeb, 0x5f, 0x5a, 0xc3, 0x14, 0x50, 0x71, 0x68, 0x8a, 0x3e, 0x90, 0xa9, 0x98, 0x3b, 0xb8,
-	0xed, 0x6e, 0x2d, 0xca, 0x9e, 0xa4, 0x7c, 0xca, 0x4f, 0x6b, 0x7e, 0xbc, 0x28, 0x55, 0x3b, 0x77,
-	0x5e, 0x2a, 0x57, 0x3b, 0x7f, 0x22, 0x18, 0x15, 0x75, 0x76, 0x6c, 0x23, 0xcf, 0x95, 0x74, 0x4c,
-	0x27, 0x2f, 0x6a, 0xf9, 0x14, 0x48, 0x5b, 0x42, 0x06, 0xc0, 0x2e, 0x0e, 0x1f, 0xe1, 0x30, 0x70,
-	0x2c, 0x8a, 0xde, 0x95, 0x1e, 0xf1, 0x19, 0x43, 0xac, 0xe3, 0xce, 0x99, 0x7c, 0xb1, 0x82, 0xde,
-	0x9f, 0xeb, 0x50, 0xe7, 0xd1, 0x65, 0x77, 0xe3, 0xff, 0x01, 0xf7, 0x12, 0x00, 0xf7, 0x09, 0xb4,
-	0x73, 0xd3, 0x35, 0x39, 0xe0, 0xca, 0x47, 0x70, 0x67, 0x9d, 0xbc, 0x21, 0xa0, 0xe2, 0x68, 0x4b,
-	0x7e, 0x04, 0xe6, 0x8e, 0xc0, 0xce, 0xd2, 0xf1, 0x04, 0xda, 0xb9, 0xd1, 0x92, 0xdc, 0x03, 0xf9,
-	0xfc, 0xe9, 0x2c, 0xe9, 0x5f, 0x40, 0x23, 0x3d, 0x64, 0x40, 0x77, 0xe6, 0xe1, 0x5e, 0xee, 0x69,
-	0xfd, 0xea, 0x51, 0xef, 0xf2, 0x6f, 0x85, 0x27, 0xd0, 0xce, 0xcd, 0x15, 0xe4, 0x91, 0x97, 0x0f,
-	0x1f, 0xce, 0x92, 0xfe, 0x06, 0xe1, 0xd8, 0x83, 0x8f, 0x1f, 0xf7, 0x46, 0x4e, 0x38, 0x9e, 0x0e,
-	0x99, 0x97, 0xdb, 0x11, 0xe7, 0x07, 0x0e, 0x11, 0xbf, 0xb6, 0xe3, 0x03, 0xbd, 0xcd, 0x25, 0x6d,
-	0x73, 0x6b, 0x27, 0xc3, 0x61, 0x95, 0x2f, 0xef, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0x92, 0x29,
-	0x0a, 0x06, 0x50, 0x22, 0x00, 0x00,
+	// 2166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0xf7, 0xe8, 0xc3, 0x96, 0x9e, 0x64, 0x69, 0xd2, 0x89, 0xbd, 0x8a, 0xd8, 0x64, 0xcd, 0x64,
+	0xb3, 0xc9, 0x3a, 0xac, 0xbd, 0xeb, 0x2c, 0x55, 0xe4, 0x40, 0x15, 0x1b, 0x6b, 0xe3, 0x15, 0x10,
+	0xc7, 0x8c, 0xcd, 0x52, 0xa4, 0x52, 0x0c, 0x23, 0x4d, 0x5b, 0x9a, 0xda, 0x99, 0x69, 0x65, 0x7a,
+	0x14, 0xc7, 0x39, 0x70, 0xe2, 0xc2, 0x1f, 0xc0, 0x09, 0x8a, 0x2a, 0x0a, 0x28, 0x6a, 0x0f, 0x14,
+	0x77, 0x4e, 0xdc, 0xf9, 0x1b, 0xa0, 0x8a, 0xa2, 0xb8, 0xc2, 0x89, 0x3b, 0xd5, 0x1f, 0x33, 0x9a,
+	0x2f, 0x59, 0xb2, 0xbd, 0x5e, 0xa7, 0x28, 0x6e, 0xea, 0xd7, 0xaf, 0xfb, 0xbd, 0x7e, 0xef, 0xd7,
+	0xef, 0xbd, 0x7e, 0x23, 0xb8, 0xf2, 0x7c, 0x8c, 0xfd, 0x63, 0xa3, 0x4f, 0x88, 0x6f, 0x6d, 0x8c,
+	0x7c, 0x12, 0x10, 0x84, 0x5c, 0xdb, 0x79, 0x31, 0xa6, 0x62, 0xb4, 0xc1, 0xe7, 0xdb, 0xf5, 0x3e,
+	0x71, 0x5d, 0xe2, 0x09, 0x5a, 0xbb, 0x1e, 0xe7, 0x68, 0x37, 0x6c, 0x2f, 0xc0, 0xbe, 0x67, 0x3a,
+	0xe1, 0x2c, 0xed, 0x0f, 0xb1, 0x6b, 0xca, 0x91, 0x6a, 0x99, 0x81, 0x19, 0xdf, 0x5f, 0xfb, 0xa9,
+	0x02, 0xab, 0xfb, 0x43, 0x72, 0xb4, 0x4d, 0x1c, 0x07, 0xf7, 0x03, 0x9b, 0x78, 0x54, 0xc7, 0xcf,
+	0xc7, 0x98, 0x06, 0xe8, 0x7d, 0x28, 0xf5, 0x4c, 0x8a, 0x5b, 0xca, 0x9a, 0x72, 0xb7, 0xb6, 0xf5,
+	0xe6, 0x46, 0x42, 0x13, 0xa9, 0xc2, 0x63, 0x3a, 0x78, 0x68, 0x52, 0xac, 0x73, 0x4e, 0x84, 0xa0,
+	0x64, 0xf5, 0xba, 0x9d, 0x56, 0x61, 0x4d, 0xb9, 0x5b, 0xd4, 0xf9, 0x6f, 0xf4, 0x36, 0x2c, 0xf7,
+	0xa3, 0xbd, 0xbb, 0x1d, 0xda, 0x2a, 0xae, 0x15, 0xef, 0x16, 0xf5, 0x24, 0x51, 0xfb, 0x97, 0x02,
+	0x6f, 0x64, 0xd4, 0xa0, 0x23, 0xe2, 0x51, 0x8c, 0xee, 0xc3, 0x22, 0x0d, 0xcc, 0x60, 0x4c, 0xa5,
+	0x26, 0x5f, 0xc9, 0xd5, 0x64, 0x9f, 0xb3, 0xe8, 0x92, 0x35, 0x2b, 0xb6, 0x90, 0x23, 0x16, 0x7d,
+	0x00, 0xd7, 0x6c, 0xef, 0x31, 0x76, 0x89, 0x7f, 0x6c, 0x8c, 0xb0, 0xdf, 0xc7, 0x5e, 0x60, 0x0e,
+	0x70, 0xa8, 0xe3, 0xd5, 0x70, 0x6e, 0x6f, 0x32, 0x85, 0x3e, 0x86, 0x65, 0x87, 0x98, 0x16, 0xb6,
+	0x8c, 0x43, 0x1b, 0x3b, 0x16, 0x6d, 0x95, 0xd6, 0x8a, 0x77, 0x6b, 0x5b, 0x6b, 0x1b, 0x59, 0x47,
+	0x6d, 0x7c, 0x97, 0x33, 0x3e, 0xe2, 0x7c, 0x7a, 0xdd, 0x89, 0x8d, 0xb4, 0x75, 0xa8, 0xc7, 0x67,
+	0x51, 0x1b, 0x2a, 0x7c, 0x3f, 0xa6, 0xaa, 0xc2, 0xa5, 0x47, 0x63, 0xed, 0x77, 0x0a, 0xac, 0x30,
+	0xe3, 0xec, 0x99, 0x7e, 0x60, 0x5f, 0x80, 0x8b, 0x34, 0xa8, 0xc7, 0xcd, 0xd2, 0x2a, 0xf2, 0xb9,
+	0x04, 0x8d, 0xf1, 0x8c, 0x42, 0xf1, 0xdd, 0x8e, 0x38, 0x75, 0x51, 0x4f, 0xd0, 0xb4, 0xdf, 0x4a,
+	0x2c, 0xc5, 0xf5, 0x3c, 0x8f, 0x0f, 0xd3, 0x32, 0x0b, 0x59, 0x99, 0x67, 0xf0, 0xa0, 0xf6, 0x4f,
+	0x05, 0x56, 0x98, 0xed, 0x27, 0x58, 0xfb, 0xf2, 0xcd, 0xf9, 0x4d, 0x58, 0x14, 0x17, 0xb3, 0x55,
+	0xe2, 0xb2, 0x6e, 0x27, 0x65, 0x89, 0xb9, 0x8d, 0x89, 0x86, 0xfb, 0x9c, 0xa0, 0xcb, 0x45, 0xe8,
+	0x96, 0x00, 0xa1, 0x11, 0x41, 0xa6, 0x2c, 0x4c, 0xc3, 0x88, 0x8f, 0x42, 0xd8, 0xfc, 0x52, 0x81,
+	0x96, 0x8e, 0x1d, 0x6c, 0x52, 0x7c, 0x99, 0x47, 0x5d, 0x85, 0x45, 0x8f, 0x58, 0xb8, 0xdb, 0xe1,
+	0x47, 0x2d, 0xea, 0x72, 0xa4, 0xfd, 0x91, 0xab, 0x47, 0x87, 0xa6, 0x7f, 0xa9, 0x9e, 0x78, 0x17,
+	0x54, 0x1f, 0x07, 0xb6, 0x8f, 0x2d, 0xa3, 0x3f, 0x34, 0x3d, 0x0f, 0x3b, 0x02, 0xdc, 0x55, 0xbd,
+	0x29, 0xe9, 0xdb, 0x92, 0xac, 0xfd, 0xac, 0x20, 0x80, 0xf3, 0x9a, 0xdf, 0xc3, 0x18, 0xb8, 0xca,
+	0x5f, 0x08, 0xb8, 0x16, 0x73, 0xc0, 0xf5, 0xe7, 0x09, 0xb8, 0x5e, 0x77, 0x73, 0x4c, 0x00, 0x58,
+	0x4e, 0x00, 0xf0, 0x87, 0x70, 0x7d, 0xdb, 0xc7, 0x66, 0x80, 0xbf, 0xc7, 0x82, 0xb5, 0xf4, 0x72,
+	0x78, 0x84, 0xb4, 0x70, 0x25, 0x47, 0x78, 0x0b, 0x96, 0x46, 0x3e, 0x79, 0x79, 0x1c, 0xe9, 0x1d,
+	0x0e, 0xb5, 0x5f, 0x2b, 0xd0, 0xce, 0xdb, 0xfb, 0x3c, 0xd1, 0xf0, 0x0e, 0x34, 0x7d, 0xa1, 0x5c,
+	0x08, 0x54, 0x2e, 0xb5, 0xaa, 0x37, 0x24, 0x59, 0x4a, 0x41, 0xb7, 0xa1, 0xe1, 0x63, 0x3a, 0x76,
+	0x26, 0x7c, 0x45, 0xce, 0xb7, 0x2c, 0xa8, 0x92, 0x4d, 0xfb, 0x5c, 0x81, 0xeb, 0x3b, 0x38, 0x88,
+	0xbc, 0xc7, 0xc4, 0xe1, 0xd7, 0x34, 0xb3, 0xfc, 0x4a, 0x81, 0x66, 0x4a, 0x51, 0xb4, 0x06, 0xb5,
+	0x18, 0x8f, 0x74, 0x50, 0x9c, 0x84, 0xbe, 0x01, 0x65, 0x66, 0x3b, 0xcc, 0x55, 0x6a, 0x6c, 0x69,
+	0x79, 0x29, 0x3a, 0xb9, 0xab, 0x2e, 0x16, 0xa0, 0x4d, 0xb8, 0x9a, 0x93, 0x55, 0xa4, 0xfa, 0x28,
+	0x9b, 0x54, 0xb4, 0x3f, 0x28, 0xd0, 0xce, 0x33, 0xe6, 0x79, 0x1c, 0xfe, 0x14, 0x56, 0xa3, 0xd3,
+	0x18, 0x16, 0xa6, 0x7d, 0xdf, 0x1e, 0xb1, 0xdf, 0x22, 0x11, 0xd6, 0xb6, 0x6e, 0xcd, 0x3e, 0x0f,
+	0xd5, 0x57, 0xa2, 0x2d, 0x3a, 0xb1, 0x1d, 0x34, 0x1b, 0x56, 0x76, 0x70, 0xb0, 0x8f, 0x07, 0x2e,
+	0xf6, 0x82, 0xae, 0x77, 0x48, 0xce, 0xee, 0xf7, 0x9b, 0x00, 0x54, 0xee, 0x13, 0xe5, 0xe8, 0x18,
+	0x45, 0xfb, 0x6b, 0x01, 0x6a, 0x31, 0x41, 0xe8, 0x4d, 0xa8, 0x46, 0xb3, 0xd2, 0x6b, 0x13, 0x42,
+	0x06, 0x31, 0x85, 0x1c, 0xc4, 0xa4, 0x3c, 0x5f, 0xcc, 0x7a, 0x7e, 0x4a, 0xce, 0x41, 0xd7, 0xa1,
+	0xe2, 0x62, 0xd7, 0xa0, 0xf6, 0x2b, 0x2c, 0x83, 0xc1, 0x92, 0x8b, 0xdd, 0x7d, 0xfb, 0x15, 0x66,
+	0x53, 0xde, 0xd8, 0x35, 0x7c, 0x72, 0xc4, 0x02, 0x1e, 0x9f, 0xf2, 0xc6, 0xae, 0x4e, 0x8e, 0x28,
+	0xba, 0x01, 0x60, 0x7b, 0x16, 0x7e, 0x69, 0x78, 0xa6, 0x8b, 0x5b, 0x4b, 0xfc, 0x32, 0x55, 0x39,
+	0x65, 0xd7, 0x74, 0x31, 0x0b, 0x03, 0x7c, 0xd0, 0xed, 0xb4, 0x2a, 0x62, 0xa1, 0x1c, 0xb2, 0xa3,
+	0xca, 0x2b, 0xd8, 0xed, 0xb4, 0xaa, 0x62, 0x5d, 0x44, 0x60, 0x95, 0xa4, 0x3c, 0xb7, 0x21, 0x60,
+	0x0a, 0x1c, 0xa6, 0xb9, 0x95, 0xa4, 0x34, 0xa0, 0x00, 0x69, 0x9d, 0xc6, 0x46, 0xbc, 0x82, 0x4f,
+	0xfb, 0xf2, 0x3c, 0xb0, 0xfb, 0x3a, 0x94, 0x6d, 0xef, 0x90, 0x84, 0x28, 0x7b, 0xeb, 0x04, 0x75,
+	0xb8, 0x30, 0xc1, 0xad, 0xfd, 0x4d, 0x81, 0xd5, 0x8f, 0x2c, 0x2b, 0x2f, 0x96, 0x9e, 0x1e, 0x53,
+	0x13, 0xff, 0x15, 0x12, 0xfe, 0x9b, 0x27, 0x9e, 0xdc, 0x83, 0x2b, 0xa9, 0x38, 0x29, 0x61, 0x50,
+	0xd5, 0xd5, 0x64, 0xa4, 0x0c, 0xb3, 0x7f, 0x3c, 0x56, 0xca, 0x2c, 0xc1, 0xb3, 0x7f, 0x2c, 0x5a,
+	0x76, 0x3b, 0xda, 0xdf, 0x15, 0xb8, 0xae, 0x63, 0x97, 0xbc, 0xc0, 0xff, 0xbb, 0x67, 0xfc, 0x47,
+	0x01, 0x56, 0x7f, 0x60, 0x06, 0xfd, 0x61, 0xc7, 0x95, 0x44, 0x7a, 0x39, 0x07, 0x4c, 0x5d, 0xf1,
+	0x52, 0xf6, 0x8a, 0x47, 0x30, 0x2d, 0xe7, 0xc1, 0x94, 0xbd, 0x73, 0x37, 0x3e, 0x0d, 0xcf, 0x3b,
+	0x81, 0x69, 0xac, 0x36, 0x5a, 0x3c, 0x4b, 0x6d, 0xb4, 0x0d, 0xcb, 0xf8, 0x65, 0xdf, 0x19, 0x5b,
+	0xd8, 0x10, 0xd2, 0x97, 0xb8, 0xf4, 0x9b, 0x39, 0xd2, 0xe3, 0x77, 0xa4, 0x2e, 0x17, 0x75, 0xf9,
+	0x55, 0xf9, 0xbc, 0x00, 0x4d, 0x39, 0xcb, 0xca, 0xc9, 0x39, 0xa2, 0x62, 0xca, 0x1c, 0x85, 0xac,
+	0x39, 0xe6, 0x31, 0x6a, 0x98, 0xa1, 0x4b, 0xb1, 0x0c, 0x7d, 0x03, 0xe0, 0xd0, 0x19, 0xd3, 0xa1,
+	0x11, 0xd8, 0x6e, 0x18, 0x13, 0xab, 0x9c, 0x72, 0x60, 0xbb, 0x18, 0x7d, 0x04, 0xf5, 0x9e, 0xed,
+	0x39, 0x64, 0x60, 0x8c, 0xcc, 0x60, 0x28, 0x4a, 0xc1, 0xfc, 0xe3, 0xf2, 0xca, 0xf0, 0x21, 0xe7,
+	0xd5, 0x6b, 0x62, 0xcd, 0x1e, 0x5b, 0xc2, 0x4e, 0x66, 0x61, 0x27, 0x30, 0x1d, 0x32, 0x10, 0xe6,
+	0xaa, 0xea, 0x13, 0x02, 0xba, 0x09, 0x35, 0x16, 0x76, 0xc9, 0xa1, 0x88, 0xbc, 0x22, 0x80, 0x56,
+	0xbd, 0xb1, 0xfb, 0xe4, 0x90, 0xc5, 0x5e, 0xed, 0x2f, 0x05, 0xb8, 0xca, 0x8c, 0x24, 0xed, 0x75,
+	0x01, 0x70, 0x7c, 0x10, 0x02, 0xa9, 0x38, 0x3d, 0xab, 0xa6, 0xbc, 0x95, 0x05, 0xd3, 0x99, 0x5e,
+	0x71, 0xdf, 0x81, 0x06, 0x2f, 0xb4, 0xfb, 0xc4, 0xb3, 0xb8, 0x1f, 0xb9, 0xfd, 0x1b, 0x5b, 0x6f,
+	0xe7, 0xa9, 0x70, 0xe0, 0xdb, 0x83, 0x01, 0xf6, 0xb7, 0x43, 0x5e, 0x9d, 0x17, 0xe9, 0xd1, 0x70,
+	0xbe, 0xaa, 0x9d, 0x05, 0x69, 0x59, 0xb5, 0x5f, 0x9c, 0x41, 0x43, 0x98, 0x15, 0x4f, 0x28, 0x04,
+	0x4b, 0x73, 0x14, 0x82, 0xe5, 0x9c, 0x5a, 0x3e, 0x59, 0x6c, 0x2c, 0x66, 0x8a, 0x8d, 0x6f, 0x41,
+	0xbd, 0xe3, 0x9b, 0xf6, 0xd9, 0xdf, 0x91, 0xda, 0x6f, 0x14, 0x78, 0x43, 0x84, 0xf9, 0xcb, 0x8e,
+	0x81, 0x6d, 0xa8, 0xa4, 0x5e, 0xa4, 0xd1, 0x58, 0x3b, 0x80, 0xe5, 0x48, 0x3d, 0x1e, 0x3f, 0x6e,
+	0xc1, 0xb2, 0xd8, 0xda, 0x10, 0x6d, 0xa6, 0xf0, 0xc1, 0x22, 0x88, 0xa2, 0xd5, 0xc4, 0xac, 0x17,
+	0xa5, 0x00, 0x91, 0xdf, 0xab, 0x7a, 0x8c, 0xa2, 0xfd, 0x5c, 0x01, 0x35, 0x9e, 0xdc, 0xf8, 0xce,
+	0xf3, 0xbc, 0x84, 0xee, 0x40, 0x53, 0xb6, 0x2e, 0xa3, 0x0c, 0x23, 0xdf, 0x26, 0xcf, 0xe3, 0xdb,
+	0x75, 0xd0, 0x87, 0xb0, 0x2a, 0x18, 0x33, 0x19, 0x49, 0xbc, 0x51, 0xae, 0xf1, 0x59, 0x3d, 0x95,
+	0x96, 0xfe, 0x53, 0x84, 0xc6, 0xe4, 0x16, 0xcd, 0xad, 0xd5, 0x3c, 0xfd, 0xa3, 0x5d, 0x50, 0x27,
+	0x45, 0x36, 0x2f, 0xc3, 0x4e, 0x0c, 0x04, 0xe9, 0xf2, 0xba, 0x39, 0x4a, 0x12, 0xd0, 0x23, 0x58,
+	0x96, 0x67, 0x92, 0x09, 0x42, 0xb4, 0x07, 0xbf, 0x9a, 0xb7, 0x59, 0xc2, 0x83, 0x7a, 0x3d, 0x96,
+	0xad, 0x28, 0x7a, 0x00, 0x55, 0x7e, 0x9d, 0x83, 0xe3, 0x11, 0x96, 0x61, 0xe1, 0xcd, 0x69, 0x2d,
+	0xc6, 0x83, 0xe3, 0x11, 0xd6, 0x2b, 0x8e, 0xfc, 0x75, 0xde, 0x14, 0x77, 0x1f, 0x56, 0x7c, 0x11,
+	0x22, 0x2c, 0x23, 0x61, 0xbe, 0x25, 0x6e, 0xbe, 0x6b, 0xe1, 0xe4, 0x5e, 0xdc, 0x8c, 0x53, 0x1e,
+	0x4c, 0x95, 0x69, 0x0f, 0xa6, 0x6c, 0xb8, 0xaa, 0xe6, 0x84, 0xab, 0x9f, 0x40, 0xf3, 0x13, 0xd3,
+	0xb3, 0xc8, 0xe1, 0x61, 0x18, 0xad, 0xce, 0x70, 0x05, 0x1f, 0x24, 0xeb, 0xd9, 0x53, 0xc4, 0x77,
+	0xed, 0x17, 0x05, 0x58, 0x65, 0xb4, 0x87, 0xa6, 0x63, 0x7a, 0x7d, 0x3c, 0xff, 0x2b, 0xe6, 0x8b,
+	0xc9, 0xd7, 0xb7, 0x60, 0x99, 0x92, 0xb1, 0xdf, 0xc7, 0x46, 0xe2, 0x31, 0x53, 0x17, 0xc4, 0x5d,
+	0x4e, 0x63, 0x09, 0xdc, 0xa2, 0x81, 0x91, 0xe8, 0x70, 0x54, 0x2d, 0x1a, 0xc8, 0xe9, 0xb7, 0xa0,
+	0x26, 0xf7, 0xb0, 0x88, 0x87, 0x39, 0x22, 0x2a, 0x3a, 0x08, 0x52, 0x87, 0x78, 0xfc, 0xdd, 0xc3,
+	0xd6, 0xf3, 0xd9, 0x25, 0x3e, 0xbb, 0x64, 0xd1, 0x80, 0x4f, 0xdd, 0x00, 0x78, 0x61, 0x3a, 0xb6,
+	0xc5, 0x91, 0xcc, 0x7d, 0x59, 0xd1, 0xab, 0x9c, 0xc2, 0x4c, 0xa0, 0xfd, 0x49, 0x01, 0x14, 0xb3,
+	0xce, 0xd9, 0x83, 0xe4, 0x6d, 0x68, 0x24, 0xce, 0x19, 0x35, 0xeb, 0xe3, 0x07, 0xa5, 0x2c, 0x5d,
+	0xf6, 0x84, 0x28, 0xc3, 0xc7, 0x26, 0x25, 0x5e, 0xab, 0x78, 0x9a, 0x74, 0xd9, 0x0b, 0xd5, 0x64,
+	0x4b, 0xd7, 0x5f, 0x41, 0x23, 0x79, 0x97, 0x51, 0x1d, 0x2a, 0xbb, 0x24, 0xf8, 0xf8, 0xa5, 0x4d,
+	0x03, 0x75, 0x01, 0x35, 0x00, 0x76, 0x49, 0xb0, 0xe7, 0x63, 0x8a, 0xbd, 0x40, 0x55, 0x10, 0xc0,
+	0xe2, 0x13, 0xaf, 0x63, 0xd3, 0xcf, 0xd4, 0x02, 0xba, 0x2a, 0x9b, 0x11, 0xa6, 0xd3, 0x95, 0xc0,
+	0x56, 0x8b, 0x6c, 0x79, 0x34, 0x2a, 0x21, 0x15, 0xea, 0x11, 0xcb, 0xce, 0xde, 0xf7, 0xd5, 0x32,
+	0xaa, 0x42, 0x59, 0xfc, 0x5c, 0x5c, 0x7f, 0x02, 0x6a, 0x5a, 0x3d, 0x54, 0x83, 0xa5, 0xa1, 0x80,
+	0xba, 0xba, 0x80, 0x9a, 0x50, 0x73, 0x26, 0x86, 0x55, 0x15, 0x46, 0x18, 0xf8, 0xa3, 0xbe, 0x34,
+	0xb1, 0x5a, 0x60, 0xd2, 0x98, 0xad, 0x3a, 0xe4, 0xc8, 0x53, 0x8b, 0xeb, 0xdf, 0x86, 0x7a, 0xfc,
+	0x81, 0x88, 0x2a, 0x50, 0xda, 0x25, 0x1e, 0x56, 0x17, 0xd8, 0xb6, 0x3b, 0x3e, 0x39, 0xb2, 0xbd,
+	0x81, 0x38, 0xc3, 0x23, 0x9f, 0xbc, 0xc2, 0x9e, 0x5a, 0x60, 0x13, 0x14, 0x9b, 0x0e, 0x9b, 0x28,
+	0xb2, 0x09, 0x36, 0xc0, 0x96, 0x5a, 0x5a, 0xff, 0x00, 0x2a, 0x61, 0x4c, 0x41, 0x57, 0x60, 0x39,
+	0xd1, 0xef, 0x54, 0x17, 0x10, 0x12, 0x35, 0xcb, 0x24, 0x7a, 0xa8, 0xca, 0xd6, 0xef, 0xeb, 0x00,
+	0x22, 0x6d, 0xb0, 0x0f, 0x4b, 0x68, 0x04, 0x68, 0x07, 0x07, 0xdb, 0xc4, 0x1d, 0x11, 0x2f, 0x54,
+	0x89, 0xa2, 0xf7, 0x93, 0x5e, 0x8a, 0x3e, 0x53, 0x65, 0x59, 0xe5, 0x29, 0xdb, 0xef, 0x4c, 0x59,
+	0x91, 0x62, 0xd7, 0x16, 0x90, 0xcb, 0x25, 0xb2, 0x82, 0xf5, 0xc0, 0xee, 0x7f, 0x16, 0xf6, 0xc1,
+	0x4e, 0x90, 0x98, 0x62, 0x0d, 0x25, 0xa6, 0x62, 0x83, 0x1c, 0xec, 0x07, 0xbe, 0xed, 0x0d, 0xc2,
+	0x47, 0xb5, 0xb6, 0x80, 0x9e, 0xc3, 0x35, 0xf6, 0xe0, 0x0e, 0xcc, 0xc0, 0xa6, 0x81, 0xdd, 0xa7,
+	0xa1, 0xc0, 0xad, 0xe9, 0x02, 0x33, 0xcc, 0xa7, 0x14, 0xe9, 0x40, 0x33, 0xf5, 0x79, 0x0c, 0xad,
+	0xe7, 0x06, 0xb2, 0xdc, 0x4f, 0x79, 0xed, 0x7b, 0x73, 0xf1, 0x46, 0xd2, 0x6c, 0x68, 0x24, 0xbf,
+	0xe3, 0xa0, 0x77, 0xa7, 0x6d, 0x90, 0x69, 0xfe, 0xb6, 0xd7, 0xe7, 0x61, 0x8d, 0x44, 0x3d, 0x85,
+	0x46, 0xb2, 0xa5, 0x9e, 0x2f, 0x2a, 0xb7, 0xed, 0xde, 0x3e, 0xa9, 0x9f, 0xa1, 0x2d, 0xa0, 0x1f,
+	0xc3, 0x95, 0x4c, 0x8b, 0x1a, 0x7d, 0x2d, 0x6f, 0xfb, 0x69, 0x9d, 0xec, 0x59, 0x12, 0xa4, 0xf6,
+	0x13, 0x2b, 0x4e, 0xd7, 0x3e, 0xf3, 0x8d, 0x63, 0x7e, 0xed, 0x63, 0xdb, 0x9f, 0xa4, 0xfd, 0xa9,
+	0x25, 0x8c, 0x01, 0x65, 0x9b, 0xd4, 0xe8, 0xbd, 0x3c, 0x11, 0x53, 0x1b, 0xe5, 0xed, 0x8d, 0x79,
+	0xd9, 0x23, 0x97, 0x8f, 0xf9, 0x6d, 0x4d, 0xb7, 0x73, 0x73, 0xc5, 0x4e, 0xed, 0x4f, 0xb7, 0x37,
+	0xe6, 0x65, 0x8f, 0x83, 0x3a, 0xd9, 0x26, 0xcb, 0xf7, 0x55, 0x6e, 0x5b, 0xb4, 0xbd, 0x3e, 0x0f,
+	0x6b, 0x24, 0xea, 0x00, 0x6a, 0xb1, 0xc4, 0x88, 0xde, 0x99, 0x86, 0x89, 0x64, 0xe6, 0x9c, 0x0b,
+	0x10, 0xa9, 0xef, 0x65, 0xd3, 0x00, 0x91, 0xff, 0x59, 0x6d, 0x96, 0x04, 0x03, 0x60, 0x07, 0x07,
+	0x8f, 0x71, 0xe0, 0xdb, 0x7d, 0x9a, 0x56, 0x5b, 0x0e, 0x26, 0x0c, 0xe1, 0xa6, 0x77, 0x66, 0xf2,
+	0x85, 0x86, 0xd9, 0xfa, 0x37, 0x40, 0x95, 0xa3, 0x82, 0xe5, 0xf4, 0xff, 0x27, 0x8a, 0x0b, 0x48,
+	0x14, 0xcf, 0xa0, 0x99, 0xea, 0xc2, 0xe6, 0x27, 0x8a, 0xfc, 0x56, 0xed, 0x2c, 0x80, 0xf4, 0x00,
+	0x65, 0x5b, 0xa0, 0xf9, 0x57, 0x77, 0x6a, 0xab, 0x74, 0x96, 0x8c, 0x67, 0xd0, 0x4c, 0xb5, 0x20,
+	0xf3, 0x4f, 0x90, 0xdf, 0xa7, 0x9c, 0xb5, 0xfb, 0xa7, 0xe2, 0x7f, 0x17, 0xd1, 0x7b, 0xe2, 0xce,
+	0xb4, 0xbb, 0x99, 0xea, 0x8f, 0x5c, 0x7e, 0xb4, 0xbe, 0xf8, 0x6c, 0xf6, 0x0c, 0x9a, 0xa9, 0xe6,
+	0x50, 0xbe, 0xe5, 0xf3, 0x3b, 0x48, 0xb3, 0x76, 0xff, 0x12, 0xe3, 0xef, 0x27, 0x50, 0xe6, 0x5d,
+	0x20, 0x94, 0xfb, 0x2d, 0x25, 0xde, 0x20, 0x9a, 0xa5, 0xf4, 0x8f, 0x40, 0x4d, 0x37, 0x83, 0xd0,
+	0xbd, 0xe9, 0x70, 0x3f, 0x35, 0x1c, 0x2f, 0x3a, 0xe2, 0x3e, 0xfc, 0xf0, 0xe9, 0xd6, 0xc0, 0x0e,
+	0x86, 0xe3, 0x1e, 0x13, 0xbd, 0x29, 0x38, 0xdf, 0xb3, 0x89, 0xfc, 0xb5, 0x19, 0x86, 0x9e, 0x4d,
+	0xbe, 0xd3, 0x26, 0x3f, 0xcb, 0xa8, 0xd7, 0x5b, 0xe4, 0xc3, 0xfb, 0xff, 0x1d, 0x00, 0x01, 0x9b,
+	0xda, 0xe5, 0x91, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2171,6 +2452,8 @@ type QueryCoordClient interface {
 	CreateQueryChannel(ctx context.Context, in *CreateQueryChannelRequest, opts ...grpc.CallOption) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReshardCollection(ctx context.Context, in *ReshardCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2291,6 +2574,24 @@ func (c *queryCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInf
 	return out, nil
 }
 
+func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/LoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ReshardCollection(ctx context.Context, in *ReshardCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ReshardCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
@@ -2314,6 +2615,8 @@ type QueryCoordServer interface {
 	CreateQueryChannel(context.Context, *CreateQueryChannelRequest) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	ReshardCollection(context.Context, *ReshardCollectionRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2358,6 +2661,12 @@ func (*UnimplementedQueryCoordServer) GetPartitionStates(ctx context.Context, re
 func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryCoordServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) ReshardCollection(ctx context.Context, req *ReshardCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshardCollection not implemented")
+}
 func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -2582,6 +2891,42 @@ func _QueryCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_LoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).LoadBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/LoadBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).LoadBalance(ctx, req.(*LoadBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ReshardCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReshardCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ReshardCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ReshardCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ReshardCollection(ctx, req.(*ReshardCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -2652,6 +2997,14 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryCoord_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "LoadBalance",
+			Handler:    _QueryCoord_LoadBalance_Handler,
+		},
+		{
+			MethodName: "ReshardCollection",
+			Handler:    _QueryCoord_ReshardCollection_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryCoord_GetMetrics_Handler,
@@ -2676,6 +3029,8 @@ type QueryNodeClient interface {
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RemoveDmChannels(ctx context.Context, in *RemoveDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2787,6 +3142,24 @@ func (c *queryNodeClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfo
 	return out, nil
 }
 
+func (c *queryNodeClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) RemoveDmChannels(ctx context.Context, in *RemoveDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/RemoveDmChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetMetrics", in, out, opts...)
@@ -2809,6 +3182,8 @@ type QueryNodeServer interface {
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	Drain(context.Context, *DrainRequest) (*commonpb.Status, error)
+	RemoveDmChannels(context.Context, *RemoveDmChannelsRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2850,6 +3225,12 @@ func (*UnimplementedQueryNodeServer) ReleaseSegments(ctx context.Context, req *R
 func (*UnimplementedQueryNodeServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryNodeServer) Drain(ctx context.Context, req *DrainRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (*UnimplementedQueryNodeServer) RemoveDmChannels(ctx context.Context, req *RemoveDmChannelsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDmChannels not implemented")
+}
 func (*UnimplementedQueryNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -3056,6 +3437,42 @@ func _QueryNode_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_RemoveDmChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDmChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).RemoveDmChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/RemoveDmChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).RemoveDmChannels(ctx, req.(*RemoveDmChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -3122,6 +3539,14 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryNode_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "Drain",
+			Handler:    _QueryNode_Drain_Handler,
+		},
+		{
+			MethodName: "RemoveDmChannels",
+			Handler:    _QueryNode_RemoveDmChannels_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryNode_GetMetrics_Handler,
@@ -29,6 +29,249 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type GetCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
+func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialRequest) ProtoMessage()    {}
+func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{0}
+}
+
+func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialRequest.Unmarshal(m, b)
+}
+func (m *GetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialRequest.Merge(m, src)
+}
+func (m *GetCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialRequest.Size(m)
+}
+func (m *GetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialRequest proto.InternalMessageInfo
+
+func (m *GetCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetCredentialResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Username             string           `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	EncryptedPassword    string           `protobuf:"bytes,3,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
+func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialResponse) ProtoMessage()    {}
+func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{1}
+}
+
+func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialResponse.Unmarshal(m, b)
+}
+func (m *GetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialResponse.Merge(m, src)
+}
+func (m *GetCredentialResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialResponse.Size(m)
+}
+func (m *GetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialResponse proto.InternalMessageInfo
+
+func (m *GetCredentialResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCredentialResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+type UserRole struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserRole) Reset()         { *m = UserRole{} }
+func (m *UserRole) String() string { return proto.CompactTextString(m) }
+func (*UserRole) ProtoMessage()    {}
+func (*UserRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{2}
+}
+
+func (m *UserRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserRole.Unmarshal(m, b)
+}
+func (m *UserRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserRole.Marshal(b, m, deterministic)
+}
+func (m *UserRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRole.Merge(m, src)
+}
+func (m *UserRole) XXX_Size() int {
+	return xxx_messageInfo_UserRole.Size(m)
+}
+func (m *UserRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRole proto.InternalMessageInfo
+
+func (m *UserRole) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserRole) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type ListPolicyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
+func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyRequest) ProtoMessage()    {}
+func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{3}
+}
+
+func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyRequest.Unmarshal(m, b)
+}
+func (m *ListPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyRequest.Merge(m, src)
+}
+func (m *ListPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyRequest.Size(m)
+}
+func (m *ListPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyRequest proto.InternalMessageInfo
+
+func (m *ListPolicyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListPolicyResponse struct {
+	Status               *commonpb.Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	UserRoles            []*UserRole             `protobuf:"bytes,2,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	Grants               []*milvuspb.GrantEntity `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
+func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyResponse) ProtoMessage()    {}
+func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyResponse.Unmarshal(m, b)
+}
+func (m *ListPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyResponse.Merge(m, src)
+}
+func (m *ListPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyResponse.Size(m)
+}
+func (m *ListPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyResponse proto.InternalMessageInfo
+
+func (m *ListPolicyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetUserRoles() []*UserRole {
+	if m != nil {
+		return m.UserRoles
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetGrants() []*milvuspb.GrantEntity {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 type AllocTimestampRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
@@ -41,7 +284,7 @@ func (m *AllocTimestampRequest) Reset()         { *m = AllocTimestampRequest{} }
 func (m *AllocTimestampRequest) String() string { return proto.CompactTextString(m) }
 func (*AllocTimestampRequest) ProtoMessage()    {}
 func (*AllocTimestampRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{0}
+	return fileDescriptor_4513485a144f6b06, []int{5}
 }
 
 func (m *AllocTimestampRequest) XXX_Unmarshal(b []byte) error {
@@ -89,7 +332,7 @@ func (m *AllocTimestampResponse) Reset()         { *m = AllocTimestampResponse{}
 func (m *AllocTimestampResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocTimestampResponse) ProtoMessage()    {}
 func (*AllocTimestampResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{1}
+	return fileDescriptor_4513485a144f6b06, []int{6}
 }
 
 func (m *AllocTimestampResponse) XXX_Unmarshal(b []byte) error {
@@ -143,7 +386,7 @@ func (m *AllocIDRequest) Reset()         { *m = AllocIDRequest{} }
 func (m *AllocIDRequest) String() string { return proto.CompactTextString(m) }
 func (*AllocIDRequest) ProtoMessage()    {}
 func (*AllocIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{2}
+	return fileDescriptor_4513485a144f6b06, []int{7}
 }
 
 func (m *AllocIDRequest) XXX_Unmarshal(b []byte) error {
@@ -191,7 +434,7 @@ func (m *AllocIDResponse) Reset()         { *m = AllocIDResponse{} }
 func (m *AllocIDResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocIDResponse) ProtoMessage()    {}
 func (*AllocIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{3}
+	return fileDescriptor_4513485a144f6b06, []int{8}
 }
 
 func (m *AllocIDResponse) XXX_Unmarshal(b []byte) error {
@@ -234,6 +477,11 @@ func (m *AllocIDResponse) GetCount() uint32 {
 }
 
 func init() {
+	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
+	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
+	proto.RegisterType((*UserRole)(nil), "milvus.proto.rootcoord.UserRole")
+	proto.RegisterType((*ListPolicyRequest)(nil), "milvus.proto.rootcoord.ListPolicyRequest")
+	proto.RegisterType((*ListPolicyResponse)(nil), "milvus.proto.rootcoord.ListPolicyResponse")
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
@@ -243,55 +491,86 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x5b, 0x4f, 0xdb, 0x30,
-	0x14, 0xc7, 0x69, 0x61, 0x4c, 0x1c, 0xda, 0xc2, 0x2c, 0x60, 0xa8, 0xe3, 0x81, 0x75, 0x1a, 0xb4,
-	0x5c, 0x52, 0x04, 0xd2, 0xb4, 0xd7, 0xd1, 0x6a, 0x50, 0x69, 0x48, 0x23, 0x05, 0x69, 0x37, 0x54,
-	0xb9, 0xe9, 0x51, 0x1b, 0x91, 0xc4, 0x21, 0x76, 0x07, 0x7b, 0xdc, 0x27, 0xdd, 0x57, 0x99, 0x72,
-	0x73, 0x93, 0x34, 0x29, 0x41, 0xdb, 0x5b, 0x1d, 0xff, 0xfc, 0xff, 0xfb, 0x5c, 0x2a, 0x1f, 0x58,
-	0x75, 0x18, 0x13, 0x3d, 0x8d, 0x31, 0x67, 0xa0, 0xd8, 0x0e, 0x13, 0x8c, 0x6c, 0x98, 0xba, 0xf1,
-	0x73, 0xcc, 0xfd, 0x95, 0xe2, 0x6e, 0x7b, 0xbb, 0xd5, 0x92, 0xc6, 0x4c, 0x93, 0x59, 0xfe, 0xf7,
-	0x6a, 0x29, 0x4a, 0x55, 0x2b, 0xba, 0x25, 0xd0, 0xb1, 0xa8, 0x11, 0xac, 0x97, 0x6d, 0x87, 0x3d,
-	0xfc, 0x0a, 0x16, 0xab, 0x03, 0x2a, 0x68, 0xd4, 0xa2, 0xd6, 0x83, 0xf5, 0x0f, 0x86, 0xc1, 0xb4,
-	0x2b, 0xdd, 0x44, 0x2e, 0xa8, 0x69, 0xab, 0x78, 0x37, 0x46, 0x2e, 0xc8, 0x11, 0x2c, 0xf4, 0x29,
-	0xc7, 0xcd, 0xc2, 0x76, 0xa1, 0xbe, 0x7c, 0xbc, 0xa5, 0xc4, 0xae, 0x12, 0xf8, 0x5f, 0xf0, 0xe1,
-	0x29, 0xe5, 0xa8, 0x7a, 0x24, 0x59, 0x83, 0x67, 0x1a, 0x1b, 0x5b, 0x62, 0x73, 0x7e, 0xbb, 0x50,
-	0x2f, 0xab, 0xfe, 0xa2, 0xf6, 0xbb, 0x00, 0x1b, 0x49, 0x07, 0x6e, 0x33, 0x8b, 0x23, 0x39, 0x81,
-	0x45, 0x2e, 0xa8, 0x18, 0xf3, 0xc0, 0xe4, 0x55, 0xaa, 0x49, 0xd7, 0x43, 0xd4, 0x00, 0x25, 0x5b,
-	0xb0, 0x24, 0x42, 0xa5, 0xcd, 0xe2, 0x76, 0xa1, 0xbe, 0xa0, 0x4e, 0x3e, 0x64, 0xdc, 0xe1, 0x0b,
-	0x54, 0xbc, 0x2b, 0x74, 0xda, 0xff, 0x21, 0xba, 0x62, 0x54, 0xd9, 0x80, 0x15, 0xa9, 0xfc, 0x2f,
-	0x51, 0x55, 0xa0, 0xd8, 0x69, 0x7b, 0xd2, 0xf3, 0x6a, 0xb1, 0xd3, 0x4e, 0x8f, 0xe3, 0xf8, 0xcf,
-	0x0b, 0x58, 0x52, 0x19, 0x13, 0x2d, 0xb7, 0x80, 0xc4, 0x06, 0x72, 0x86, 0xa2, 0xc5, 0x4c, 0x9b,
-	0x59, 0x68, 0x09, 0x57, 0x11, 0x39, 0x39, 0x8a, 0xdb, 0xc9, 0x6e, 0x98, 0x46, 0x83, 0x5c, 0x54,
-	0x77, 0x32, 0x4e, 0x24, 0xf0, 0xda, 0x1c, 0x31, 0x3d, 0x47, 0xb7, 0x90, 0x57, 0xba, 0x76, 0xdb,
-	0x1a, 0x51, 0xcb, 0x42, 0x63, 0x96, 0x63, 0x02, 0x0d, 0x1d, 0xdf, 0xc4, 0x4f, 0x04, 0x8b, 0xae,
-	0x70, 0x74, 0x6b, 0x18, 0xe6, 0xb1, 0x36, 0x47, 0xee, 0x60, 0xed, 0x0c, 0x3d, 0x77, 0x9d, 0x0b,
-	0x5d, 0xe3, 0xa1, 0xe1, 0x71, 0xb6, 0xe1, 0x14, 0xfc, 0x44, 0xcb, 0x1e, 0xac, 0xb6, 0x1c, 0xa4,
-	0x02, 0x5b, 0xcc, 0x30, 0x50, 0x13, 0x3a, 0xb3, 0xc8, 0x41, 0xea, 0xd1, 0x24, 0x16, 0x1a, 0xcd,
-	0x2a, 0x77, 0x6d, 0x8e, 0x7c, 0x87, 0x4a, 0xdb, 0x61, 0x76, 0x44, 0x7e, 0x2f, 0x55, 0x3e, 0x0e,
-	0xe5, 0x14, 0xef, 0x41, 0xf9, 0x9c, 0xf2, 0x88, 0x76, 0x23, 0x55, 0x3b, 0xc6, 0x84, 0xd2, 0xaf,
-	0x53, 0xd1, 0x53, 0xc6, 0x8c, 0x48, 0x7a, 0xee, 0x81, 0xb4, 0x91, 0x6b, 0x8e, 0xde, 0x8f, 0x26,
-	0x48, 0x49, 0x8f, 0x60, 0x0a, 0x0c, 0xad, 0x9a, 0xb9, 0x79, 0x69, 0x6c, 0xc1, 0x4a, 0x77, 0xc4,
-	0xee, 0x27, 0x7b, 0x9c, 0xec, 0xa7, 0x57, 0x34, 0x4e, 0x85, 0x96, 0x07, 0xf9, 0x60, 0xe9, 0x77,
-	0x03, 0x2b, 0x7e, 0x81, 0x3f, 0x53, 0x47, 0xe8, 0x5e, 0x94, 0xfb, 0x33, 0xda, 0x40, 0x52, 0x39,
-	0x0b, 0xf5, 0x15, 0xca, 0x6e, 0x81, 0x27, 0xe2, 0x8d, 0xcc, 0x26, 0x78, 0xaa, 0xf4, 0x0d, 0x94,
-	0xce, 0x29, 0x9f, 0x28, 0xd7, 0xb3, 0x5a, 0x60, 0x4a, 0x38, 0x57, 0x07, 0xdc, 0x42, 0xc5, 0xcd,
-	0x9a, 0x3c, 0xcc, 0x33, 0xfa, 0x37, 0x0e, 0x85, 0x16, 0xfb, 0xb9, 0xd8, 0x68, 0xd5, 0xc3, 0xae,
-	0xe8, 0xe2, 0xd0, 0x44, 0x4b, 0x64, 0x54, 0x21, 0x41, 0xcd, 0xae, 0xfa, 0x14, 0x2c, 0xfd, 0x10,
-	0x4a, 0xee, 0x5d, 0x82, 0x0d, 0x9e, 0x91, 0xbb, 0x28, 0x12, 0x3a, 0x35, 0x72, 0x90, 0xd2, 0xe6,
-	0x1a, 0x96, 0xfd, 0xb6, 0xe9, 0x58, 0x03, 0x7c, 0x20, 0xbb, 0x33, 0x1a, 0xcb, 0x23, 0x72, 0x56,
-	0x7e, 0x04, 0xe5, 0x30, 0x34, 0x5f, 0xb8, 0x31, 0x33, 0xfc, 0x98, 0xf4, 0x5e, 0x1e, 0x54, 0x06,
-	0x70, 0x09, 0x4b, 0x6e, 0x6b, 0xfa, 0x2e, 0x6f, 0x33, 0x5b, 0xf7, 0x29, 0x97, 0xbf, 0x0b, 0x9e,
-	0x68, 0x39, 0x25, 0x90, 0x43, 0x25, 0x7d, 0xfa, 0x51, 0x52, 0xe7, 0x95, 0xaa, 0x92, 0x17, 0x97,
-	0x51, 0xfc, 0x80, 0xe7, 0xc1, 0xdb, 0x4d, 0x76, 0x66, 0x1e, 0x96, 0x63, 0x43, 0x75, 0xf7, 0x51,
-	0x4e, 0xaa, 0x53, 0x58, 0xbf, 0xb6, 0x07, 0xee, 0x13, 0xe1, 0x3f, 0x44, 0xe1, 0x53, 0x98, 0xac,
-	0xca, 0xe4, 0xb9, 0x8d, 0x73, 0x17, 0x7c, 0xf8, 0x58, 0xce, 0x0c, 0x78, 0xa9, 0xa2, 0x81, 0x94,
-	0x63, 0xfb, 0xf2, 0xd3, 0x05, 0x72, 0x4e, 0x87, 0xd8, 0x15, 0x0e, 0x52, 0x33, 0xf9, 0x44, 0xfa,
-	0x33, 0x60, 0x06, 0x9c, 0xb3, 0x42, 0x1a, 0xac, 0x07, 0xbd, 0xfc, 0xd1, 0x18, 0xf3, 0x91, 0x3b,
-	0x1d, 0x18, 0x28, 0x70, 0x90, 0xfc, 0x4b, 0xba, 0x23, 0xa6, 0x92, 0x4a, 0x3e, 0x1e, 0xd2, 0xe9,
-	0xfb, 0x6f, 0xef, 0x86, 0xba, 0x18, 0x8d, 0xfb, 0xee, 0x4e, 0xd3, 0x47, 0x0f, 0x75, 0x16, 0xfc,
-	0x6a, 0x86, 0xc9, 0x6a, 0x7a, 0xa7, 0x9b, 0x32, 0xff, 0x76, 0xbf, 0xbf, 0xe8, 0x7d, 0x3a, 0xf9,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x39, 0x40, 0xa4, 0x9c, 0x46, 0x0b, 0x00, 0x00,
+	// 1254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0xc7, 0x1b, 0xe8, 0x76, 0xc9, 0x01, 0x02, 0x1d, 0x95, 0x2e, 0x4a, 0x7b, 0x41, 0xb3, 0xfd,
+	0x48, 0x68, 0x09, 0x15, 0x48, 0xab, 0xde, 0xad, 0x4a, 0xd2, 0x16, 0x24, 0x58, 0xa8, 0x53, 0xb4,
+	0x1f, 0x2d, 0x8a, 0x1c, 0xfb, 0x28, 0xb1, 0x70, 0x3c, 0x66, 0x66, 0x02, 0xe5, 0x72, 0xb5, 0x0f,
+	0xb1, 0x2f, 0xb4, 0x6f, 0xb3, 0x2f, 0xb1, 0x1a, 0x7f, 0xc5, 0x76, 0x3c, 0x61, 0x52, 0xf6, 0x2e,
+	0xce, 0xfc, 0xe6, 0xff, 0xf7, 0x39, 0x67, 0x66, 0xec, 0x63, 0x58, 0x65, 0x94, 0x8a, 0xae, 0x45,
+	0x29, 0xb3, 0x9b, 0x3e, 0xa3, 0x82, 0x92, 0x87, 0x43, 0xc7, 0xbd, 0x1c, 0xf1, 0xf0, 0xaa, 0x29,
+	0x87, 0x83, 0xd1, 0xea, 0x92, 0x45, 0x87, 0x43, 0xea, 0x85, 0xff, 0x57, 0x97, 0xd2, 0x54, 0xb5,
+	0xe2, 0x78, 0x02, 0x99, 0x67, 0xba, 0xd1, 0xf5, 0xa2, 0xcf, 0xe8, 0xd7, 0xeb, 0xe8, 0x62, 0xd5,
+	0x36, 0x85, 0x99, 0xb6, 0xa8, 0xd9, 0xf0, 0xe0, 0x03, 0x8a, 0x16, 0x43, 0x1b, 0x3d, 0xe1, 0x98,
+	0xae, 0x81, 0x17, 0x23, 0xe4, 0x82, 0xbc, 0x86, 0xbb, 0x3d, 0x93, 0xe3, 0x7a, 0x69, 0xa3, 0x54,
+	0x5f, 0xdc, 0x79, 0xdc, 0xcc, 0xdc, 0x49, 0x64, 0x7f, 0xc4, 0xfb, 0x7b, 0x26, 0x47, 0x23, 0x20,
+	0x49, 0x15, 0x16, 0x46, 0x5c, 0x3a, 0x0f, 0x71, 0x7d, 0x6e, 0xa3, 0x54, 0x2f, 0x1b, 0xc9, 0x75,
+	0xed, 0xef, 0x12, 0xac, 0xe5, 0x6c, 0xb8, 0x4f, 0x3d, 0x8e, 0x64, 0x17, 0xee, 0x71, 0x61, 0x8a,
+	0x11, 0x8f, 0x9c, 0x1e, 0x15, 0x3a, 0x75, 0x02, 0xc4, 0x88, 0xd0, 0x69, 0x56, 0x64, 0x0b, 0x08,
+	0x7a, 0x16, 0xbb, 0xf6, 0x05, 0xda, 0x5d, 0xdf, 0xe4, 0xfc, 0x8a, 0x32, 0x7b, 0x7d, 0x3e, 0xa0,
+	0xee, 0x27, 0x23, 0x27, 0xd1, 0x40, 0xad, 0x05, 0x0b, 0xa7, 0x1c, 0x99, 0x41, 0xdd, 0x6c, 0x04,
+	0xa5, 0x9c, 0xec, 0x23, 0x28, 0x33, 0xea, 0x62, 0x37, 0xed, 0x29, 0xff, 0xf8, 0x45, 0x86, 0xf7,
+	0x0e, 0xee, 0x1f, 0x3a, 0x5c, 0x9c, 0x50, 0xd7, 0xb1, 0xae, 0xbf, 0x39, 0x83, 0xb5, 0x7f, 0x4a,
+	0x40, 0xd2, 0x3a, 0xb7, 0x49, 0xd1, 0xcf, 0x00, 0xf2, 0xde, 0xbb, 0xf2, 0x1e, 0xf9, 0xfa, 0xdc,
+	0xc6, 0x7c, 0x7d, 0x71, 0x67, 0xa3, 0x59, 0xbc, 0x9e, 0x9a, 0x71, 0x06, 0x8c, 0xf2, 0x28, 0xfa,
+	0xc5, 0xc9, 0x1b, 0xb8, 0xd7, 0x67, 0xa6, 0x27, 0xf8, 0xfa, 0x7c, 0xd1, 0xe4, 0xe8, 0xe2, 0x83,
+	0x44, 0xde, 0x79, 0xc2, 0x11, 0xd7, 0x46, 0xc4, 0xd7, 0xba, 0xb0, 0xf6, 0xd6, 0x75, 0xa9, 0xf5,
+	0xc9, 0x19, 0x22, 0x17, 0xe6, 0xd0, 0xff, 0xf6, 0x35, 0xf5, 0x00, 0xbe, 0xb3, 0xe8, 0xc8, 0x13,
+	0x41, 0xfd, 0x96, 0x8d, 0xf0, 0xa2, 0xf6, 0x67, 0x09, 0x1e, 0xe6, 0x1d, 0x6e, 0x93, 0xab, 0xc7,
+	0x50, 0x16, 0xb1, 0x52, 0x50, 0xdb, 0xbb, 0xc6, 0xf8, 0x0f, 0xc5, 0x3d, 0xfc, 0x06, 0x95, 0xe0,
+	0x16, 0x0e, 0xda, 0xff, 0x43, 0x74, 0x73, 0x69, 0x65, 0x17, 0x56, 0x12, 0xe5, 0xdb, 0x44, 0x55,
+	0x81, 0xb9, 0x83, 0x76, 0x20, 0x3d, 0x6f, 0xcc, 0x1d, 0xb4, 0x8b, 0xe3, 0xd8, 0xf9, 0xf7, 0x09,
+	0x94, 0x0d, 0x4a, 0x45, 0x4b, 0x2e, 0x04, 0xe2, 0x03, 0x91, 0xdb, 0x94, 0x0e, 0x7d, 0xea, 0xa1,
+	0x27, 0xa4, 0x22, 0x72, 0xf2, 0x3a, 0x6b, 0x97, 0x1c, 0x30, 0x93, 0x68, 0x94, 0x8b, 0xea, 0x73,
+	0xc5, 0x8c, 0x1c, 0x5e, 0xbb, 0x43, 0x86, 0x81, 0xa3, 0x2c, 0xe4, 0x27, 0xc7, 0x3a, 0x6f, 0x0d,
+	0x4c, 0xcf, 0x43, 0x77, 0x9a, 0x63, 0x0e, 0x8d, 0x1d, 0x7f, 0x2c, 0x5c, 0x9e, 0x1d, 0xc1, 0x1c,
+	0xaf, 0x1f, 0xe7, 0xb1, 0x76, 0x87, 0x5c, 0x04, 0xc7, 0x9d, 0x74, 0x77, 0xb8, 0x70, 0x2c, 0x1e,
+	0x1b, 0xee, 0xa8, 0x0d, 0x27, 0xe0, 0x19, 0x2d, 0xbb, 0xb0, 0xda, 0x62, 0x68, 0x0a, 0x6c, 0x51,
+	0xd7, 0x45, 0x4b, 0x38, 0xd4, 0x23, 0xaf, 0x0a, 0xa7, 0xe6, 0xb1, 0xd8, 0x68, 0x5a, 0xb9, 0x6b,
+	0x77, 0xc8, 0x67, 0xa8, 0xb4, 0x19, 0xf5, 0x53, 0xf2, 0x9b, 0x85, 0xf2, 0x59, 0x48, 0x53, 0xbc,
+	0x0b, 0xcb, 0xfb, 0x26, 0x4f, 0x69, 0x37, 0x0a, 0xb5, 0x33, 0x4c, 0x2c, 0xfd, 0xa4, 0x10, 0xdd,
+	0xa3, 0xd4, 0x4d, 0xa5, 0xe7, 0x0a, 0x48, 0x1b, 0xb9, 0xc5, 0x9c, 0x5e, 0x3a, 0x41, 0xcd, 0xe2,
+	0x08, 0x26, 0xc0, 0xd8, 0x6a, 0x5b, 0x9b, 0x4f, 0x8c, 0x3d, 0x58, 0xe9, 0x0c, 0xe8, 0xd5, 0x78,
+	0x8c, 0x93, 0x97, 0xc5, 0x15, 0xcd, 0x52, 0xb1, 0xe5, 0x2b, 0x3d, 0x38, 0xf1, 0x3b, 0x86, 0x85,
+	0xb7, 0xb6, 0xfd, 0xde, 0x41, 0xd7, 0x26, 0x4f, 0x0b, 0xe7, 0xc6, 0xc3, 0xda, 0xa5, 0x59, 0x35,
+	0x50, 0x3e, 0x8f, 0x6e, 0x5c, 0x58, 0x79, 0x4c, 0xd3, 0xe0, 0x1c, 0x2a, 0xf2, 0x71, 0xd4, 0x6e,
+	0x1f, 0xee, 0x3b, 0x5c, 0x50, 0x76, 0xad, 0x58, 0x58, 0x59, 0x28, 0x16, 0x7f, 0xa9, 0xc5, 0x26,
+	0xe9, 0x39, 0x93, 0xc7, 0x9e, 0x40, 0x96, 0x0a, 0xa6, 0x58, 0x21, 0x47, 0x69, 0xc6, 0xf2, 0x57,
+	0x09, 0x1e, 0x06, 0xde, 0x8c, 0xfa, 0x3e, 0xda, 0xe9, 0xaa, 0xef, 0xa8, 0x6f, 0x74, 0x02, 0x8e,
+	0xdd, 0x76, 0x67, 0x9a, 0x93, 0x04, 0x69, 0xc2, 0x7d, 0x03, 0x2d, 0x7a, 0x99, 0x09, 0x73, 0x4b,
+	0x51, 0xb3, 0x1c, 0xa7, 0x19, 0x68, 0x60, 0xc1, 0x07, 0x26, 0xb3, 0x35, 0x2c, 0x72, 0x9c, 0xa6,
+	0xc5, 0x19, 0xac, 0x84, 0x47, 0xd5, 0x89, 0xc9, 0x84, 0x33, 0xa5, 0x54, 0x39, 0x4a, 0x53, 0xfe,
+	0x77, 0x58, 0x96, 0x49, 0x1c, 0x8b, 0x37, 0x94, 0xc7, 0xd9, 0xac, 0xd2, 0x67, 0xb0, 0xb4, 0x6f,
+	0xf2, 0xb1, 0x72, 0x5d, 0x75, 0x98, 0x4d, 0x08, 0x6b, 0x9d, 0x65, 0xe7, 0x50, 0x91, 0xfb, 0x3f,
+	0x99, 0xcc, 0x15, 0x1b, 0x26, 0x0b, 0x4d, 0xdf, 0x30, 0x79, 0x36, 0x31, 0xfb, 0x0c, 0x95, 0x30,
+	0xbf, 0x6d, 0x53, 0x98, 0xc1, 0xfb, 0xc4, 0xe6, 0x94, 0x22, 0xc4, 0x90, 0x66, 0xa2, 0x7e, 0x85,
+	0x25, 0x99, 0xdf, 0x44, 0xba, 0xae, 0x2c, 0xc1, 0x8c, 0xc2, 0x03, 0x58, 0x0e, 0x76, 0x49, 0x34,
+	0x8b, 0x2b, 0x8a, 0x9b, 0x61, 0x62, 0xe9, 0x4d, 0x1d, 0xb4, 0xe0, 0xb9, 0x9b, 0x74, 0x1d, 0xd3,
+	0x9f, 0xbb, 0xf9, 0x1e, 0x48, 0xe3, 0xfc, 0x3d, 0xf5, 0x6d, 0x1d, 0x83, 0x3c, 0xa6, 0x6f, 0xd0,
+	0x46, 0x17, 0x35, 0x0c, 0xf2, 0x98, 0xa6, 0xc1, 0x17, 0x28, 0xcb, 0xec, 0xc9, 0xd7, 0x7f, 0x4e,
+	0x9e, 0x29, 0xb3, 0x1b, 0x8c, 0x2b, 0x5e, 0xed, 0x26, 0xb1, 0xd4, 0x03, 0x76, 0x39, 0xd3, 0xf3,
+	0x91, 0x57, 0xaa, 0xfe, 0xa3, 0xa8, 0x03, 0xad, 0x6e, 0x69, 0xd2, 0x89, 0x5f, 0x07, 0x20, 0xac,
+	0x64, 0xd0, 0xcc, 0x3d, 0x9f, 0x52, 0x6a, 0x09, 0x68, 0xa6, 0xe8, 0x18, 0x16, 0xe4, 0x2a, 0x0f,
+	0x24, 0x9f, 0x2a, 0x37, 0xc1, 0x0c, 0x82, 0x67, 0xb0, 0x72, 0xec, 0x23, 0x33, 0x05, 0x26, 0x7d,
+	0x67, 0xf1, 0xc6, 0xcf, 0x51, 0xfa, 0x6b, 0x26, 0x9a, 0x78, 0xc2, 0x9c, 0x4b, 0xc7, 0xc5, 0x3e,
+	0x2a, 0xd6, 0x4c, 0x1e, 0xd3, 0x34, 0xe8, 0xc1, 0x62, 0x07, 0xe5, 0xf3, 0x22, 0x68, 0xfd, 0xc8,
+	0x8b, 0xe2, 0x43, 0x6b, 0x4c, 0xc4, 0xb2, 0xf5, 0x9b, 0xc1, 0xa4, 0x92, 0x08, 0x30, 0xee, 0x83,
+	0x49, 0x43, 0xb5, 0x10, 0x26, 0x7a, 0xee, 0xea, 0xa6, 0x0e, 0x9a, 0x7e, 0x03, 0x8c, 0xdf, 0x10,
+	0x3b, 0xd8, 0x1f, 0xa2, 0x27, 0x14, 0xa5, 0xc8, 0x51, 0xd3, 0xdf, 0x00, 0x27, 0xe0, 0x54, 0x58,
+	0x4b, 0xf2, 0x34, 0x8f, 0x06, 0xb8, 0xe2, 0x50, 0x4d, 0x23, 0xb1, 0x53, 0x43, 0x83, 0x4c, 0x6c,
+	0x4e, 0x61, 0x31, 0x5c, 0xe6, 0x07, 0x9e, 0x8d, 0x5f, 0x15, 0x15, 0x4a, 0x11, 0xfa, 0x27, 0x77,
+	0x1c, 0x5a, 0x28, 0xdc, 0x98, 0x1a, 0x7e, 0x46, 0x7a, 0x53, 0x07, 0x4d, 0x02, 0xf8, 0x08, 0x65,
+	0xb9, 0xa9, 0x42, 0x97, 0x67, 0xca, 0x4d, 0x37, 0xcb, 0xcd, 0x5f, 0x44, 0xed, 0x7a, 0xf2, 0xc5,
+	0x80, 0x28, 0x8f, 0x97, 0xc2, 0x6f, 0x17, 0xd5, 0xa6, 0x2e, 0x9e, 0x44, 0xf1, 0x05, 0xbe, 0x8f,
+	0xfa, 0x78, 0xf2, 0x7c, 0xea, 0xe4, 0xe4, 0x13, 0x42, 0xf5, 0xc5, 0x8d, 0x5c, 0xea, 0x4d, 0x72,
+	0x2d, 0x7a, 0xaa, 0x84, 0x4d, 0x69, 0xdc, 0x16, 0x93, 0x86, 0xa2, 0x93, 0xcd, 0x71, 0x47, 0xbc,
+	0x7f, 0x53, 0xce, 0x5c, 0xf8, 0xc1, 0x40, 0x17, 0x4d, 0x8e, 0xed, 0x8f, 0x87, 0x47, 0xc8, 0xb9,
+	0xd9, 0xc7, 0x8e, 0x60, 0x68, 0x0e, 0xf3, 0xaf, 0xcc, 0xe1, 0x27, 0x46, 0x05, 0xac, 0x59, 0x21,
+	0x0b, 0xd6, 0xa2, 0xb5, 0xfc, 0xde, 0x1d, 0xf1, 0x81, 0xfc, 0x52, 0xe0, 0xa2, 0x40, 0x3b, 0xbf,
+	0x25, 0xe5, 0x17, 0xcc, 0x66, 0x21, 0x79, 0x73, 0x48, 0x7b, 0x6f, 0xfe, 0xf8, 0xa9, 0xef, 0x88,
+	0xc1, 0xa8, 0x27, 0x47, 0xb6, 0x43, 0x74, 0xcb, 0xa1, 0xd1, 0xaf, 0xed, 0x38, 0x59, 0xdb, 0xc1,
+	0xec, 0xed, 0x24, 0xff, 0x7e, 0xaf, 0x77, 0x2f, 0xf8, 0x6b, 0xf7, 0xbf, 0x01, 0x00, 0xed, 0xac,
+	0xac, 0xb4, 0xa5, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -309,61 +588,134 @@ type RootCoordClient interface {
 	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	//*
+	//
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
 	//
 	// @return CollectionSchema
 	DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
-	//*
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
-	//*
+	//
+	// @brief This method is used to add a nullable or default valued scalar field to a collection.
+	//
+	// @return Status
+	AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
+	// @brief This method is used to rename a collection, the collection id is kept.
+	//
+	// @return Status
+	RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
+	// @brief This method is used to list the audit records of the DDLs executed on a collection.
+	//
+	// @return ListDDLHistoryResponse
+	ListDDLHistory(ctx context.Context, in *milvuspb.ListDDLHistoryRequest, opts ...grpc.CallOption) (*milvuspb.ListDDLHistoryResponse, error)
+	//
+	// @brief This method is used to change the deletion protection of a collection.
+	//
+	// @return Status
+	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
+	// @brief This method is used to list the soft dropped collections which are not purged yet.
+	//
+	// @return ListDroppedCollectionsResponse
+	ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ListDroppedCollectionsResponse, error)
+	//
+	// @brief This method is used to recover a soft dropped collection.
+	//
+	// @return Status
+	RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
+	// @brief This method is used to change the number of virtual channels of a collection.
+	//
+	// @return Status
+	ReshardCollection(ctx context.Context, in *milvuspb.ReshardCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
 	//
 	// @return StringListResponse
 	ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
+	//
+	// @brief This method is used to create database
+	//
+	// @return Status
+	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
+	// @brief This method is used to drop database, only empty database can be dropped
+	//
+	// @return Status
+	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
+	// @brief This method is used to list all databases
+	//
+	// @return ListDatabasesResponse, database name list
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
+	//
+	// @brief This method is used to create a user, the password is saved as a salted hash
+	//
+	// @return Status
+	CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListUsersResponse, error)
+	//
+	// @brief This method is used by proxies to get the password hash of a user when authenticating requests
+	//
+	// @return GetCredentialResponse
+	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
+	CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
+	//
+	// @brief This method is used by proxies to load the roles of users and the grants of roles
+	//
+	// @return ListPolicyResponse
+	ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error)
 	DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegmentRequest, opts ...grpc.CallOption) (*milvuspb.DescribeSegmentResponse, error)
 	ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ShowSegmentsResponse, error)
 	CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -456,6 +808,69 @@ func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.Show
 	return out, nil
 }
 
+func (c *rootCoordClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDDLHistory(ctx context.Context, in *milvuspb.ListDDLHistoryRequest, opts ...grpc.CallOption) (*milvuspb.ListDDLHistoryResponse, error) {
+	out := new(milvuspb.ListDDLHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDDLHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	out := new(milvuspb.ListDroppedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDroppedCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RecoverCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ReshardCollection(ctx context.Context, in *milvuspb.ReshardCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ReshardCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreatePartition", in, out, opts...)
@@ -492,156 +907,355 @@ func (c *rootCoordClient) ShowPartitions(ctx context.Context, in *milvuspb.ShowP
 	return out, nil
 }
 
-func (c *rootCoordClient) DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegmentRequest, opts ...grpc.CallOption) (*milvuspb.DescribeSegmentResponse, error) {
-	out := new(milvuspb.DescribeSegmentResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DescribeSegment", in, out, opts...)
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ShowSegmentsResponse, error) {
-	out := new(milvuspb.ShowSegmentsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ShowSegments", in, out, opts...)
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	out := new(milvuspb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateIndex", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequest, opts ...grpc.CallOption) (*milvuspb.DescribeIndexResponse, error) {
-	out := new(milvuspb.DescribeIndexResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DescribeIndex", in, out, opts...)
+func (c *rootCoordClient) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/UpdateCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *rootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropIndex", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DeleteCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error) {
-	out := new(AllocTimestampResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AllocTimestamp", in, out, opts...)
+func (c *rootCoordClient) ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListUsersResponse, error) {
+	out := new(milvuspb.ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
-	out := new(AllocIDResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AllocID", in, out, opts...)
+func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
+	out := new(GetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *rootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/UpdateChannelTimeTick", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ReleaseDQLMessageStream", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *rootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SegmentFlushCompleted", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateUserRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RootCoordServer is the server API for RootCoord service.
-type RootCoordServer interface {
-	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
+func (c *rootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperatePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
+	out := new(milvuspb.SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error) {
+	out := new(ListPolicyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegmentRequest, opts ...grpc.CallOption) (*milvuspb.DescribeSegmentResponse, error) {
+	out := new(milvuspb.DescribeSegmentResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DescribeSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ShowSegmentsResponse, error) {
+	out := new(milvuspb.ShowSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ShowSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequest, opts ...grpc.CallOption) (*milvuspb.DescribeIndexResponse, error) {
+	out := new(milvuspb.DescribeIndexResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DescribeIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error) {
+	out := new(AllocTimestampResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AllocTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
+	out := new(AllocIDResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AllocID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/UpdateChannelTimeTick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ReleaseDQLMessageStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SegmentFlushCompleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RootCoordServer is the server API for RootCoord service.
+type RootCoordServer interface {
+	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	//*
+	//
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(context.Context, *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(context.Context, *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(context.Context, *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
 	//
 	// @return CollectionSchema
 	DescribeCollection(context.Context, *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
-	//*
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(context.Context, *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
-	//*
+	//
+	// @brief This method is used to add a nullable or default valued scalar field to a collection.
+	//
+	// @return Status
+	AddField(context.Context, *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+	//
+	// @brief This method is used to rename a collection, the collection id is kept.
+	//
+	// @return Status
+	RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+	//
+	// @brief This method is used to list the audit records of the DDLs executed on a collection.
+	//
+	// @return ListDDLHistoryResponse
+	ListDDLHistory(context.Context, *milvuspb.ListDDLHistoryRequest) (*milvuspb.ListDDLHistoryResponse, error)
+	//
+	// @brief This method is used to change the deletion protection of a collection.
+	//
+	// @return Status
+	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+	//
+	// @brief This method is used to list the soft dropped collections which are not purged yet.
+	//
+	// @return ListDroppedCollectionsResponse
+	ListDroppedCollections(context.Context, *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error)
+	//
+	// @brief This method is used to recover a soft dropped collection.
+	//
+	// @return Status
+	RecoverCollection(context.Context, *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error)
+	//
+	// @brief This method is used to change the number of virtual channels of a collection.
+	//
+	// @return Status
+	ReshardCollection(context.Context, *milvuspb.ReshardCollectionRequest) (*commonpb.Status, error)
+	//
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(context.Context, *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(context.Context, *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(context.Context, *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
 	//
 	// @return StringListResponse
 	ShowPartitions(context.Context, *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
+	//
+	// @brief This method is used to create database
+	//
+	// @return Status
+	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	//
+	// @brief This method is used to drop database, only empty database can be dropped
+	//
+	// @return Status
+	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	//
+	// @brief This method is used to list all databases
+	//
+	// @return ListDatabasesResponse, database name list
+	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+	//
+	// @brief This method is used to create a user, the password is saved as a salted hash
+	//
+	// @return Status
+	CreateCredential(context.Context, *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+	ListUsers(context.Context, *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error)
+	//
+	// @brief This method is used by proxies to get the password hash of a user when authenticating requests
+	//
+	// @return GetCredentialResponse
+	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
+	CreateRole(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+	OperatePrivilege(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+	//
+	// @brief This method is used by proxies to load the roles of users and the grants of roles
+	//
+	// @return ListPolicyResponse
+	ListPolicy(context.Context, *ListPolicyRequest) (*ListPolicyResponse, error)
 	DescribeSegment(context.Context, *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error)
 	ShowSegments(context.Context, *milvuspb.ShowSegmentsRequest) (*milvuspb.ShowSegmentsResponse, error)
 	CreateIndex(context.Context, *milvuspb.CreateIndexRequest) (*commonpb.Status, error)
@@ -682,6 +1296,27 @@ func (*UnimplementedRootCoordServer) DescribeCollection(ctx context.Context, req
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedRootCoordServer) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedRootCoordServer) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDDLHistory(ctx context.Context, req *milvuspb.ListDDLHistoryRequest) (*milvuspb.ListDDLHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDDLHistory not implemented")
+}
+func (*UnimplementedRootCoordServer) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDroppedCollections(ctx context.Context, req *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDroppedCollections not implemented")
+}
+func (*UnimplementedRootCoordServer) RecoverCollection(ctx context.Context, req *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) ReshardCollection(ctx context.Context, req *milvuspb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshardCollection not implemented")
+}
 func (*UnimplementedRootCoordServer) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -694,6 +1329,48 @@ func (*UnimplementedRootCoordServer) HasPartition(ctx context.Context, req *milv
 func (*UnimplementedRootCoordServer) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowPartitions not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedRootCoordServer) GetCredential(ctx context.Context, req *GetCredentialRequest) (*GetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedRootCoordServer) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
+func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *ListPolicyRequest) (*ListPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+}
 func (*UnimplementedRootCoordServer) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeSegment not implemented")
 }
@@ -873,6 +1550,132 @@ func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddField(ctx, req.(*milvuspb.AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RenameCollection(ctx, req.(*milvuspb.RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDDLHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDDLHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDDLHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDDLHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDDLHistory(ctx, req.(*milvuspb.ListDDLHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterCollection(ctx, req.(*milvuspb.AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDroppedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDroppedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDroppedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDroppedCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDroppedCollections(ctx, req.(*milvuspb.ListDroppedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RecoverCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RecoverCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RecoverCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RecoverCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RecoverCollection(ctx, req.(*milvuspb.RecoverCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ReshardCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ReshardCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ReshardCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ReshardCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ReshardCollection(ctx, req.(*milvuspb.ReshardCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -945,6 +1748,258 @@ func _RootCoord_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCredential(ctx, req.(*milvuspb.CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.UpdateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).UpdateCredential(ctx, req.(*milvuspb.UpdateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DeleteCredential(ctx, req.(*milvuspb.DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListUsers(ctx, req.(*milvuspb.ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetCredential(ctx, req.(*GetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateRole(ctx, req.(*milvuspb.CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateUserRole(ctx, req.(*milvuspb.OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperatePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperatePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperatePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperatePrivilege(ctx, req.(*milvuspb.OperatePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SelectGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SelectGrant(ctx, req.(*milvuspb.SelectGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListPolicy(ctx, req.(*ListPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_DescribeSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.DescribeSegmentRequest)
 	if err := dec(in); err != nil {
@@ -1161,6 +2216,34 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
 		},
+		{
+			MethodName: "AddField",
+			Handler:    _RootCoord_AddField_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _RootCoord_RenameCollection_Handler,
+		},
+		{
+			MethodName: "ListDDLHistory",
+			Handler:    _RootCoord_ListDDLHistory_Handler,
+		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _RootCoord_AlterCollection_Handler,
+		},
+		{
+			MethodName: "ListDroppedCollections",
+			Handler:    _RootCoord_ListDroppedCollections_Handler,
+		},
+		{
+			MethodName: "RecoverCollection",
+			Handler:    _RootCoord_RecoverCollection_Handler,
+		},
+		{
+			MethodName: "ReshardCollection",
+			Handler:    _RootCoord_ReshardCollection_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _RootCoord_CreatePartition_Handler,
@@ -1177,6 +2260,62 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowPartitions",
 			Handler:    _RootCoord_ShowPartitions_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _RootCoord_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _RootCoord_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _RootCoord_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _RootCoord_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _RootCoord_GetCredential_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _RootCoord_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _RootCoord_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _RootCoord_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _RootCoord_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _RootCoord_SelectGrant_Handler,
+		},
+		{
+			MethodName: "ListPolicy",
+			Handler:    _RootCoord_ListPolicy_Handler,
+		},
 		{
 			MethodName: "DescribeSegment",
 			Handler:    _RootCoord_DescribeSegment_Handler,
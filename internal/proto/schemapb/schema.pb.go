@@ -21,7 +21,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//*
 // @brief Field data type
 type DataType int32
 
@@ -75,7 +74,6 @@ func (DataType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1c5fb4d8cc22d66a, []int{0}
 }
 
-//*
 // @brief Field schema
 type FieldSchema struct {
 	FieldID              int64                    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -86,6 +84,9 @@ type FieldSchema struct {
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Nullable             bool                     `protobuf:"varint,9,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	DefaultValue         *ValueField              `protobuf:"bytes,10,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	IsPartitionKey       bool                     `protobuf:"varint,11,opt,name=is_partition_key,json=isPartitionKey,proto3" json:"is_partition_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -172,13 +173,176 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
-//*
+func (m *FieldSchema) GetNullable() bool {
+	if m != nil {
+		return m.Nullable
+	}
+	return false
+}
+
+func (m *FieldSchema) GetDefaultValue() *ValueField {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *FieldSchema) GetIsPartitionKey() bool {
+	if m != nil {
+		return m.IsPartitionKey
+	}
+	return false
+}
+
+// @brief Single value of a scalar field
+type ValueField struct {
+	// Types that are valid to be assigned to Data:
+	//	*ValueField_BoolData
+	//	*ValueField_IntData
+	//	*ValueField_LongData
+	//	*ValueField_FloatData
+	//	*ValueField_DoubleData
+	//	*ValueField_StringData
+	Data                 isValueField_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValueField) Reset()         { *m = ValueField{} }
+func (m *ValueField) String() string { return proto.CompactTextString(m) }
+func (*ValueField) ProtoMessage()    {}
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{1}
+}
+
+func (m *ValueField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueField.Unmarshal(m, b)
+}
+func (m *ValueField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueField.Marshal(b, m, deterministic)
+}
+func (m *ValueField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueField.Merge(m, src)
+}
+func (m *ValueField) XXX_Size() int {
+	return xxx_messageInfo_ValueField.Size(m)
+}
+func (m *ValueField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueField proto.InternalMessageInfo
+
+type isValueField_Data interface {
+	isValueField_Data()
+}
+
+type ValueField_BoolData struct {
+	BoolData bool `protobuf:"varint,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ValueField_IntData struct {
+	IntData int32 `protobuf:"varint,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ValueField_LongData struct {
+	LongData int64 `protobuf:"varint,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ValueField_FloatData struct {
+	FloatData float32 `protobuf:"fixed32,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ValueField_DoubleData struct {
+	DoubleData float64 `protobuf:"fixed64,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ValueField_StringData struct {
+	StringData string `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+func (*ValueField_BoolData) isValueField_Data() {}
+
+func (*ValueField_IntData) isValueField_Data() {}
+
+func (*ValueField_LongData) isValueField_Data() {}
+
+func (*ValueField_FloatData) isValueField_Data() {}
+
+func (*ValueField_DoubleData) isValueField_Data() {}
+
+func (*ValueField_StringData) isValueField_Data() {}
+
+func (m *ValueField) GetData() isValueField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ValueField) GetBoolData() bool {
+	if x, ok := m.GetData().(*ValueField_BoolData); ok {
+		return x.BoolData
+	}
+	return false
+}
+
+func (m *ValueField) GetIntData() int32 {
+	if x, ok := m.GetData().(*ValueField_IntData); ok {
+		return x.IntData
+	}
+	return 0
+}
+
+func (m *ValueField) GetLongData() int64 {
+	if x, ok := m.GetData().(*ValueField_LongData); ok {
+		return x.LongData
+	}
+	return 0
+}
+
+func (m *ValueField) GetFloatData() float32 {
+	if x, ok := m.GetData().(*ValueField_FloatData); ok {
+		return x.FloatData
+	}
+	return 0
+}
+
+func (m *ValueField) GetDoubleData() float64 {
+	if x, ok := m.GetData().(*ValueField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return 0
+}
+
+func (m *ValueField) GetStringData() string {
+	if x, ok := m.GetData().(*ValueField_StringData); ok {
+		return x.StringData
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ValueField) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ValueField_BoolData)(nil),
+		(*ValueField_IntData)(nil),
+		(*ValueField_LongData)(nil),
+		(*ValueField_FloatData)(nil),
+		(*ValueField_DoubleData)(nil),
+		(*ValueField_StringData)(nil),
+	}
+}
+
 // @brief Collection schema
 type CollectionSchema struct {
 	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
 	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	BinlogCompression    string         `protobuf:"bytes,5,opt,name=binlog_compression,json=binlogCompression,proto3" json:"binlog_compression,omitempty"`
+	NumPartitions        int64          `protobuf:"varint,6,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -188,7 +352,7 @@ func (m *CollectionSchema) Reset()         { *m = CollectionSchema{} }
 func (m *CollectionSchema) String() string { return proto.CompactTextString(m) }
 func (*CollectionSchema) ProtoMessage()    {}
 func (*CollectionSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{1}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
 }
 
 func (m *CollectionSchema) XXX_Unmarshal(b []byte) error {
@@ -237,6 +401,20 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetBinlogCompression() string {
+	if m != nil {
+		return m.BinlogCompression
+	}
+	return ""
+}
+
+func (m *CollectionSchema) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -248,7 +426,7 @@ func (m *BoolArray) Reset()         { *m = BoolArray{} }
 func (m *BoolArray) String() string { return proto.CompactTextString(m) }
 func (*BoolArray) ProtoMessage()    {}
 func (*BoolArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
 }
 
 func (m *BoolArray) XXX_Unmarshal(b []byte) error {
@@ -287,7 +465,7 @@ func (m *IntArray) Reset()         { *m = IntArray{} }
 func (m *IntArray) String() string { return proto.CompactTextString(m) }
 func (*IntArray) ProtoMessage()    {}
 func (*IntArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
 }
 
 func (m *IntArray) XXX_Unmarshal(b []byte) error {
@@ -326,7 +504,7 @@ func (m *LongArray) Reset()         { *m = LongArray{} }
 func (m *LongArray) String() string { return proto.CompactTextString(m) }
 func (*LongArray) ProtoMessage()    {}
 func (*LongArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
 }
 
 func (m *LongArray) XXX_Unmarshal(b []byte) error {
@@ -365,7 +543,7 @@ func (m *FloatArray) Reset()         { *m = FloatArray{} }
 func (m *FloatArray) String() string { return proto.CompactTextString(m) }
 func (*FloatArray) ProtoMessage()    {}
 func (*FloatArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
 }
 
 func (m *FloatArray) XXX_Unmarshal(b []byte) error {
@@ -404,7 +582,7 @@ func (m *DoubleArray) Reset()         { *m = DoubleArray{} }
 func (m *DoubleArray) String() string { return proto.CompactTextString(m) }
 func (*DoubleArray) ProtoMessage()    {}
 func (*DoubleArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
 }
 
 func (m *DoubleArray) XXX_Unmarshal(b []byte) error {
@@ -444,7 +622,7 @@ func (m *BytesArray) Reset()         { *m = BytesArray{} }
 func (m *BytesArray) String() string { return proto.CompactTextString(m) }
 func (*BytesArray) ProtoMessage()    {}
 func (*BytesArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
 }
 
 func (m *BytesArray) XXX_Unmarshal(b []byte) error {
@@ -483,7 +661,7 @@ func (m *StringArray) Reset()         { *m = StringArray{} }
 func (m *StringArray) String() string { return proto.CompactTextString(m) }
 func (*StringArray) ProtoMessage()    {}
 func (*StringArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
 }
 
 func (m *StringArray) XXX_Unmarshal(b []byte) error {
@@ -530,7 +708,7 @@ func (m *ScalarField) Reset()         { *m = ScalarField{} }
 func (m *ScalarField) String() string { return proto.CompactTextString(m) }
 func (*ScalarField) ProtoMessage()    {}
 func (*ScalarField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
 }
 
 func (m *ScalarField) XXX_Unmarshal(b []byte) error {
@@ -681,7 +859,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -771,7 +949,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -872,7 +1050,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -954,7 +1132,7 @@ func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1020,6 +1198,7 @@ func (m *SearchResultData) GetTopks() []int64 {
 func init() {
 	proto.RegisterEnum("milvus.proto.schema.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*FieldSchema)(nil), "milvus.proto.schema.FieldSchema")
+	proto.RegisterType((*ValueField)(nil), "milvus.proto.schema.ValueField")
 	proto.RegisterType((*CollectionSchema)(nil), "milvus.proto.schema.CollectionSchema")
 	proto.RegisterType((*BoolArray)(nil), "milvus.proto.schema.BoolArray")
 	proto.RegisterType((*IntArray)(nil), "milvus.proto.schema.IntArray")
@@ -1038,66 +1217,75 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xe3, 0xfc, 0xd8, 0xc7, 0xa1, 0x58, 0xd3, 0x0a, 0x19, 0xa4, 0x76, 0xd3, 0x08, 0xa4,
-	0xa8, 0x12, 0xbb, 0xea, 0x2e, 0x94, 0x52, 0x51, 0x01, 0x69, 0xb4, 0x4a, 0xb4, 0xa8, 0x5a, 0xbc,
-	0xa8, 0x17, 0xdc, 0x44, 0x93, 0x78, 0xba, 0x3b, 0x5a, 0x7b, 0x26, 0x78, 0x26, 0x15, 0x79, 0x00,
-	0xae, 0xb9, 0xe1, 0x8a, 0x77, 0xe3, 0x02, 0xf1, 0x1c, 0x48, 0x68, 0xce, 0x4c, 0x12, 0xd3, 0x78,
-	0x57, 0x7b, 0x77, 0x66, 0x7c, 0xbe, 0x6f, 0xce, 0xf9, 0xce, 0x8f, 0xa1, 0xa7, 0x16, 0x57, 0xac,
-	0xa0, 0x87, 0xcb, 0x52, 0x6a, 0x49, 0xee, 0x17, 0x3c, 0x7f, 0xb7, 0x52, 0xf6, 0x74, 0x68, 0x3f,
-	0x7d, 0xd2, 0x5b, 0xc8, 0xa2, 0x90, 0xc2, 0x5e, 0x0e, 0xfe, 0x6e, 0x42, 0x74, 0xca, 0x59, 0x9e,
-	0x5d, 0xe0, 0x57, 0x92, 0x40, 0xf7, 0xad, 0x39, 0x4e, 0xc7, 0x89, 0xd7, 0xf7, 0x86, 0x7e, 0xba,
-	0x39, 0x12, 0x02, 0x2d, 0x41, 0x0b, 0x96, 0x34, 0xfb, 0xde, 0x30, 0x4c, 0xd1, 0x26, 0x9f, 0xc2,
-	0x3d, 0xae, 0x66, 0xcb, 0x92, 0x17, 0xb4, 0x5c, 0xcf, 0xae, 0xd9, 0x3a, 0xf1, 0xfb, 0xde, 0x30,
-	0x48, 0x7b, 0x5c, 0x9d, 0xdb, 0xcb, 0x33, 0xb6, 0x26, 0x7d, 0x88, 0x32, 0xa6, 0x16, 0x25, 0x5f,
-	0x6a, 0x2e, 0x45, 0xd2, 0x42, 0x82, 0xea, 0x15, 0x79, 0x01, 0x61, 0x46, 0x35, 0x9d, 0xe9, 0xf5,
-	0x92, 0x25, 0xed, 0xbe, 0x37, 0xbc, 0x77, 0xfc, 0xf0, 0xb0, 0x26, 0xf8, 0xc3, 0x31, 0xd5, 0xf4,
-	0xa7, 0xf5, 0x92, 0xa5, 0x41, 0xe6, 0x2c, 0x32, 0x82, 0xc8, 0xc0, 0x66, 0x4b, 0x5a, 0xd2, 0x42,
-	0x25, 0x9d, 0xbe, 0x3f, 0x8c, 0x8e, 0x1f, 0xff, 0x1f, 0xed, 0x52, 0x3e, 0x63, 0xeb, 0x37, 0x34,
-	0x5f, 0xb1, 0x73, 0xca, 0xcb, 0x14, 0x0c, 0xea, 0x1c, 0x41, 0x64, 0x0c, 0x3d, 0x2e, 0x32, 0xf6,
-	0xeb, 0x86, 0xa4, 0x7b, 0x57, 0x92, 0x08, 0x61, 0x8e, 0xe5, 0x23, 0xe8, 0xd0, 0x95, 0x96, 0xd3,
-	0x71, 0x12, 0xa0, 0x0a, 0xee, 0x34, 0xf8, 0xd3, 0x83, 0xf8, 0x95, 0xcc, 0x73, 0xb6, 0x30, 0xc9,
-	0x3a, 0xa1, 0x37, 0x72, 0x7a, 0x15, 0x39, 0xdf, 0x13, 0xaa, 0xb9, 0x2f, 0xd4, 0xee, 0x09, 0xbf,
-	0xfa, 0x04, 0x79, 0x0e, 0x1d, 0xac, 0x93, 0x4a, 0x5a, 0x18, 0x7a, 0xbf, 0x56, 0xbd, 0x4a, 0xa1,
-	0x53, 0xe7, 0x3f, 0x38, 0x80, 0x70, 0x24, 0x65, 0xfe, 0x7d, 0x59, 0xd2, 0xb5, 0x09, 0xca, 0xe8,
-	0x9a, 0x78, 0x7d, 0x7f, 0x18, 0xa4, 0x68, 0x0f, 0x1e, 0x41, 0x30, 0x15, 0x7a, 0xff, 0x7b, 0xdb,
-	0x7d, 0x3f, 0x80, 0xf0, 0x07, 0x29, 0x2e, 0xf7, 0x1d, 0x7c, 0xe7, 0xd0, 0x07, 0x38, 0xcd, 0x25,
-	0xad, 0xa1, 0x68, 0x3a, 0x8f, 0xc7, 0x10, 0x8d, 0xe5, 0x6a, 0x9e, 0xb3, 0x7d, 0x17, 0x6f, 0x47,
-	0x32, 0x5a, 0x6b, 0xa6, 0xf6, 0x3d, 0x7a, 0x3b, 0x92, 0x0b, 0x5d, 0xf2, 0xba, 0x48, 0x42, 0xe7,
-	0xf2, 0x97, 0x0f, 0xd1, 0xc5, 0x82, 0xe6, 0xb4, 0x44, 0x25, 0xc8, 0x4b, 0x08, 0xe7, 0x52, 0xe6,
-	0x33, 0xe7, 0xe8, 0x0d, 0xa3, 0xe3, 0x47, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0xd2, 0xc0, 0x40,
-	0x4c, 0x1f, 0x92, 0x17, 0x10, 0x70, 0xa1, 0x2d, 0xba, 0x89, 0xe8, 0xfa, 0xa6, 0xdd, 0xc8, 0x37,
-	0x69, 0xa4, 0x5d, 0x2e, 0x34, 0x62, 0x5f, 0x42, 0x98, 0x4b, 0x71, 0x69, 0xc1, 0xfe, 0x2d, 0x4f,
-	0x6f, 0xb5, 0x35, 0x4f, 0x1b, 0x08, 0xc2, 0xbf, 0x03, 0x78, 0x6b, 0x34, 0xb5, 0xf8, 0x16, 0xe2,
-	0x0f, 0xea, 0x6b, 0xbe, 0x95, 0x7e, 0xd2, 0x48, 0x43, 0x04, 0x21, 0xc3, 0x2b, 0x88, 0x32, 0xd4,
-	0xdc, 0x52, 0xb4, 0x91, 0xa2, 0xbe, 0x6d, 0x2a, 0xb5, 0x99, 0x34, 0x52, 0xb0, 0xb0, 0x0d, 0x89,
-	0x42, 0xcd, 0x2d, 0x49, 0xe7, 0x16, 0x92, 0x4a, 0x6d, 0x0c, 0x89, 0x85, 0x6d, 0x72, 0x99, 0x9b,
-	0xd2, 0x5a, 0x8e, 0xee, 0x2d, 0xb9, 0xec, 0x3a, 0xc0, 0xe4, 0x82, 0x20, 0xc3, 0x30, 0xea, 0xd8,
-	0x5a, 0x0f, 0xfe, 0xf0, 0x20, 0x7a, 0xc3, 0x16, 0x5a, 0xba, 0xfa, 0xc6, 0xe0, 0x67, 0xbc, 0x70,
-	0x8b, 0xcc, 0x98, 0x66, 0xd0, 0xad, 0x6e, 0xef, 0xd0, 0xcd, 0x95, 0xed, 0x0e, 0xca, 0x45, 0x08,
-	0xb3, 0xe4, 0xe4, 0x33, 0xf8, 0x60, 0xce, 0x85, 0x59, 0x79, 0x8e, 0xc6, 0x14, 0xb0, 0x37, 0x69,
-	0xa4, 0x3d, 0x7b, 0x6d, 0xdd, 0xb6, 0x61, 0xfd, 0xeb, 0x41, 0x88, 0x01, 0x61, 0xba, 0x4f, 0xa1,
-	0x85, 0x6b, 0xce, 0xbb, 0xcb, 0x9a, 0x43, 0x57, 0xf2, 0x10, 0x00, 0xa7, 0x75, 0x56, 0x59, 0xc0,
-	0x21, 0xde, 0xbc, 0x36, 0x6b, 0xe3, 0x1b, 0xe8, 0x2a, 0xec, 0x6a, 0xe5, 0x3a, 0xe9, 0x86, 0x0a,
-	0xec, 0x3a, 0xdf, 0x74, 0xa2, 0x83, 0x18, 0xb4, 0xcd, 0x42, 0xb9, 0x3e, 0xaa, 0x47, 0x57, 0x74,
-	0x35, 0x68, 0x07, 0x21, 0x1f, 0x43, 0x60, 0x43, 0xe3, 0x19, 0xf6, 0xd0, 0xf6, 0x87, 0x91, 0x8d,
-	0xba, 0xd0, 0x46, 0x73, 0xf0, 0x9b, 0x07, 0xfe, 0x74, 0xac, 0xc8, 0x57, 0xd0, 0x31, 0xf3, 0xc2,
-	0xb3, 0x5b, 0x67, 0xad, 0xda, 0xf0, 0x6d, 0x2e, 0xf4, 0x34, 0x23, 0x5f, 0x43, 0x47, 0xe9, 0xd2,
-	0x00, 0x9b, 0x77, 0xee, 0xb0, 0xb6, 0xd2, 0xe5, 0x34, 0x1b, 0x01, 0x04, 0x3c, 0x9b, 0xd9, 0x38,
-	0xfe, 0xf1, 0x20, 0xbe, 0x60, 0xb4, 0x5c, 0x5c, 0xa5, 0x4c, 0xad, 0x72, 0x3b, 0x07, 0x07, 0x10,
-	0x89, 0x55, 0x31, 0xfb, 0x65, 0xc5, 0x4a, 0xce, 0x94, 0xeb, 0x15, 0x10, 0xab, 0xe2, 0x47, 0x7b,
-	0x43, 0xee, 0x43, 0x5b, 0xcb, 0xe5, 0xec, 0x1a, 0xdf, 0xf6, 0xd3, 0x96, 0x96, 0xcb, 0x33, 0xf2,
-	0x2d, 0x44, 0x76, 0x7f, 0x6e, 0x06, 0xd8, 0xbf, 0x31, 0x9f, 0x6d, 0xe5, 0x53, 0x5b, 0x44, 0x6c,
-	0x59, 0xb3, 0xc8, 0xd5, 0x42, 0x96, 0xcc, 0x2e, 0xec, 0x66, 0xea, 0x4e, 0xe4, 0x09, 0xf8, 0x3c,
-	0x53, 0x6e, 0x1c, 0x93, 0xfa, 0x75, 0x32, 0x56, 0xa9, 0x71, 0x22, 0x0f, 0x30, 0xb2, 0x6b, 0xfb,
-	0xcf, 0xf3, 0x53, 0x7b, 0x78, 0xf2, 0xbb, 0x07, 0xc1, 0xa6, 0x7f, 0x48, 0x00, 0xad, 0xd7, 0x52,
-	0xb0, 0xb8, 0x61, 0x2c, 0xb3, 0xc5, 0x62, 0xcf, 0x58, 0x53, 0xa1, 0x9f, 0xc7, 0x4d, 0x12, 0x42,
-	0x7b, 0x2a, 0xf4, 0xd3, 0x67, 0xb1, 0xef, 0xcc, 0x93, 0xe3, 0xb8, 0xe5, 0xcc, 0x67, 0x5f, 0xc4,
-	0x6d, 0x63, 0xe2, 0x14, 0xc4, 0x40, 0x00, 0x3a, 0x76, 0x0f, 0xc4, 0x91, 0xb1, 0xad, 0xd8, 0xf1,
-	0x03, 0x12, 0x43, 0x6f, 0x54, 0x69, 0xfa, 0x38, 0x23, 0x1f, 0x42, 0x74, 0xba, 0x1b, 0x96, 0x98,
-	0x8d, 0xbe, 0xfc, 0xf9, 0xe4, 0x92, 0xeb, 0xab, 0xd5, 0xdc, 0xfc, 0x42, 0x8f, 0x6c, 0x4a, 0x9f,
-	0x73, 0xe9, 0xac, 0x23, 0x2e, 0x34, 0x2b, 0x05, 0xcd, 0x8f, 0x30, 0xcb, 0x23, 0x9b, 0xe5, 0x72,
-	0x3e, 0xef, 0xe0, 0xf9, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3c, 0xbb, 0x94, 0xd4,
-	0x08, 0x00, 0x00,
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x45, 0x51, 0x22, 0x87, 0x8a, 0xcb, 0x6e, 0x82, 0x82, 0x4d, 0xe1, 0x58, 0x31, 0x1a,
+	0x40, 0x08, 0x10, 0x1b, 0x71, 0xda, 0x34, 0x0d, 0x1a, 0xb4, 0x95, 0x05, 0xc3, 0x82, 0x8b, 0xc0,
+	0xa5, 0x8b, 0x1c, 0x7a, 0x11, 0x28, 0x71, 0x6d, 0x2f, 0x4c, 0xee, 0xaa, 0xdc, 0xa5, 0x51, 0x3d,
+	0x40, 0xcf, 0xbd, 0xf4, 0xd9, 0x7a, 0xeb, 0xa9, 0xe7, 0x02, 0x7d, 0x81, 0x02, 0xc5, 0xec, 0xae,
+	0xfe, 0x2c, 0xcb, 0xf0, 0x6d, 0x76, 0xfe, 0x96, 0x33, 0xdf, 0x37, 0x3b, 0x84, 0xb6, 0x1c, 0x5f,
+	0xd2, 0x22, 0xdd, 0x9b, 0x94, 0x42, 0x09, 0xf2, 0xb0, 0x60, 0xf9, 0x75, 0x25, 0xcd, 0x69, 0xcf,
+	0x98, 0x1e, 0xb7, 0xc7, 0xa2, 0x28, 0x04, 0x37, 0xca, 0xdd, 0x7f, 0x5c, 0x08, 0x8f, 0x18, 0xcd,
+	0xb3, 0x33, 0x6d, 0x25, 0x31, 0xb4, 0xce, 0xf1, 0x38, 0xe8, 0xc7, 0x4e, 0xc7, 0xe9, 0xba, 0xc9,
+	0xec, 0x48, 0x08, 0x34, 0x78, 0x5a, 0xd0, 0xb8, 0xde, 0x71, 0xba, 0x41, 0xa2, 0x65, 0xf2, 0x39,
+	0x6c, 0x31, 0x39, 0x9c, 0x94, 0xac, 0x48, 0xcb, 0xe9, 0xf0, 0x8a, 0x4e, 0x63, 0xb7, 0xe3, 0x74,
+	0xfd, 0xa4, 0xcd, 0xe4, 0xa9, 0x51, 0x9e, 0xd0, 0x29, 0xe9, 0x40, 0x98, 0x51, 0x39, 0x2e, 0xd9,
+	0x44, 0x31, 0xc1, 0xe3, 0x86, 0x4e, 0xb0, 0xac, 0x22, 0x6f, 0x21, 0xc8, 0x52, 0x95, 0x0e, 0xd5,
+	0x74, 0x42, 0x63, 0xaf, 0xe3, 0x74, 0xb7, 0x0e, 0xb6, 0xf7, 0x6e, 0xf9, 0xf8, 0xbd, 0x7e, 0xaa,
+	0xd2, 0x9f, 0xa6, 0x13, 0x9a, 0xf8, 0x99, 0x95, 0x48, 0x0f, 0x42, 0x0c, 0x1b, 0x4e, 0xd2, 0x32,
+	0x2d, 0x64, 0xdc, 0xec, 0xb8, 0xdd, 0xf0, 0xe0, 0xe9, 0x6a, 0xb4, 0x2d, 0xf9, 0x84, 0x4e, 0x3f,
+	0xa4, 0x79, 0x45, 0x4f, 0x53, 0x56, 0x26, 0x80, 0x51, 0xa7, 0x3a, 0x88, 0xf4, 0xa1, 0xcd, 0x78,
+	0x46, 0x7f, 0x9d, 0x25, 0x69, 0xdd, 0x37, 0x49, 0xa8, 0xc3, 0x6c, 0x96, 0x4f, 0xa0, 0x99, 0x56,
+	0x4a, 0x0c, 0xfa, 0xb1, 0xaf, 0xbb, 0x60, 0x4f, 0xe4, 0x31, 0xf8, 0xbc, 0xca, 0xf3, 0x74, 0x94,
+	0xd3, 0x38, 0xd0, 0x96, 0xf9, 0x99, 0xf4, 0xe1, 0x41, 0x46, 0xcf, 0xd3, 0x2a, 0x57, 0xc3, 0x6b,
+	0xcc, 0x1a, 0x43, 0xc7, 0xe9, 0x86, 0x07, 0x3b, 0xb7, 0x56, 0xaf, 0xef, 0xd5, 0x68, 0x25, 0x6d,
+	0x1b, 0xa5, 0x55, 0xa4, 0x0b, 0x11, 0xe2, 0x90, 0x96, 0x8a, 0x61, 0x3f, 0x35, 0x12, 0xa1, 0xbe,
+	0x69, 0x8b, 0xc9, 0xd3, 0x99, 0xfa, 0x84, 0x4e, 0x77, 0xff, 0x74, 0x00, 0x16, 0x69, 0xc8, 0x36,
+	0x04, 0x23, 0x21, 0xf2, 0x21, 0x76, 0x53, 0x03, 0xee, 0x1f, 0xd7, 0x12, 0x1f, 0x55, 0xd8, 0x69,
+	0xf2, 0x19, 0xf8, 0x8c, 0x2b, 0x63, 0x45, 0xdc, 0xbd, 0xe3, 0x5a, 0xd2, 0x62, 0x5c, 0x69, 0xe3,
+	0x36, 0x04, 0xb9, 0xe0, 0x17, 0xc6, 0x8a, 0xb8, 0xbb, 0x18, 0x8b, 0x2a, 0x6d, 0xde, 0x01, 0x38,
+	0xcf, 0x45, 0x6a, 0xa3, 0x11, 0xf4, 0xfa, 0x71, 0x2d, 0x09, 0xb4, 0x4e, 0x3b, 0x3c, 0x85, 0x30,
+	0x13, 0xd5, 0x28, 0xa7, 0xc6, 0x03, 0x61, 0x77, 0x8e, 0x6b, 0x09, 0x18, 0xe5, 0xcc, 0x45, 0xaa,
+	0x92, 0xcd, 0x2e, 0x69, 0x22, 0x73, 0xd0, 0xc5, 0x28, 0xd1, 0xa5, 0xd7, 0x84, 0x06, 0xda, 0x76,
+	0xff, 0x75, 0x20, 0x3a, 0x14, 0x79, 0x4e, 0xc7, 0x58, 0xaa, 0x65, 0xf3, 0x8c, 0xb3, 0xce, 0x12,
+	0x67, 0x6f, 0xb0, 0xb1, 0xbe, 0xce, 0xc6, 0x05, 0x8e, 0xee, 0x0a, 0x8e, 0x6f, 0xa0, 0xa9, 0x87,
+	0x41, 0xc6, 0x0d, 0xcd, 0x8f, 0xce, 0xad, 0x20, 0x2d, 0x4d, 0x53, 0x62, 0xfd, 0xc9, 0x0b, 0x20,
+	0x23, 0xc6, 0x73, 0x71, 0x31, 0x1c, 0x8b, 0x62, 0x52, 0x52, 0x29, 0xf1, 0x6a, 0x4f, 0x5f, 0xfd,
+	0xb1, 0xb1, 0x1c, 0x2e, 0x0c, 0xe4, 0x19, 0x6c, 0xf1, 0xaa, 0x58, 0xe0, 0x29, 0x75, 0xe5, 0x6e,
+	0xf2, 0x80, 0x57, 0xc5, 0x1c, 0x4d, 0xb9, 0xbb, 0x03, 0x41, 0x4f, 0x88, 0xfc, 0xfb, 0xb2, 0x4c,
+	0xa7, 0x84, 0x98, 0x3e, 0xc4, 0x4e, 0xc7, 0xed, 0xfa, 0x89, 0xe9, 0xc9, 0x13, 0xf0, 0x07, 0x5c,
+	0xad, 0xdb, 0x3d, 0x6b, 0xdf, 0x81, 0xe0, 0x07, 0xc1, 0x2f, 0xd6, 0x1d, 0x5c, 0xeb, 0xd0, 0x01,
+	0x38, 0x42, 0xbc, 0xd6, 0x3d, 0xea, 0xd6, 0xe3, 0x29, 0x84, 0x7d, 0x8d, 0xd7, 0xba, 0x8b, 0xb3,
+	0x48, 0xd2, 0x9b, 0x2a, 0x2a, 0xd7, 0x3d, 0xda, 0x8b, 0x24, 0x67, 0x1a, 0xd1, 0x75, 0x97, 0xc0,
+	0xba, 0xfc, 0xe5, 0x42, 0x78, 0x36, 0x4e, 0xf3, 0xb4, 0x34, 0xc4, 0x7d, 0x77, 0x93, 0xb8, 0xe1,
+	0xc1, 0x93, 0x5b, 0xe1, 0x98, 0x77, 0x68, 0x85, 0xd8, 0x6f, 0x6f, 0x10, 0x3b, 0xdc, 0xf0, 0xde,
+	0xcc, 0xda, 0xb7, 0xcc, 0xfb, 0x77, 0x37, 0x79, 0xbf, 0xe9, 0xea, 0x79, 0x6f, 0x57, 0xe6, 0xe2,
+	0xbb, 0xb5, 0xb9, 0xd8, 0x34, 0xee, 0x8b, 0xd6, 0xaf, 0x0e, 0xce, 0xe1, 0xfa, 0xe0, 0x6c, 0x22,
+	0xe3, 0x12, 0x36, 0x37, 0x46, 0xeb, 0x70, 0x7d, 0xb4, 0x36, 0x25, 0x59, 0xc2, 0x66, 0x75, 0xf8,
+	0xb0, 0x96, 0x11, 0x42, 0x6b, 0x72, 0xb4, 0xee, 0xa8, 0x65, 0xc1, 0x00, 0xac, 0x45, 0x07, 0xad,
+	0x8c, 0xef, 0x1f, 0x0e, 0x84, 0x1f, 0xe8, 0x58, 0x09, 0x8b, 0x6f, 0x04, 0x6e, 0xc6, 0x0a, 0xbb,
+	0x83, 0x50, 0xc4, 0x37, 0xda, 0xf4, 0xed, 0x5a, 0xbb, 0xc5, 0xf5, 0x3b, 0x6e, 0x5b, 0xe9, 0x5c,
+	0xa8, 0xc3, 0x4c, 0x72, 0xf2, 0x0c, 0x1e, 0x8c, 0x18, 0xc7, 0x6d, 0x65, 0xd3, 0x20, 0x80, 0xed,
+	0xe3, 0x5a, 0xd2, 0x36, 0x6a, 0xe3, 0x36, 0xff, 0xac, 0xff, 0x1c, 0x08, 0xf4, 0x07, 0xe9, 0x72,
+	0x5f, 0x42, 0x43, 0x6f, 0x28, 0xe7, 0x3e, 0x1b, 0x4a, 0xbb, 0x92, 0x6d, 0x00, 0xfd, 0x06, 0x0c,
+	0x97, 0x76, 0x67, 0xa0, 0x35, 0xef, 0xf1, 0x31, 0xfa, 0x06, 0x5a, 0x52, 0xb3, 0x5a, 0xc6, 0xee,
+	0x5d, 0x08, 0x2c, 0x98, 0x8f, 0x4c, 0xb4, 0x21, 0x18, 0x6d, 0xaa, 0x90, 0x71, 0xe3, 0x8e, 0xe8,
+	0xa5, 0xbe, 0x62, 0xb4, 0x0d, 0x21, 0x9f, 0x82, 0x6f, 0x3e, 0x8d, 0x65, 0xb1, 0xb7, 0xbc, 0xeb,
+	0xb3, 0x5e, 0x0b, 0x3c, 0x2d, 0xee, 0xfe, 0xe6, 0x80, 0x3b, 0xe8, 0x4b, 0xf2, 0x15, 0x34, 0x71,
+	0x5e, 0x58, 0x16, 0x3b, 0xf7, 0x24, 0xbc, 0xc7, 0xb8, 0x1a, 0x64, 0xe4, 0x6b, 0x68, 0x4a, 0x55,
+	0x62, 0x60, 0xfd, 0xde, 0x0c, 0xf3, 0xa4, 0x2a, 0x07, 0x59, 0x0f, 0xc0, 0x67, 0xd9, 0xd0, 0x7c,
+	0xc7, 0xdf, 0x0e, 0x44, 0x67, 0x34, 0x2d, 0xc7, 0x97, 0x09, 0x95, 0x55, 0xae, 0xec, 0x86, 0x09,
+	0xf1, 0x99, 0xfc, 0xa5, 0xa2, 0x25, 0xa3, 0xd2, 0x72, 0x05, 0x78, 0x55, 0xfc, 0x68, 0x34, 0xe4,
+	0x21, 0x78, 0x4a, 0x4c, 0x86, 0x57, 0xfa, 0x6e, 0x37, 0x69, 0x28, 0x31, 0x39, 0x21, 0xdf, 0x42,
+	0x68, 0x5e, 0xe5, 0xd9, 0x00, 0xbb, 0x1b, 0xeb, 0x99, 0x23, 0x9f, 0x18, 0x10, 0x35, 0x65, 0x71,
+	0x3d, 0xc8, 0xb1, 0x28, 0xa9, 0x59, 0x03, 0xf5, 0xc4, 0x9e, 0xc8, 0x73, 0x70, 0x59, 0x26, 0xed,
+	0x38, 0xc6, 0xb7, 0x3f, 0x27, 0x7d, 0x99, 0xa0, 0x13, 0x79, 0xa4, 0xbf, 0xec, 0xca, 0xfc, 0xae,
+	0xb8, 0x89, 0x39, 0x3c, 0xff, 0xdd, 0x01, 0x7f, 0xc6, 0x1f, 0xe2, 0x43, 0xe3, 0xbd, 0xe0, 0x34,
+	0xaa, 0xa1, 0x84, 0xaf, 0x58, 0xe4, 0xa0, 0x34, 0xe0, 0xea, 0x4d, 0x54, 0x27, 0x01, 0x78, 0x03,
+	0xae, 0x5e, 0xbe, 0x8e, 0x5c, 0x2b, 0xbe, 0x3a, 0x88, 0x1a, 0x56, 0x7c, 0xfd, 0x45, 0xe4, 0xa1,
+	0xa8, 0xa7, 0x20, 0x02, 0x02, 0xd0, 0x34, 0xef, 0x40, 0x14, 0xa2, 0x6c, 0x9a, 0x1d, 0x3d, 0x22,
+	0x11, 0xb4, 0x7b, 0x4b, 0xa4, 0x8f, 0x32, 0xf2, 0x11, 0x84, 0x47, 0x8b, 0x61, 0x89, 0x68, 0xef,
+	0xcb, 0x9f, 0x5f, 0x5d, 0x30, 0x75, 0x59, 0x8d, 0xf0, 0xef, 0x67, 0xdf, 0x94, 0xf4, 0x82, 0x09,
+	0x2b, 0xed, 0x33, 0xae, 0x68, 0xc9, 0xd3, 0x7c, 0x5f, 0x57, 0xb9, 0x6f, 0xaa, 0x9c, 0x8c, 0x46,
+	0x4d, 0x7d, 0x7e, 0xf5, 0xff, 0x00, 0xe1, 0x90, 0x23, 0x94, 0x8f, 0x0a, 0x00, 0x00,
 }
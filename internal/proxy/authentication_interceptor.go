@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+const (
+	// milvusServicePrefix is the prefix of the full method names of the public milvus service,
+	// only these methods are authenticated, the internal proxy service is left alone
+	milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
+
+	authorizationHeader = "authorization"
+)
+
+type ctxUsernameKey struct{}
+
+// UnaryServerAuthInterceptor returns a grpc interceptor which authenticates the requests of milvus service
+// by the basic auth credential in the "authorization" metadata, the username is put into the context,
+// and is passed to the coordinators as the caller of the requests sent on behalf of the user
+func UnaryServerAuthInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !Params.AuthorizationEnabled || !strings.HasPrefix(info.FullMethod, milvusServicePrefix) {
+			return handler(ctx, req)
+		}
+		username, err := authenticate(ctx)
+		if err != nil {
+			log.Debug("authentication failed", zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		ctx = funcutil.AppendCallerToOutgoingContext(ctx, username)
+		return handler(context.WithValue(ctx, ctxUsernameKey{}, username), req)
+	}
+}
+
+func authenticate(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("missing metadata")
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", errors.New("missing authorization")
+	}
+	username, password, err := crypto.ParseBasicAuth(values[0])
+	if err != nil {
+		return "", err
+	}
+	if !passwordVerify(ctx, username, password) {
+		return "", errors.New("username or password is incorrect")
+	}
+	return username, nil
+}
+
+// passwordVerify checks the password against the cached credential, the sha256 of a verified password
+// is remembered so that bcrypt only runs when the password is new to the cache
+func passwordVerify(ctx context.Context, username, password string) bool {
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		log.Debug("get credential failed", zap.String("username", username), zap.Error(err))
+		return false
+	}
+	sha256Password := crypto.SHA256(password)
+	if credInfo.sha256Password != "" && credInfo.sha256Password == sha256Password {
+		return true
+	}
+	if !crypto.PasswordVerify(password, credInfo.encryptedPassword) {
+		return false
+	}
+	credInfo.sha256Password = sha256Password
+	globalMetaCache.UpdateCredential(credInfo)
+	return true
+}
+
+// GetCurUserFromContext returns the username authenticated by UnaryServerAuthInterceptor
+func GetCurUserFromContext(ctx context.Context) (string, error) {
+	username, ok := ctx.Value(ctxUsernameKey{}).(string)
+	if !ok || username == "" {
+		return "", errors.New("fail to get the current user from context")
+	}
+	return username, nil
+}
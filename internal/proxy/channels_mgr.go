@@ -240,13 +240,20 @@ func (mgr *singleTypeChannelsMgr) deleteStreamByVID(vid int) {
 	delete(mgr.id2Stream, vid)
 }
 
-func (mgr *singleTypeChannelsMgr) deleteStreamByVIDs(vids []int) {
+// deleteStreamByVIDs removes the streams of the vids, and returns the removed ones
+func (mgr *singleTypeChannelsMgr) deleteStreamByVIDs(vids []int) []msgstream.MsgStream {
 	mgr.streamMtx.Lock()
 	defer mgr.streamMtx.Unlock()
 
+	streams := make([]msgstream.MsgStream, 0, len(vids))
 	for _, vid := range vids {
+		if stream, ok := mgr.id2Stream[vid]; ok {
+			streams = append(streams, stream)
+		}
 		delete(mgr.id2Stream, vid)
+		delete(mgr.id2UsageHistogramOfStream, vid)
 	}
+	return streams
 }
 
 func (mgr *singleTypeChannelsMgr) updateChannels(channels map[vChan]pChan) {
@@ -280,6 +287,13 @@ func (mgr *singleTypeChannelsMgr) deleteAllVChans() {
 	mgr.id2vchans = nil
 }
 
+func (mgr *singleTypeChannelsMgr) deleteCollection(collectionID UniqueID) {
+	mgr.collMtx.Lock()
+	defer mgr.collMtx.Unlock()
+
+	delete(mgr.collectionID2VIDs, collectionID)
+}
+
 func (mgr *singleTypeChannelsMgr) deleteAllCollection() {
 	mgr.collMtx.Lock()
 	defer mgr.collMtx.Unlock()
@@ -402,7 +416,15 @@ func (mgr *singleTypeChannelsMgr) removeStream(collectionID UniqueID) error {
 	}
 
 	mgr.deleteVChansByVIDs(ids)
-	mgr.deleteStreamByVIDs(ids)
+	streams := mgr.deleteStreamByVIDs(ids)
+	mgr.deleteCollection(collectionID)
+
+	// close the streams at once rather than leaving them to the finalizers, the producers of the channels
+	// are released then, an insert still holding the stream fails instead of producing to the old channels
+	for _, stream := range streams {
+		runtime.SetFinalizer(stream, nil)
+		stream.Close()
+	}
 
 	return nil
 }
@@ -135,6 +135,8 @@ func TestChannelsMgrImpl_removeDMLMsgStream(t *testing.T) {
 
 	_, err = mgr.getDMLStream(collID)
 	assert.NotEqual(t, nil, err)
+	_, err = mgr.getVChannels(collID)
+	assert.NotEqual(t, nil, err)
 }
 
 func TestChannelsMgrImpl_removeAllDMLMsgStream(t *testing.T) {
@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const defaultSessionTsCacheCapacity = 65536
+
+// sessionKey identifies the writes of a client session to a collection
+type sessionKey struct {
+	session      string
+	collectionID UniqueID
+}
+
+type sessionTsEntry struct {
+	key sessionKey
+	ts  Timestamp
+}
+
+// sessionTsCache records the timestamp of the last write of each client session to each collection through
+// this proxy, it is used as the guarantee timestamp of Session consistency. The least recently used entries
+// are evicted once the cache holds capacity entries, a session whose entry is evicted falls back to Strong.
+type sessionTsCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[sessionKey]*list.Element
+}
+
+func newSessionTsCache(capacity int) *sessionTsCache {
+	return &sessionTsCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[sessionKey]*list.Element),
+	}
+}
+
+// getSessionID returns the identity of the client session of a request, which is the authenticated user
+// and the address of the client connection
+func getSessionID(ctx context.Context) string {
+	var session string
+	if username, err := GetCurUserFromContext(ctx); err == nil {
+		session = username + "@"
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		session += p.Addr.String()
+	}
+	return session
+}
+
+func (c *sessionTsCache) update(ctx context.Context, collectionID UniqueID, ts Timestamp) {
+	key := sessionKey{session: getSessionID(ctx), collectionID: collectionID}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*sessionTsEntry)
+		if ts > entry.ts {
+			entry.ts = ts
+		}
+		c.ll.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&sessionTsEntry{key: key, ts: ts})
+	for c.ll.Len() > c.capacity {
+		elem := c.ll.Back()
+		c.ll.Remove(elem)
+		delete(c.items, elem.Value.(*sessionTsEntry).key)
+	}
+}
+
+// get returns the last write timestamp of the session of ctx to the collection, ok is false if it's unknown
+func (c *sessionTsCache) get(ctx context.Context, collectionID UniqueID) (Timestamp, bool) {
+	key := sessionKey{session: getSessionID(ctx), collectionID: collectionID}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.items[key]
+	if !ok {
+		return 0, false
+	}
+	c.ll.MoveToFront(elem)
+	return elem.Value.(*sessionTsEntry).ts, true
+}
+
+// getGuaranteeTimestamp translates a consistency level to the guarantee timestamp of a search or query,
+// query nodes serve the request only after their service time has reached it.
+// Strong uses the timestamp of the request, which is the latest tso. Session uses the last write
+// timestamp observed by this proxy. Bounded uses the timestamp of the request minus the graceful time
+// in milliseconds. Eventually uses zero so the request is served immediately.
+func getGuaranteeTimestamp(level commonpb.ConsistencyLevel, gracefulTime uint64, beginTs Timestamp, lastWriteTs Timestamp) Timestamp {
+	switch level {
+	case commonpb.ConsistencyLevel_Session:
+		return lastWriteTs
+	case commonpb.ConsistencyLevel_Bounded:
+		physical, _ := tsoutil.ParseHybridTs(beginTs)
+		if physical <= gracefulTime {
+			return typeutil.ZeroTimestamp
+		}
+		return tsoutil.ComposeTS(int64(physical-gracefulTime), 0)
+	case commonpb.ConsistencyLevel_Eventually:
+		return typeutil.ZeroTimestamp
+	default:
+		return beginTs
+	}
+}
+
+// translateConsistencyLevel returns the consistency level and the guarantee timestamp of a search or query
+// request which doesn't specify the guarantee timestamp explicitly.
+// The consistency level of the collection is used only if useDefault is set, the level of the request is never
+// taken as unspecified since Strong is its zero value, so a request leaving both unset is served as Strong. A
+// Session request is served as Strong if the last write of
+// its session is unknown to this proxy, e.g. the session wrote through another proxy or its entry was evicted.
+func translateConsistencyLevel(ctx context.Context, dbName, collectionName string, level commonpb.ConsistencyLevel,
+	useDefault bool, gracefulTime uint64, beginTs Timestamp, sessionTs *sessionTsCache) (commonpb.ConsistencyLevel, Timestamp, error) {
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName)
+	if err != nil {
+		return level, 0, err
+	}
+	if useDefault {
+		level = collInfo.consistencyLevel
+	}
+	if gracefulTime == 0 {
+		gracefulTime = Params.GracefulTime
+	}
+	var lastWriteTs Timestamp
+	if level == commonpb.ConsistencyLevel_Session {
+		var ok bool
+		if sessionTs != nil {
+			lastWriteTs, ok = sessionTs.get(ctx, collInfo.collID)
+		}
+		if !ok {
+			level = commonpb.ConsistencyLevel_Strong
+		}
+	}
+	return level, getGuaranteeTimestamp(level, gracefulTime, beginTs, lastWriteTs), nil
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestSessionTsCache(t *testing.T) {
+	cache := newSessionTsCache(3)
+	ctx1 := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1}})
+	ctx2 := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2}})
+	_, ok := cache.get(ctx1, 1)
+	assert.False(t, ok)
+
+	cache.update(ctx1, 1, 100)
+	ts, ok := cache.get(ctx1, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Timestamp(100), ts)
+
+	// timestamp never goes back
+	cache.update(ctx1, 1, 50)
+	ts, _ = cache.get(ctx1, 1)
+	assert.Equal(t, Timestamp(100), ts)
+
+	// the writes of another session are not observed
+	_, ok = cache.get(ctx2, 1)
+	assert.False(t, ok)
+	cache.update(ctx2, 1, 200)
+	ts, _ = cache.get(ctx1, 1)
+	assert.Equal(t, Timestamp(100), ts)
+	ts, _ = cache.get(ctx2, 1)
+	assert.Equal(t, Timestamp(200), ts)
+
+	// the least recently used entry is evicted
+	cache.update(ctx1, 2, 300)
+	cache.update(ctx1, 3, 400)
+	_, ok = cache.get(ctx2, 1)
+	assert.True(t, ok)
+	_, ok = cache.get(ctx1, 1)
+	assert.False(t, ok)
+	_, ok = cache.get(ctx1, 3)
+	assert.True(t, ok)
+}
+
+func TestGetSessionID(t *testing.T) {
+	assert.Equal(t, "", getSessionID(context.Background()))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 19530}})
+	assert.Equal(t, "127.0.0.1:19530", getSessionID(ctx))
+
+	ctx = context.WithValue(ctx, ctxUsernameKey{}, "root")
+	assert.Equal(t, "root@127.0.0.1:19530", getSessionID(ctx))
+}
+
+func TestGetGuaranteeTimestamp(t *testing.T) {
+	beginTs := tsoutil.ComposeTS(10000, 5)
+	lastWriteTs := tsoutil.ComposeTS(9000, 1)
+
+	ts := getGuaranteeTimestamp(commonpb.ConsistencyLevel_Strong, 1000, beginTs, lastWriteTs)
+	assert.Equal(t, beginTs, ts)
+
+	ts = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Session, 1000, beginTs, lastWriteTs)
+	assert.Equal(t, lastWriteTs, ts)
+
+	ts = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Bounded, 1000, beginTs, lastWriteTs)
+	assert.Equal(t, tsoutil.ComposeTS(9000, 0), ts)
+
+	ts = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Bounded, 20000, beginTs, lastWriteTs)
+	assert.Equal(t, Timestamp(0), ts)
+
+	ts = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Eventually, 1000, beginTs, lastWriteTs)
+	assert.Equal(t, Timestamp(0), ts)
+}
+
+type consistencyTestCache struct {
+	Cache
+	info *collectionInfo
+}
+
+func (c *consistencyTestCache) GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
+	return c.info, nil
+}
+
+func TestTranslateConsistencyLevel(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	globalMetaCache = &consistencyTestCache{info: &collectionInfo{collID: 1, consistencyLevel: commonpb.ConsistencyLevel_Eventually}}
+
+	beginTs := tsoutil.ComposeTS(10000, 5)
+	ctx := context.Background()
+
+	// an explicit Strong is not replaced by the level of the collection
+	level, ts, err := translateConsistencyLevel(ctx, "", "coll", commonpb.ConsistencyLevel_Strong, false, 1000, beginTs, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Strong, level)
+	assert.Equal(t, beginTs, ts)
+
+	level, ts, err = translateConsistencyLevel(ctx, "", "coll", commonpb.ConsistencyLevel_Strong, true, 1000, beginTs, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Eventually, level)
+	assert.Equal(t, Timestamp(0), ts)
+
+	level, _, err = translateConsistencyLevel(ctx, "", "coll", commonpb.ConsistencyLevel_Bounded, false, 1000, beginTs, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Bounded, level)
+
+	// the last write of the session is unknown
+	level, ts, err = translateConsistencyLevel(ctx, "", "coll", commonpb.ConsistencyLevel_Session, false, 1000, beginTs, newSessionTsCache(1))
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Strong, level)
+	assert.Equal(t, beginTs, ts)
+}
@@ -81,7 +81,18 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.DbName, collectionName) // no need to return error, though collection may be not cached
+	}
+	if node.searchCache != nil {
+		node.searchCache.removeCollection(request.DbName, collectionName, request.GetBase().GetTimestamp())
+	}
+	// the channels of the collection are changed, the dml stream is created again with the new channels
+	if request.CollectionID != 0 && node.chMgr != nil {
+		pchans, _ := node.chMgr.getChannels(request.CollectionID)
+		for _, pchan := range pchans {
+			_ = node.chTicker.removePChan(pchan)
+		}
+		_ = node.chMgr.removeDMLStream(request.CollectionID)
 	}
 	log.Debug("InvalidateCollectionMetaCache Done",
 		zap.String("role", Params.RoleName),
@@ -117,10 +128,57 @@ func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb
 	}, nil
 }
 
+func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	log.Debug("InvalidateCredentialCache",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	if globalMetaCache != nil {
+		// an empty username expires all the cached credentials
+		if request.Username == "" {
+			globalMetaCache.RemoveAllCredentials()
+		} else {
+			globalMetaCache.RemoveCredential(request.Username)
+		}
+	}
+
+	log.Debug("InvalidateCredentialCache Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	log.Debug("RefreshPolicyInfoCache",
+		zap.String("role", Params.RoleName))
+
+	if globalMetaCache != nil {
+		if err := globalMetaCache.RefreshPolicyInfo(ctx); err != nil {
+			log.Warn("RefreshPolicyInfoCache failed", zap.String("role", Params.RoleName), zap.Error(err))
+			return unexpectedErrorStatus(err), nil
+		}
+	}
+
+	log.Debug("RefreshPolicyInfoCache Done",
+		zap.String("role", Params.RoleName))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Database, request.DbName, "", commonpb.ObjectPrivilege_PrivilegeCreateCollection); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	cct := &CreateCollectionTask{
 		ctx:                     ctx,
 		Condition:               NewTaskCondition(ctx),
@@ -170,6 +228,9 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDropCollection); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	dct := &DropCollectionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -220,6 +281,11 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.BoolResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	hct := &HasCollectionTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -270,6 +336,9 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeLoad); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	lct := &LoadCollectionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -316,6 +385,9 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeRelease); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	rct := &ReleaseCollectionTask{
 		ctx:                      ctx,
 		Condition:                NewTaskCondition(ctx),
@@ -365,6 +437,11 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	dct := &DescribeCollectionTask{
 		ctx:                       ctx,
 		Condition:                 NewTaskCondition(ctx),
@@ -417,6 +494,11 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.GetCollectionStatisticsResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	g := &GetCollectionStatisticsTask{
 		ctx:                            ctx,
 		Condition:                      NewTaskCondition(ctx),
@@ -469,6 +551,11 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Database, request.DbName, "", commonpb.ObjectPrivilege_PrivilegeShowCollections); err != nil {
+		return &milvuspb.ShowCollectionsResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	sct := &ShowCollectionsTask{
 		ctx:                    ctx,
 		Condition:              NewTaskCondition(ctx),
@@ -511,10 +598,477 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 	return sct.result, nil
 }
 
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Global, "", request.DbName, commonpb.ObjectPrivilege_PrivilegeCreateDatabase); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	cdt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(cdt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("CreateDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("CreateDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = cdt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return cdt.result, nil
+}
+
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Global, "", request.DbName, commonpb.ObjectPrivilege_PrivilegeDropDatabase); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	ddt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(ddt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("DropDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("DropDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = ddt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return ddt.result, nil
+}
+
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	ldt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(ldt)
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("ListDatabases",
+		zap.String("role", Params.RoleName),
+		zap.Any("request", request))
+	defer func() {
+		log.Debug("ListDatabases Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Any("request", request),
+			zap.Any("result", ldt.result))
+	}()
+
+	err = ldt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return ldt.result, nil
+}
+
+func (node *Proxy) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeAddField); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	aft := &AddFieldTask{
+		ctx:             ctx,
+		Condition:       NewTaskCondition(ctx),
+		AddFieldRequest: request,
+		rootCoord:       node.rootCoord,
+		result:          nil,
+	}
+
+	err := node.sched.DdQueue.Enqueue(aft)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("AddField",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+	defer func() {
+		log.Debug("AddField Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.GetField().GetName()))
+	}()
+
+	err = aft.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return aft.result, nil
+}
+
+func (node *Proxy) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.OldName, commonpb.ObjectPrivilege_PrivilegeRenameCollection); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	rct := &RenameCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		RenameCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+		result:                  nil,
+	}
+
+	err := node.sched.DdQueue.Enqueue(rct)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("RenameCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("old name", request.OldName),
+		zap.String("new name", request.NewName))
+	defer func() {
+		log.Debug("RenameCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("old name", request.OldName),
+			zap.String("new name", request.NewName))
+	}()
+
+	err = rct.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return rct.result, nil
+}
+
+func (node *Proxy) ListDDLHistory(ctx context.Context, request *milvuspb.ListDDLHistoryRequest) (*milvuspb.ListDDLHistoryResponse, error) {
+	log.Debug("ListDDLHistory",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Uint64("since_ts", request.SinceTs))
+
+	if !node.checkHealthy() {
+		return &milvuspb.ListDDLHistoryResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	objectType := commonpb.ObjectType_Collection
+	if request.CollectionName == "" {
+		objectType = commonpb.ObjectType_Database
+	}
+	if err := checkPrivilege(ctx, objectType, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeListDDLHistory); err != nil {
+		return &milvuspb.ListDDLHistoryResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_ListDDLHistory,
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.ListDDLHistory(ctx, request)
+	if err != nil {
+		return &milvuspb.ListDDLHistoryResponse{
+			Status: unexpectedErrorStatus(err),
+		}, nil
+	}
+
+	log.Debug("ListDDLHistory Done",
+		zap.String("role", Params.RoleName),
+		zap.Int("records", len(resp.Records)))
+	return resp, nil
+}
+
+func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeAlterCollection); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	act := &AlterCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		AlterCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+		result:                 nil,
+	}
+
+	err := node.sched.DdQueue.Enqueue(act)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("AlterCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Bool("deletion protection", request.DeletionProtection))
+	defer func() {
+		log.Debug("AlterCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Bool("deletion protection", request.DeletionProtection))
+	}()
+
+	err = act.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return act.result, nil
+}
+
+func (node *Proxy) ListDroppedCollections(ctx context.Context, request *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	log.Debug("ListDroppedCollections",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName))
+
+	if !node.checkHealthy() {
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Database, request.DbName, "", commonpb.ObjectPrivilege_PrivilegeShowCollections); err != nil {
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_ListDroppedCollections,
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.ListDroppedCollections(ctx, request)
+	if err != nil {
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: unexpectedErrorStatus(err),
+		}, nil
+	}
+
+	log.Debug("ListDroppedCollections Done",
+		zap.String("role", Params.RoleName),
+		zap.Strings("collections", resp.CollectionNames))
+	return resp, nil
+}
+
+func (node *Proxy) RecoverCollection(ctx context.Context, request *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Database, request.DbName, "", commonpb.ObjectPrivilege_PrivilegeRecoverCollection); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	rct := &RecoverCollectionTask{
+		ctx:                      ctx,
+		Condition:                NewTaskCondition(ctx),
+		RecoverCollectionRequest: request,
+		rootCoord:                node.rootCoord,
+		result:                   nil,
+	}
+
+	err := node.sched.DdQueue.Enqueue(rct)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("RecoverCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.Int64("collectionID", request.CollectionID))
+	defer func() {
+		log.Debug("RecoverCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.Int64("collectionID", request.CollectionID))
+	}()
+
+	err = rct.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return rct.result, nil
+}
+
+func (node *Proxy) ReshardCollection(ctx context.Context, request *milvuspb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeReshardCollection); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	rct := &ReshardCollectionTask{
+		ctx:                      ctx,
+		Condition:                NewTaskCondition(ctx),
+		ReshardCollectionRequest: request,
+		rootCoord:                node.rootCoord,
+		result:                   nil,
+	}
+
+	err := node.sched.DdQueue.Enqueue(rct)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("ReshardCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int32("shards num", request.ShardsNum))
+	defer func() {
+		log.Debug("ReshardCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Int32("shards num", request.ShardsNum))
+	}()
+
+	err = rct.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return rct.result, nil
+}
+
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeCreatePartition); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	cpt := &CreatePartitionTask{
 		ctx:                    ctx,
 		Condition:              NewTaskCondition(ctx),
@@ -563,6 +1117,9 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDropPartition); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	dpt := &DropPartitionTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -614,6 +1171,11 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.BoolResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	hpt := &HasPartitionTask{
 		ctx:                 ctx,
 		Condition:           NewTaskCondition(ctx),
@@ -669,6 +1231,9 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeLoad); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	lpt := &LoadPartitionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -717,6 +1282,9 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeRelease); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	rpt := &ReleasePartitionTask{
 		ctx:                      ctx,
 		Condition:                NewTaskCondition(ctx),
@@ -764,7 +1332,12 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb.GetPartitionStatisticsRequest) (*milvuspb.GetPartitionStatisticsResponse, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.GetPartitionStatisticsResponse{
-			Status: unhealthyStatus(),
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.GetPartitionStatisticsResponse{
+			Status: permissionDeniedStatus(err),
 		}, nil
 	}
 	g := &GetPartitionStatisticsTask{
@@ -821,6 +1394,11 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.ShowPartitionsResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	spt := &ShowPartitionsTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -868,6 +1446,9 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeCreateIndex); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	cit := &CreateIndexTask{
 		ctx:                ctx,
 		Condition:          NewTaskCondition(ctx),
@@ -920,6 +1501,11 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.DescribeIndexResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	dit := &DescribeIndexTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -978,6 +1564,9 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDropIndex); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
 	dit := &DropIndexTask{
 		ctx:              ctx,
 		Condition:        NewTaskCondition(ctx),
@@ -1030,6 +1619,11 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.GetIndexBuildProgressResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	gibpt := &GetIndexBuildProgressTask{
 		ctx:                          ctx,
 		Condition:                    NewTaskCondition(ctx),
@@ -1090,6 +1684,11 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.GetIndexStateResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	dipt := &GetIndexStateTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -1147,6 +1746,11 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeInsert); err != nil {
+		return &milvuspb.MutationResult{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	it := &InsertTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -1161,6 +1765,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					MsgType: commonpb.MsgType_Insert,
 					MsgID:   0,
 				},
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				// RowData: transfer column based request to this
@@ -1170,9 +1775,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		segIDAssigner:  node.segAssigner,
 		chMgr:          node.chMgr,
 		chTicker:       node.chTicker,
-	}
-	if len(it.PartitionName) <= 0 {
-		it.PartitionName = Params.DefaultPartitionName
+		sessionTs:      node.sessionTs,
 	}
 
 	result := &milvuspb.MutationResult{
@@ -1246,6 +1849,11 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeSearch); err != nil {
+		return &milvuspb.SearchResults{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	qt := &SearchTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -1256,10 +1864,12 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			},
 			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
 		},
-		resultBuf: make(chan []*internalpb.SearchResults),
-		query:     request,
-		chMgr:     node.chMgr,
-		qc:        node.queryCoord,
+		resultBuf:   make(chan []*internalpb.SearchResults),
+		query:       request,
+		chMgr:       node.chMgr,
+		qc:          node.queryCoord,
+		sessionTs:   node.sessionTs,
+		searchCache: node.searchCache,
 	}
 
 	err := node.sched.DqQueue.Enqueue(qt)
@@ -1331,6 +1941,12 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 		resp.Status.Reason = "proxy is not healthy"
 		return resp, nil
 	}
+	for _, collectionName := range request.CollectionNames {
+		if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, collectionName, commonpb.ObjectPrivilege_PrivilegeFlush); err != nil {
+			resp.Status = permissionDeniedStatus(err)
+			return resp, nil
+		}
+	}
 	ft := &FlushTask{
 		ctx:          ctx,
 		Condition:    NewTaskCondition(ctx),
@@ -1369,12 +1985,125 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 	return ft.result, nil
 }
 
+// Export starts writing the flushed data of a collection as parquet files in DataCoord, the returned task id is
+// polled by GetExportState
+func (node *Proxy) Export(ctx context.Context, request *milvuspb.ExportRequest) (*milvuspb.ExportResponse, error) {
+	resp := &milvuspb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeExport); err != nil {
+		resp.Status = permissionDeniedStatus(err)
+		return resp, nil
+	}
+	et := &ExportTask{
+		ctx:           ctx,
+		Condition:     NewTaskCondition(ctx),
+		ExportRequest: request,
+		dataCoord:     node.dataCoord,
+	}
+
+	err := node.sched.DqQueue.Enqueue(et)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("Export",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Strings("partitions", request.PartitionNames),
+		zap.String("output path", request.OutputPath))
+	defer func() {
+		log.Debug("Export Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Strings("partitions", request.PartitionNames),
+			zap.String("output path", request.OutputPath))
+	}()
+
+	err = et.WaitToFinish()
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	return et.result, nil
+}
+
+// GetExportState returns the state of an export task of a collection
+func (node *Proxy) GetExportState(ctx context.Context, request *milvuspb.GetExportStateRequest) (*milvuspb.GetExportStateResponse, error) {
+	log.Debug("GetExportState",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int64("taskID", request.TaskID))
+
+	resp := &milvuspb.GetExportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeExport); err != nil {
+		resp.Status = permissionDeniedStatus(err)
+		return resp, nil
+	}
+	// the collection is resolved so the task of another collection isn't seen without its privilege
+	collID, err := globalMetaCache.GetCollectionID(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	stateResp, err := node.dataCoord.GetExportState(ctx, &datapb.GetExportStateRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetExportState,
+			SourceID: Params.ProxyID,
+		},
+		TaskID:       request.TaskID,
+		CollectionID: collID,
+	})
+	if err != nil {
+		resp.Status.Reason = fmt.Errorf("dataCoord:GetExportState, err:%w", err).Error()
+		return resp, nil
+	}
+	if stateResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		resp.Status.Reason = stateResp.Status.Reason
+		return resp, nil
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.State = stateResp.State
+	resp.Files = stateResp.Files
+	resp.NumRows = stateResp.NumRows
+	resp.FailReason = stateResp.FailReason
+	return resp, nil
+}
+
 func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.QueryResults{
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, request.CollectionName, commonpb.ObjectPrivilege_PrivilegeQuery); err != nil {
+		return &milvuspb.QueryResults{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 
 	queryRequest := &milvuspb.QueryRequest{
 		DbName:         request.DbName,
@@ -1398,6 +2127,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		query:     queryRequest,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		sessionTs: node.sessionTs,
 	}
 
 	err := node.sched.DqQueue.Enqueue(qt)
@@ -1455,11 +2185,23 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 		}, nil
 	}
 
+	// the vectors of ids are queried from the collections, which requires the privilege to query them
+	for _, ids := range []*milvuspb.VectorIDs{request.GetOpLeft().GetIdArray(), request.GetOpRight().GetIdArray()} {
+		if ids == nil {
+			continue
+		}
+		if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, request.DbName, ids.CollectionName, commonpb.ObjectPrivilege_PrivilegeQuery); err != nil {
+			return &milvuspb.CalcDistanceResults{
+				Status: permissionDeniedStatus(err),
+			}, nil
+		}
+	}
+
 	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
 		outputFields := []string{ids.FieldName}
 
 		queryRequest := &milvuspb.QueryRequest{
-			DbName:         "",
+			DbName:         request.DbName,
 			CollectionName: ids.CollectionName,
 			PartitionNames: ids.PartitionNames,
 			OutputFields:   outputFields,
@@ -1480,6 +2222,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			query:     queryRequest,
 			chMgr:     node.chMgr,
 			qc:        node.queryCoord,
+			sessionTs: node.sessionTs,
 		}
 
 		err := node.sched.DqQueue.Enqueue(qt)
@@ -1754,6 +2497,11 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 		resp.Status = unhealthyStatus()
 		return resp, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, req.DbName, req.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.GetPersistentSegmentInfoResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = fmt.Errorf("getSegmentsOfCollection, err:%w", err).Error()
@@ -1807,6 +2555,11 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 		resp.Status = unhealthyStatus()
 		return resp, nil
 	}
+	if err := checkPrivilege(ctx, commonpb.ObjectType_Collection, req.DbName, req.CollectionName, commonpb.ObjectPrivilege_PrivilegeDescribeCollection); err != nil {
+		return &milvuspb.GetQuerySegmentInfoResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
 	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = err.Error()
@@ -2041,6 +2794,326 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 	}, nil
 }
 
+// checkManageUser checks the privilege of managing users, roles and grants
+func checkManageUser(ctx context.Context) error {
+	return checkPrivilege(ctx, commonpb.ObjectType_Global, "", "", commonpb.ObjectPrivilege_PrivilegeManageUser)
+}
+
+func illegalArgumentStatus(err error) *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_IllegalArgument,
+		Reason:    err.Error(),
+	}
+}
+
+func unexpectedErrorStatus(err error) *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Reason:    err.Error(),
+	}
+}
+
+func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("CreateCredential",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	if err := ValidatePassword(req.Password); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_CreateCredential,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.CreateCredential(ctx, req)
+	if err != nil {
+		return unexpectedErrorStatus(err), nil
+	}
+
+	log.Debug("CreateCredential Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username),
+		zap.String("status", status.ErrorCode.String()))
+	return status, nil
+}
+
+func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("UpdateCredential",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	// users are always allowed to change their own passwords
+	if curUser, err := GetCurUserFromContext(ctx); err != nil || curUser != req.Username {
+		if err := checkManageUser(ctx); err != nil {
+			return permissionDeniedStatus(err), nil
+		}
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	if err := ValidatePassword(req.NewPassword); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_UpdateCredential,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.UpdateCredential(ctx, req)
+	if err != nil {
+		return unexpectedErrorStatus(err), nil
+	}
+
+	log.Debug("UpdateCredential Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username),
+		zap.String("status", status.ErrorCode.String()))
+	return status, nil
+}
+
+func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("DeleteCredential",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_DeleteCredential,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.DeleteCredential(ctx, req)
+	if err != nil {
+		return unexpectedErrorStatus(err), nil
+	}
+
+	log.Debug("DeleteCredential Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username),
+		zap.String("status", status.ErrorCode.String()))
+	return status, nil
+}
+
+func (node *Proxy) ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	log.Debug("ListUsers",
+		zap.String("role", Params.RoleName))
+
+	if !node.checkHealthy() {
+		return &milvuspb.ListUsersResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return &milvuspb.ListUsersResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_ListUsers,
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.ListUsers(ctx, req)
+	if err != nil {
+		return &milvuspb.ListUsersResponse{
+			Status: unexpectedErrorStatus(err),
+		}, nil
+	}
+
+	log.Debug("ListUsers Done",
+		zap.String("role", Params.RoleName),
+		zap.Int("users", len(resp.Usernames)))
+	return resp, nil
+}
+
+func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	log.Debug("CreateRole",
+		zap.String("role", Params.RoleName),
+		zap.String("role_name", req.RoleName))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_CreateRole,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.CreateRole(ctx, req)
+	if err != nil {
+		return unexpectedErrorStatus(err), nil
+	}
+
+	log.Debug("CreateRole Done",
+		zap.String("role", Params.RoleName),
+		zap.String("role_name", req.RoleName),
+		zap.String("status", status.ErrorCode.String()))
+	return status, nil
+}
+
+func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole",
+		zap.String("role", Params.RoleName),
+		zap.String("role_name", req.RoleName))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_DropRole,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.DropRole(ctx, req)
+	if err != nil {
+		return unexpectedErrorStatus(err), nil
+	}
+
+	log.Debug("DropRole Done",
+		zap.String("role", Params.RoleName),
+		zap.String("role_name", req.RoleName),
+		zap.String("status", status.ErrorCode.String()))
+	return status, nil
+}
+
+func (node *Proxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	log.Debug("OperateUserRole",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username),
+		zap.String("role_name", req.RoleName),
+		zap.String("type", req.Type.String()))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_OperateUserRole,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.OperateUserRole(ctx, req)
+	if err != nil {
+		return unexpectedErrorStatus(err), nil
+	}
+
+	log.Debug("OperateUserRole Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", req.Username),
+		zap.String("role_name", req.RoleName),
+		zap.String("status", status.ErrorCode.String()))
+	return status, nil
+}
+
+func (node *Proxy) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("OperatePrivilege",
+		zap.String("role", Params.RoleName),
+		zap.Any("entity", req.Entity),
+		zap.String("type", req.Type.String()))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return permissionDeniedStatus(err), nil
+	}
+	if req.Entity == nil {
+		return illegalArgumentStatus(errors.New("the grant entity should not be empty")), nil
+	}
+	if err := ValidateRoleName(req.Entity.RoleName); err != nil {
+		return illegalArgumentStatus(err), nil
+	}
+	if req.Entity.ObjectType != commonpb.ObjectType_Global && req.Entity.ObjectName == "" {
+		return illegalArgumentStatus(errors.New("the object name should not be empty, use * for all the objects")), nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_OperatePrivilege,
+		SourceID: Params.ProxyID,
+	}
+	status, err := node.rootCoord.OperatePrivilege(ctx, req)
+	if err != nil {
+		return unexpectedErrorStatus(err), nil
+	}
+
+	log.Debug("OperatePrivilege Done",
+		zap.String("role", Params.RoleName),
+		zap.Any("entity", req.Entity),
+		zap.String("status", status.ErrorCode.String()))
+	return status, nil
+}
+
+func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	log.Debug("SelectGrant",
+		zap.String("role", Params.RoleName),
+		zap.String("role_name", req.RoleName))
+
+	if !node.checkHealthy() {
+		return &milvuspb.SelectGrantResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if err := checkManageUser(ctx); err != nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: permissionDeniedStatus(err),
+		}, nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: illegalArgumentStatus(err),
+		}, nil
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_SelectGrant,
+		SourceID: Params.ProxyID,
+	}
+	resp, err := node.rootCoord.SelectGrant(ctx, req)
+	if err != nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: unexpectedErrorStatus(err),
+		}, nil
+	}
+
+	log.Debug("SelectGrant Done",
+		zap.String("role", Params.RoleName),
+		zap.String("role_name", req.RoleName),
+		zap.Int("grants", len(resp.Entities)))
+	return resp, nil
+}
+
 // checkHealthy checks proxy state is Healthy
 func (node *Proxy) checkHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
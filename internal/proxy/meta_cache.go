@@ -22,20 +22,33 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// Cache caches the meta of collections, collections are identified by database name and collection name,
+// an empty database name refers to the default database.
 type Cache interface {
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error)
+	GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error)
+	GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error)
+	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error)
+	RemoveCollection(ctx context.Context, database, collectionName string)
+	RemovePartition(ctx context.Context, database, collectionName string, partitionName string)
+	RemoveDatabase(ctx context.Context, database string)
+
+	GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error)
+	UpdateCredential(credInfo *credentialInfo)
+	RemoveCredential(username string)
+	RemoveAllCredentials()
+
+	GetUserRoles(ctx context.Context, username string) ([]string, error)
+	GetRoleGrants(ctx context.Context, roleName string) ([]*milvuspb.GrantEntity, error)
+	RefreshPolicyInfo(ctx context.Context) error
 }
 
 type collectionInfo struct {
@@ -44,6 +57,7 @@ type collectionInfo struct {
 	partInfo            map[string]*partitionInfo
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
+	consistencyLevel    commonpb.ConsistencyLevel
 }
 
 type partitionInfo struct {
@@ -52,11 +66,27 @@ type partitionInfo struct {
 	createdUtcTimestamp uint64
 }
 
+// credentialInfo caches the credential of a user, sha256Password is the digest of the last password
+// verified against encryptedPassword, it saves the cost of bcrypt for the following requests
+type credentialInfo struct {
+	username          string
+	encryptedPassword string
+	sha256Password    string
+}
+
 type MetaCache struct {
 	client types.RootCoord
 
-	collInfo map[string]*collectionInfo
+	collInfo map[string]map[string]*collectionInfo // database name -> collection name -> collection info
 	mu       sync.RWMutex
+
+	credMap map[string]*credentialInfo // username -> credential info
+	credMut sync.RWMutex
+
+	policyLoaded bool
+	userRoles    map[string][]string                // username -> role names
+	roleGrants   map[string][]*milvuspb.GrantEntity // role name -> grants
+	policyMut    sync.RWMutex
 }
 
 var globalMetaCache Cache
@@ -73,24 +103,26 @@ func InitMetaCache(client types.RootCoord) error {
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
 		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		collInfo: map[string]map[string]*collectionInfo{},
+		credMap:  map[string]*credentialInfo{},
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *MetaCache) GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
+	database = normalizeDatabaseName(database)
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[database][collectionName]
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, database, collectionName)
+		collInfo = m.collInfo[database][collectionName]
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -98,21 +130,22 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 	return collInfo.collID, nil
 }
 
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
+	database = normalizeDatabaseName(database)
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[database][collectionName]
 	m.mu.RUnlock()
 
 	if !ok {
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, database, collectionName)
+		collInfo = m.collInfo[database][collectionName]
 	}
 
 	return &collectionInfo{
@@ -121,23 +154,25 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string
 		partInfo:            collInfo.partInfo,
 		createdTimestamp:    collInfo.createdTimestamp,
 		createdUtcTimestamp: collInfo.createdUtcTimestamp,
+		consistencyLevel:    collInfo.consistencyLevel,
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+	database = normalizeDatabaseName(database)
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[database][collectionName]
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, database, collectionName)
+		collInfo = m.collInfo[database][collectionName]
 		return collInfo.schema, nil
 	}
 	defer m.mu.RUnlock()
@@ -145,34 +180,39 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, database, collectionName string) {
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = map[string]*collectionInfo{}
+	}
+	_, ok := m.collInfo[database][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		m.collInfo[database][collectionName] = &collectionInfo{}
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	m.collInfo[database][collectionName].schema = coll.Schema
+	m.collInfo[database][collectionName].collID = coll.CollectionID
+	m.collInfo[database][collectionName].createdTimestamp = coll.CreatedTimestamp
+	m.collInfo[database][collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	m.collInfo[database][collectionName].consistencyLevel = coll.ConsistencyLevel
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, database, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+	database = normalizeDatabaseName(database)
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[database][collectionName]
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -181,7 +221,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -189,10 +229,10 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		m.updatePartitions(partitions, collectionName)
+		m.updatePartitions(partitions, database, collectionName)
 
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
+		partInfo := m.collInfo[database][collectionName].partInfo
 		for k, v := range partInfo {
 			ret[k] = v.partitionID
 		}
@@ -202,7 +242,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	defer m.mu.RUnlock()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := m.collInfo[database][collectionName].partInfo
 	for k, v := range partInfo {
 		ret[k] = v.partitionID
 	}
@@ -210,15 +250,16 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error) {
+	database = normalizeDatabaseName(database)
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[database][collectionName]
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -229,7 +270,7 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	m.mu.RUnlock()
 
 	if !ok {
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -237,10 +278,10 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 		m.mu.Lock()
 		defer m.mu.Unlock()
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions before update", partitions), zap.Any("collectionName", collectionName))
-		m.updatePartitions(partitions, collectionName)
+		m.updatePartitions(partitions, database, collectionName)
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		partInfo, ok = m.collInfo[database][collectionName].partInfo[partitionName]
 		if !ok {
 			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
 		}
@@ -252,11 +293,12 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	}, nil
 }
 
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, database, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 	coll, err := m.client.DescribeCollection(ctx, req)
@@ -279,6 +321,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		CreatedTimestamp:     coll.CreatedTimestamp,
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
+		ConsistencyLevel:     coll.ConsistencyLevel,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= 100 { // TODO(dragondriver): use StartOfUserField to replace 100
@@ -288,11 +331,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, database, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 
@@ -312,14 +356,17 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, database, collectionName string) {
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = map[string]*collectionInfo{}
+	}
+	_, ok := m.collInfo[database][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
+		m.collInfo[database][collectionName] = &collectionInfo{
 			partInfo: map[string]*partitionInfo{},
 		}
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := m.collInfo[database][collectionName].partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -333,25 +380,160 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	m.collInfo[database][collectionName].partInfo = partInfo
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionName string) {
+	database = normalizeDatabaseName(database)
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	delete(m.collInfo[database], collectionName)
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, database, collectionName, partitionName string) {
+	database = normalizeDatabaseName(database)
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	_, ok := m.collInfo[database][collectionName]
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := m.collInfo[database][collectionName].partInfo
 	if partInfo == nil {
 		return
 	}
 	delete(partInfo, partitionName)
 }
+
+func (m *MetaCache) RemoveDatabase(ctx context.Context, database string) {
+	database = normalizeDatabaseName(database)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.collInfo, database)
+}
+
+// normalizeDatabaseName maps the empty database name to the default database
+func normalizeDatabaseName(database string) string {
+	if database == "" {
+		return Params.DefaultDatabaseName
+	}
+	return database
+}
+
+func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error) {
+	m.credMut.RLock()
+	credInfo, ok := m.credMap[username]
+	m.credMut.RUnlock()
+	if ok {
+		return &credentialInfo{
+			username:          credInfo.username,
+			encryptedPassword: credInfo.encryptedPassword,
+			sha256Password:    credInfo.sha256Password,
+		}, nil
+	}
+
+	req := &rootcoordpb.GetCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_GetCredential,
+		},
+		Username: username,
+	}
+	resp, err := m.client.GetCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	credInfo = &credentialInfo{
+		username:          resp.Username,
+		encryptedPassword: resp.EncryptedPassword,
+	}
+	m.UpdateCredential(credInfo)
+	return &credentialInfo{
+		username:          credInfo.username,
+		encryptedPassword: credInfo.encryptedPassword,
+	}, nil
+}
+
+func (m *MetaCache) UpdateCredential(credInfo *credentialInfo) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credMap[credInfo.username] = &credentialInfo{
+		username:          credInfo.username,
+		encryptedPassword: credInfo.encryptedPassword,
+		sha256Password:    credInfo.sha256Password,
+	}
+}
+
+func (m *MetaCache) RemoveCredential(username string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	delete(m.credMap, username)
+}
+
+func (m *MetaCache) RemoveAllCredentials() {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credMap = map[string]*credentialInfo{}
+}
+
+func (m *MetaCache) GetUserRoles(ctx context.Context, username string) ([]string, error) {
+	if err := m.loadPolicyInfo(ctx); err != nil {
+		return nil, err
+	}
+	m.policyMut.RLock()
+	defer m.policyMut.RUnlock()
+	return append([]string{}, m.userRoles[username]...), nil
+}
+
+func (m *MetaCache) GetRoleGrants(ctx context.Context, roleName string) ([]*milvuspb.GrantEntity, error) {
+	if err := m.loadPolicyInfo(ctx); err != nil {
+		return nil, err
+	}
+	m.policyMut.RLock()
+	defer m.policyMut.RUnlock()
+	return append([]*milvuspb.GrantEntity{}, m.roleGrants[roleName]...), nil
+}
+
+// RefreshPolicyInfo reloads the user-role bindings and the grants from root coord
+func (m *MetaCache) RefreshPolicyInfo(ctx context.Context) error {
+	req := &rootcoordpb.ListPolicyRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ListPolicy,
+		},
+	}
+	resp, err := m.client.ListPolicy(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+
+	userRoles := map[string][]string{}
+	for _, ur := range resp.UserRoles {
+		userRoles[ur.Username] = append(userRoles[ur.Username], ur.RoleName)
+	}
+	roleGrants := map[string][]*milvuspb.GrantEntity{}
+	for _, grant := range resp.Grants {
+		roleGrants[grant.RoleName] = append(roleGrants[grant.RoleName], grant)
+	}
+
+	m.policyMut.Lock()
+	defer m.policyMut.Unlock()
+	m.userRoles = userRoles
+	m.roleGrants = roleGrants
+	m.policyLoaded = true
+	return nil
+}
+
+func (m *MetaCache) loadPolicyInfo(ctx context.Context) error {
+	m.policyMut.RLock()
+	loaded := m.policyLoaded
+	m.policyMut.RUnlock()
+	if loaded {
+		return nil
+	}
+	return m.RefreshPolicyInfo(ctx)
+}
@@ -54,6 +54,12 @@ type ParamTable struct {
 	MaxDimension               int64
 	DefaultPartitionName       string
 	DefaultIndexName           string
+	DefaultDatabaseName        string
+	GracefulTime               uint64
+	SearchCacheEnabled         bool
+	SearchCacheCapacity        int
+	SearchCacheStaleness       uint64
+	AuthorizationEnabled       bool
 
 	PulsarMaxMessageSize int
 	Log                  log.Config
@@ -89,6 +95,12 @@ func (pt *ParamTable) initParams() {
 	pt.initMaxDimension()
 	pt.initDefaultPartitionName()
 	pt.initDefaultIndexName()
+	pt.initDefaultDatabaseName()
+	pt.initGracefulTime()
+	pt.initSearchCacheEnabled()
+	pt.initSearchCacheCapacity()
+	pt.initSearchCacheStaleness()
+	pt.initAuthorizationEnabled()
 
 	pt.initPulsarMaxMessageSize()
 	pt.initRoleName()
@@ -199,6 +211,48 @@ func (pt *ParamTable) initDefaultIndexName() {
 	pt.DefaultIndexName = name
 }
 
+func (pt *ParamTable) initDefaultDatabaseName() {
+	name, err := pt.Load("common.defaultDatabaseName")
+	if err != nil {
+		panic(err)
+	}
+	pt.DefaultDatabaseName = name
+}
+
+func (pt *ParamTable) initGracefulTime() {
+	pt.GracefulTime = uint64(pt.ParseInt64("proxy.gracefulTime"))
+}
+
+func (pt *ParamTable) initSearchCacheEnabled() {
+	ret, err := pt.Load("proxy.searchCache.enabled")
+	if err != nil {
+		panic(err)
+	}
+	pt.SearchCacheEnabled, err = strconv.ParseBool(ret)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initSearchCacheCapacity() {
+	pt.SearchCacheCapacity = pt.ParseInt("proxy.searchCache.capacity")
+}
+
+func (pt *ParamTable) initSearchCacheStaleness() {
+	pt.SearchCacheStaleness = uint64(pt.ParseInt64("proxy.searchCache.staleness"))
+}
+
+func (pt *ParamTable) initAuthorizationEnabled() {
+	ret, err := pt.Load("common.security.authorizationEnabled")
+	if err != nil {
+		panic(err)
+	}
+	pt.AuthorizationEnabled, err = strconv.ParseBool(ret)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (pt *ParamTable) initPulsarMaxMessageSize() {
 	// pulsarHost, err := pt.Load("pulsar.address")
 	// if err != nil {
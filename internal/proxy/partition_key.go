@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// A collection with partition key owns a fixed number of hidden partitions which are created by rootcoord
+// along with the collection, rows are routed to them by hashing the value of the partition key field.
+
+// getPartitionKeyPartitionIDs returns the ids of the hidden partitions ordered by their index, the number
+// of them is fixed in the schema when the collection is created
+func getPartitionKeyPartitionIDs(ctx context.Context, dbName, collectionName string, schema *schemapb.CollectionSchema) ([]UniqueID, error) {
+	if schema.GetNumPartitions() <= 0 {
+		return nil, fmt.Errorf("number of partitions of collection %s with partition key is unknown", collectionName)
+	}
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	partitionIDs := make([]UniqueID, schema.GetNumPartitions())
+	for i := range partitionIDs {
+		name := typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, i)
+		partitionID, ok := partitionsMap[name]
+		if !ok {
+			return nil, fmt.Errorf("partition %s of collection %s not found", name, collectionName)
+		}
+		partitionIDs[i] = partitionID
+	}
+	return partitionIDs, nil
+}
+
+// getPartitionKeyData returns the values of the partition key field in the inserted data
+func getPartitionKeyData(fieldsData []*schemapb.FieldData, keyField *schemapb.FieldSchema) ([]int64, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldName() != keyField.Name {
+			continue
+		}
+		switch data := fieldData.GetScalars().GetData().(type) {
+		case *schemapb.ScalarField_LongData:
+			return data.LongData.GetData(), nil
+		case *schemapb.ScalarField_IntData:
+			values := make([]int64, 0, len(data.IntData.GetData()))
+			for _, v := range data.IntData.GetData() {
+				values = append(values, int64(v))
+			}
+			return values, nil
+		default:
+			return nil, fmt.Errorf("partition key field %s should be integer type", keyField.Name)
+		}
+	}
+	return nil, fmt.Errorf("partition key field %s is missing in the inserted data", keyField.Name)
+}
+
+// splitByPartitionKey splits the insert message into one message pack per hidden partition
+func (it *InsertTask) splitByPartitionKey(ctx context.Context, keyField *schemapb.FieldSchema, pack *msgstream.MsgPack) ([]*msgstream.MsgPack, error) {
+	partitionIDs, err := getPartitionKeyPartitionIDs(ctx, it.DbName, it.CollectionName, it.schema)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := getPartitionKeyData(it.req.FieldsData, keyField)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) != len(it.RowData) {
+		return nil, fmt.Errorf("the length of partition key data %d is not equal to the row num %d", len(keys), len(it.RowData))
+	}
+
+	msgs := make(map[int]*msgstream.InsertMsg)
+	packs := make([]*msgstream.MsgPack, 0)
+	for i, key := range keys {
+		idx, err := typeutil.PartitionKeyIndex(key, len(partitionIDs))
+		if err != nil {
+			return nil, err
+		}
+		msg, ok := msgs[idx]
+		if !ok {
+			msg = &msgstream.InsertMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            it.TraceCtx(),
+					BeginTimestamp: it.BeginTimestamp,
+					EndTimestamp:   it.EndTimestamp,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base:           it.Base,
+					DbName:         it.DbName,
+					CollectionName: it.CollectionName,
+					PartitionName:  typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, idx),
+					DbID:           it.DbID,
+					CollectionID:   it.CollectionID,
+					PartitionID:    partitionIDs[idx],
+				},
+			}
+			msgs[idx] = msg
+			packs = append(packs, &msgstream.MsgPack{
+				BeginTs: pack.BeginTs,
+				EndTs:   pack.EndTs,
+				Msgs:    []msgstream.TsMsg{msg},
+			})
+		}
+		msg.HashValues = append(msg.HashValues, it.HashValues[i])
+		msg.Timestamps = append(msg.Timestamps, it.Timestamps[i])
+		msg.RowIDs = append(msg.RowIDs, it.RowIDs[i])
+		msg.RowData = append(msg.RowData, it.RowData[i])
+	}
+	return packs, nil
+}
+
+// getPartitionKeyValues returns the values of the partition key which the expression is restricted to,
+// false is returned if the expression may match rows with any value of the key.
+func getPartitionKeyValues(expr *planpb.Expr, keyFieldID int64) ([]int64, bool) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetColumnInfo().GetFieldId() != keyFieldID || e.UnaryRangeExpr.GetOp() != planpb.OpType_Equal {
+			return nil, false
+		}
+		v, ok := e.UnaryRangeExpr.GetValue().GetVal().(*planpb.GenericValue_Int64Val)
+		if !ok {
+			return nil, false
+		}
+		return []int64{v.Int64Val}, true
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetColumnInfo().GetFieldId() != keyFieldID {
+			return nil, false
+		}
+		values := make([]int64, 0, len(e.TermExpr.GetValues()))
+		for _, value := range e.TermExpr.GetValues() {
+			v, ok := value.GetVal().(*planpb.GenericValue_Int64Val)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, v.Int64Val)
+		}
+		return values, true
+	case *planpb.Expr_BinaryExpr:
+		left, leftOk := getPartitionKeyValues(e.BinaryExpr.GetLeft(), keyFieldID)
+		right, rightOk := getPartitionKeyValues(e.BinaryExpr.GetRight(), keyFieldID)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			// either side restricts the whole expression
+			if leftOk {
+				return left, true
+			}
+			return right, rightOk
+		case planpb.BinaryExpr_LogicalOr:
+			if leftOk && rightOk {
+				return append(left, right...), true
+			}
+		}
+		return nil, false
+	default:
+		return nil, false
+	}
+}
+
+// prunePartitionsByKey returns the hidden partitions which may contain rows matching the predicates,
+// nil is returned if the collection has no partition key or all partitions need to be searched.
+func prunePartitionsByKey(ctx context.Context, dbName, collectionName string, schema *schemapb.CollectionSchema, predicates *planpb.Expr) ([]UniqueID, error) {
+	if predicates == nil {
+		return nil, nil
+	}
+	keyField, err := typeutil.GetPartitionKeyField(schema)
+	if err != nil || keyField == nil {
+		return nil, err
+	}
+	values, ok := getPartitionKeyValues(predicates, keyField.FieldID)
+	if !ok || len(values) == 0 {
+		return nil, nil
+	}
+	partitionIDs, err := getPartitionKeyPartitionIDs(ctx, dbName, collectionName, schema)
+	if err != nil {
+		return nil, err
+	}
+	pruned := make([]UniqueID, 0, len(values))
+	record := make(map[int]bool)
+	for _, v := range values {
+		idx, err := typeutil.PartitionKeyIndex(v, len(partitionIDs))
+		if err != nil {
+			return nil, err
+		}
+		if !record[idx] {
+			record[idx] = true
+			pruned = append(pruned, partitionIDs[idx])
+		}
+	}
+	return pruned, nil
+}
@@ -0,0 +1,360 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestGetPartitionKeyValues(t *testing.T) {
+	schemaPb := newTestSchema()
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+	keyField, err := schema.GetFieldFromName("Int64Field")
+	assert.Nil(t, err)
+
+	cases := []struct {
+		expr   string
+		values []int64
+		ok     bool
+	}{
+		{"Int64Field == 3", []int64{3}, true},
+		{"Int64Field in [1, 2]", []int64{1, 2}, true},
+		{"Int64Field == 3 && FloatField > 1.0", []int64{3}, true},
+		{"FloatField > 1.0 && Int64Field == 3", []int64{3}, true},
+		{"Int64Field == 3 || Int64Field == 4", []int64{3, 4}, true},
+		{"Int64Field == 3 || FloatField > 1.0", nil, false},
+		{"Int64Field > 3", nil, false},
+		{"not (Int64Field == 3)", nil, false},
+		{"Int32Field == 3", nil, false},
+	}
+	for _, c := range cases {
+		expr, err := parseQueryExpr(schema, c.expr)
+		assert.Nil(t, err, c.expr)
+		values, ok := getPartitionKeyValues(expr, keyField.FieldID)
+		assert.Equal(t, c.ok, ok, c.expr)
+		assert.Equal(t, c.values, values, c.expr)
+	}
+}
+
+func TestGetPartitionKeyData(t *testing.T) {
+	keyField := &schemapb.FieldSchema{Name: "tenant", DataType: schemapb.DataType_Int32}
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldName: "tenant",
+			Type:      schemapb.DataType_Int32,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{1, 2, 3}}},
+				},
+			},
+		},
+	}
+	values, err := getPartitionKeyData(fieldsData, keyField)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, values)
+
+	fieldsData[0].Field = &schemapb.FieldData_Scalars{
+		Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{4, 5}}},
+		},
+	}
+	values, err = getPartitionKeyData(fieldsData, keyField)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 5}, values)
+
+	fieldsData[0].Field = &schemapb.FieldData_Scalars{
+		Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: []float32{1}}},
+		},
+	}
+	_, err = getPartitionKeyData(fieldsData, keyField)
+	assert.NotNil(t, err)
+
+	_, err = getPartitionKeyData(nil, keyField)
+	assert.NotNil(t, err)
+}
+
+type partitionKeyTestCache struct {
+	Cache
+	schema     *schemapb.CollectionSchema
+	partitions map[string]UniqueID
+}
+
+func (c *partitionKeyTestCache) GetCollectionID(ctx context.Context, database, collectionName string) (UniqueID, error) {
+	return 1, nil
+}
+
+func (c *partitionKeyTestCache) GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+	return c.schema, nil
+}
+
+func (c *partitionKeyTestCache) GetPartitions(ctx context.Context, database, collectionName string) (map[string]UniqueID, error) {
+	return c.partitions, nil
+}
+
+func (c *partitionKeyTestCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (UniqueID, error) {
+	partitionID, ok := c.partitions[partitionName]
+	if !ok {
+		return 0, fmt.Errorf("partition %s not found", partitionName)
+	}
+	return partitionID, nil
+}
+
+type partitionKeyTestDataCoord struct {
+	types.DataCoord
+}
+
+func (dc *partitionKeyTestDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
+	assignments := make([]*datapb.SegmentIDAssignment, 0, len(req.SegmentIDRequests))
+	for _, r := range req.SegmentIDRequests {
+		assignments = append(assignments, &datapb.SegmentIDAssignment{
+			SegID:        r.PartitionID * 10,
+			ChannelName:  r.ChannelName,
+			Count:        r.Count,
+			CollectionID: r.CollectionID,
+			PartitionID:  r.PartitionID,
+			ExpireTime:   math.MaxUint64,
+			Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		})
+	}
+	return &datapb.AssignSegmentIDResponse{
+		Status:           &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		SegIDAssignments: assignments,
+	}, nil
+}
+
+// partitionKeyTestStream produces all the rows to a single channel
+type partitionKeyTestStream struct {
+	*msgstream.SimpleMsgStream
+}
+
+func (ms *partitionKeyTestStream) ComputeProduceChannelIndexes(tsMsgs []msgstream.TsMsg) [][]int32 {
+	indexes := make([][]int32, len(tsMsgs))
+	for i, msg := range tsMsgs {
+		indexes[i] = make([]int32, len(msg.HashKeys()))
+	}
+	return indexes
+}
+
+type partitionKeyTestChannelsMgr struct {
+	channelsMgr
+	stream msgstream.MsgStream
+}
+
+func (mgr *partitionKeyTestChannelsMgr) getVChannels(collectionID UniqueID) ([]vChan, error) {
+	return []vChan{"by-dev-vchan_0"}, nil
+}
+
+func (mgr *partitionKeyTestChannelsMgr) getDMLStream(collectionID UniqueID) (msgstream.MsgStream, error) {
+	return mgr.stream, nil
+}
+
+// newPartitionKeyTestIDAllocator returns an allocator which doesn't talk to rootcoord, the row ids
+// allocated for each request start from zero
+func newPartitionKeyTestIDAllocator(ctx context.Context) *allocator.IDAllocator {
+	ctx, cancel := context.WithCancel(ctx)
+	ia := &allocator.IDAllocator{
+		Allocator: allocator.Allocator{
+			Ctx:         ctx,
+			CancelFunc:  cancel,
+			Role:        "IDAllocator",
+			TChan:       &allocator.EmptyTicker{},
+			ProcessFunc: func(req allocator.Request) error { return nil },
+		},
+	}
+	ia.Init()
+	ia.Allocator.Start()
+	return ia
+}
+
+func TestInsertTask_PartitionKey(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	numPartitions := 4
+	partitions := make(map[string]UniqueID)
+	for i := 0; i < numPartitions; i++ {
+		partitions[typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, i)] = UniqueID(100 + i)
+	}
+	dim := 8
+	schema := &schemapb.CollectionSchema{
+		Name:          "TestInsertTask_PartitionKey",
+		NumPartitions: int64(numPartitions),
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", IsPartitionKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: fmt.Sprint(dim)}}},
+		},
+	}
+	globalMetaCache = &partitionKeyTestCache{schema: schema, partitions: partitions}
+
+	idAllocator := newPartitionKeyTestIDAllocator(ctx)
+	defer idAllocator.Close()
+	segIDAssigner, err := NewSegIDAssigner(ctx, &partitionKeyTestDataCoord{}, func() Timestamp { return 0 })
+	assert.Nil(t, err)
+	segIDAssigner.Start()
+	defer segIDAssigner.Close()
+	stream := &partitionKeyTestStream{msgstream.NewSimpleMsgStream()}
+	chMgr := &partitionKeyTestChannelsMgr{stream: stream}
+
+	numRows := 20
+	tenants := make([]int64, numRows)
+	pks := make([]int64, numRows)
+	for i := 0; i < numRows; i++ {
+		tenants[i] = int64(i % 7)
+		pks[i] = int64(i)
+	}
+	// the task is built in the same way as Proxy.Insert does
+	newTask := func(partitionName string) *InsertTask {
+		req := &milvuspb.InsertRequest{
+			CollectionName: schema.Name,
+			PartitionName:  partitionName,
+			NumRows:        uint32(numRows),
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "pk",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+						},
+					},
+				},
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "tenant",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: tenants}},
+						},
+					},
+				},
+				newFloatVectorFieldData("vec", numRows, dim),
+			},
+		}
+		it := &InsertTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			req:       req,
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+					},
+					CollectionName: req.CollectionName,
+					PartitionName:  req.PartitionName,
+				},
+			},
+			rowIDAllocator: idAllocator,
+			segIDAssigner:  segIDAssigner,
+			chMgr:          chMgr,
+		}
+		it.SetTs(100)
+		return it
+	}
+
+	checkRouted := func() {
+		pack := stream.Consume()
+		assert.NotNil(t, pack)
+		rows := 0
+		for _, msg := range pack.Msgs {
+			insertMsg := msg.(*msgstream.InsertMsg)
+			for _, rowID := range insertMsg.RowIDs {
+				idx, err := typeutil.PartitionKeyIndex(tenants[rowID], numPartitions)
+				assert.Nil(t, err)
+				assert.Equal(t, typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, idx), insertMsg.PartitionName)
+				assert.Equal(t, UniqueID(100+idx), insertMsg.PartitionID)
+				assert.Equal(t, insertMsg.PartitionID*10, insertMsg.SegmentID)
+			}
+			rows += len(insertMsg.RowIDs)
+		}
+		assert.Equal(t, numRows, rows)
+	}
+
+	// no partition is specified
+	it := newTask("")
+	assert.Nil(t, it.PreExecute(ctx))
+	assert.Nil(t, it.Execute(ctx))
+	checkRouted()
+
+	// the default partition is taken as unspecified
+	it = newTask(Params.DefaultPartitionName)
+	assert.Nil(t, it.PreExecute(ctx))
+	assert.Nil(t, it.Execute(ctx))
+	checkRouted()
+
+	// the hidden partitions can't be specified
+	it = newTask(typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 0))
+	assert.Nil(t, it.PreExecute(ctx))
+	assert.NotNil(t, it.Execute(ctx))
+
+	// rows go to the default partition of a collection without partition key
+	schema.Fields[1].IsPartitionKey = false
+	partitions[Params.DefaultPartitionName] = 10
+	it = newTask("")
+	assert.Nil(t, it.PreExecute(ctx))
+	assert.Nil(t, it.Execute(ctx))
+	pack := stream.Consume()
+	assert.NotNil(t, pack)
+	for _, msg := range pack.Msgs {
+		insertMsg := msg.(*msgstream.InsertMsg)
+		assert.Equal(t, Params.DefaultPartitionName, insertMsg.PartitionName)
+		assert.Equal(t, UniqueID(10), insertMsg.PartitionID)
+	}
+}
+
+func TestGetPartitionKeyPartitionIDs(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	partitions := map[string]UniqueID{
+		typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 0): 100,
+		typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 1): 101,
+		typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 2): 102,
+	}
+	globalMetaCache = &partitionKeyTestCache{partitions: partitions}
+
+	// the number of partitions is taken from the schema rather than the partitions found
+	schema := &schemapb.CollectionSchema{Name: "coll", NumPartitions: 2}
+	partitionIDs, err := getPartitionKeyPartitionIDs(ctx, "", schema.Name, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []UniqueID{100, 101}, partitionIDs)
+
+	schema.NumPartitions = 4
+	_, err = getPartitionKeyPartitionIDs(ctx, "", schema.Name, schema)
+	assert.NotNil(t, err)
+
+	schema.NumPartitions = 0
+	_, err = getPartitionKeyPartitionIDs(ctx, "", schema.Name, schema)
+	assert.NotNil(t, err)
+}
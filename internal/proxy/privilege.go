@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+const (
+	// rootUserName is the built-in super user, which owns all the privileges
+	rootUserName = "root"
+
+	// anyObjectName grants a privilege on all the objects of the given type
+	anyObjectName = "*"
+)
+
+// checkPrivilege checks whether the user of the request owns the privilege on the object,
+// it always passes when authorization is disabled
+func checkPrivilege(ctx context.Context, objectType commonpb.ObjectType, dbName, objectName string, privilege commonpb.ObjectPrivilege) error {
+	if !Params.AuthorizationEnabled {
+		return nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if username == rootUserName {
+		return nil
+	}
+	roles, err := globalMetaCache.GetUserRoles(ctx, username)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		grants, err := globalMetaCache.GetRoleGrants(ctx, role)
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants {
+			if grantMatch(grant, objectType, dbName, objectName, privilege) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("user %s has no privilege %s on %s %s",
+		username, privilege.String(), objectType.String(), privilegeObjectString(objectType, dbName, objectName))
+}
+
+// grantMatch checks whether the grant covers the privilege on the object, a global grant covers every object,
+// a database grant covers the collections in the database as well
+func grantMatch(grant *milvuspb.GrantEntity, objectType commonpb.ObjectType, dbName, objectName string, privilege commonpb.ObjectPrivilege) bool {
+	if grant.Privilege != commonpb.ObjectPrivilege_PrivilegeAll && grant.Privilege != privilege {
+		return false
+	}
+	dbName = normalizeDatabaseName(dbName)
+	switch grant.ObjectType {
+	case commonpb.ObjectType_Global:
+		return true
+	case commonpb.ObjectType_Database:
+		grantDB := grant.ObjectName
+		if grantDB == "" {
+			grantDB = grant.DbName
+		}
+		if grantDB == anyObjectName {
+			return true
+		}
+		return objectType != commonpb.ObjectType_Global && normalizeDatabaseName(grantDB) == dbName
+	case commonpb.ObjectType_Collection:
+		if objectType != commonpb.ObjectType_Collection || normalizeDatabaseName(grant.DbName) != dbName {
+			return false
+		}
+		return grant.ObjectName == anyObjectName || grant.ObjectName == objectName
+	}
+	return false
+}
+
+func privilegeObjectString(objectType commonpb.ObjectType, dbName, objectName string) string {
+	switch objectType {
+	case commonpb.ObjectType_Database:
+		return normalizeDatabaseName(dbName)
+	case commonpb.ObjectType_Collection:
+		return normalizeDatabaseName(dbName) + "." + objectName
+	}
+	return objectName
+}
+
+// permissionDeniedStatus wraps the failure of checkPrivilege
+func permissionDeniedStatus(err error) *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_PermissionDenied,
+		Reason:    err.Error(),
+	}
+}
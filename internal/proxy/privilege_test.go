@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantMatch(t *testing.T) {
+	Params.Init()
+	defaultDB := Params.DefaultDatabaseName
+
+	global := &milvuspb.GrantEntity{
+		ObjectType: commonpb.ObjectType_Global,
+		Privilege:  commonpb.ObjectPrivilege_PrivilegeAll,
+	}
+	assert.True(t, grantMatch(global, commonpb.ObjectType_Global, "", "db1", commonpb.ObjectPrivilege_PrivilegeCreateDatabase))
+	assert.True(t, grantMatch(global, commonpb.ObjectType_Collection, "db1", "coll", commonpb.ObjectPrivilege_PrivilegeInsert))
+
+	db := &milvuspb.GrantEntity{
+		ObjectType: commonpb.ObjectType_Database,
+		ObjectName: "db1",
+		Privilege:  commonpb.ObjectPrivilege_PrivilegeSearch,
+	}
+	assert.True(t, grantMatch(db, commonpb.ObjectType_Collection, "db1", "coll", commonpb.ObjectPrivilege_PrivilegeSearch))
+	assert.False(t, grantMatch(db, commonpb.ObjectType_Collection, "db1", "coll", commonpb.ObjectPrivilege_PrivilegeInsert))
+	assert.False(t, grantMatch(db, commonpb.ObjectType_Collection, "db2", "coll", commonpb.ObjectPrivilege_PrivilegeSearch))
+	assert.False(t, grantMatch(db, commonpb.ObjectType_Global, "", "db1", commonpb.ObjectPrivilege_PrivilegeSearch))
+
+	coll := &milvuspb.GrantEntity{
+		ObjectType: commonpb.ObjectType_Collection,
+		DbName:     "",
+		ObjectName: "coll",
+		Privilege:  commonpb.ObjectPrivilege_PrivilegeQuery,
+	}
+	assert.True(t, grantMatch(coll, commonpb.ObjectType_Collection, defaultDB, "coll", commonpb.ObjectPrivilege_PrivilegeQuery))
+	assert.True(t, grantMatch(coll, commonpb.ObjectType_Collection, "", "coll", commonpb.ObjectPrivilege_PrivilegeQuery))
+	assert.False(t, grantMatch(coll, commonpb.ObjectType_Collection, "", "coll2", commonpb.ObjectPrivilege_PrivilegeQuery))
+	assert.False(t, grantMatch(coll, commonpb.ObjectType_Database, "", "", commonpb.ObjectPrivilege_PrivilegeQuery))
+
+	coll.ObjectName = anyObjectName
+	assert.True(t, grantMatch(coll, commonpb.ObjectType_Collection, "", "coll2", commonpb.ObjectPrivilege_PrivilegeQuery))
+}
+
+func TestCheckPrivilege(t *testing.T) {
+	Params.Init()
+	enabled := Params.AuthorizationEnabled
+	defer func() { Params.AuthorizationEnabled = enabled }()
+
+	Params.AuthorizationEnabled = false
+	assert.Nil(t, checkPrivilege(context.Background(), commonpb.ObjectType_Global, "", "", commonpb.ObjectPrivilege_PrivilegeManageUser))
+
+	Params.AuthorizationEnabled = true
+	assert.NotNil(t, checkPrivilege(context.Background(), commonpb.ObjectType_Global, "", "", commonpb.ObjectPrivilege_PrivilegeManageUser))
+	ctx := context.WithValue(context.Background(), ctxUsernameKey{}, rootUserName)
+	assert.Nil(t, checkPrivilege(ctx, commonpb.ObjectType_Global, "", "", commonpb.ObjectPrivilege_PrivilegeManageUser))
+}
+
+func TestProxy_CalcDistancePrivilege(t *testing.T) {
+	Params.Init()
+	enabled := Params.AuthorizationEnabled
+	defer func() { Params.AuthorizationEnabled = enabled }()
+	Params.AuthorizationEnabled = true
+
+	node := &Proxy{}
+	req := &milvuspb.CalcDistanceRequest{
+		OpLeft: &milvuspb.VectorsArray{
+			Array: &milvuspb.VectorsArray_IdArray{
+				IdArray: &milvuspb.VectorIDs{CollectionName: "coll", FieldName: "vec"},
+			},
+		},
+		Params: []*commonpb.KeyValuePair{{Key: "metric", Value: "L2"}},
+	}
+	// the vectors of the collection can't be queried without the privilege
+	resp, err := node.CalcDistance(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.Status.ErrorCode)
+}
@@ -66,6 +66,9 @@ type Proxy struct {
 
 	chTicker channelsTimeTicker
 
+	sessionTs   *sessionTsCache
+	searchCache *searchResultCache
+
 	idAllocator  *allocator.IDAllocator
 	tsoAllocator *TimestampAllocator
 	segAssigner  *SegIDAssigner
@@ -86,6 +89,7 @@ func NewProxy(ctx context.Context, factory msgstream.Factory) (*Proxy, error) {
 		ctx:       ctx1,
 		cancel:    cancel,
 		msFactory: factory,
+		sessionTs: newSessionTsCache(defaultSessionTsCacheCapacity),
 	}
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	log.Debug("Proxy", zap.Any("State", node.stateCode.Load()))
@@ -184,6 +188,13 @@ func (node *Proxy) Init() error {
 	node.segAssigner = segAssigner
 	node.segAssigner.PeerID = Params.ProxyID
 
+	if Params.SearchCacheEnabled {
+		node.searchCache = newSearchResultCache(Params.SearchCacheCapacity, Params.SearchCacheStaleness)
+		log.Debug("Proxy search result cache enabled",
+			zap.Int("capacity", Params.SearchCacheCapacity),
+			zap.Uint64("staleness", Params.SearchCacheStaleness))
+	}
+
 	getDmlChannelsFunc := func(collectionID UniqueID) (map[vChan]pChan, error) {
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
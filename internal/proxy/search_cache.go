@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+type searchCacheEntry struct {
+	key            string
+	dbName         string
+	collectionName string
+	ts             Timestamp
+	result         *milvuspb.SearchResults
+}
+
+// searchResultCache is a LRU cache of search results, the results expire once the
+// timestamp of a new search has gone beyond the staleness (in milliseconds).
+// Only the searches of Bounded or Eventually consistency are cached.
+//
+// A DDL on a collection drops the results searched before the DDL timestamp, the
+// timestamp is kept so that a search started before the DDL but finished after
+// the invalidation doesn't put its result back into the cache.
+type searchResultCache struct {
+	mu        sync.Mutex
+	capacity  int
+	staleness uint64
+	ll        *list.List
+	items     map[string]*list.Element
+	ddlTs     map[string]Timestamp
+}
+
+func newSearchResultCache(capacity int, staleness uint64) *searchResultCache {
+	return &searchResultCache{
+		capacity:  capacity,
+		staleness: staleness,
+		ll:        list.New(),
+		items:     make(map[string]*list.Element),
+		ddlTs:     make(map[string]Timestamp),
+	}
+}
+
+// key builds the cache key of a search, guarantee timestamps are grouped into buckets
+// of the staleness width so that searches sent close in time share the same entry.
+func (c *searchResultCache) key(collectionID UniqueID, partitionIDs []UniqueID, serializedPlan []byte,
+	placeholderGroup []byte, topK int64, travelTs Timestamp, guaranteeTs Timestamp) string {
+	partitions := make([]string, 0, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		partitions = append(partitions, fmt.Sprint(partitionID))
+	}
+	sort.Strings(partitions)
+
+	planHash := sha256.Sum256(serializedPlan)
+	placeholderHash := sha256.Sum256(placeholderGroup)
+
+	physical, _ := tsoutil.ParseHybridTs(guaranteeTs)
+	bucket := physical
+	if c.staleness > 0 {
+		bucket = physical / c.staleness
+	}
+
+	return fmt.Sprintf("%d/%s/%s/%s/%d/%d/%d", collectionID, strings.Join(partitions, ","),
+		hex.EncodeToString(planHash[:]), hex.EncodeToString(placeholderHash[:]), topK, travelTs, bucket)
+}
+
+// get returns a copy of the cached result if it is still fresh at ts.
+func (c *searchResultCache) get(key string, ts Timestamp) (*milvuspb.SearchResults, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		metrics.ProxySearchCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	entry := elem.Value.(*searchCacheEntry)
+	if c.expired(entry, ts) {
+		c.removeElement(elem)
+		metrics.ProxySearchCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	metrics.ProxySearchCacheCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+	return proto.Clone(entry.result).(*milvuspb.SearchResults), true
+}
+
+func (c *searchResultCache) put(key string, dbName, collectionName string, ts Timestamp, result *milvuspb.SearchResults) {
+	dbName = normalizeDatabaseName(dbName)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ts <= c.ddlTs[collectionKey(dbName, collectionName)] {
+		return
+	}
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*searchCacheEntry)
+		entry.ts = ts
+		entry.result = proto.Clone(result).(*milvuspb.SearchResults)
+		c.ll.MoveToFront(elem)
+		return
+	}
+	entry := &searchCacheEntry{
+		key:            key,
+		dbName:         dbName,
+		collectionName: collectionName,
+		ts:             ts,
+		result:         proto.Clone(result).(*milvuspb.SearchResults),
+	}
+	c.items[key] = c.ll.PushFront(entry)
+	for c.capacity > 0 && c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// removeCollection drops the cached results of a collection searched at or before ts,
+// it's called when a DDL at ts invalidates the meta cache of the collection. A zero ts
+// drops all the cached results of the collection.
+func (c *searchResultCache) removeCollection(dbName, collectionName string, ts Timestamp) {
+	dbName = normalizeDatabaseName(dbName)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := collectionKey(dbName, collectionName)
+	if ts > c.ddlTs[key] {
+		c.ddlTs[key] = ts
+	}
+	for elem := c.ll.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*searchCacheEntry)
+		if entry.dbName == dbName && entry.collectionName == collectionName && (ts == 0 || entry.ts <= ts) {
+			c.removeElement(elem)
+		}
+		elem = next
+	}
+}
+
+func (c *searchResultCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *searchResultCache) expired(entry *searchCacheEntry, ts Timestamp) bool {
+	cached, _ := tsoutil.ParseHybridTs(entry.ts)
+	current, _ := tsoutil.ParseHybridTs(ts)
+	return current > cached+c.staleness
+}
+
+func collectionKey(dbName, collectionName string) string {
+	return dbName + "/" + collectionName
+}
+
+func (c *searchResultCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*searchCacheEntry).key)
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestSearchResultCache_Key(t *testing.T) {
+	cache := newSearchResultCache(10, 1000)
+	plan := []byte("plan")
+	placeholder := []byte("placeholder")
+	ts := tsoutil.ComposeTS(10100, 0)
+
+	key := cache.key(1, []UniqueID{2, 3}, plan, placeholder, 10, 0, ts)
+	assert.Equal(t, key, cache.key(1, []UniqueID{3, 2}, plan, placeholder, 10, 0, ts))
+	// same bucket of guarantee timestamp
+	assert.Equal(t, key, cache.key(1, []UniqueID{2, 3}, plan, placeholder, 10, 0, tsoutil.ComposeTS(10900, 3)))
+
+	assert.NotEqual(t, key, cache.key(2, []UniqueID{2, 3}, plan, placeholder, 10, 0, ts))
+	assert.NotEqual(t, key, cache.key(1, []UniqueID{2}, plan, placeholder, 10, 0, ts))
+	assert.NotEqual(t, key, cache.key(1, []UniqueID{2, 3}, []byte("plan2"), placeholder, 10, 0, ts))
+	assert.NotEqual(t, key, cache.key(1, []UniqueID{2, 3}, plan, []byte("placeholder2"), 10, 0, ts))
+	assert.NotEqual(t, key, cache.key(1, []UniqueID{2, 3}, plan, placeholder, 20, 0, ts))
+	assert.NotEqual(t, key, cache.key(1, []UniqueID{2, 3}, plan, placeholder, 10, ts, ts))
+	assert.NotEqual(t, key, cache.key(1, []UniqueID{2, 3}, plan, placeholder, 10, 0, tsoutil.ComposeTS(11000, 0)))
+}
+
+func TestSearchResultCache_GetPut(t *testing.T) {
+	cache := newSearchResultCache(2, 1000)
+	result := &milvuspb.SearchResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+
+	_, ok := cache.get("a", tsoutil.ComposeTS(10000, 0))
+	assert.False(t, ok)
+
+	cache.put("a", "", "coll1", tsoutil.ComposeTS(10000, 0), result)
+	cached, ok := cache.get("a", tsoutil.ComposeTS(10500, 0))
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_Success, cached.Status.ErrorCode)
+
+	// the returned result is a copy
+	cached.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+	cached, ok = cache.get("a", tsoutil.ComposeTS(10500, 0))
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ErrorCode_Success, cached.Status.ErrorCode)
+
+	// expired once the timestamp advances past the staleness
+	_, ok = cache.get("a", tsoutil.ComposeTS(11001, 0))
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.len())
+
+	// least recently used entry is evicted
+	cache.put("a", "", "coll1", tsoutil.ComposeTS(10000, 0), result)
+	cache.put("b", "", "coll1", tsoutil.ComposeTS(10000, 0), result)
+	_, ok = cache.get("a", tsoutil.ComposeTS(10000, 0))
+	assert.True(t, ok)
+	cache.put("c", "", "coll2", tsoutil.ComposeTS(10000, 0), result)
+	assert.Equal(t, 2, cache.len())
+	_, ok = cache.get("b", tsoutil.ComposeTS(10000, 0))
+	assert.False(t, ok)
+}
+
+func TestSearchResultCache_RemoveCollection(t *testing.T) {
+	cache := newSearchResultCache(10, 1000)
+	result := &milvuspb.SearchResults{}
+	ts := tsoutil.ComposeTS(10000, 0)
+
+	cache.put("a", "", "coll1", ts, result)
+	cache.put("b", "", "coll2", ts, result)
+	cache.put("c", "", "coll1", ts, result)
+	cache.put("d", "db1", "coll1", ts, result)
+
+	cache.removeCollection("", "coll1", ts)
+	assert.Equal(t, 2, cache.len())
+	_, ok := cache.get("b", ts)
+	assert.True(t, ok)
+	_, ok = cache.get("a", ts)
+	assert.False(t, ok)
+	_, ok = cache.get("d", ts)
+	assert.True(t, ok)
+
+	cache.removeCollection("db1", "coll1", 0)
+	_, ok = cache.get("d", ts)
+	assert.False(t, ok)
+}
+
+func TestSearchResultCache_DDLTimestamp(t *testing.T) {
+	cache := newSearchResultCache(10, 1000)
+	result := &milvuspb.SearchResults{}
+	before := tsoutil.ComposeTS(10000, 0)
+	ddlTs := tsoutil.ComposeTS(10001, 0)
+	after := tsoutil.ComposeTS(10002, 0)
+
+	cache.put("a", "", "coll1", before, result)
+	cache.put("b", "", "coll1", after, result)
+	cache.removeCollection("", "coll1", ddlTs)
+	_, ok := cache.get("a", after)
+	assert.False(t, ok)
+	_, ok = cache.get("b", after)
+	assert.True(t, ok)
+
+	// a search started before the DDL finishes after the invalidation
+	cache.put("c", "", "coll1", before, result)
+	_, ok = cache.get("c", before)
+	assert.False(t, ok)
+	cache.put("c", "", "coll1", after, result)
+	_, ok = cache.get("c", after)
+	assert.True(t, ok)
+
+	// an older invalidation arriving late doesn't roll the DDL timestamp back
+	cache.removeCollection("", "coll1", before)
+	cache.put("d", "", "coll1", ddlTs, result)
+	_, ok = cache.get("d", ddlTs)
+	assert.False(t, ok)
+}
@@ -77,6 +77,15 @@ const (
 	ReleaseCollectionTaskName       = "ReleaseCollectionTask"
 	LoadPartitionTaskName           = "LoadPartitionTask"
 	ReleasePartitionTaskName        = "ReleasePartitionTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	AddFieldTaskName                = "AddFieldTask"
+	RenameCollectionTaskName        = "RenameCollectionTask"
+	AlterCollectionTaskName         = "AlterCollectionTask"
+	RecoverCollectionTaskName       = "RecoverCollectionTask"
+	ReshardCollectionTaskName       = "ReshardCollectionTask"
+	ExportTaskName                  = "ExportTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
 )
 
 type task interface {
@@ -117,6 +126,7 @@ type InsertTask struct {
 	segIDAssigner  *SegIDAssigner
 	chMgr          channelsMgr
 	chTicker       channelsTimeTicker
+	sessionTs      *sessionTsCache
 	vChannels      []vChan
 	pChannels      []pChan
 	schema         *schemapb.CollectionSchema
@@ -180,7 +190,7 @@ func (it *InsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *InsertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.BaseInsertTask.DbName, it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -246,6 +256,65 @@ func (it *InsertTask) checkLengthOfFieldsData() error {
 	return nil
 }
 
+// fillDefaultFieldsData appends the data of the fields which are missing in the request but are nullable
+// or have a default value, such fields are added to the collection after it's created.
+func (it *InsertTask) fillDefaultFieldsData() {
+	provided := make(map[string]struct{}, len(it.req.FieldsData))
+	for _, fieldData := range it.req.FieldsData {
+		provided[fieldData.FieldName] = struct{}{}
+	}
+	rowNums := int(it.req.NumRows)
+	for _, field := range it.schema.Fields {
+		if _, ok := provided[field.Name]; ok || field.AutoID {
+			continue
+		}
+		if field.DefaultValue == nil && !field.Nullable {
+			continue
+		}
+		scalars := &schemapb.ScalarField{}
+		switch field.DataType {
+		case schemapb.DataType_Bool:
+			data := make([]bool, rowNums)
+			for i := range data {
+				data[i] = field.DefaultValue.GetBoolData()
+			}
+			scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+			data := make([]int32, rowNums)
+			for i := range data {
+				data[i] = field.DefaultValue.GetIntData()
+			}
+			scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+		case schemapb.DataType_Int64:
+			data := make([]int64, rowNums)
+			for i := range data {
+				data[i] = field.DefaultValue.GetLongData()
+			}
+			scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+		case schemapb.DataType_Float:
+			data := make([]float32, rowNums)
+			for i := range data {
+				data[i] = field.DefaultValue.GetFloatData()
+			}
+			scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+		case schemapb.DataType_Double:
+			data := make([]float64, rowNums)
+			for i := range data {
+				data[i] = field.DefaultValue.GetDoubleData()
+			}
+			scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+		default:
+			continue
+		}
+		it.req.FieldsData = append(it.req.FieldsData, &schemapb.FieldData{
+			Type:      field.DataType,
+			FieldName: field.Name,
+			FieldId:   field.FieldID,
+			Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+		})
+	}
+}
+
 func (it *InsertTask) checkRowNums() error {
 	if it.req.NumRows <= 0 {
 		return errNumRowsLessThanOrEqualToZero(it.req.NumRows)
@@ -736,18 +805,23 @@ func (it *InsertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// the partition is decided in Execute if it's not specified
 	partitionTag := it.BaseInsertTask.PartitionName
-	if err := ValidatePartitionTag(partitionTag, true); err != nil {
-		return err
+	if len(partitionTag) > 0 {
+		if err := ValidatePartitionTag(partitionTag, true); err != nil {
+			return err
+		}
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.BaseInsertTask.DbName, collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
 		return err
 	}
 	it.schema = collSchema
 
+	it.fillDefaultFieldsData()
+
 	err = it.checkRowNums()
 	if err != nil {
 		return err
@@ -791,6 +865,8 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 	}
 	log.Debug("_assignSemgentID, produceChannels:", zap.Any("Channels", channelNames))
 
+	// all the messages in the pack belong to the same partition
+	partitionID := it.PartitionID
 	for i, request := range tsMsgs {
 		if request.Type() != commonpb.MsgType_Insert {
 			return nil, fmt.Errorf("msg's must be Insert")
@@ -799,6 +875,7 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		if !ok {
 			return nil, fmt.Errorf("msg's must be Insert")
 		}
+		partitionID = insertRequest.PartitionID
 
 		keys := hashKeys[i]
 		timestampLen := len(insertRequest.Timestamps)
@@ -833,7 +910,7 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		if channelName == "" {
 			return nil, fmt.Errorf("Proxy, repack_func, can not found channelName")
 		}
-		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, it.PartitionID, channelName, count, ts)
+		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, partitionID, channelName, count, ts)
 		if err != nil {
 			log.Debug("InsertTask.go", zap.Any("MapInfo", mapInfo),
 				zap.Error(err))
@@ -986,19 +1063,28 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 
 func (it *InsertTask) Execute(ctx context.Context) error {
 	collectionName := it.BaseInsertTask.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.BaseInsertTask.DbName, collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
+	keyField, err := typeutil.GetPartitionKeyField(it.schema)
+	if err != nil {
+		return err
+	}
 	var partitionID UniqueID
-	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
-		if err != nil {
-			return err
+	if keyField != nil {
+		// the default partition is taken as unspecified since some clients always fill it in,
+		// rows of a collection with partition key are routed to the hidden partitions below
+		if len(it.PartitionName) > 0 && it.PartitionName != Params.DefaultPartitionName {
+			return fmt.Errorf("partition name can't be specified when inserting into collection %s with partition key", collectionName)
 		}
+		it.PartitionName = ""
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.DefaultPartitionName)
+		if len(it.PartitionName) <= 0 {
+			it.PartitionName = Params.DefaultPartitionName
+		}
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.BaseInsertTask.DbName, collectionName, it.PartitionName)
 		if err != nil {
 			return err
 		}
@@ -1031,11 +1117,25 @@ func (it *InsertTask) Execute(ctx context.Context) error {
 		}
 	}
 
+	msgPacks := []*msgstream.MsgPack{&msgPack}
+	if keyField != nil {
+		msgPacks, err = it.splitByPartitionKey(ctx, keyField, &msgPack)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Assign SegmentID
-	var pack *msgstream.MsgPack
-	pack, err = it._assignSegmentID(stream, &msgPack)
-	if err != nil {
-		return err
+	pack := &msgstream.MsgPack{
+		BeginTs: msgPack.BeginTs,
+		EndTs:   msgPack.EndTs,
+	}
+	for _, partitionPack := range msgPacks {
+		assigned, err := it._assignSegmentID(stream, partitionPack)
+		if err != nil {
+			return err
+		}
+		pack.Msgs = append(pack.Msgs, assigned.Msgs...)
 	}
 
 	err = stream.Produce(pack)
@@ -1044,6 +1144,9 @@ func (it *InsertTask) Execute(ctx context.Context) error {
 		it.result.Status.Reason = err.Error()
 		return err
 	}
+	if it.sessionTs != nil {
+		it.sessionTs.update(ctx, collID, it.EndTs())
+	}
 
 	return nil
 }
@@ -1132,6 +1235,14 @@ func (cct *CreateCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := ValidatePartitionKey(cct.schema, cct.NumPartitions); err != nil {
+		return err
+	}
+
+	if err := ValidateBinlogCompression(cct.schema); err != nil {
+		return err
+	}
+
 	// validate field name
 	for _, field := range cct.schema.Fields {
 		if err := ValidateFieldName(field.Name); err != nil {
@@ -1237,7 +1348,7 @@ func (dct *DropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *DropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.DbName, dct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1259,7 +1370,7 @@ func (dct *DropCollectionTask) Execute(ctx context.Context) error {
 }
 
 func (dct *DropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.DbName, dct.CollectionName)
 	return nil
 }
 
@@ -1315,6 +1426,87 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 	return resultFieldNames, nil
 }
 
+// translateLoadFields converts the load_fields of a LoadCollectionRequest to field ids,
+// the primary key is always loaded since query nodes need it to locate entities.
+func translateLoadFields(loadFields []string, schema *schemapb.CollectionSchema) ([]int64, error) {
+	if len(loadFields) == 0 {
+		return nil, nil
+	}
+	fieldIDs := make([]int64, 0)
+	hasVectorField := false
+	for _, name := range loadFields {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, field := range schema.Fields {
+			if field.Name == name {
+				if !funcutil.SliceContain(fieldIDs, field.FieldID) {
+					fieldIDs = append(fieldIDs, field.FieldID)
+				}
+				if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+					hasVectorField = true
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("load field %s not exist in collection %s", name, schema.Name)
+		}
+	}
+	if !hasVectorField {
+		return nil, errors.New("load fields should contain at least one vector field")
+	}
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey && !funcutil.SliceContain(fieldIDs, field.FieldID) {
+			fieldIDs = append(fieldIDs, field.FieldID)
+		}
+	}
+	return fieldIDs, nil
+}
+
+// checkFieldsLoaded returns an error if any of fieldIDs was not loaded into query nodes,
+// an empty loadedFieldIDs means the whole collection was loaded.
+func checkFieldsLoaded(schema *schemapb.CollectionSchema, loadedFieldIDs []int64, fieldIDs ...int64) error {
+	if len(loadedFieldIDs) == 0 {
+		return nil
+	}
+	for _, fieldID := range fieldIDs {
+		if fieldID < 100 || funcutil.SliceContain(loadedFieldIDs, fieldID) { // TODO(dragondriver): use StartOfUserFieldID replacing 100
+			continue
+		}
+		for _, field := range schema.Fields {
+			if field.FieldID == fieldID {
+				return fmt.Errorf("field %s of collection %s was not loaded into memory", field.Name, schema.Name)
+			}
+		}
+		return fmt.Errorf("field %d of collection %s was not loaded into memory", fieldID, schema.Name)
+	}
+	return nil
+}
+
+// getExprFieldIDs returns the ids of all fields referenced by a plan expression.
+func getExprFieldIDs(expr *planpb.Expr) []int64 {
+	if expr == nil {
+		return nil
+	}
+	switch e := expr.Expr.(type) {
+	case *planpb.Expr_TermExpr:
+		return []int64{e.TermExpr.GetColumnInfo().GetFieldId()}
+	case *planpb.Expr_UnaryExpr:
+		return getExprFieldIDs(e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		return append(getExprFieldIDs(e.BinaryExpr.GetLeft()), getExprFieldIDs(e.BinaryExpr.GetRight())...)
+	case *planpb.Expr_CompareExpr:
+		return []int64{e.CompareExpr.GetLeftColumnInfo().GetFieldId(), e.CompareExpr.GetRightColumnInfo().GetFieldId()}
+	case *planpb.Expr_UnaryRangeExpr:
+		return []int64{e.UnaryRangeExpr.GetColumnInfo().GetFieldId()}
+	case *planpb.Expr_BinaryRangeExpr:
+		return []int64{e.BinaryRangeExpr.GetColumnInfo().GetFieldId()}
+	default:
+		return nil
+	}
+}
+
 type SearchTask struct {
 	Condition
 	*internalpb.SearchRequest
@@ -1324,6 +1516,14 @@ type SearchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	sessionTs *sessionTsCache
+
+	searchCache *searchResultCache
+	cacheKey    string
+	cacheHit    bool
+
+	// consistencyLevel is the resolved consistency level, it's Strong if the guarantee timestamp is given explicitly
+	consistencyLevel commonpb.ConsistencyLevel
 }
 
 func (st *SearchTask) TraceCtx() context.Context {
@@ -1364,7 +1564,7 @@ func (st *SearchTask) OnEnqueue() error {
 }
 
 func (st *SearchTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1373,7 +1573,7 @@ func (st *SearchTask) getChannels() ([]pChan, error) {
 }
 
 func (st *SearchTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1394,7 +1594,7 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 	st.Base.SourceID = Params.ProxyID
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1430,10 +1630,14 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 		zap.Any("collections", showResp.CollectionIDs),
 	)
 	collectionLoaded := false
+	var loadedFieldIDs []int64
 
-	for _, collectionID := range showResp.CollectionIDs {
+	for i, collectionID := range showResp.CollectionIDs {
 		if collectionID == collID {
 			collectionLoaded = true
+			if i < len(showResp.LoadedFields) {
+				loadedFieldIDs = showResp.LoadedFields[i].GetFieldIDs()
+			}
 			break
 		}
 	}
@@ -1445,7 +1649,7 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 
 	st.Base.MsgType = commonpb.MsgType_Search
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1457,6 +1661,7 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 	log.Debug("translate output fields", zap.Any("OutputFields", outputFields))
 	st.query.OutputFields = outputFields
 
+	var predicates *planpb.Expr
 	if st.query.GetDslType() == commonpb.DslType_BoolExprV1 {
 		annsField, err := GetAttrByKeyFromRepeatedKV(AnnsFieldKey, st.query.SearchParams)
 		if err != nil {
@@ -1493,6 +1698,15 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 			//return errors.New("invalid expression: " + st.query.Dsl)
 			return err
 		}
+		err = checkFieldsLoaded(schema, loadedFieldIDs, plan.GetVectorAnns().GetFieldId())
+		if err != nil {
+			return err
+		}
+		predicates = plan.GetVectorAnns().GetPredicates()
+		err = checkFieldsLoaded(schema, loadedFieldIDs, getExprFieldIDs(predicates)...)
+		if err != nil {
+			return err
+		}
 		for _, name := range st.query.OutputFields {
 			hitField := false
 			for _, field := range schema.Fields {
@@ -1500,6 +1714,9 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 					if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
 						return errors.New("Search doesn't support vector field as output_fields")
 					}
+					if err := checkFieldsLoaded(schema, loadedFieldIDs, field.FieldID); err != nil {
+						return err
+					}
 
 					st.SearchRequest.OutputFieldsId = append(st.SearchRequest.OutputFieldsId, field.FieldID)
 					plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
@@ -1527,21 +1744,25 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 	}
 	guaranteeTimestamp := st.query.GuaranteeTimestamp
 	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = st.BeginTs()
+		st.consistencyLevel, guaranteeTimestamp, err = translateConsistencyLevel(ctx, st.query.DbName, collectionName, st.query.ConsistencyLevel,
+			st.query.UseDefaultConsistency, st.query.GracefulTime, st.BeginTs(), st.sessionTs)
+		if err != nil {
+			return err
+		}
 	}
 	st.SearchRequest.TravelTimestamp = travelTimestamp
 	st.SearchRequest.GuaranteeTimestamp = guaranteeTimestamp
 
 	st.SearchRequest.ResultChannelID = Params.SearchResultChannelNames[0]
 	st.SearchRequest.DbID = 0 // todo
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
 	st.SearchRequest.CollectionID = collectionID
 	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, st.query.DbName, collectionName)
 	if err != nil {
 		return err
 	}
@@ -1568,14 +1789,49 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 			return errors.New(errMsg)
 		}
 	}
+	if len(st.query.PartitionNames) == 0 {
+		// search only the hidden partitions which the partition key in the expression is hashed to
+		pruned, err := prunePartitionsByKey(ctx, st.query.DbName, collectionName, schema, predicates)
+		if err != nil {
+			return err
+		}
+		if len(pruned) > 0 {
+			st.PartitionIDs = pruned
+		}
+	}
 
 	st.SearchRequest.Dsl = st.query.Dsl
 	st.SearchRequest.PlaceholderGroup = st.query.PlaceholderGroup
 
+	// only the searches which tolerate staleness are served by the cache, a cached result may miss the writes
+	// since it was searched
+	if st.searchCache != nil && st.SearchRequest.DslType == commonpb.DslType_BoolExprV1 &&
+		(st.consistencyLevel == commonpb.ConsistencyLevel_Bounded || st.consistencyLevel == commonpb.ConsistencyLevel_Eventually) {
+		st.cacheKey = st.searchCacheKey()
+		st.result, st.cacheHit = st.searchCache.get(st.cacheKey, st.BeginTs())
+		log.Debug("Proxy::SearchTask::PreExecute", zap.Bool("search cache hit", st.cacheHit))
+	}
+
 	return nil
 }
 
+// searchCacheKey returns the key of search result cache, the top k is contained in the serialized plan
+// but still put into the key explicitly in case the plan changes its layout.
+func (st *SearchTask) searchCacheKey() string {
+	var topK int64
+	if topKStr, err := GetAttrByKeyFromRepeatedKV(TopKKey, st.query.SearchParams); err == nil {
+		topK, _ = strconv.ParseInt(topKStr, 10, 64)
+	}
+	return st.searchCache.key(st.SearchRequest.CollectionID, st.SearchRequest.PartitionIDs,
+		st.SearchRequest.SerializedExprPlan, st.SearchRequest.PlaceholderGroup, topK,
+		st.query.TravelTimestamp, st.SearchRequest.GuaranteeTimestamp)
+}
+
 func (st *SearchTask) Execute(ctx context.Context) error {
+	if st.cacheHit {
+		return nil
+	}
+
 	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
 		SearchRequest: *st.SearchRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -1593,7 +1849,7 @@ func (st *SearchTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1886,6 +2142,10 @@ func printSearchResult(partialSearchResult *internalpb.SearchResults) {
 }
 
 func (st *SearchTask) PostExecute(ctx context.Context) error {
+	if st.cacheHit {
+		return nil
+	}
+
 	t0 := time.Now()
 	defer func() {
 		log.Debug("WaitAndPostExecute", zap.Any("time cost", time.Since(t0)))
@@ -1970,7 +2230,7 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 				return err
 			}
 
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, st.query.CollectionName)
 			if err != nil {
 				return err
 			}
@@ -1985,6 +2245,9 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 					}
 				}
 			}
+			if st.searchCache != nil && st.cacheKey != "" {
+				st.searchCache.put(st.cacheKey, st.query.DbName, st.query.CollectionName, st.BeginTs(), st.result)
+			}
 			log.Debug("Proxy Search PostExecute Done")
 			return nil
 		}
@@ -2000,6 +2263,7 @@ type QueryTask struct {
 	query     *milvuspb.QueryRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	sessionTs *sessionTsCache
 }
 
 func (qt *QueryTask) TraceCtx() context.Context {
@@ -2040,7 +2304,7 @@ func (qt *QueryTask) OnEnqueue() error {
 }
 
 func (qt *QueryTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2049,7 +2313,7 @@ func (qt *QueryTask) getChannels() ([]pChan, error) {
 }
 
 func (qt *QueryTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2098,7 +2362,7 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	qt.Base.SourceID = Params.ProxyID
 
 	collectionName := qt.query.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2146,9 +2410,13 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 		zap.Any("collID", collectionID))
 
 	collectionLoaded := false
-	for _, collID := range showResp.CollectionIDs {
+	var loadedFieldIDs []int64
+	for i, collID := range showResp.CollectionIDs {
 		if collectionID == collID {
 			collectionLoaded = true
+			if i < len(showResp.LoadedFields) {
+				loadedFieldIDs = showResp.LoadedFields[i].GetFieldIDs()
+			}
 			break
 		}
 	}
@@ -2156,7 +2424,7 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -2194,6 +2462,9 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	if len(qt.query.OutputFields) == 0 {
 		for _, field := range schema.Fields {
 			if field.FieldID >= 100 && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
+				if checkFieldsLoaded(schema, loadedFieldIDs, field.FieldID) != nil {
+					continue
+				}
 				qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
 			}
 		}
@@ -2221,6 +2492,9 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 			}
 		}
 	}
+	if err := checkFieldsLoaded(schema, loadedFieldIDs, qt.OutputFieldsId...); err != nil {
+		return err
+	}
 	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", qt.OutputFieldsId))
 
 	travelTimestamp := qt.query.TravelTimestamp
@@ -2229,7 +2503,11 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	}
 	guaranteeTimestamp := qt.query.GuaranteeTimestamp
 	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = qt.BeginTs()
+		_, guaranteeTimestamp, err = translateConsistencyLevel(ctx, qt.query.DbName, collectionName, qt.query.ConsistencyLevel,
+			qt.query.UseDefaultConsistency, qt.query.GracefulTime, qt.BeginTs(), qt.sessionTs)
+		if err != nil {
+			return err
+		}
 	}
 	qt.TravelTimestamp = travelTimestamp
 	qt.GuaranteeTimestamp = guaranteeTimestamp
@@ -2240,7 +2518,7 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	qt.CollectionID = collectionID
 	qt.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2298,7 +2576,7 @@ func (qt *QueryTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := qt.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		return err
 	}
@@ -2434,7 +2712,7 @@ func (qt *QueryTask) PostExecute(ctx context.Context) error {
 			return nil
 		}
 
-		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 		if err != nil {
 			return err
 		}
@@ -2577,6 +2855,9 @@ func (dct *DescribeCollectionTask) PreExecute(ctx context.Context) error {
 	if err := ValidateCollectionName(dct.CollectionName); err != nil {
 		return err
 	}
+	if err := validateTravelTimestamp(dct.TimeStamp, dct.BeginTs()); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2614,6 +2895,9 @@ func (dct *DescribeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
 		dct.result.CreatedTimestamp = result.CreatedTimestamp
 		dct.result.CreatedUtcTimestamp = result.CreatedUtcTimestamp
+		dct.result.ConsistencyLevel = result.ConsistencyLevel
+		dct.result.DeletionProtection = result.DeletionProtection
+		dct.result.ShardsNum = result.ShardsNum
 
 		for _, field := range result.Schema.Fields {
 			if field.FieldID >= 100 { // TODO(dragondriver): use StartOfUserFieldID replacing 100
@@ -2689,7 +2973,7 @@ func (g *GetCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *GetCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2776,11 +3060,11 @@ func (g *GetPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *GetPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.DbName, g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -2865,6 +3149,9 @@ func (sct *ShowCollectionsTask) OnEnqueue() error {
 func (sct *ShowCollectionsTask) PreExecute(ctx context.Context) error {
 	sct.Base.MsgType = commonpb.MsgType_ShowCollections
 	sct.Base.SourceID = Params.ProxyID
+	if err := validateTravelTimestamp(sct.TimeStamp, sct.BeginTs()); err != nil {
+		return err
+	}
 	if sct.GetType() == milvuspb.ShowType_InMemory {
 		for _, collectionName := range sct.CollectionNames {
 			if err := ValidateCollectionName(collectionName); err != nil {
@@ -2899,7 +3186,7 @@ func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -2948,7 +3235,7 @@ func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -2971,241 +3258,609 @@ func (sct *ShowCollectionsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-type CreatePartitionTask struct {
+type AddFieldTask struct {
 	Condition
-	*milvuspb.CreatePartitionRequest
+	*milvuspb.AddFieldRequest
 	ctx       context.Context
 	rootCoord types.RootCoord
 	result    *commonpb.Status
 }
 
-func (cpt *CreatePartitionTask) TraceCtx() context.Context {
-	return cpt.ctx
+func (aft *AddFieldTask) TraceCtx() context.Context {
+	return aft.ctx
 }
 
-func (cpt *CreatePartitionTask) ID() UniqueID {
-	return cpt.Base.MsgID
+func (aft *AddFieldTask) ID() UniqueID {
+	return aft.Base.MsgID
 }
 
-func (cpt *CreatePartitionTask) SetID(uid UniqueID) {
-	cpt.Base.MsgID = uid
+func (aft *AddFieldTask) SetID(uid UniqueID) {
+	aft.Base.MsgID = uid
 }
 
-func (cpt *CreatePartitionTask) Name() string {
-	return CreatePartitionTaskName
+func (aft *AddFieldTask) Name() string {
+	return AddFieldTaskName
 }
 
-func (cpt *CreatePartitionTask) Type() commonpb.MsgType {
-	return cpt.Base.MsgType
+func (aft *AddFieldTask) Type() commonpb.MsgType {
+	return aft.Base.MsgType
 }
 
-func (cpt *CreatePartitionTask) BeginTs() Timestamp {
-	return cpt.Base.Timestamp
+func (aft *AddFieldTask) BeginTs() Timestamp {
+	return aft.Base.Timestamp
 }
 
-func (cpt *CreatePartitionTask) EndTs() Timestamp {
-	return cpt.Base.Timestamp
+func (aft *AddFieldTask) EndTs() Timestamp {
+	return aft.Base.Timestamp
 }
 
-func (cpt *CreatePartitionTask) SetTs(ts Timestamp) {
-	cpt.Base.Timestamp = ts
+func (aft *AddFieldTask) SetTs(ts Timestamp) {
+	aft.Base.Timestamp = ts
 }
 
-func (cpt *CreatePartitionTask) OnEnqueue() error {
-	cpt.Base = &commonpb.MsgBase{}
+func (aft *AddFieldTask) OnEnqueue() error {
+	aft.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (cpt *CreatePartitionTask) PreExecute(ctx context.Context) error {
-	cpt.Base.MsgType = commonpb.MsgType_CreatePartition
-	cpt.Base.SourceID = Params.ProxyID
-
-	collName, partitionTag := cpt.CollectionName, cpt.PartitionName
+func (aft *AddFieldTask) PreExecute(ctx context.Context) error {
+	aft.Base.MsgType = commonpb.MsgType_AddField
+	aft.Base.SourceID = Params.ProxyID
 
-	if err := ValidateCollectionName(collName); err != nil {
+	if err := ValidateCollectionName(aft.CollectionName); err != nil {
 		return err
 	}
-
-	if err := ValidatePartitionTag(partitionTag, true); err != nil {
+	if aft.Field == nil {
+		return errors.New("field schema should not be empty")
+	}
+	if err := ValidateFieldName(aft.Field.Name); err != nil {
 		return err
 	}
-
 	return nil
 }
 
-func (cpt *CreatePartitionTask) Execute(ctx context.Context) (err error) {
-	cpt.result, err = cpt.rootCoord.CreatePartition(ctx, cpt.CreatePartitionRequest)
-	if cpt.result == nil {
-		return errors.New("get collection statistics resp is nil")
+func (aft *AddFieldTask) Execute(ctx context.Context) (err error) {
+	aft.result, err = aft.rootCoord.AddField(ctx, aft.AddFieldRequest)
+	if aft.result == nil {
+		return errors.New("add field resp is nil")
 	}
-	if cpt.result.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(cpt.result.Reason)
+	if aft.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(aft.result.Reason)
 	}
 	return err
 }
 
-func (cpt *CreatePartitionTask) PostExecute(ctx context.Context) error {
+func (aft *AddFieldTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveCollection(ctx, aft.DbName, aft.CollectionName)
 	return nil
 }
 
-type DropPartitionTask struct {
+type RenameCollectionTask struct {
 	Condition
-	*milvuspb.DropPartitionRequest
+	*milvuspb.RenameCollectionRequest
 	ctx       context.Context
 	rootCoord types.RootCoord
 	result    *commonpb.Status
 }
 
-func (dpt *DropPartitionTask) TraceCtx() context.Context {
-	return dpt.ctx
+func (rct *RenameCollectionTask) TraceCtx() context.Context {
+	return rct.ctx
 }
 
-func (dpt *DropPartitionTask) ID() UniqueID {
-	return dpt.Base.MsgID
+func (rct *RenameCollectionTask) ID() UniqueID {
+	return rct.Base.MsgID
 }
 
-func (dpt *DropPartitionTask) SetID(uid UniqueID) {
-	dpt.Base.MsgID = uid
+func (rct *RenameCollectionTask) SetID(uid UniqueID) {
+	rct.Base.MsgID = uid
 }
 
-func (dpt *DropPartitionTask) Name() string {
-	return DropPartitionTaskName
+func (rct *RenameCollectionTask) Name() string {
+	return RenameCollectionTaskName
 }
 
-func (dpt *DropPartitionTask) Type() commonpb.MsgType {
-	return dpt.Base.MsgType
+func (rct *RenameCollectionTask) Type() commonpb.MsgType {
+	return rct.Base.MsgType
 }
 
-func (dpt *DropPartitionTask) BeginTs() Timestamp {
-	return dpt.Base.Timestamp
+func (rct *RenameCollectionTask) BeginTs() Timestamp {
+	return rct.Base.Timestamp
 }
 
-func (dpt *DropPartitionTask) EndTs() Timestamp {
-	return dpt.Base.Timestamp
+func (rct *RenameCollectionTask) EndTs() Timestamp {
+	return rct.Base.Timestamp
 }
 
-func (dpt *DropPartitionTask) SetTs(ts Timestamp) {
-	dpt.Base.Timestamp = ts
+func (rct *RenameCollectionTask) SetTs(ts Timestamp) {
+	rct.Base.Timestamp = ts
 }
 
-func (dpt *DropPartitionTask) OnEnqueue() error {
-	dpt.Base = &commonpb.MsgBase{}
+func (rct *RenameCollectionTask) OnEnqueue() error {
+	rct.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (dpt *DropPartitionTask) PreExecute(ctx context.Context) error {
-	dpt.Base.MsgType = commonpb.MsgType_DropPartition
-	dpt.Base.SourceID = Params.ProxyID
-
-	collName, partitionTag := dpt.CollectionName, dpt.PartitionName
+func (rct *RenameCollectionTask) PreExecute(ctx context.Context) error {
+	rct.Base.MsgType = commonpb.MsgType_RenameCollection
+	rct.Base.SourceID = Params.ProxyID
 
-	if err := ValidateCollectionName(collName); err != nil {
+	if err := ValidateCollectionName(rct.OldName); err != nil {
 		return err
 	}
-
-	if err := ValidatePartitionTag(partitionTag, true); err != nil {
+	if err := ValidateCollectionName(rct.NewName); err != nil {
 		return err
 	}
-
+	if rct.OldName == rct.NewName {
+		return errors.New("the new collection name should be different from the old one")
+	}
 	return nil
 }
 
-func (dpt *DropPartitionTask) Execute(ctx context.Context) (err error) {
-	dpt.result, err = dpt.rootCoord.DropPartition(ctx, dpt.DropPartitionRequest)
-	if dpt.result == nil {
-		return errors.New("get collection statistics resp is nil")
+func (rct *RenameCollectionTask) Execute(ctx context.Context) (err error) {
+	rct.result, err = rct.rootCoord.RenameCollection(ctx, rct.RenameCollectionRequest)
+	if rct.result == nil {
+		return errors.New("rename collection resp is nil")
 	}
-	if dpt.result.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(dpt.result.Reason)
+	if rct.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(rct.result.Reason)
 	}
 	return err
 }
 
-func (dpt *DropPartitionTask) PostExecute(ctx context.Context) error {
+func (rct *RenameCollectionTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveCollection(ctx, rct.DbName, rct.OldName)
+	globalMetaCache.RemoveCollection(ctx, rct.DbName, rct.NewName)
 	return nil
 }
 
-type HasPartitionTask struct {
+type AlterCollectionTask struct {
 	Condition
-	*milvuspb.HasPartitionRequest
+	*milvuspb.AlterCollectionRequest
 	ctx       context.Context
 	rootCoord types.RootCoord
-	result    *milvuspb.BoolResponse
+	result    *commonpb.Status
 }
 
-func (hpt *HasPartitionTask) TraceCtx() context.Context {
-	return hpt.ctx
+func (act *AlterCollectionTask) TraceCtx() context.Context {
+	return act.ctx
 }
 
-func (hpt *HasPartitionTask) ID() UniqueID {
-	return hpt.Base.MsgID
+func (act *AlterCollectionTask) ID() UniqueID {
+	return act.Base.MsgID
 }
 
-func (hpt *HasPartitionTask) SetID(uid UniqueID) {
-	hpt.Base.MsgID = uid
+func (act *AlterCollectionTask) SetID(uid UniqueID) {
+	act.Base.MsgID = uid
 }
 
-func (hpt *HasPartitionTask) Name() string {
-	return HasPartitionTaskName
+func (act *AlterCollectionTask) Name() string {
+	return AlterCollectionTaskName
 }
 
-func (hpt *HasPartitionTask) Type() commonpb.MsgType {
-	return hpt.Base.MsgType
+func (act *AlterCollectionTask) Type() commonpb.MsgType {
+	return act.Base.MsgType
 }
 
-func (hpt *HasPartitionTask) BeginTs() Timestamp {
-	return hpt.Base.Timestamp
+func (act *AlterCollectionTask) BeginTs() Timestamp {
+	return act.Base.Timestamp
 }
 
-func (hpt *HasPartitionTask) EndTs() Timestamp {
-	return hpt.Base.Timestamp
+func (act *AlterCollectionTask) EndTs() Timestamp {
+	return act.Base.Timestamp
 }
 
-func (hpt *HasPartitionTask) SetTs(ts Timestamp) {
-	hpt.Base.Timestamp = ts
+func (act *AlterCollectionTask) SetTs(ts Timestamp) {
+	act.Base.Timestamp = ts
 }
 
-func (hpt *HasPartitionTask) OnEnqueue() error {
-	hpt.Base = &commonpb.MsgBase{}
+func (act *AlterCollectionTask) OnEnqueue() error {
+	act.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (hpt *HasPartitionTask) PreExecute(ctx context.Context) error {
-	hpt.Base.MsgType = commonpb.MsgType_HasPartition
-	hpt.Base.SourceID = Params.ProxyID
-
-	collName, partitionTag := hpt.CollectionName, hpt.PartitionName
-
-	if err := ValidateCollectionName(collName); err != nil {
-		return err
-	}
+func (act *AlterCollectionTask) PreExecute(ctx context.Context) error {
+	act.Base.MsgType = commonpb.MsgType_AlterCollection
+	act.Base.SourceID = Params.ProxyID
 
-	if err := ValidatePartitionTag(partitionTag, true); err != nil {
-		return err
-	}
-	return nil
+	return ValidateCollectionName(act.CollectionName)
 }
 
-func (hpt *HasPartitionTask) Execute(ctx context.Context) (err error) {
-	hpt.result, err = hpt.rootCoord.HasPartition(ctx, hpt.HasPartitionRequest)
-	if hpt.result == nil {
-		return errors.New("get collection statistics resp is nil")
+func (act *AlterCollectionTask) Execute(ctx context.Context) (err error) {
+	act.result, err = act.rootCoord.AlterCollection(ctx, act.AlterCollectionRequest)
+	if act.result == nil {
+		return errors.New("alter collection resp is nil")
 	}
-	if hpt.result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(hpt.result.Status.Reason)
+	if act.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(act.result.Reason)
 	}
 	return err
 }
 
-func (hpt *HasPartitionTask) PostExecute(ctx context.Context) error {
+func (act *AlterCollectionTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveCollection(ctx, act.DbName, act.CollectionName)
 	return nil
 }
 
-type ShowPartitionsTask struct {
+type RecoverCollectionTask struct {
 	Condition
-	*milvuspb.ShowPartitionsRequest
-	ctx        context.Context
-	rootCoord  types.RootCoord
+	*milvuspb.RecoverCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (rct *RecoverCollectionTask) TraceCtx() context.Context {
+	return rct.ctx
+}
+
+func (rct *RecoverCollectionTask) ID() UniqueID {
+	return rct.Base.MsgID
+}
+
+func (rct *RecoverCollectionTask) SetID(uid UniqueID) {
+	rct.Base.MsgID = uid
+}
+
+func (rct *RecoverCollectionTask) Name() string {
+	return RecoverCollectionTaskName
+}
+
+func (rct *RecoverCollectionTask) Type() commonpb.MsgType {
+	return rct.Base.MsgType
+}
+
+func (rct *RecoverCollectionTask) BeginTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *RecoverCollectionTask) EndTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *RecoverCollectionTask) SetTs(ts Timestamp) {
+	rct.Base.Timestamp = ts
+}
+
+func (rct *RecoverCollectionTask) OnEnqueue() error {
+	rct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (rct *RecoverCollectionTask) PreExecute(ctx context.Context) error {
+	rct.Base.MsgType = commonpb.MsgType_RecoverCollection
+	rct.Base.SourceID = Params.ProxyID
+
+	if rct.CollectionID <= 0 {
+		return fmt.Errorf("invalid collection id %d", rct.CollectionID)
+	}
+	return nil
+}
+
+func (rct *RecoverCollectionTask) Execute(ctx context.Context) (err error) {
+	rct.result, err = rct.rootCoord.RecoverCollection(ctx, rct.RecoverCollectionRequest)
+	if rct.result == nil {
+		return errors.New("recover collection resp is nil")
+	}
+	if rct.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(rct.result.Reason)
+	}
+	return err
+}
+
+// PostExecute does nothing, the cache of the recovered collection is invalidated by root coordinator
+func (rct *RecoverCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type ReshardCollectionTask struct {
+	Condition
+	*milvuspb.ReshardCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (rct *ReshardCollectionTask) TraceCtx() context.Context {
+	return rct.ctx
+}
+
+func (rct *ReshardCollectionTask) ID() UniqueID {
+	return rct.Base.MsgID
+}
+
+func (rct *ReshardCollectionTask) SetID(uid UniqueID) {
+	rct.Base.MsgID = uid
+}
+
+func (rct *ReshardCollectionTask) Name() string {
+	return ReshardCollectionTaskName
+}
+
+func (rct *ReshardCollectionTask) Type() commonpb.MsgType {
+	return rct.Base.MsgType
+}
+
+func (rct *ReshardCollectionTask) BeginTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *ReshardCollectionTask) EndTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *ReshardCollectionTask) SetTs(ts Timestamp) {
+	rct.Base.Timestamp = ts
+}
+
+func (rct *ReshardCollectionTask) OnEnqueue() error {
+	rct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (rct *ReshardCollectionTask) PreExecute(ctx context.Context) error {
+	rct.Base.MsgType = commonpb.MsgType_ReshardCollection
+	rct.Base.SourceID = Params.ProxyID
+
+	if err := ValidateCollectionName(rct.CollectionName); err != nil {
+		return err
+	}
+	if rct.ShardsNum <= 0 {
+		return fmt.Errorf("shards num should be positive, got %d", rct.ShardsNum)
+	}
+	return nil
+}
+
+func (rct *ReshardCollectionTask) Execute(ctx context.Context) (err error) {
+	rct.result, err = rct.rootCoord.ReshardCollection(ctx, rct.ReshardCollectionRequest)
+	if rct.result == nil {
+		return errors.New("reshard collection resp is nil")
+	}
+	if rct.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(rct.result.Reason)
+	}
+	return err
+}
+
+// PostExecute does nothing, the cache and the dml stream of the collection are dropped by root coordinator
+func (rct *ReshardCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type CreatePartitionTask struct {
+	Condition
+	*milvuspb.CreatePartitionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (cpt *CreatePartitionTask) TraceCtx() context.Context {
+	return cpt.ctx
+}
+
+func (cpt *CreatePartitionTask) ID() UniqueID {
+	return cpt.Base.MsgID
+}
+
+func (cpt *CreatePartitionTask) SetID(uid UniqueID) {
+	cpt.Base.MsgID = uid
+}
+
+func (cpt *CreatePartitionTask) Name() string {
+	return CreatePartitionTaskName
+}
+
+func (cpt *CreatePartitionTask) Type() commonpb.MsgType {
+	return cpt.Base.MsgType
+}
+
+func (cpt *CreatePartitionTask) BeginTs() Timestamp {
+	return cpt.Base.Timestamp
+}
+
+func (cpt *CreatePartitionTask) EndTs() Timestamp {
+	return cpt.Base.Timestamp
+}
+
+func (cpt *CreatePartitionTask) SetTs(ts Timestamp) {
+	cpt.Base.Timestamp = ts
+}
+
+func (cpt *CreatePartitionTask) OnEnqueue() error {
+	cpt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (cpt *CreatePartitionTask) PreExecute(ctx context.Context) error {
+	cpt.Base.MsgType = commonpb.MsgType_CreatePartition
+	cpt.Base.SourceID = Params.ProxyID
+
+	collName, partitionTag := cpt.CollectionName, cpt.PartitionName
+
+	if err := ValidateCollectionName(collName); err != nil {
+		return err
+	}
+
+	if err := ValidatePartitionTag(partitionTag, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cpt *CreatePartitionTask) Execute(ctx context.Context) (err error) {
+	cpt.result, err = cpt.rootCoord.CreatePartition(ctx, cpt.CreatePartitionRequest)
+	if cpt.result == nil {
+		return errors.New("get collection statistics resp is nil")
+	}
+	if cpt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(cpt.result.Reason)
+	}
+	return err
+}
+
+func (cpt *CreatePartitionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type DropPartitionTask struct {
+	Condition
+	*milvuspb.DropPartitionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (dpt *DropPartitionTask) TraceCtx() context.Context {
+	return dpt.ctx
+}
+
+func (dpt *DropPartitionTask) ID() UniqueID {
+	return dpt.Base.MsgID
+}
+
+func (dpt *DropPartitionTask) SetID(uid UniqueID) {
+	dpt.Base.MsgID = uid
+}
+
+func (dpt *DropPartitionTask) Name() string {
+	return DropPartitionTaskName
+}
+
+func (dpt *DropPartitionTask) Type() commonpb.MsgType {
+	return dpt.Base.MsgType
+}
+
+func (dpt *DropPartitionTask) BeginTs() Timestamp {
+	return dpt.Base.Timestamp
+}
+
+func (dpt *DropPartitionTask) EndTs() Timestamp {
+	return dpt.Base.Timestamp
+}
+
+func (dpt *DropPartitionTask) SetTs(ts Timestamp) {
+	dpt.Base.Timestamp = ts
+}
+
+func (dpt *DropPartitionTask) OnEnqueue() error {
+	dpt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (dpt *DropPartitionTask) PreExecute(ctx context.Context) error {
+	dpt.Base.MsgType = commonpb.MsgType_DropPartition
+	dpt.Base.SourceID = Params.ProxyID
+
+	collName, partitionTag := dpt.CollectionName, dpt.PartitionName
+
+	if err := ValidateCollectionName(collName); err != nil {
+		return err
+	}
+
+	if err := ValidatePartitionTag(partitionTag, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dpt *DropPartitionTask) Execute(ctx context.Context) (err error) {
+	dpt.result, err = dpt.rootCoord.DropPartition(ctx, dpt.DropPartitionRequest)
+	if dpt.result == nil {
+		return errors.New("get collection statistics resp is nil")
+	}
+	if dpt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(dpt.result.Reason)
+	}
+	return err
+}
+
+func (dpt *DropPartitionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type HasPartitionTask struct {
+	Condition
+	*milvuspb.HasPartitionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.BoolResponse
+}
+
+func (hpt *HasPartitionTask) TraceCtx() context.Context {
+	return hpt.ctx
+}
+
+func (hpt *HasPartitionTask) ID() UniqueID {
+	return hpt.Base.MsgID
+}
+
+func (hpt *HasPartitionTask) SetID(uid UniqueID) {
+	hpt.Base.MsgID = uid
+}
+
+func (hpt *HasPartitionTask) Name() string {
+	return HasPartitionTaskName
+}
+
+func (hpt *HasPartitionTask) Type() commonpb.MsgType {
+	return hpt.Base.MsgType
+}
+
+func (hpt *HasPartitionTask) BeginTs() Timestamp {
+	return hpt.Base.Timestamp
+}
+
+func (hpt *HasPartitionTask) EndTs() Timestamp {
+	return hpt.Base.Timestamp
+}
+
+func (hpt *HasPartitionTask) SetTs(ts Timestamp) {
+	hpt.Base.Timestamp = ts
+}
+
+func (hpt *HasPartitionTask) OnEnqueue() error {
+	hpt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (hpt *HasPartitionTask) PreExecute(ctx context.Context) error {
+	hpt.Base.MsgType = commonpb.MsgType_HasPartition
+	hpt.Base.SourceID = Params.ProxyID
+
+	collName, partitionTag := hpt.CollectionName, hpt.PartitionName
+
+	if err := ValidateCollectionName(collName); err != nil {
+		return err
+	}
+
+	if err := ValidatePartitionTag(partitionTag, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (hpt *HasPartitionTask) Execute(ctx context.Context) (err error) {
+	hpt.result, err = hpt.rootCoord.HasPartition(ctx, hpt.HasPartitionRequest)
+	if hpt.result == nil {
+		return errors.New("get collection statistics resp is nil")
+	}
+	if hpt.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(hpt.result.Status.Reason)
+	}
+	return err
+}
+
+func (hpt *HasPartitionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type ShowPartitionsTask struct {
+	Condition
+	*milvuspb.ShowPartitionsRequest
+	ctx        context.Context
+	rootCoord  types.RootCoord
 	queryCoord types.QueryCoord
 	result     *milvuspb.ShowPartitionsResponse
 }
@@ -3254,6 +3909,9 @@ func (spt *ShowPartitionsTask) PreExecute(ctx context.Context) error {
 	if err := ValidateCollectionName(spt.CollectionName); err != nil {
 		return err
 	}
+	if err := validateTravelTimestamp(spt.TimeStamp, spt.BeginTs()); err != nil {
+		return err
+	}
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		for _, partitionName := range spt.PartitionNames {
@@ -3282,7 +3940,7 @@ func (spt *ShowPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.DbName, collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3295,7 +3953,7 @@ func (spt *ShowPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3343,7 +4001,7 @@ func (spt *ShowPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3699,7 +4357,7 @@ func (gibpt *GetIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *GetIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3920,7 +4578,7 @@ func (gist *GetIndexStateTask) PreExecute(ctx context.Context) error {
 
 func (gist *GetIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -4122,7 +4780,7 @@ func (ft *FlushTask) PreExecute(ctx context.Context) error {
 func (ft *FlushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.DbName, collName)
 		if err != nil {
 			return err
 		}
@@ -4160,6 +4818,106 @@ func (ft *FlushTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type ExportTask struct {
+	Condition
+	*milvuspb.ExportRequest
+	ctx       context.Context
+	dataCoord types.DataCoord
+	result    *milvuspb.ExportResponse
+}
+
+func (et *ExportTask) TraceCtx() context.Context {
+	return et.ctx
+}
+
+func (et *ExportTask) ID() UniqueID {
+	return et.Base.MsgID
+}
+
+func (et *ExportTask) SetID(uid UniqueID) {
+	et.Base.MsgID = uid
+}
+
+func (et *ExportTask) Name() string {
+	return ExportTaskName
+}
+
+func (et *ExportTask) Type() commonpb.MsgType {
+	return et.Base.MsgType
+}
+
+func (et *ExportTask) BeginTs() Timestamp {
+	return et.Base.Timestamp
+}
+
+func (et *ExportTask) EndTs() Timestamp {
+	return et.Base.Timestamp
+}
+
+func (et *ExportTask) SetTs(ts Timestamp) {
+	et.Base.Timestamp = ts
+}
+
+func (et *ExportTask) OnEnqueue() error {
+	et.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (et *ExportTask) PreExecute(ctx context.Context) error {
+	et.Base.MsgType = commonpb.MsgType_Export
+	et.Base.SourceID = Params.ProxyID
+
+	if err := ValidateCollectionName(et.CollectionName); err != nil {
+		return err
+	}
+	if et.OutputPath == "" {
+		return errors.New("output path is not specified")
+	}
+	return nil
+}
+
+func (et *ExportTask) Execute(ctx context.Context) error {
+	collID, err := globalMetaCache.GetCollectionID(ctx, et.DbName, et.CollectionName)
+	if err != nil {
+		return err
+	}
+	partitionIDs := make([]UniqueID, 0, len(et.PartitionNames))
+	for _, partitionName := range et.PartitionNames {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, et.DbName, et.CollectionName, partitionName)
+		if err != nil {
+			return err
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	resp, err := et.dataCoord.Export(ctx, &datapb.ExportRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_Export,
+			MsgID:     et.Base.MsgID,
+			Timestamp: et.Base.Timestamp,
+			SourceID:  et.Base.SourceID,
+		},
+		CollectionID: collID,
+		PartitionIDs: partitionIDs,
+		Timestamp:    et.TravelTimestamp,
+		OutputPath:   et.OutputPath,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to call export to data coordinator: %s", err.Error())
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	et.result = &milvuspb.ExportResponse{
+		Status: resp.Status,
+		TaskID: resp.TaskID,
+	}
+	return nil
+}
+
+func (et *ExportTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type LoadCollectionTask struct {
 	Condition
 	*milvuspb.LoadCollectionRequest
@@ -4221,11 +4979,15 @@ func (lct *LoadCollectionTask) PreExecute(ctx context.Context) error {
 
 func (lct *LoadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("LoadCollectionTask Execute", zap.String("role", Params.RoleName), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.DbName, lct.CollectionName)
+	if err != nil {
+		return err
+	}
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	loadFieldIDs, err := translateLoadFields(lct.LoadFields, collSchema)
 	if err != nil {
 		return err
 	}
@@ -4240,6 +5002,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) (err error) {
 		DbID:         0,
 		CollectionID: collID,
 		Schema:       collSchema,
+		LoadFieldIDs: loadFieldIDs,
 	}
 	log.Debug("send LoadCollectionRequest to query coordinator", zap.String("role", Params.RoleName), zap.Int64("msgID", request.Base.MsgID), zap.Int64("collectionID", request.CollectionID),
 		zap.Any("schema", request.Schema))
@@ -4315,7 +5078,7 @@ func (rct *ReleaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *ReleaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.DbName, rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4401,21 +5164,25 @@ func (lpt *LoadPartitionTask) PreExecute(ctx context.Context) error {
 
 func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.DbName, lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
 		partitionIDs = append(partitionIDs, partitionID)
 	}
+	loadFieldIDs, err := translateLoadFields(lpt.LoadFields, collSchema)
+	if err != nil {
+		return err
+	}
 	request := &querypb.LoadPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_LoadPartitions,
@@ -4427,6 +5194,7 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 		CollectionID: collID,
 		PartitionIDs: partitionIDs,
 		Schema:       collSchema,
+		LoadFieldIDs: loadFieldIDs,
 	}
 	lpt.result, err = lpt.queryCoord.LoadPartitions(ctx, request)
 	return err
@@ -4496,12 +5264,12 @@ func (rpt *ReleasePartitionTask) PreExecute(ctx context.Context) error {
 
 func (rpt *ReleasePartitionTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.DbName, rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.DbName, rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4525,3 +5293,213 @@ func (rpt *ReleasePartitionTask) Execute(ctx context.Context) (err error) {
 func (rpt *ReleasePartitionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (cdt *CreateDatabaseTask) TraceCtx() context.Context {
+	return cdt.ctx
+}
+
+func (cdt *CreateDatabaseTask) ID() UniqueID {
+	return cdt.Base.MsgID
+}
+
+func (cdt *CreateDatabaseTask) SetID(uid UniqueID) {
+	cdt.Base.MsgID = uid
+}
+
+func (cdt *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (cdt *CreateDatabaseTask) Type() commonpb.MsgType {
+	return cdt.Base.MsgType
+}
+
+func (cdt *CreateDatabaseTask) BeginTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *CreateDatabaseTask) EndTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *CreateDatabaseTask) SetTs(ts Timestamp) {
+	cdt.Base.Timestamp = ts
+}
+
+func (cdt *CreateDatabaseTask) OnEnqueue() error {
+	cdt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (cdt *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	cdt.Base.MsgType = commonpb.MsgType_CreateDatabase
+	cdt.Base.SourceID = Params.ProxyID
+
+	if err := ValidateDatabaseName(cdt.DbName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cdt *CreateDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	cdt.result, err = cdt.rootCoord.CreateDatabase(ctx, cdt.CreateDatabaseRequest)
+	if cdt.result == nil {
+		return errors.New("create database resp is nil")
+	}
+	if cdt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(cdt.result.Reason)
+	}
+	return err
+}
+
+func (cdt *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (ddt *DropDatabaseTask) TraceCtx() context.Context {
+	return ddt.ctx
+}
+
+func (ddt *DropDatabaseTask) ID() UniqueID {
+	return ddt.Base.MsgID
+}
+
+func (ddt *DropDatabaseTask) SetID(uid UniqueID) {
+	ddt.Base.MsgID = uid
+}
+
+func (ddt *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (ddt *DropDatabaseTask) Type() commonpb.MsgType {
+	return ddt.Base.MsgType
+}
+
+func (ddt *DropDatabaseTask) BeginTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *DropDatabaseTask) EndTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *DropDatabaseTask) SetTs(ts Timestamp) {
+	ddt.Base.Timestamp = ts
+}
+
+func (ddt *DropDatabaseTask) OnEnqueue() error {
+	ddt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (ddt *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	ddt.Base.MsgType = commonpb.MsgType_DropDatabase
+	ddt.Base.SourceID = Params.ProxyID
+
+	if err := ValidateDatabaseName(ddt.DbName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ddt *DropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	ddt.result, err = ddt.rootCoord.DropDatabase(ctx, ddt.DropDatabaseRequest)
+	if ddt.result == nil {
+		return errors.New("drop database resp is nil")
+	}
+	if ddt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ddt.result.Reason)
+	}
+	return err
+}
+
+func (ddt *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveDatabase(ctx, ddt.DbName)
+	return nil
+}
+
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
+}
+
+func (ldt *ListDatabasesTask) TraceCtx() context.Context {
+	return ldt.ctx
+}
+
+func (ldt *ListDatabasesTask) ID() UniqueID {
+	return ldt.Base.MsgID
+}
+
+func (ldt *ListDatabasesTask) SetID(uid UniqueID) {
+	ldt.Base.MsgID = uid
+}
+
+func (ldt *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (ldt *ListDatabasesTask) Type() commonpb.MsgType {
+	return ldt.Base.MsgType
+}
+
+func (ldt *ListDatabasesTask) BeginTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *ListDatabasesTask) EndTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *ListDatabasesTask) SetTs(ts Timestamp) {
+	ldt.Base.Timestamp = ts
+}
+
+func (ldt *ListDatabasesTask) OnEnqueue() error {
+	ldt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (ldt *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	ldt.Base.MsgType = commonpb.MsgType_ListDatabases
+	ldt.Base.SourceID = Params.ProxyID
+	return nil
+}
+
+func (ldt *ListDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	ldt.result, err = ldt.rootCoord.ListDatabases(ctx, ldt.ListDatabasesRequest)
+	if ldt.result == nil {
+		return errors.New("list databases resp is nil")
+	}
+	if ldt.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ldt.result.Status.Reason)
+	}
+	return err
+}
+
+func (ldt *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
@@ -508,3 +508,38 @@ func TestTranslateOutputFields(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 }
+
+func TestTranslateLoadFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestTranslateLoadFields",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "float_vector", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 103, Name: "binary_vector", DataType: schemapb.DataType_BinaryVector},
+		},
+	}
+
+	fieldIDs, err := translateLoadFields([]string{}, schema)
+	assert.Nil(t, err)
+	assert.Nil(t, fieldIDs)
+
+	fieldIDs, err = translateLoadFields([]string{"float_vector", "age"}, schema)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int64{100, 101, 102}, fieldIDs)
+
+	fieldIDs, err = translateLoadFields([]string{" float_vector ", "float_vector"}, schema)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int64{100, 102}, fieldIDs)
+
+	_, err = translateLoadFields([]string{"age"}, schema)
+	assert.NotNil(t, err)
+
+	_, err = translateLoadFields([]string{"float_vector", "not_exist"}, schema)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, checkFieldsLoaded(schema, nil, 101, 103))
+	assert.Nil(t, checkFieldsLoaded(schema, []int64{100, 102}, 0, 1, 100, 102))
+	assert.NotNil(t, checkFieldsLoaded(schema, []int64{100, 102}, 103))
+	assert.NotNil(t, checkFieldsLoaded(schema, []int64{100, 102}, 101, 102))
+}
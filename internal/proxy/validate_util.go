@@ -19,6 +19,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isAlpha(c uint8) bool {
@@ -65,6 +67,96 @@ func ValidateCollectionName(collName string) error {
 	return nil
 }
 
+func ValidateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	if dbName == "" {
+		return errors.New("Database name should not be empty")
+	}
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if int64(len(dbName)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of a database name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+func ValidateUsername(username string) error {
+	return validateAuthName(username, "username")
+}
+
+func ValidateRoleName(roleName string) error {
+	return validateAuthName(roleName, "role name")
+}
+
+// validateAuthName checks usernames and role names, which follow the rules of database names
+func validateAuthName(name string, kind string) error {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return fmt.Errorf("The %s should not be empty", kind)
+	}
+
+	invalidMsg := "Invalid " + kind + ": " + name + ". "
+	if int64(len(name)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of a " + kind + " must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := name[0]
+	if !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a " + kind + " must be a letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(name); i++ {
+		c := name[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "The " + kind + " can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+const (
+	minPasswordLength = 6
+	maxPasswordLength = 256
+)
+
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return fmt.Errorf("The length of a password must be between %d and %d characters", minPasswordLength, maxPasswordLength)
+	}
+	return nil
+}
+
+// validateTravelTimestamp checks the timestamp of reading the meta in the past, which can't be later than
+// the timestamp of the request itself
+func validateTravelTimestamp(travelTs, tMax Timestamp) error {
+	if travelTs > tMax {
+		return fmt.Errorf("travel timestamp %d is later than the current timestamp %d", travelTs, tMax)
+	}
+	return nil
+}
+
 func ValidatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -201,6 +293,27 @@ func ValidatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// ValidatePartitionKey checks the partition key field and the number of hidden partitions of the collection
+func ValidatePartitionKey(coll *schemapb.CollectionSchema, numPartitions int64) error {
+	keyField, err := typeutil.GetPartitionKeyField(coll)
+	if err != nil {
+		return err
+	}
+	if numPartitions < 0 {
+		return fmt.Errorf("invalid number of partitions %d", numPartitions)
+	}
+	if keyField == nil && numPartitions != 0 {
+		return errors.New("number of partitions can only be specified for collection with partition key")
+	}
+	return nil
+}
+
+// ValidateBinlogCompression checks the binlog compression of the collection is a known codec
+func ValidateBinlogCompression(coll *schemapb.CollectionSchema) error {
+	_, err := storage.ParseCompressionType(coll.BinlogCompression)
+	return err
+}
+
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
 	for _, kv := range kvPairs {
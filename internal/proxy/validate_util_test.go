@@ -51,6 +51,90 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	assert.Nil(t, ValidateDatabaseName("abc"))
+	assert.Nil(t, ValidateDatabaseName("_123abc"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"abc$",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateDatabaseName(name))
+	}
+}
+
+func TestValidateUsername(t *testing.T) {
+	assert.Nil(t, ValidateUsername("root"))
+	assert.Nil(t, ValidateUsername("user_1"))
+	assert.Nil(t, ValidateRoleName("admin"))
+
+	invalidNames := []string{
+		"_user",
+		"1user",
+		"user$",
+		"us er",
+		" ",
+		"",
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateUsername(name))
+		assert.NotNil(t, ValidateRoleName(name))
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.Nil(t, ValidatePassword("Milvus"))
+	assert.NotNil(t, ValidatePassword("12345"))
+	assert.NotNil(t, ValidatePassword(string(make([]byte, 257))))
+}
+
+func TestValidateTravelTimestamp(t *testing.T) {
+	assert.Nil(t, validateTravelTimestamp(0, 100))
+	assert.Nil(t, validateTravelTimestamp(100, 100))
+	assert.NotNil(t, validateTravelTimestamp(101, 100))
+}
+
+func TestValidatePartitionKey(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "tenant", DataType: schemapb.DataType_Int64},
+		},
+	}
+	assert.Nil(t, ValidatePartitionKey(schema, 0))
+	assert.NotNil(t, ValidatePartitionKey(schema, 16))
+
+	schema.Fields[1].IsPartitionKey = true
+	assert.Nil(t, ValidatePartitionKey(schema, 0))
+	assert.Nil(t, ValidatePartitionKey(schema, 16))
+	assert.NotNil(t, ValidatePartitionKey(schema, -1))
+
+	schema.Fields[0].IsPartitionKey = true
+	assert.NotNil(t, ValidatePartitionKey(schema, 0))
+}
+
+func TestValidateBinlogCompression(t *testing.T) {
+	schema := &schemapb.CollectionSchema{}
+	assert.Nil(t, ValidateBinlogCompression(schema))
+	schema.BinlogCompression = "zstd"
+	assert.Nil(t, ValidateBinlogCompression(schema))
+	schema.BinlogCompression = "gzip"
+	assert.NotNil(t, ValidateBinlogCompression(schema))
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, ValidatePartitionTag("abc", true))
 	assert.Nil(t, ValidatePartitionTag("123abc", true))
@@ -248,6 +248,21 @@ func (c *queryNodeCluster) watchDmChannels(ctx context.Context, nodeID int64, in
 	return errors.New("WatchDmChannels: Can't find query node by nodeID ")
 }
 
+func (c *queryNodeCluster) releaseDmChannels(ctx context.Context, nodeID int64, in *querypb.RemoveDmChannelsRequest) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if node, ok := c.nodes[nodeID]; ok {
+		err := node.releaseDmChannels(ctx, in)
+		if err != nil {
+			log.Debug("ReleaseDmChannels: queryNode release dm channel error", zap.String("error", err.Error()))
+			return err
+		}
+		return c.clusterMeta.removeDmChannel(in.CollectionID, nodeID, in.Channels)
+	}
+	return errors.New("ReleaseDmChannels: Can't find query node by nodeID ")
+}
+
 func (c *queryNodeCluster) hasWatchedQueryChannel(ctx context.Context, nodeID int64, collectionID UniqueID) bool {
 	c.Lock()
 	defer c.Unlock()
@@ -307,6 +322,19 @@ func (c *queryNodeCluster) releaseCollection(ctx context.Context, nodeID int64,
 	return errors.New("ReleaseCollection: can't find query node by nodeID")
 }
 
+// releaseDrainedCollection releases a collection from a draining node, unlike releaseCollection the node is
+// released though it's not on service, and the collection is kept in the meta
+func (c *queryNodeCluster) releaseDrainedCollection(ctx context.Context, nodeID int64, in *querypb.ReleaseCollectionRequest) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if node, ok := c.nodes[nodeID]; ok {
+		return node.releaseDrainedCollection(ctx, in)
+	}
+
+	return fmt.Errorf("ReleaseDrainedCollection: can't find query node %d", nodeID)
+}
+
 func (c *queryNodeCluster) releasePartitions(ctx context.Context, nodeID int64, in *querypb.ReleasePartitionsRequest) error {
 	c.Lock()
 	defer c.Unlock()
@@ -486,6 +514,21 @@ func (c *queryNodeCluster) stopNode(nodeID int64) {
 	}
 }
 
+// drainNode stops assigning segments and channels to the node, the node keeps serving
+// until its segments and channels are handed off to other nodes
+func (c *queryNodeCluster) drainNode(nodeID int64) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if node, ok := c.nodes[nodeID]; ok {
+		node.setNodeState(false)
+		log.Debug("DrainNode: queryNode is draining", zap.Int64("nodeID", nodeID))
+		return nil
+	}
+
+	return fmt.Errorf("DrainNode: query node %d not exist", nodeID)
+}
+
 func (c *queryNodeCluster) onServiceNodes() (map[int64]Node, error) {
 	c.RLock()
 	defer c.RUnlock()
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
 	"go.uber.org/zap"
@@ -94,15 +95,18 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 		inMemoryCollectionIDs = append(inMemoryCollectionIDs, info.CollectionID)
 	}
 	inMemoryPercentages := make([]int64, 0)
+	loadedFields := make([]*querypb.LoadedFields, 0)
 	if len(req.CollectionIDs) == 0 {
 		for _, id := range inMemoryCollectionIDs {
 			inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+			loadedFields = append(loadedFields, &querypb.LoadedFields{FieldIDs: ID2collectionInfo[id].LoadFieldIDs})
 		}
 		log.Debug("show collection end", zap.Int64s("collections", inMemoryCollectionIDs), zap.Int64s("inMemoryPercentage", inMemoryPercentages))
 		return &querypb.ShowCollectionsResponse{
 			Status:              status,
 			CollectionIDs:       inMemoryCollectionIDs,
 			InMemoryPercentages: inMemoryPercentages,
+			LoadedFields:        loadedFields,
 		}, nil
 	}
 	for _, id := range req.CollectionIDs {
@@ -115,12 +119,14 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 			}, err
 		}
 		inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+		loadedFields = append(loadedFields, &querypb.LoadedFields{FieldIDs: ID2collectionInfo[id].LoadFieldIDs})
 	}
 	log.Debug("show collection end", zap.Int64s("collections", req.CollectionIDs), zap.Int64s("inMemoryPercentage", inMemoryPercentages))
 	return &querypb.ShowCollectionsResponse{
 		Status:              status,
 		CollectionIDs:       req.CollectionIDs,
 		InMemoryPercentages: inMemoryPercentages,
+		LoadedFields:        loadedFields,
 	}, nil
 }
 
@@ -503,6 +509,122 @@ func (qc *QueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmen
 	}, nil
 }
 
+// LoadBalance moves the segments and dm channels of the source nodes to other nodes,
+// it's called by a query node draining itself before shutdown
+func (qc *QueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+	log.Debug("LoadBalanceRequest received", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64s("sourceNodeIDs", req.SourceNodeIDs))
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("load balance end with query coordinator not healthy")
+		return status, err
+	}
+
+	if len(req.SourceNodeIDs) == 0 {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("source nodeIDs are empty")
+		status.Reason = err.Error()
+		return status, err
+	}
+
+	hasDstNode := false
+	onServiceNodes, _ := qc.cluster.onServiceNodes()
+	for nodeID := range onServiceNodes {
+		if !funcutil.SliceContain(req.SourceNodeIDs, nodeID) {
+			hasDstNode = true
+			break
+		}
+	}
+	if !hasDstNode {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("no other query node to hand off the segments and channels")
+		status.Reason = err.Error()
+		return status, err
+	}
+
+	for _, nodeID := range req.SourceNodeIDs {
+		if err := qc.cluster.drainNode(nodeID); err != nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = err.Error()
+			return status, err
+		}
+	}
+
+	req.BalanceReason = querypb.TriggerCondition_loadBalance
+	loadBalanceTask := &LoadBalanceTask{
+		BaseTask: BaseTask{
+			ctx:              qc.loopCtx,
+			Condition:        NewTaskCondition(qc.loopCtx),
+			triggerCondition: querypb.TriggerCondition_loadBalance,
+		},
+		LoadBalanceRequest: req,
+		rootCoord:          qc.rootCoordClient,
+		dataCoord:          qc.dataCoordClient,
+		cluster:            qc.cluster,
+		meta:               qc.meta,
+	}
+	qc.scheduler.Enqueue([]task{loadBalanceTask})
+
+	err := loadBalanceTask.WaitToFinish()
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return status, err
+	}
+	log.Debug("LoadBalanceRequest completed", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64s("sourceNodeIDs", req.SourceNodeIDs))
+	return status, nil
+}
+
+// ReshardCollection watches the new dm channels of a resharded collection if it's loaded, so the collection
+// keeps serving without being released. It's called by root coordinator again after the segments on the
+// retired channels are flushed, then the segments are loaded and the retired channels are removed.
+func (qc *QueryCoord) ReshardCollection(ctx context.Context, req *querypb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	log.Debug("ReshardCollectionRequest received", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID),
+		zap.Int64("collectionID", req.CollectionID), zap.Strings("retiredChannels", req.RetiredChannels))
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("reshard collection end with query coordinator not healthy")
+		return status, err
+	}
+
+	if !qc.meta.hasCollection(req.CollectionID) {
+		log.Debug("reshard collection end, collection is not loaded", zap.Int64("collectionID", req.CollectionID))
+		return status, nil
+	}
+
+	reshardCollectionTask := &ReshardCollectionTask{
+		BaseTask: BaseTask{
+			ctx:              qc.loopCtx,
+			Condition:        NewTaskCondition(qc.loopCtx),
+			triggerCondition: querypb.TriggerCondition_grpcRequest,
+		},
+		ReshardCollectionRequest: req,
+		dataCoord:                qc.dataCoordClient,
+		cluster:                  qc.cluster,
+		meta:                     qc.meta,
+	}
+	qc.scheduler.Enqueue([]task{reshardCollectionTask})
+
+	err := reshardCollectionTask.WaitToFinish()
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return status, err
+	}
+	log.Debug("ReshardCollectionRequest completed", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID),
+		zap.Int64("collectionID", req.CollectionID))
+	return status, nil
+}
+
 func (qc *QueryCoord) isHealthy() bool {
 	code := qc.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
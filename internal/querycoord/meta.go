@@ -27,10 +27,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
+// the prefixes of the meta keys, they're also used by the meta tool
 const (
-	collectionMetaPrefix   = "queryCoord-collectionMeta"
-	segmentMetaPrefix      = "queryCoord-segmentMeta"
-	queryChannelMetaPrefix = "queryCoord-queryChannel"
+	CollectionMetaPrefix   = "queryCoord-collectionMeta"
+	SegmentMetaPrefix      = "queryCoord-segmentMeta"
+	QueryChannelMetaPrefix = "queryCoord-queryChannel"
 )
 
 type Meta interface {
@@ -68,6 +69,7 @@ type Meta interface {
 
 	setLoadType(collectionID UniqueID, loadType querypb.LoadType) error
 	getLoadType(collectionID UniqueID) (querypb.LoadType, error)
+	setLoadFieldIDs(collectionID UniqueID, fieldIDs []UniqueID) error
 	setLoadPercentage(collectionID UniqueID, partitionID UniqueID, percentage int64, loadType querypb.LoadType) error
 	printMeta()
 }
@@ -104,7 +106,7 @@ func newMeta(kv *etcdkv.EtcdKV) (Meta, error) {
 }
 
 func (m *MetaReplica) reloadFromKV() error {
-	collectionKeys, collectionValues, err := m.client.LoadWithPrefix(collectionMetaPrefix)
+	collectionKeys, collectionValues, err := m.client.LoadWithPrefix(CollectionMetaPrefix)
 	if err != nil {
 		return err
 	}
@@ -121,7 +123,7 @@ func (m *MetaReplica) reloadFromKV() error {
 		m.collectionInfos[collectionID] = collectionInfo
 	}
 
-	segmentKeys, segmentValues, err := m.client.LoadWithPrefix(segmentMetaPrefix)
+	segmentKeys, segmentValues, err := m.client.LoadWithPrefix(SegmentMetaPrefix)
 	if err != nil {
 		return err
 	}
@@ -138,7 +140,7 @@ func (m *MetaReplica) reloadFromKV() error {
 		m.segmentInfos[segmentID] = segmentInfo
 	}
 
-	queryChannelKeys, queryChannelValues, err := m.client.LoadWithPrefix(queryChannelMetaPrefix)
+	queryChannelKeys, queryChannelValues, err := m.client.LoadWithPrefix(QueryChannelMetaPrefix)
 	if err != nil {
 		return nil
 	}
@@ -660,6 +662,23 @@ func (m *MetaReplica) getLoadType(collectionID UniqueID) (querypb.LoadType, erro
 	return 0, errors.New("getLoadType: can't find collection in collectionInfos")
 }
 
+func (m *MetaReplica) setLoadFieldIDs(collectionID UniqueID, fieldIDs []UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if info, ok := m.collectionInfos[collectionID]; ok {
+		info.LoadFieldIDs = fieldIDs
+		err := saveGlobalCollectionInfo(collectionID, info, m.client)
+		if err != nil {
+			log.Error("save collectionInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
+			return err
+		}
+		return nil
+	}
+
+	return errors.New("setLoadFieldIDs: can't find collection in collectionInfos")
+}
+
 func (m *MetaReplica) setLoadPercentage(collectionID UniqueID, partitionID UniqueID, percentage int64, loadType querypb.LoadType) error {
 	m.Lock()
 	defer m.Unlock()
@@ -726,35 +745,35 @@ func (m *MetaReplica) printMeta() {
 func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, kv *etcdkv.EtcdKV) error {
 	infoBytes := proto.MarshalTextString(info)
 
-	key := fmt.Sprintf("%s/%d", collectionMetaPrefix, collectionID)
+	key := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collectionID)
 	return kv.Save(key, infoBytes)
 }
 
 func removeGlobalCollectionInfo(collectionID UniqueID, kv *etcdkv.EtcdKV) error {
-	key := fmt.Sprintf("%s/%d", collectionMetaPrefix, collectionID)
+	key := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collectionID)
 	return kv.Remove(key)
 }
 
 func saveSegmentInfo(segmentID UniqueID, info *querypb.SegmentInfo, kv *etcdkv.EtcdKV) error {
 	infoBytes := proto.MarshalTextString(info)
 
-	key := fmt.Sprintf("%s/%d", segmentMetaPrefix, segmentID)
+	key := fmt.Sprintf("%s/%d", SegmentMetaPrefix, segmentID)
 	return kv.Save(key, infoBytes)
 }
 
 func removeSegmentInfo(segmentID UniqueID, kv *etcdkv.EtcdKV) error {
-	key := fmt.Sprintf("%s/%d", segmentMetaPrefix, segmentID)
+	key := fmt.Sprintf("%s/%d", SegmentMetaPrefix, segmentID)
 	return kv.Remove(key)
 }
 
 func saveQueryChannelInfo(collectionID UniqueID, info *querypb.QueryChannelInfo, kv *etcdkv.EtcdKV) error {
 	infoBytes := proto.MarshalTextString(info)
 
-	key := fmt.Sprintf("%s/%d", queryChannelMetaPrefix, collectionID)
+	key := fmt.Sprintf("%s/%d", QueryChannelMetaPrefix, collectionID)
 	return kv.Save(key, infoBytes)
 }
 
 func removeQueryChannelInfo(collectionID UniqueID, kv *etcdkv.EtcdKV) error {
-	key := fmt.Sprintf("%s/%d", queryChannelMetaPrefix, collectionID)
+	key := fmt.Sprintf("%s/%d", QueryChannelMetaPrefix, collectionID)
 	return kv.Remove(key)
 }
@@ -233,6 +233,10 @@ func (qc *QueryCoord) watchNodeLoop() {
 			switch event.EventType {
 			case sessionutil.SessionAddEvent:
 				serverID := event.Session.ServerID
+				if event.Session.Stopping {
+					log.Debug("queryNode is stopping", zap.Int64("nodeID", serverID))
+					continue
+				}
 				log.Debug("start add a queryNode to cluster", zap.Any("nodeID", serverID))
 				err := qc.cluster.registerNode(ctx, event.Session, serverID)
 				if err != nil {
@@ -43,6 +43,7 @@ type Node interface {
 	getCollectionInfoByID(collectionID UniqueID) (*querypb.CollectionInfo, error)
 	showCollections() []*querypb.CollectionInfo
 	releaseCollection(ctx context.Context, in *querypb.ReleaseCollectionRequest) error
+	releaseDrainedCollection(ctx context.Context, in *querypb.ReleaseCollectionRequest) error
 
 	hasPartition(collectionID UniqueID, partitionID UniqueID) bool
 	addPartition(collectionID UniqueID, partitionID UniqueID) error
@@ -52,6 +53,7 @@ type Node interface {
 	getDmChannelsByCollectionID(collectionID UniqueID) ([]string, error)
 	watchDmChannels(ctx context.Context, in *querypb.WatchDmChannelsRequest) error
 	removeDmChannel(collectionID UniqueID, channels []string) error
+	releaseDmChannels(ctx context.Context, in *querypb.RemoveDmChannelsRequest) error
 
 	hasWatchedQueryChannel(collectionID UniqueID) bool
 	showWatchedQueryChannels() []*querypb.QueryChannelInfo
@@ -470,6 +472,26 @@ func (qn *queryNode) watchDmChannels(ctx context.Context, in *querypb.WatchDmCha
 	return err
 }
 
+// releaseDmChannels stops the node consuming some dm channels of a collection, e.g. the retired channels
+// of a resharded collection
+func (qn *queryNode) releaseDmChannels(ctx context.Context, in *querypb.RemoveDmChannelsRequest) error {
+	qn.serviceLock.RLock()
+	onService := qn.onService
+	qn.serviceLock.RUnlock()
+	if !onService {
+		return errors.New("RemoveDmChannels: queryNode is offline")
+	}
+
+	status, err := qn.client.RemoveDmChannels(ctx, in)
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+	return qn.removeDmChannel(in.CollectionID, in.Channels)
+}
+
 func (qn *queryNode) addQueryChannel(ctx context.Context, in *querypb.AddQueryChannelRequest) error {
 	qn.serviceLock.RLock()
 	onService := qn.onService
@@ -539,6 +561,20 @@ func (qn *queryNode) releaseCollection(ctx context.Context, in *querypb.ReleaseC
 	return nil
 }
 
+// releaseDrainedCollection releases the segments and dm channels of a collection from the node, the node is
+// draining so it's not on service any more
+func (qn *queryNode) releaseDrainedCollection(ctx context.Context, in *querypb.ReleaseCollectionRequest) error {
+	status, err := qn.client.ReleaseCollection(ctx, in)
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+
+	return qn.releaseCollectionInfo(in.CollectionID)
+}
+
 func (qn *queryNode) releasePartitions(ctx context.Context, in *querypb.ReleasePartitionsRequest) error {
 	qn.serviceLock.RLock()
 	onService := qn.onService
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 )
@@ -194,6 +195,14 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	watchPartition := false
 	if hasCollection {
 		watchPartition = true
+		collectionInfo, err := lct.meta.getCollectionInfoByID(collectionID)
+		if err == nil && !funcutil.SliceSetEqual(collectionInfo.LoadFieldIDs, lct.LoadFieldIDs) {
+			err = fmt.Errorf("collection %d has been loaded with fields %v, release it before loading fields %v",
+				collectionID, collectionInfo.LoadFieldIDs, lct.LoadFieldIDs)
+			status.Reason = err.Error()
+			lct.result = status
+			return err
+		}
 		loadType, _ := lct.meta.getLoadType(collectionID)
 		if loadType == querypb.LoadType_loadCollection {
 			for _, partitionID := range partitionIDs {
@@ -217,6 +226,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	log.Debug("loadCollectionTask: toLoadPartitionIDs", zap.Int64s("partitionIDs", toLoadPartitionIDs))
 	lct.meta.addCollection(collectionID, lct.Schema)
 	lct.meta.setLoadType(collectionID, querypb.LoadType_loadCollection)
+	lct.meta.setLoadFieldIDs(collectionID, lct.LoadFieldIDs)
 	for _, id := range toLoadPartitionIDs {
 		lct.meta.addPartition(collectionID, id)
 	}
@@ -245,6 +255,8 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				Deltalogs:    segmentBingLog.Deltalogs,
+				NumOfRows:    segmentBingLog.NumOfRows,
 			}
 
 			msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
@@ -254,6 +266,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 				Infos:         []*querypb.SegmentLoadInfo{segmentLoadInfo},
 				Schema:        lct.Schema,
 				LoadCondition: querypb.TriggerCondition_grpcRequest,
+				LoadFieldIDs:  lct.LoadFieldIDs,
 			}
 
 			segmentsToLoad = append(segmentsToLoad, segmentID)
@@ -513,16 +526,26 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 	collectionID := lpt.CollectionID
 	partitionIDs := lpt.PartitionIDs
 
-	if !lpt.meta.hasCollection(collectionID) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if lpt.meta.hasCollection(collectionID) {
+		collectionInfo, err := lpt.meta.getCollectionInfoByID(collectionID)
+		if err == nil && !funcutil.SliceSetEqual(collectionInfo.LoadFieldIDs, lpt.LoadFieldIDs) {
+			err = fmt.Errorf("collection %d has been loaded with fields %v, release it before loading fields %v",
+				collectionID, collectionInfo.LoadFieldIDs, lpt.LoadFieldIDs)
+			status.Reason = err.Error()
+			lpt.result = status
+			return err
+		}
+	} else {
 		lpt.meta.addCollection(collectionID, lpt.Schema)
 		lpt.addCol = true
+		lpt.meta.setLoadFieldIDs(collectionID, lpt.LoadFieldIDs)
 	}
 	for _, id := range partitionIDs {
 		lpt.meta.addPartition(collectionID, id)
 	}
-	status := &commonpb.Status{
-		ErrorCode: commonpb.ErrorCode_UnexpectedError,
-	}
 
 	segmentsToLoad := make([]UniqueID, 0)
 	loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
@@ -548,6 +571,8 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				Deltalogs:    segmentBingLog.Deltalogs,
+				NumOfRows:    segmentBingLog.NumOfRows,
 			}
 
 			msgBase := proto.Clone(lpt.Base).(*commonpb.MsgBase)
@@ -557,6 +582,7 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 				Infos:         []*querypb.SegmentLoadInfo{segmentLoadInfo},
 				Schema:        lpt.Schema,
 				LoadCondition: querypb.TriggerCondition_grpcRequest,
+				LoadFieldIDs:  lpt.LoadFieldIDs,
 			}
 			segmentsToLoad = append(segmentsToLoad, segmentID)
 			loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
@@ -866,6 +892,7 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 				Infos:         infos,
 				Schema:        lst.Schema,
 				LoadCondition: lst.LoadCondition,
+				LoadFieldIDs:  lst.LoadFieldIDs,
 			},
 			meta:    lst.meta,
 			cluster: lst.cluster,
@@ -1193,6 +1220,232 @@ func (wqt *WatchQueryChannelTask) PostExecute(context.Context) error {
 type HandoffTask struct {
 }
 
+//*********************** ***reshard collection task*** ************************//
+
+type ReshardCollectionTask struct {
+	BaseTask
+	*querypb.ReshardCollectionRequest
+	dataCoord types.DataCoord
+	cluster   *queryNodeCluster
+	meta      Meta
+}
+
+func (rct *ReshardCollectionTask) MsgBase() *commonpb.MsgBase {
+	return rct.Base
+}
+
+func (rct *ReshardCollectionTask) Marshal() ([]byte, error) {
+	return proto.Marshal(rct.ReshardCollectionRequest)
+}
+
+func (rct *ReshardCollectionTask) Type() commonpb.MsgType {
+	return rct.Base.MsgType
+}
+
+func (rct *ReshardCollectionTask) Timestamp() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *ReshardCollectionTask) PreExecute(context.Context) error {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	rct.result = status
+	log.Debug("start do ReshardCollectionTask",
+		zap.Int64("msgID", rct.ID()),
+		zap.Int64("collectionID", rct.CollectionID),
+		zap.Strings("retiredChannels", rct.RetiredChannels))
+	return nil
+}
+
+// Execute watches the new dm channels of a loaded collection, and loads the flushed segments on the retired
+// channels, which are served as growing segments by the nodes watching the retired channels until then.
+// The retired channels are removed from the nodes by releaseRetiredChannels after the child tasks are done.
+func (rct *ReshardCollectionTask) Execute(ctx context.Context) error {
+	collectionID := rct.CollectionID
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if !rct.meta.hasCollection(collectionID) {
+		log.Debug("reshardCollectionTask: collection is not loaded", zap.Int64("collectionID", collectionID))
+		return nil
+	}
+	collectionInfo, err := rct.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		status.Reason = err.Error()
+		rct.result = status
+		return err
+	}
+	watchedChannels, err := rct.meta.getDmChannelsByCollectionID(collectionID)
+	if err != nil {
+		status.Reason = err.Error()
+		rct.result = status
+		return err
+	}
+
+	loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
+	watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0)
+	channelsToWatch := make([]string, 0)
+	for _, partitionID := range collectionInfo.PartitionIDs {
+		getRecoveryInfoRequest := &datapb.GetRecoveryInfoRequest{
+			Base:         rct.Base,
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		}
+		recoveryInfo, err := rct.dataCoord.GetRecoveryInfo(ctx, getRecoveryInfoRequest)
+		if err == nil && recoveryInfo.Status.ErrorCode != commonpb.ErrorCode_Success {
+			err = errors.New(recoveryInfo.Status.Reason)
+		}
+		if err != nil {
+			status.Reason = err.Error()
+			rct.result = status
+			return err
+		}
+
+		for _, info := range recoveryInfo.Channels {
+			channel := info.ChannelName
+			if funcutil.SliceContain(watchedChannels, channel) {
+				continue
+			}
+			if collectionInfo.LoadType == querypb.LoadType_loadCollection {
+				merged := false
+				for index, channelName := range channelsToWatch {
+					if channel == channelName {
+						merged = true
+						oldInfo := watchDmChannelReqs[index].Infos[0]
+						newInfo := mergeVChannelInfo(oldInfo, info)
+						watchDmChannelReqs[index].Infos = []*datapb.VchannelInfo{newInfo}
+						break
+					}
+				}
+				if merged {
+					continue
+				}
+			}
+			msgBase := proto.Clone(rct.Base).(*commonpb.MsgBase)
+			msgBase.MsgType = commonpb.MsgType_WatchDmChannels
+			watchRequest := &querypb.WatchDmChannelsRequest{
+				Base:         msgBase,
+				CollectionID: collectionID,
+				Infos:        []*datapb.VchannelInfo{info},
+				Schema:       collectionInfo.Schema,
+			}
+			if collectionInfo.LoadType != querypb.LoadType_loadCollection {
+				watchRequest.PartitionID = partitionID
+			}
+			channelsToWatch = append(channelsToWatch, channel)
+			watchDmChannelReqs = append(watchDmChannelReqs, watchRequest)
+		}
+
+		if len(rct.RetiredChannels) == 0 {
+			continue
+		}
+		// the segments flushed after the collection is loaded are not in the meta
+		segment2Binlogs := make(map[UniqueID]*datapb.SegmentBinlogs)
+		segmentIDs := make([]UniqueID, 0)
+		for _, segmentBinlog := range recoveryInfo.Binlogs {
+			if !rct.meta.hasSegmentInfo(segmentBinlog.SegmentID) {
+				segment2Binlogs[segmentBinlog.SegmentID] = segmentBinlog
+				segmentIDs = append(segmentIDs, segmentBinlog.SegmentID)
+			}
+		}
+		if len(segmentIDs) == 0 {
+			continue
+		}
+		segmentInfoResponse, err := rct.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_SegmentInfo,
+			},
+			SegmentIDs: segmentIDs,
+		})
+		if err == nil && segmentInfoResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
+			err = errors.New(segmentInfoResponse.Status.Reason)
+		}
+		if err != nil {
+			status.Reason = err.Error()
+			rct.result = status
+			return err
+		}
+		for _, info := range segmentInfoResponse.Infos {
+			if !funcutil.SliceContain(rct.RetiredChannels, info.InsertChannel) {
+				continue
+			}
+			segmentBinlog := segment2Binlogs[info.ID]
+			segmentLoadInfo := &querypb.SegmentLoadInfo{
+				SegmentID:    info.ID,
+				PartitionID:  partitionID,
+				CollectionID: collectionID,
+				BinlogPaths:  segmentBinlog.FieldBinlogs,
+				Deltalogs:    segmentBinlog.Deltalogs,
+				NumOfRows:    segmentBinlog.NumOfRows,
+			}
+
+			msgBase := proto.Clone(rct.Base).(*commonpb.MsgBase)
+			msgBase.MsgType = commonpb.MsgType_LoadSegments
+			loadSegmentReq := &querypb.LoadSegmentsRequest{
+				Base:          msgBase,
+				Infos:         []*querypb.SegmentLoadInfo{segmentLoadInfo},
+				Schema:        collectionInfo.Schema,
+				LoadCondition: querypb.TriggerCondition_grpcRequest,
+				LoadFieldIDs:  collectionInfo.LoadFieldIDs,
+			}
+			loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
+		}
+	}
+
+	assignInternalTask(ctx, collectionID, rct, rct.meta, rct.cluster, loadSegmentReqs, watchDmChannelReqs)
+	log.Debug("ReshardCollectionTask Execute done",
+		zap.Int64("msgID", rct.ID()),
+		zap.Int64("collectionID", collectionID),
+		zap.Strings("channelsToWatch", channelsToWatch),
+		zap.Int("segmentsToLoad", len(loadSegmentReqs)))
+	return nil
+}
+
+func (rct *ReshardCollectionTask) PostExecute(context.Context) error {
+	log.Debug("ReshardCollectionTask postExecute done",
+		zap.Int64("msgID", rct.ID()),
+		zap.Int64("collectionID", rct.CollectionID))
+	return nil
+}
+
+// releaseRetiredChannels removes the retired channels from the nodes watching them, it's called after the child
+// tasks have loaded the segments on the retired channels, so that the data on them are served all the time.
+func (rct *ReshardCollectionTask) releaseRetiredChannels(ctx context.Context) error {
+	if len(rct.RetiredChannels) == 0 || !rct.meta.hasCollection(rct.CollectionID) {
+		return nil
+	}
+	collectionInfo, err := rct.meta.getCollectionInfoByID(rct.CollectionID)
+	if err != nil {
+		return err
+	}
+	for _, channelInfo := range collectionInfo.ChannelInfos {
+		channels := make([]string, 0)
+		for _, channel := range channelInfo.ChannelIDs {
+			if funcutil.SliceContain(rct.RetiredChannels, channel) {
+				channels = append(channels, channel)
+			}
+		}
+		if len(channels) == 0 {
+			continue
+		}
+		req := &querypb.RemoveDmChannelsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_RemoveDmChannels,
+			},
+			NodeID:       channelInfo.NodeIDLoaded,
+			CollectionID: rct.CollectionID,
+			Channels:     channels,
+		}
+		if err := rct.cluster.releaseDmChannels(ctx, channelInfo.NodeIDLoaded, req); err != nil {
+			return err
+		}
+		log.Debug("ReshardCollectionTask: retired channels released", zap.Int64("collectionID", rct.CollectionID),
+			zap.Int64("nodeID", channelInfo.NodeIDLoaded), zap.Strings("channels", channels))
+	}
+	return nil
+}
+
 //*********************** ***load balance task*** ************************//
 type LoadBalanceTask struct {
 	BaseTask
@@ -1201,6 +1454,9 @@ type LoadBalanceTask struct {
 	dataCoord types.DataCoord
 	cluster   *queryNodeCluster
 	meta      Meta
+
+	// collections of the draining nodes, which are released from the nodes after the handoff completes
+	drainedCollections map[int64][]UniqueID
 }
 
 func (lbt *LoadBalanceTask) MsgBase() *commonpb.MsgBase {
@@ -1236,12 +1492,20 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
 
-	if lbt.triggerCondition == querypb.TriggerCondition_nodeDown {
+	// the segments and channels of a draining node are moved in the same way as a down node,
+	// except that the draining node keeps serving until the handoff completes, see releaseDrainedNodes
+	drainedCollections := make(map[int64][]UniqueID)
+	if lbt.triggerCondition == querypb.TriggerCondition_nodeDown || lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
 		for _, nodeID := range lbt.SourceNodeIDs {
-			lbt.meta.deleteSegmentInfoByNodeID(nodeID)
+			if lbt.triggerCondition == querypb.TriggerCondition_nodeDown {
+				lbt.meta.deleteSegmentInfoByNodeID(nodeID)
+			}
 			collectionInfos := lbt.cluster.getCollectionInfosByID(lbt.ctx, nodeID)
 			for _, info := range collectionInfos {
 				collectionID := info.CollectionID
+				if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
+					drainedCollections[nodeID] = append(drainedCollections[nodeID], collectionID)
+				}
 				metaInfo, err := lbt.meta.getCollectionInfoByID(collectionID)
 				if err != nil {
 					log.Error("LoadBalanceTask: getCollectionInfoByID occur error", zap.String("error", err.Error()))
@@ -1285,6 +1549,8 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 							PartitionID:  partitionID,
 							CollectionID: collectionID,
 							BinlogPaths:  segmentBingLog.FieldBinlogs,
+							Deltalogs:    segmentBingLog.Deltalogs,
+							NumOfRows:    segmentBingLog.NumOfRows,
 						}
 
 						msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
@@ -1294,6 +1560,7 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 							Infos:         []*querypb.SegmentLoadInfo{segmentLoadInfo},
 							Schema:        schema,
 							LoadCondition: querypb.TriggerCondition_nodeDown,
+							LoadFieldIDs:  metaInfo.LoadFieldIDs,
 						}
 
 						segmentsToLoad = append(segmentsToLoad, segmentID)
@@ -1354,6 +1621,7 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 	//if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
 	//	return nil
 	//}
+	lbt.drainedCollections = drainedCollections
 
 	log.Debug("LoadBalanceTask Execute done",
 		zap.Int64s("sourceNodeIDs", lbt.SourceNodeIDs),
@@ -1363,7 +1631,11 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 }
 
 func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
+	// the draining nodes are removed by releaseDrainedNodes once the handoff completes
 	for _, id := range lbt.SourceNodeIDs {
+		if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
+			continue
+		}
 		err := lbt.cluster.removeNodeInfo(id)
 		if err != nil {
 			log.Error("LoadBalanceTask: remove mode info error", zap.Int64("nodeID", id))
@@ -1376,6 +1648,37 @@ func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
 	return nil
 }
 
+// releaseDrainedNodes releases the collections from the draining nodes, it's called after the child tasks have
+// loaded the segments and watched the dm channels on other nodes, so that a segment is not served by two nodes.
+func (lbt *LoadBalanceTask) releaseDrainedNodes(ctx context.Context) {
+	for nodeID, collectionIDs := range lbt.drainedCollections {
+		for _, collectionID := range collectionIDs {
+			req := &querypb.ReleaseCollectionRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ReleaseCollection,
+				},
+				CollectionID: collectionID,
+				NodeID:       nodeID,
+			}
+			if err := lbt.cluster.releaseDrainedCollection(ctx, nodeID, req); err != nil {
+				log.Warn("LoadBalanceTask: release collection from draining node failed",
+					zap.Int64("nodeID", nodeID), zap.Int64("collectionID", collectionID), zap.Error(err))
+			}
+		}
+	}
+	for _, nodeID := range lbt.SourceNodeIDs {
+		if lbt.triggerCondition != querypb.TriggerCondition_loadBalance {
+			continue
+		}
+		lbt.meta.deleteSegmentInfoByNodeID(nodeID)
+		if err := lbt.cluster.removeNodeInfo(nodeID); err != nil {
+			log.Error("LoadBalanceTask: remove node info error", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+	}
+	log.Debug("LoadBalanceTask: draining nodes released", zap.Int64s("sourceNodeIDs", lbt.SourceNodeIDs),
+		zap.Int64("taskID", lbt.ID()))
+}
+
 func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster) []int64 {
 	maxNumChannels := 0
 	nodes := make(map[int64]Node)
@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/opentracing/opentracing-go"
@@ -410,6 +411,24 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 			meta:               scheduler.meta,
 		}
 		newTask = loadBalanceTask
+	case commonpb.MsgType_ReshardCollection:
+		reshardReq := querypb.ReshardCollectionRequest{}
+		err = proto.Unmarshal([]byte(t), &reshardReq)
+		if err != nil {
+			log.Error(err.Error())
+		}
+		reshardCollectionTask := &ReshardCollectionTask{
+			BaseTask: BaseTask{
+				ctx:              scheduler.ctx,
+				Condition:        NewTaskCondition(scheduler.ctx),
+				triggerCondition: querypb.TriggerCondition_grpcRequest,
+			},
+			ReshardCollectionRequest: &reshardReq,
+			dataCoord:                scheduler.dataCoord,
+			cluster:                  scheduler.cluster,
+			meta:                     scheduler.meta,
+		}
+		newTask = reshardCollectionTask
 	default:
 		err = errors.New("inValid msg type when unMarshal task")
 		log.Error(err.Error())
@@ -542,18 +561,43 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 				}
 			}
 			log.Debug("scheduleLoop: num of child task", zap.Int("num child task", len(t.GetChildTask())))
+			// number of child tasks which failed and are neither retried nor rescheduled
+			failedChildTasks := new(int32)
 			for _, childTask := range t.GetChildTask() {
 				if childTask != nil {
 					log.Debug("scheduleLoop: add a activate task to activateChan", zap.Int64("taskID", childTask.ID()))
 					scheduler.activateTaskChan <- childTask
 					activeTaskWg.Add(1)
-					go scheduler.waitActivateTaskDone(activeTaskWg, childTask)
+					go scheduler.waitActivateTaskDone(activeTaskWg, childTask, failedChildTasks)
 				}
 			}
 			activeTaskWg.Wait()
 			if t.Type() == commonpb.MsgType_LoadCollection || t.Type() == commonpb.MsgType_LoadPartitions {
 				t.PostExecute(scheduler.ctx)
 			}
+			if lbt, ok := t.(*LoadBalanceTask); ok {
+				// the draining nodes still serve the segments and channels which failed to move
+				if err == nil && atomic.LoadInt32(failedChildTasks) == 0 {
+					lbt.releaseDrainedNodes(scheduler.ctx)
+				} else {
+					log.Warn("scheduleLoop: load balance task failed, keep the draining nodes",
+						zap.Int64("taskID", t.ID()), zap.Int64s("sourceNodeIDs", lbt.SourceNodeIDs),
+						zap.Int32("failed child tasks", atomic.LoadInt32(failedChildTasks)))
+				}
+			}
+			// the error of the retired channels is returned to the caller, which retries the reshard
+			var reshardErr error
+			if rct, ok := t.(*ReshardCollectionTask); ok && err == nil {
+				if failed := atomic.LoadInt32(failedChildTasks); failed > 0 {
+					reshardErr = fmt.Errorf("%d child tasks of reshard collection %d failed", failed, rct.CollectionID)
+				} else {
+					reshardErr = rct.releaseRetiredChannels(scheduler.ctx)
+				}
+				if reshardErr != nil {
+					log.Warn("scheduleLoop: reshard collection task failed", zap.Int64("taskID", t.ID()),
+						zap.Int64("collectionID", rct.CollectionID), zap.Error(reshardErr))
+				}
+			}
 
 			keys := make([]string, 0)
 			taskKey := fmt.Sprintf("%s/%d", triggerTaskPrefix, t.ID())
@@ -567,12 +611,17 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 				continue
 			}
 			log.Debug("scheduleLoop: trigger task done and delete from etcd", zap.Int64("taskID", t.ID()))
+			if reshardErr != nil {
+				err = reshardErr
+			}
 			t.Notify(err)
 		}
 	}
 }
 
-func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task) {
+// waitActivateTaskDone waits for an active task, failed tasks are retried or rescheduled, failed
+// counts the tasks which can't be.
+func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task, failed *int32) {
 	defer wg.Done()
 	err := t.WaitToFinish()
 	if err != nil {
@@ -582,6 +631,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 				reScheduledTasks, err := t.Reschedule()
 				if err != nil {
 					log.Error(err.Error())
+					atomic.AddInt32(failed, 1)
 					return
 				}
 				removes := make([]string, 0)
@@ -597,6 +647,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 						id, err := scheduler.taskIDAllocator()
 						if err != nil {
 							log.Error(err.Error())
+							atomic.AddInt32(failed, 1)
 							continue
 						}
 						rt.SetID(id)
@@ -604,6 +655,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 						blobs, err := rt.Marshal()
 						if err != nil {
 							log.Error("waitActivateTaskDone: error when marshal active task")
+							atomic.AddInt32(failed, 1)
 							continue
 							//TODO::xige-16 deal error when marshal task failed
 						}
@@ -625,7 +677,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 						log.Debug("waitActivateTaskDone: add a reScheduled active task to activateChan", zap.Int64("taskID", rt.ID()))
 						scheduler.activateTaskChan <- rt
 						wg.Add(1)
-						go scheduler.waitActivateTaskDone(wg, rt)
+						go scheduler.waitActivateTaskDone(wg, rt, failed)
 					}
 				}
 				//delete task from etcd
@@ -633,7 +685,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 				log.Debug("waitActivateTaskDone: retry the active task", zap.Int64("taskID", t.ID()))
 				scheduler.activateTaskChan <- t
 				wg.Add(1)
-				go scheduler.waitActivateTaskDone(wg, t)
+				go scheduler.waitActivateTaskDone(wg, t, failed)
 			}
 		}
 
@@ -642,8 +694,9 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 				log.Debug("waitActivateTaskDone: retry the active task", zap.Int64("taskID", t.ID()))
 				scheduler.activateTaskChan <- t
 				wg.Add(1)
-				go scheduler.waitActivateTaskDone(wg, t)
+				go scheduler.waitActivateTaskDone(wg, t, failed)
 			} else {
+				atomic.AddInt32(failed, 1)
 				removes := make([]string, 0)
 				taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, t.ID())
 				removes = append(removes, taskKey)
@@ -671,6 +724,7 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 			redoFunc2()
 		default:
 			//TODO:: case commonpb.MsgType_RemoveDmChannels:
+			atomic.AddInt32(failed, 1)
 		}
 	} else {
 		keys := make([]string, 0)
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"go.uber.org/zap"
@@ -34,10 +35,44 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 )
 
+// cCollection is a segcore collection, it's shared by the Collection and the plans created from it
+// since the plans refer to its schema, and is deleted once the last of them releases it.
+type cCollection struct {
+	ptr  C.CCollection
+	refs int32
+}
+
+func newCCollection(schema *schemapb.CollectionSchema) *cCollection {
+	/*
+		CCollection
+		NewCollection(const char* schema_proto_blob);
+	*/
+	schemaBlob := proto.MarshalTextString(schema)
+
+	cSchemaBlob := C.CString(schemaBlob)
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	return &cCollection{
+		ptr:  C.NewCollection(cSchemaBlob),
+		refs: 1,
+	}
+}
+
+func (cc *cCollection) release() {
+	/*
+		void
+		deleteCollection(CCollection collection);
+	*/
+	if atomic.AddInt32(&cc.refs, -1) == 0 {
+		C.DeleteCollection(cc.ptr)
+	}
+}
+
 type Collection struct {
-	collectionPtr C.CCollection
+	schemaMu      sync.RWMutex // guards collectionPtr and schema, which are replaced by updateSchema
+	collectionPtr *cCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
 	schema        *schemapb.CollectionSchema
@@ -56,9 +91,31 @@ func (c *Collection) ID() UniqueID {
 }
 
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// acquireCCollection returns the current segcore collection, the caller must release it once done
+func (c *Collection) acquireCCollection() *cCollection {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
+	atomic.AddInt32(&c.collectionPtr.refs, 1)
+	return c.collectionPtr
+}
+
+// newCSegment creates a segcore segment of the current schema, the segment keeps the schema
+// itself so that it doesn't depend on the segcore collection after it's created
+func (c *Collection) newCSegment(segmentID UniqueID, segType C.SegmentType) C.CSegmentInterface {
+	/*
+		CSegmentInterface
+		NewSegment(CCollection collection, uint64_t segment_id, SegmentType seg_type);
+	*/
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
+	return C.NewSegment(c.collectionPtr.ptr, C.ulong(segmentID), segType)
+}
+
 func (c *Collection) addPartitionID(partitionID UniqueID) {
 	c.releaseMu.Lock()
 	defer c.releaseMu.Unlock()
@@ -89,6 +146,33 @@ func (c *Collection) getVChannels() []Channel {
 	return c.vChannels
 }
 
+// removeVChannels removes the vChannels and their pChannels, e.g. the retired channels of a resharded collection
+func (c *Collection) removeVChannels(channels []Channel) {
+	log.Debug("remove vChannels from collection",
+		zap.Any("channels", channels),
+		zap.Any("collectionID", c.ID()))
+	removedV := make(map[Channel]bool)
+	removedP := make(map[Channel]bool)
+	for _, channel := range channels {
+		removedV[channel] = true
+		removedP[rootcoord.ToPhysicalChannel(channel)] = true
+	}
+	vChannels := make([]Channel, 0, len(c.vChannels))
+	for _, channel := range c.vChannels {
+		if !removedV[channel] {
+			vChannels = append(vChannels, channel)
+		}
+	}
+	c.vChannels = vChannels
+	pChannels := make([]Channel, 0, len(c.pChannels))
+	for _, channel := range c.pChannels {
+		if !removedP[channel] {
+			pChannels = append(pChannels, channel)
+		}
+	}
+	c.pChannels = pChannels
+}
+
 func (c *Collection) addPChannels(channels []Channel) {
 	log.Debug("add pChannels to collection",
 		zap.Any("channels", channels),
@@ -160,24 +244,14 @@ func (c *Collection) getLoadType() loadType {
 }
 
 func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Collection {
-	/*
-		CCollection
-		NewCollection(const char* schema_proto_blob);
-	*/
-	schemaBlob := proto.MarshalTextString(schema)
-
-	cSchemaBlob := C.CString(schemaBlob)
-	collection := C.NewCollection(cSchemaBlob)
-
 	var newCollection = &Collection{
-		collectionPtr:      collection,
+		collectionPtr:      newCCollection(schema),
 		id:                 collectionID,
 		schema:             schema,
 		vChannels:          make([]Channel, 0),
 		pChannels:          make([]Channel, 0),
 		releasedPartitions: make(map[UniqueID]struct{}),
 	}
-	C.free(unsafe.Pointer(cSchemaBlob))
 
 	log.Debug("create collection", zap.Int64("collectionID", collectionID))
 
@@ -185,15 +259,28 @@ func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Co
 	return newCollection
 }
 
+// updateSchema replaces the schema of the collection after fields are added to it, the segments
+// created before keep the schema they were created with, and the old segcore collection is kept
+// until the plans created from it are deleted.
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema) {
+	collection := newCCollection(schema)
+
+	c.schemaMu.Lock()
+	oldPtr := c.collectionPtr
+	c.collectionPtr = collection
+	c.schema = schema
+	c.schemaMu.Unlock()
+	oldPtr.release()
+
+	log.Debug("update collection schema", zap.Int64("collectionID", c.id), zap.Int("numFields", len(schema.Fields)))
+}
+
 func deleteCollection(collection *Collection) {
-	/*
-		void
-		deleteCollection(CCollection collection);
-	*/
+	collection.schemaMu.Lock()
 	cPtr := collection.collectionPtr
-	C.DeleteCollection(cPtr)
-
 	collection.collectionPtr = nil
+	collection.schemaMu.Unlock()
+	cPtr.release()
 
 	log.Debug("delete collection", zap.Int64("collectionID", collection.ID()))
 
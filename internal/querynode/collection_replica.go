@@ -464,6 +464,7 @@ func (colReplica *collectionReplica) getSegmentStatistics() []*internalpb.Segmen
 			MemorySize:       currentMemSize,
 			NumRows:          segmentNumOfRows,
 			RecentlyModified: segment.getRecentlyModified(),
+			LastAccessTime:   segment.getLastAccessTime(),
 		}
 
 		statisticData = append(statisticData, &stat)
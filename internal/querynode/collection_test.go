@@ -14,7 +14,10 @@ package querynode
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestCollection_newCollection(t *testing.T) {
@@ -33,3 +36,37 @@ func TestCollection_deleteCollection(t *testing.T) {
 	assert.Equal(t, collection.ID(), collectionID)
 	deleteCollection(collection)
 }
+
+func TestCollection_updateSchema(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	dslString := "{\"bool\": { \n\"vector\": {\n \"vec\": {\n \"metric_type\": \"L2\", \n \"params\": {\n \"nprobe\": 10 \n},\n \"query\": \"$0\",\"topk\": 10 \n } \n } \n } \n }"
+	plan, err := createSearchPlan(collection, dslString)
+	assert.NoError(t, err)
+	oldPtr := collection.collectionPtr
+	assert.Equal(t, int32(2), oldPtr.refs)
+
+	schema := proto.Clone(collectionMeta.Schema).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:  200,
+		Name:     "added",
+		DataType: schemapb.DataType_Int64,
+	})
+	collection.updateSchema(schema)
+	assert.Len(t, collection.Schema().Fields, len(collectionMeta.Schema.Fields)+1)
+	assert.NotEqual(t, oldPtr, collection.collectionPtr)
+
+	// the plan created before keeps the old segcore collection
+	assert.Equal(t, int32(1), oldPtr.refs)
+	assert.Equal(t, int64(10), plan.getTopK())
+	plan.delete()
+	assert.Equal(t, int32(0), oldPtr.refs)
+
+	plan, err = createSearchPlan(collection, dslString)
+	assert.NoError(t, err)
+	deleteCollection(collection)
+	assert.Equal(t, "L2", plan.getMetricType())
+	plan.delete()
+}
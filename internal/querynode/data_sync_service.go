@@ -189,6 +189,28 @@ func (dsService *dataSyncService) removePartitionFlowGraph(partitionID UniqueID)
 	delete(dsService.partitionFlowGraphs, partitionID)
 }
 
+// removeChannelFlowGraphs closes and removes the collection and partition flow graphs of the vChannels,
+// while the flow graphs of the other vChannels keep consuming
+func (dsService *dataSyncService) removeChannelFlowGraphs(vChannels []Channel) {
+	dsService.mu.Lock()
+	defer dsService.mu.Unlock()
+
+	removeFrom := func(flowGraphs map[UniqueID]map[Channel]*queryNodeFlowGraph) {
+		for _, nodeFGs := range flowGraphs {
+			for _, channel := range vChannels {
+				if nodeFG, ok := nodeFGs[channel]; ok {
+					// close flow graph
+					nodeFG.close()
+					delete(nodeFGs, channel)
+					log.Debug("remove flow graph", zap.Any("channel", channel))
+				}
+			}
+		}
+	}
+	removeFrom(dsService.collectionFlowGraphs)
+	removeFrom(dsService.partitionFlowGraphs)
+}
+
 func newDataSyncService(ctx context.Context,
 	streamingReplica ReplicaInterface,
 	tSafeReplica TSafeReplicaInterface,
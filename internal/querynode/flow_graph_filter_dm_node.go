@@ -14,12 +14,14 @@ package querynode
 import (
 	"errors"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
 )
@@ -75,6 +77,14 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			if resMsg != nil {
 				iMsg.insertMessages = append(iMsg.insertMessages, resMsg)
 			}
+		case commonpb.MsgType_AddField:
+			fdmNode.updateCollectionSchema(msg.(*msgstream.AddFieldMsg))
+		case commonpb.MsgType_RenameCollection:
+			// segments are organized by collection id, nothing changes but the name
+			if msg.(*msgstream.RenameCollectionMsg).CollectionID == fdmNode.collectionID {
+				log.Debug("collection renamed", zap.Int64("collectionID", fdmNode.collectionID),
+					zap.String("newName", msg.(*msgstream.RenameCollectionMsg).NewName))
+			}
 		default:
 			log.Warn("Non supporting", zap.Int32("message type", int32(msg.Type())))
 		}
@@ -170,6 +180,26 @@ func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg
 	return msg
 }
 
+func (fdmNode *filterDmNode) updateCollectionSchema(msg *msgstream.AddFieldMsg) {
+	if msg.CollectionID != fdmNode.collectionID {
+		return
+	}
+	col, err := fdmNode.replica.getCollectionByID(msg.CollectionID)
+	if err != nil {
+		log.Warn(err.Error())
+		return
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err = proto.Unmarshal(msg.Schema, schema); err != nil {
+		log.Warn("failed to unmarshal schema of add field message", zap.Error(err))
+		return
+	}
+	if len(schema.Fields) <= len(col.Schema().Fields) {
+		return
+	}
+	col.updateSchema(schema)
+}
+
 func newFilteredDmNode(replica ReplicaInterface,
 	loadType loadType,
 	collectionID UniqueID,
@@ -212,7 +212,12 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
 			}
+			if err = h.loader.acquireSegment(seg); err != nil {
+				return retrieveResults, retrieveSegmentIDs, err
+			}
+			seg.setAccessed()
 			result, err := seg.getEntityByIds(plan)
+			h.loader.releaseSegments([]UniqueID{segID})
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
 			}
@@ -227,6 +232,8 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 	return retrieveResults, retrieveSegmentIDs, nil
 }
 
+// search searches the sealed segments of the collection, lazy loaded segments are kept pinned
+// until releaseSearchedSegments is called since the search results refer to the segment data
 func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, plan *SearchPlan,
 	searchTs Timestamp) ([]*SearchResult, []UniqueID, error) {
 
@@ -295,8 +302,14 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 			if !seg.getOnService() {
 				continue
 			}
+			if err = h.loader.acquireSegment(seg); err != nil {
+				h.loader.releaseSegments(searchSegmentIDs)
+				return searchResults, searchSegmentIDs, err
+			}
+			seg.setAccessed()
 			searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
 			if err != nil {
+				h.loader.releaseSegments(append(searchSegmentIDs, seg.segmentID))
 				return searchResults, searchSegmentIDs, err
 			}
 			searchResults = append(searchResults, searchResult)
@@ -306,3 +319,7 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 
 	return searchResults, searchSegmentIDs, nil
 }
+
+func (h *historical) releaseSearchedSegments(segmentIDs []UniqueID) {
+	h.loader.releaseSegments(segmentIDs)
+}
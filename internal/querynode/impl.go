@@ -482,6 +482,109 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 	}, nil
 }
 
+// Drain marks the query node as stopping and asks query coordinator to hand off its segments
+// and dm channels to other query nodes, it returns after the handoff completes.
+func (node *QueryNode) Drain(ctx context.Context, in *queryPb.DrainRequest) (*commonpb.Status, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", Params.QueryNodeID)
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+	if node.queryCoord == nil {
+		err := fmt.Errorf("query node %d has no query coordinator to hand off", Params.QueryNodeID)
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+	if err := node.connectQueryCoord(); err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+
+	log.Debug("query node start draining", zap.Int64("nodeID", Params.QueryNodeID))
+	if err := node.session.MarkStopping(); err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+
+	status, err := node.queryCoord.LoadBalance(ctx, &queryPb.LoadBalanceRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_LoadBalanceSegments,
+			SourceID: Params.QueryNodeID,
+		},
+		SourceNodeIDs: []int64{Params.QueryNodeID},
+		BalanceReason: queryPb.TriggerCondition_loadBalance,
+	})
+	if err != nil {
+		log.Warn("query node drain failed", zap.Int64("nodeID", Params.QueryNodeID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, err
+	}
+	log.Debug("query node drain done", zap.Int64("nodeID", Params.QueryNodeID), zap.Any("status", status))
+	return status, nil
+}
+
+// RemoveDmChannels stops consuming some dm channels of a collection, e.g. the retired channels of a resharded
+// collection after the segments on them are handed off
+func (node *QueryNode) RemoveDmChannels(ctx context.Context, in *queryPb.RemoveDmChannelsRequest) (*commonpb.Status, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", Params.QueryNodeID)
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
+	rdt := &removeDmChannelsTask{
+		baseTask: baseTask{
+			ctx:  ctx,
+			done: make(chan error),
+		},
+		req:  in,
+		node: node,
+	}
+
+	err := node.scheduler.queue.Enqueue(rdt)
+	if err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		log.Warn(err.Error())
+		return status, err
+	}
+	log.Debug("removeDmChannelsTask Enqueue done", zap.Any("collectionID", in.CollectionID))
+
+	err = rdt.WaitToFinish()
+	if err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		log.Warn(err.Error())
+		return status, err
+	}
+	log.Debug("removeDmChannelsTask WaitToFinish done", zap.Any("collectionID", in.CollectionID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (node *QueryNode) isHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
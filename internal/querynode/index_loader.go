@@ -24,7 +24,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -38,6 +37,7 @@ import (
 type indexParam = map[string]string
 
 type indexLoader struct {
+	ctx     context.Context
 	replica ReplicaInterface
 
 	fieldIndexes   map[string][]*internalpb.IndexStats
@@ -47,6 +47,12 @@ type indexLoader struct {
 	indexCoord types.IndexCoord
 
 	kv kv.BaseKV // minio kv
+	// downloads the index files in parallel
+	downloader *storage.DownloadManager
+	// caches the index files on local disk in tiered load mode, nil if disabled
+	localChunkManager storage.ChunkManager
+	// decrypts the index files, nil if encryption is disabled
+	keyManager *storage.KeyManager
 }
 
 //func (loader *indexLoader) doLoadIndex(wg *sync.WaitGroup) {
@@ -233,36 +239,35 @@ func (loader *indexLoader) updateSegmentIndexStats(segment *Segment) error {
 }
 
 func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexParam, string, error) {
-	index := make([][]byte, 0)
-
-	var indexParams indexParam
-	var indexName string
-	for _, p := range indexPath {
-		log.Debug("", zap.String("load path", fmt.Sprintln(indexPath)))
-		indexPiece, err := loader.kv.Load(p)
-		if err != nil {
-			return nil, nil, "", err
-		}
-		// get index params when detecting indexParamPrefix
-		if path.Base(p) == storage.IndexParamsFile {
-			indexCodec := storage.NewIndexCodec()
-			_, indexParams, indexName, _, err = indexCodec.Deserialize([]*storage.Blob{
-				{
-					Key:   storage.IndexParamsFile,
-					Value: []byte(indexPiece),
-				},
-			})
-			if err != nil {
-				return nil, nil, "", err
-			}
-		} else {
-			index = append(index, []byte(indexPiece))
-		}
+	log.Debug("", zap.String("load path", fmt.Sprintln(indexPath)))
+	// the index files are large, so they're downloaded by ranged requests in parallel, and cached on local disk
+	// in tiered load mode so that evicted segments load their indexes again without downloading
+	indexPieces, err := readWithLocalCache(loader.ctx, loader.downloader, loader.localChunkManager, indexPath)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	blobs := make([]*storage.Blob, 0, len(indexPath))
+	for i, p := range indexPath {
+		blobs = append(blobs, &storage.Blob{
+			Key:   path.Base(p),
+			Value: indexPieces[i],
+		})
 	}
 
+	// the index params file is split from the index files, which are decrypted if they're encrypted
+	indexCodec := storage.NewIndexCodec()
+	indexCodec.KeyManager = loader.keyManager
+	indexBlobs, indexParams, indexName, _, err := indexCodec.Deserialize(blobs)
+	if err != nil {
+		return nil, nil, "", err
+	}
 	if len(indexParams) <= 0 {
 		return nil, nil, "", errors.New("cannot find index param")
 	}
+	index := make([][]byte, 0, len(indexBlobs))
+	for _, blob := range indexBlobs {
+		index = append(index, blob.Value)
+	}
 	return index, indexParams, indexName, nil
 }
 
@@ -360,21 +365,13 @@ func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment,
 //}
 
 func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface) *indexLoader {
-	option := &minioKV.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
-	}
-
-	client, err := minioKV.NewMinIOKV(ctx, option)
+	client, err := newStorageKV(ctx)
 	if err != nil {
 		panic(err)
 	}
 
 	return &indexLoader{
+		ctx:     ctx,
 		replica: replica,
 
 		fieldIndexes:   make(map[string][]*internalpb.IndexStats),
@@ -383,6 +380,7 @@ func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord t
 		rootCoord:  rootCoord,
 		indexCoord: indexCoord,
 
-		kv: client,
+		kv:         client,
+		downloader: newDownloadManager(newRemoteChunkManager(client)),
 	}
 }
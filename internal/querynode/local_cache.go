@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"container/list"
+	"os"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// tieredLoadCacheDir is the directory of the local cache under the local storage path
+const tieredLoadCacheDir = "tiered_load"
+
+type localCacheFile struct {
+	key  string
+	size int64
+}
+
+// localCache caches the binlogs and index files on local disk in tiered load mode, the least recently
+// used files are removed once the size of the cached files exceeds the capacity, there is no limit if
+// the capacity isn't positive. Only the files written since the cache is created are known to it.
+type localCache struct {
+	*storage.LocalChunkManager
+	mu       sync.Mutex
+	capacity int64
+	size     int64
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+// newLocalCache creates a cache under localPath, the files left by the last run are removed
+func newLocalCache(localPath string, capacity int64) *localCache {
+	if err := os.RemoveAll(localPath); err != nil {
+		log.Warn("failed to clean local cache", zap.String("path", localPath), zap.Error(err))
+	}
+	return &localCache{
+		LocalChunkManager: storage.NewLocalChunkManager(localPath),
+		capacity:          capacity,
+		ll:                list.New(),
+		items:             make(map[string]*list.Element),
+	}
+}
+
+func (c *localCache) Write(key string, content []byte) error {
+	if err := c.LocalChunkManager.Write(key, content); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+	c.items[key] = c.ll.PushFront(&localCacheFile{key: key, size: int64(len(content))})
+	c.size += int64(len(content))
+	// the file just written is kept even if it exceeds the capacity alone
+	for c.capacity > 0 && c.size > c.capacity && c.ll.Len() > 1 {
+		elem := c.ll.Back()
+		c.removeElement(elem)
+		key := elem.Value.(*localCacheFile).key
+		if err := c.LocalChunkManager.Remove(key); err != nil {
+			log.Warn("failed to remove cached file", zap.String("key", key), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Exist reports whether key is cached, and marks it as recently used
+func (c *localCache) Exist(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.items[key]
+	if ok {
+		c.ll.MoveToFront(elem)
+	}
+	return ok
+}
+
+func (c *localCache) getSize() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *localCache) removeElement(elem *list.Element) {
+	file := elem.Value.(*localCacheFile)
+	c.ll.Remove(elem)
+	delete(c.items, file.key)
+	c.size -= file.size
+}
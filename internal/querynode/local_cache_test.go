@@ -0,0 +1,62 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestLocalCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_local_cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the files left by the last run are removed
+	stale := storage.NewLocalChunkManager(dir)
+	assert.NoError(t, stale.Write("stale", []byte("stale")))
+	cache := newLocalCache(dir, 10)
+	assert.False(t, stale.Exist("stale"))
+	assert.False(t, cache.Exist("stale"))
+
+	assert.NoError(t, cache.Write("a", []byte("aaaa")))
+	assert.NoError(t, cache.Write("b", []byte("bbbb")))
+	assert.Equal(t, int64(8), cache.getSize())
+
+	// the least recently used file is removed beyond the capacity
+	assert.True(t, cache.Exist("a"))
+	assert.NoError(t, cache.Write("c", []byte("cccc")))
+	assert.Equal(t, int64(8), cache.getSize())
+	assert.False(t, cache.Exist("b"))
+	_, err = os.Stat(path.Join(dir, "b"))
+	assert.True(t, os.IsNotExist(err))
+	content, err := cache.Read("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("aaaa"), content)
+
+	// a file larger than the capacity is still cached until the next write
+	assert.NoError(t, cache.Write("d", []byte("dddddddddddd")))
+	assert.Equal(t, int64(12), cache.getSize())
+	assert.True(t, cache.Exist("d"))
+	assert.False(t, cache.Exist("a"))
+	assert.False(t, cache.Exist("c"))
+
+	// rewriting a file replaces its size
+	assert.NoError(t, cache.Write("d", []byte("dd")))
+	assert.Equal(t, int64(2), cache.getSize())
+}
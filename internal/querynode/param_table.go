@@ -46,6 +46,17 @@ type ParamTable struct {
 	MinioUseSSLStr       bool
 	MinioBucketName      string
 
+	// storage
+	StorageType       string
+	StoragePath       string
+	EncryptionKeyFile string
+	ColdBucketName    string
+	ColdRootPath      string
+
+	DownloadParallelism   int
+	DownloadPartSize      int64
+	DownloadRetryAttempts uint
+
 	// search
 	SearchChannelNames         []string
 	SearchResultChannelNames   []string
@@ -68,6 +79,12 @@ type ParamTable struct {
 	MsgChannelSubName string
 	SliceIndex        int
 
+	// tiered load
+	TieredLoadEnabled      bool
+	TieredLoadMemoryBudget int64
+	TieredLoadCacheSize    int64
+	LocalStoragePath       string
+
 	Log log.Config
 }
 
@@ -94,6 +111,8 @@ func (p *ParamTable) Init() {
 		p.initMinioUseSSLStr()
 		p.initMinioBucketName()
 
+		p.initStorage()
+
 		p.initPulsarAddress()
 		p.initRocksmqPath()
 		p.initEtcdEndpoints()
@@ -111,6 +130,11 @@ func (p *ParamTable) Init() {
 		p.initStatsPublishInterval()
 		p.initStatsChannelName()
 
+		p.initTieredLoadEnabled()
+		p.initTieredLoadMemoryBudget()
+		p.initTieredLoadCacheSize()
+		p.initLocalStoragePath()
+
 		p.initLogCfg()
 	})
 }
@@ -169,6 +193,13 @@ func (p *ParamTable) initMinioBucketName() {
 	p.MinioBucketName = bucketName
 }
 
+func (p *ParamTable) initStorage() {
+	p.StorageType, p.StoragePath = p.LoadStorage()
+	p.EncryptionKeyFile = p.LoadEncryptionKeyFile()
+	p.ColdBucketName, p.ColdRootPath = p.LoadColdTier()
+	p.DownloadParallelism, p.DownloadPartSize, p.DownloadRetryAttempts = p.LoadDownload()
+}
+
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
 	if err != nil {
@@ -254,6 +285,34 @@ func (p *ParamTable) initStatsChannelName() {
 	p.StatsChannelName = channels
 }
 
+// tiered load
+func (p *ParamTable) initTieredLoadEnabled() {
+	enabled, err := p.Load("queryNode.tieredLoad.enabled")
+	if err != nil {
+		panic(err)
+	}
+	p.TieredLoadEnabled, err = strconv.ParseBool(enabled)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *ParamTable) initTieredLoadMemoryBudget() {
+	p.TieredLoadMemoryBudget = p.ParseInt64("queryNode.tieredLoad.memoryBudget")
+}
+
+func (p *ParamTable) initTieredLoadCacheSize() {
+	p.TieredLoadCacheSize = p.ParseInt64("queryNode.tieredLoad.localCacheSize")
+}
+
+func (p *ParamTable) initLocalStoragePath() {
+	localPath, err := p.Load("localStorage.Path")
+	if err != nil {
+		localPath = "/tmp/milvus/data"
+	}
+	p.LocalStoragePath = localPath
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.Log = log.Config{}
 	format, err := p.Load("log.format")
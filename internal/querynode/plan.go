@@ -27,35 +27,41 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 )
 
+// SearchPlan holds the segcore collection it's created from, the plan refers to its schema
 type SearchPlan struct {
 	cSearchPlan C.CSearchPlan
+	collection  *cCollection
 }
 
 func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
 	cDsl := C.CString(dsl)
 	defer C.free(unsafe.Pointer(cDsl))
+	collection := col.acquireCCollection()
 	var cPlan C.CSearchPlan
-	status := C.CreateSearchPlan(col.collectionPtr, cDsl, &cPlan)
+	status := C.CreateSearchPlan(collection.ptr, cDsl, &cPlan)
 
 	err1 := HandleCStatus(&status, "Create Plan failed")
 	if err1 != nil {
+		collection.release()
 		return nil, err1
 	}
 
-	var newPlan = &SearchPlan{cSearchPlan: cPlan}
+	var newPlan = &SearchPlan{cSearchPlan: cPlan, collection: collection}
 	return newPlan, nil
 }
 
 func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
+	collection := col.acquireCCollection()
 	var cPlan C.CSearchPlan
-	status := C.CreateSearchPlanByExpr(col.collectionPtr, (*C.char)(unsafe.Pointer(&expr[0])), (C.int64_t)(len(expr)), &cPlan)
+	status := C.CreateSearchPlanByExpr(collection.ptr, (*C.char)(unsafe.Pointer(&expr[0])), (C.int64_t)(len(expr)), &cPlan)
 
 	err1 := HandleCStatus(&status, "Create Plan by expr failed")
 	if err1 != nil {
+		collection.release()
 		return nil, err1
 	}
 
-	var newPlan = &SearchPlan{cSearchPlan: cPlan}
+	var newPlan = &SearchPlan{cSearchPlan: cPlan, collection: collection}
 	return newPlan, nil
 }
 
@@ -73,6 +79,7 @@ func (plan *SearchPlan) getMetricType() string {
 
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
+	plan.collection.release()
 }
 
 type searchRequest struct {
@@ -108,6 +115,7 @@ func (pg *searchRequest) delete() {
 type RetrievePlan struct {
 	cRetrievePlan C.CRetrievePlan
 	Timestamp     uint64
+	collection    *cCollection
 }
 
 func createRetrievePlan(col *Collection, msg *segcorepb.RetrieveRequest, timestamp uint64) (*RetrievePlan, error) {
@@ -117,9 +125,11 @@ func createRetrievePlan(col *Collection, msg *segcorepb.RetrieveRequest, timesta
 	}
 	plan := new(RetrievePlan)
 	plan.Timestamp = timestamp
-	status := C.CreateRetrievePlan(col.collectionPtr, protoCGo.CProto, &plan.cRetrievePlan)
+	plan.collection = col.acquireCCollection()
+	status := C.CreateRetrievePlan(plan.collection.ptr, protoCGo.CProto, &plan.cRetrievePlan)
 	err2 := HandleCStatus(&status, "create retrieve plan failed")
 	if err2 != nil {
+		plan.collection.release()
 		return nil, err2
 	}
 	return plan, nil
@@ -127,4 +137,5 @@ func createRetrievePlan(col *Collection, msg *segcorepb.RetrieveRequest, timesta
 
 func (plan *RetrievePlan) delete() {
 	C.DeleteRetrievePlan(plan.cRetrievePlan)
+	plan.collection.release()
 }
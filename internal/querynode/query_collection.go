@@ -56,8 +56,11 @@ type queryCollection struct {
 	unsolvedMsgMu sync.Mutex // guards unsolvedMsg
 	unsolvedMsg   []queryMsg
 
+	watcherMu         sync.Mutex // guards tSafeWatchers, watcherChannels and watcherSelectCase
 	tSafeWatchers     map[Channel]*tSafeWatcher
+	watcherChannels   []Channel // channel of each select case after the first one
 	watcherSelectCase []reflect.SelectCase
+	watcherUpdated    chan struct{}
 
 	serviceableTimeMutex sync.Mutex // guards serviceableTime
 	serviceableTime      Timestamp
@@ -95,7 +98,8 @@ func newQueryCollection(releaseCtx context.Context,
 		historical:   historical,
 		streaming:    streaming,
 
-		tSafeWatchers: make(map[Channel]*tSafeWatcher),
+		tSafeWatchers:  make(map[Channel]*tSafeWatcher),
+		watcherUpdated: make(chan struct{}, 1),
 
 		unsolvedMsg: unsolvedMsg,
 
@@ -132,20 +136,68 @@ func (q *queryCollection) register() {
 		return
 	}
 
-	//TODO:: can't add new vChannel to selectCase
-	q.watcherSelectCase = make([]reflect.SelectCase, 0)
 	log.Debug("register tSafe watcher and init watcher select case",
 		zap.Any("collectionID", collection.ID()),
 		zap.Any("dml channels", collection.getVChannels()),
 	)
+	q.watcherMu.Lock()
+	defer q.watcherMu.Unlock()
 	for _, channel := range collection.getVChannels() {
+		if _, ok := q.tSafeWatchers[channel]; ok {
+			continue
+		}
 		q.tSafeWatchers[channel] = newTSafeWatcher()
 		q.streaming.tSafeReplica.registerTSafeWatcher(channel, q.tSafeWatchers[channel])
+	}
+	q.resetWatcherSelectCase()
+}
+
+// addTSafeWatcher watches the tSafe of a vChannel which is watched after the query collection is registered,
+// e.g. a new channel of a resharded collection
+func (q *queryCollection) addTSafeWatcher(channel Channel) {
+	q.watcherMu.Lock()
+	defer q.watcherMu.Unlock()
+	if _, ok := q.tSafeWatchers[channel]; ok {
+		return
+	}
+	q.tSafeWatchers[channel] = newTSafeWatcher()
+	q.streaming.tSafeReplica.registerTSafeWatcher(channel, q.tSafeWatchers[channel])
+	q.resetWatcherSelectCase()
+	log.Debug("add tSafe watcher", zap.Any("collectionID", q.collectionID), zap.Any("channel", channel))
+}
+
+// removeTSafeWatcher stops watching the tSafe of a vChannel, it must be called before the tSafe is removed,
+// otherwise the closed watcher is taken as the release of the collection
+func (q *queryCollection) removeTSafeWatcher(channel Channel) {
+	q.watcherMu.Lock()
+	defer q.watcherMu.Unlock()
+	if _, ok := q.tSafeWatchers[channel]; !ok {
+		return
+	}
+	delete(q.tSafeWatchers, channel)
+	q.resetWatcherSelectCase()
+	log.Debug("remove tSafe watcher", zap.Any("collectionID", q.collectionID), zap.Any("channel", channel))
+}
+
+// resetWatcherSelectCase rebuilds the select cases from the tSafe watchers, the first case wakes up
+// waitNewTSafe to select on the new cases.
+func (q *queryCollection) resetWatcherSelectCase() {
+	q.watcherChannels = make([]Channel, 0, len(q.tSafeWatchers))
+	q.watcherSelectCase = []reflect.SelectCase{{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(q.watcherUpdated),
+	}}
+	for channel, watcher := range q.tSafeWatchers {
+		q.watcherChannels = append(q.watcherChannels, channel)
 		q.watcherSelectCase = append(q.watcherSelectCase, reflect.SelectCase{
 			Dir:  reflect.SelectRecv,
-			Chan: reflect.ValueOf(q.tSafeWatchers[channel].watcherChan()),
+			Chan: reflect.ValueOf(watcher.watcherChan()),
 		})
 	}
+	select {
+	case q.watcherUpdated <- struct{}{}:
+	default:
+	}
 }
 
 func (q *queryCollection) addToUnsolvedMsg(msg queryMsg) {
@@ -163,13 +215,34 @@ func (q *queryCollection) popAllUnsolvedMsg() []queryMsg {
 }
 
 func (q *queryCollection) waitNewTSafe() Timestamp {
-	// block until any vChannel updating tSafe
-	_, _, recvOK := reflect.Select(q.watcherSelectCase)
-	if !recvOK {
-		//log.Warn("tSafe has been closed", zap.Any("collectionID", q.collectionID))
-		return Timestamp(math.MaxInt64)
+	for {
+		q.watcherMu.Lock()
+		selectCase := q.watcherSelectCase
+		channels := q.watcherChannels
+		q.watcherMu.Unlock()
+
+		// block until any vChannel updating tSafe
+		chosen, _, recvOK := reflect.Select(selectCase)
+		if chosen == 0 {
+			// the watched vChannels changed
+			continue
+		}
+		if !recvOK {
+			q.watcherMu.Lock()
+			_, watched := q.tSafeWatchers[channels[chosen-1]]
+			q.watcherMu.Unlock()
+			if !watched {
+				// the tSafe of a removed vChannel is closed
+				continue
+			}
+			//log.Warn("tSafe has been closed", zap.Any("collectionID", q.collectionID))
+			return Timestamp(math.MaxInt64)
+		}
+		break
 	}
 	//log.Debug("wait new tSafe", zap.Any("collectionID", s.collectionID))
+	q.watcherMu.Lock()
+	defer q.watcherMu.Unlock()
 	t := Timestamp(math.MaxInt64)
 	for channel := range q.tSafeWatchers {
 		ts := q.streaming.tSafeReplica.getTSafe(channel)
@@ -786,7 +859,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 	searchTimestamp := searchMsg.BeginTs()
 	travelTimestamp := searchMsg.TravelTimestamp
 
-	schema, err := typeutil.CreateSchemaHelper(q.collection.schema)
+	schema, err := typeutil.CreateSchemaHelper(q.collection.Schema())
 	if err != nil {
 		return err
 	}
@@ -805,6 +878,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 			return err
 		}
 	}
+	defer plan.delete()
 	topK := plan.getTopK()
 	if topK == 0 {
 		return fmt.Errorf("limit must be greater than 0")
@@ -817,6 +891,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 	if err != nil {
 		return err
 	}
+	defer searchReq.delete()
 	queryNum := searchReq.getNumOfQuery()
 	searchRequests := make([]*searchRequest, 0)
 	searchRequests = append(searchRequests, searchReq)
@@ -849,6 +924,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 		log.Warn(err1.Error())
 		return err1
 	}
+	defer q.historical.releaseSearchedSegments(sealedSegmentSearched)
 	searchResults = append(searchResults, hisSearchResults...)
 	tr.Record("historical search done")
 
@@ -1034,8 +1110,6 @@ func (q *queryCollection) search(msg queryMsg) error {
 	deleteSearchResults(searchResults)
 	deleteMarshaledHits(marshaledHits)
 	sp.LogFields(oplog.String("statistical time", "stats done"))
-	plan.delete()
-	searchReq.delete()
 	tr.Elapse("all done")
 	return nil
 }
@@ -128,3 +128,33 @@ func TestQueryCollection_withoutVChannel(t *testing.T) {
 	historical.close()
 	streaming.close()
 }
+
+func TestQueryCollection_tSafeWatchers(t *testing.T) {
+	tSafeReplica := newTSafeReplica()
+	queryCollection := &queryCollection{
+		streaming:      &streaming{tSafeReplica: tSafeReplica},
+		tSafeWatchers:  make(map[Channel]*tSafeWatcher),
+		watcherUpdated: make(chan struct{}, 1),
+	}
+	tSafeReplica.addTSafe("ch1")
+	tSafeReplica.addTSafe("ch2")
+	queryCollection.addTSafeWatcher("ch1")
+	queryCollection.addTSafeWatcher("ch2")
+	queryCollection.addTSafeWatcher("ch2")
+	assert.Equal(t, 2, len(queryCollection.tSafeWatchers))
+
+	tSafeReplica.setTSafe("ch1", 0, 100)
+	tSafeReplica.setTSafe("ch2", 0, 200)
+	for ts := queryCollection.waitNewTSafe(); ts != 100; ts = queryCollection.waitNewTSafe() {
+		assert.Less(t, ts, Timestamp(100))
+	}
+
+	// the closed tSafe of a removed channel doesn't release the query collection
+	queryCollection.removeTSafeWatcher("ch1")
+	tSafeReplica.removeTSafe("ch1")
+	tSafeReplica.setTSafe("ch2", 0, 300)
+	for ts := queryCollection.waitNewTSafe(); ts != 300; ts = queryCollection.waitNewTSafe() {
+		assert.NotEqual(t, Timestamp(math.MaxInt64), ts)
+	}
+	assert.Equal(t, 1, len(queryCollection.tSafeWatchers))
+}
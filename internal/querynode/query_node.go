@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"sync"
 	"sync/atomic"
 
 	"go.uber.org/zap"
@@ -59,6 +60,10 @@ type QueryNode struct {
 	// clients
 	rootCoord  types.RootCoord
 	indexCoord types.IndexCoord
+	queryCoord types.QueryCoord
+	// the query coordinator client is connected on the first drain, see connectQueryCoord
+	queryCoordMu        sync.Mutex
+	queryCoordConnected bool
 
 	msFactory msgstream.Factory
 	scheduler *taskScheduler
@@ -202,3 +207,30 @@ func (node *QueryNode) SetIndexCoord(index types.IndexCoord) error {
 	node.indexCoord = index
 	return nil
 }
+
+// SetQueryCoord sets the query coordinator client, which isn't connected until the query node is drained
+func (node *QueryNode) SetQueryCoord(query types.QueryCoord) error {
+	if query == nil {
+		return errors.New("null query coordinator interface")
+	}
+	node.queryCoord = query
+	return nil
+}
+
+// connectQueryCoord connects the query coordinator client on first use, the query coordinator is only used to drain
+// the query node, so the query node doesn't wait for it on startup
+func (node *QueryNode) connectQueryCoord() error {
+	node.queryCoordMu.Lock()
+	defer node.queryCoordMu.Unlock()
+	if node.queryCoordConnected {
+		return nil
+	}
+	if err := node.queryCoord.Init(); err != nil {
+		return err
+	}
+	if err := node.queryCoord.Start(); err != nil {
+		return err
+	}
+	node.queryCoordConnected = true
+	return nil
+}
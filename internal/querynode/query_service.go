@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 type queryService struct {
@@ -39,6 +40,8 @@ type queryService struct {
 	lcm               storage.ChunkManager
 	rcm               storage.ChunkManager
 	localCacheEnabled bool
+	// keyManager decrypts the vector binlogs, it's nil if encryption is disabled
+	keyManager *storage.KeyManager
 }
 
 func newQueryService(ctx context.Context,
@@ -58,20 +61,32 @@ func newQueryService(ctx context.Context,
 
 	lcm := storage.NewLocalChunkManager(path)
 
-	option := &miniokv.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
+	var rcm storage.ChunkManager
+	if Params.StorageType == paramtable.StorageTypeLocal {
+		// the files are laid out the same as LocalKV does
+		rcm = storage.NewLocalChunkManager(Params.StoragePath)
+	} else {
+		option := &miniokv.Option{
+			Address:           Params.MinioEndPoint,
+			AccessKeyID:       Params.MinioAccessKeyID,
+			SecretAccessKeyID: Params.MinioSecretAccessKey,
+			UseSSL:            Params.MinioUseSSLStr,
+			CreateBucket:      true,
+			BucketName:        Params.MinioBucketName,
+			ColdBucketName:    Params.ColdBucketName,
+			ColdRootPath:      Params.ColdRootPath,
+		}
+
+		client, err := miniokv.NewMinIOKV(ctx, option)
+		if err != nil {
+			panic(err)
+		}
+		rcm = storage.NewMinioChunkManager(client)
 	}
-
-	client, err := miniokv.NewMinIOKV(ctx, option)
+	keyManager, err := storage.NewKeyManagerFromKeyFile(Params.EncryptionKeyFile)
 	if err != nil {
 		panic(err)
 	}
-	rcm := storage.NewMinioChunkManager(client)
 
 	return &queryService{
 		ctx:    queryServiceCtx,
@@ -87,6 +102,7 @@ func newQueryService(ctx context.Context,
 		lcm:               lcm,
 		rcm:               rcm,
 		localCacheEnabled: localCacheEnabled,
+		keyManager:        keyManager,
 	}
 }
 
@@ -109,8 +125,9 @@ func (q *queryService) addQueryCollection(collectionID UniqueID) {
 	vcm := storage.NewVectorChunkManager(q.lcm, q.rcm,
 		&etcdpb.CollectionMeta{
 			ID:     collection.id,
-			Schema: collection.schema,
+			Schema: collection.Schema(),
 		}, q.localCacheEnabled)
+	vcm.SetKeyManager(q.keyManager)
 
 	ctx1, cancel := context.WithCancel(q.ctx)
 	qc := newQueryCollection(ctx1,
@@ -129,6 +146,11 @@ func (q *queryService) hasQueryCollection(collectionID UniqueID) bool {
 	return ok
 }
 
+func (q *queryService) getQueryCollection(collectionID UniqueID) (*queryCollection, bool) {
+	qc, ok := q.queryCollections[collectionID]
+	return qc, ok
+}
+
 func (q *queryService) stopQueryCollection(collectionID UniqueID) {
 	sc, ok := q.queryCollections[collectionID]
 	if !ok {
@@ -27,6 +27,8 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/stretchr/testify/assert"
@@ -35,9 +37,12 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type segmentType int32
@@ -80,6 +85,8 @@ type Segment struct {
 	rmMutex          sync.Mutex // guards recentlyModified
 	recentlyModified bool
 
+	lastAccessTime int64 // unix seconds of the last search or query, accessed atomically
+
 	typeMu      sync.Mutex // guards builtIndex
 	segmentType segmentType
 
@@ -88,8 +95,19 @@ type Segment struct {
 
 	idBinlogRowSizes []int64
 
+	// size of the user fields of a row in the schema the segment was created with,
+	// the fields added to the collection later are cut off from the inserted rows
+	sizeofPerRow int
+
 	vectorFieldMutex sync.RWMutex // guards vectorFieldInfos
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
+
+	lazyMu           sync.Mutex // guards loading of field data in tiered load mode
+	lazyLoadInfo     *querypb.SegmentLoadInfo
+	lazyLoadFieldIDs []int64
+	// number of rows of a lazy loaded segment, which is reported while its field data isn't resident,
+	// it's set before the segment is added to the replica and never changed
+	lazyNumRows int64
 }
 
 //-------------------------------------------------------------------------------------- common interfaces
@@ -129,6 +147,16 @@ func (s *Segment) getRecentlyModified() bool {
 	return s.recentlyModified
 }
 
+// setAccessed records the segment is searched or queried now, DataCoord moves the segments which are not accessed
+// for a long time to the cold tier
+func (s *Segment) setAccessed() {
+	atomic.StoreInt64(&s.lastAccessTime, time.Now().Unix())
+}
+
+func (s *Segment) getLastAccessTime() int64 {
+	return atomic.LoadInt64(&s.lastAccessTime)
+}
+
 func (s *Segment) setType(segType segmentType) {
 	s.typeMu.Lock()
 	defer s.typeMu.Unlock()
@@ -175,9 +203,9 @@ func newSegment(collection *Collection, segmentID int64, partitionID UniqueID, c
 		log.Warn("illegal segment type when create segment")
 		return nil
 	case segmentTypeSealed:
-		segmentPtr = C.NewSegment(collection.collectionPtr, C.ulong(segmentID), C.Sealed)
+		segmentPtr = collection.newCSegment(segmentID, C.Sealed)
 	case segmentTypeGrowing:
-		segmentPtr = C.NewSegment(collection.collectionPtr, C.ulong(segmentID), C.Growing)
+		segmentPtr = collection.newCSegment(segmentID, C.Growing)
 	default:
 		log.Warn("illegal segment type when create segment")
 		return nil
@@ -196,10 +224,27 @@ func newSegment(collection *Collection, segmentID int64, partitionID UniqueID, c
 		indexInfos:       make(map[int64]*indexInfo),
 		vectorFieldInfos: make(map[UniqueID]*VectorFieldInfo),
 	}
+	if schema := collection.Schema(); schema != nil {
+		segment.sizeofPerRow = sizeofUserFieldsPerRow(schema)
+	}
 
 	return segment
 }
 
+func sizeofUserFieldsPerRow(schema *schemapb.CollectionSchema) int {
+	userFields := &schemapb.CollectionSchema{}
+	for _, field := range schema.Fields {
+		if field.FieldID >= rootcoord.StartOfUserFieldID {
+			userFields.Fields = append(userFields.Fields, field)
+		}
+	}
+	size, err := typeutil.EstimateSizePerRecord(userFields)
+	if err != nil {
+		return 0
+	}
+	return size
+}
+
 func deleteSegment(segment *Segment) {
 	/*
 		void
@@ -220,6 +265,37 @@ func deleteSegment(segment *Segment) {
 	segment = nil
 }
 
+// setLazyLoadInfo registers a sealed segment whose field data and indexes are loaded on first access
+func (s *Segment) setLazyLoadInfo(info *querypb.SegmentLoadInfo, loadFieldIDs []int64) {
+	s.lazyMu.Lock()
+	defer s.lazyMu.Unlock()
+	s.lazyLoadInfo = info
+	s.lazyLoadFieldIDs = loadFieldIDs
+	s.lazyNumRows = info.GetNumOfRows()
+}
+
+func (s *Segment) isLazy() bool {
+	s.lazyMu.Lock()
+	defer s.lazyMu.Unlock()
+	return s.lazyLoadInfo != nil
+}
+
+// resetSealedSegment drops the field data and indexes of a sealed segment by replacing
+// its segcore segment with an empty one, the metadata of the segment is kept
+func (s *Segment) resetSealedSegment(collection *Collection) {
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	// segment has been deleted
+	if s.segmentPtr == nil {
+		return
+	}
+	C.DeleteSegment(s.segmentPtr)
+	s.segmentPtr = collection.newCSegment(s.segmentID, C.Sealed)
+	s.idBinlogRowSizes = nil
+
+	log.Debug("reset sealed segment", zap.Int64("segmentID", s.segmentID))
+}
+
 func (s *Segment) getRowCount() int64 {
 	/*
 		long int
@@ -232,6 +308,10 @@ func (s *Segment) getRowCount() int64 {
 	}
 	var rowCount = C.GetRowCount(s.segmentPtr)
 	//log.Debug("QueryNode::Segment::getRowCount", zap.Any("rowCount", rowCount))
+	if rowCount == 0 && s.lazyNumRows > 0 {
+		// the field data of the lazy loaded segment isn't resident
+		return s.lazyNumRows
+	}
 	return int64(rowCount)
 }
 
@@ -351,6 +431,10 @@ func (s *Segment) fillVectorFieldsData(collectionID UniqueID,
 					offset -= idBinlogRowSize
 				}
 			}
+			if storage.IsSegmentFileKey(vecPath) {
+				// the segment file holds all the fields, read the column of the vector field only
+				vecPath = storage.SegmentFileColumnKey(vecPath, fieldData.FieldId)
+			}
 			log.Debug("FillVectorFieldData", zap.Any("path", vecPath))
 
 			switch fieldData.Type {
@@ -595,13 +679,16 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 	// Blobs to one big blob
 	var numOfRow = len(*entityIDs)
 	var sizeofPerRow = len((*records)[0].Value)
+	if s.sizeofPerRow > 0 && sizeofPerRow > s.sizeofPerRow {
+		sizeofPerRow = s.sizeofPerRow
+	}
 
 	assert.Equal(nil, numOfRow, len(*records))
 
 	var rawData = make([]byte, numOfRow*sizeofPerRow)
 	var copyOffset = 0
 	for i := 0; i < len(*records); i++ {
-		copy(rawData[copyOffset:], (*records)[i].Value)
+		copy(rawData[copyOffset:], (*records)[i].Value[:sizeofPerRow])
 		copyOffset += sizeofPerRow
 	}
 
@@ -764,6 +851,58 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 	return nil
 }
 
+// segmentLoadDeletedRecord loads the deletes of a sealed segment, it's called after the primary keys and the
+// timestamps are loaded. A row is invisible to the searches and queries of the timestamps no earlier than the first
+// delete of its primary key which is no earlier than the row is inserted.
+func (s *Segment) segmentLoadDeletedRecord(primaryKeys []int64, timestamps []Timestamp) error {
+	/*
+		CStatus
+		LoadDeletedRecord(CSegmentInterface c_segment, CLoadDeletedRecordInfo deleted_record_info);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	if s.segmentType != segmentTypeSealed {
+		errMsg := fmt.Sprintln("segmentLoadDeletedRecord failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
+		return errors.New(errMsg)
+	}
+	if len(primaryKeys) != len(timestamps) {
+		return fmt.Errorf("%d primary keys are deleted with %d timestamps", len(primaryKeys), len(timestamps))
+	}
+	if len(primaryKeys) == 0 {
+		return nil
+	}
+
+	/*
+		typedef struct CLoadDeletedRecordInfo {
+		    void* timestamps;
+		    void* primary_keys;
+		    int64_t row_count;
+		} CLoadDeletedRecordInfo;
+	*/
+	loadInfo := C.CLoadDeletedRecordInfo{
+		timestamps:   unsafe.Pointer(&timestamps[0]),
+		primary_keys: unsafe.Pointer(&primaryKeys[0]),
+		row_count:    C.int64_t(len(primaryKeys)),
+	}
+
+	var status = C.LoadDeletedRecord(s.segmentPtr, loadInfo)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return errors.New("LoadDeletedRecord failed, C runtime error detected, error code = " + strconv.Itoa(int(errorCode)) + ", error msg = " + errorMsg)
+	}
+
+	log.Debug("load deleted record done",
+		zap.Int("row count", len(primaryKeys)),
+		zap.Int64("segmentID", s.ID()))
+
+	return nil
+}
+
 func (s *Segment) dropFieldData(fieldID int64) error {
 	/*
 		CStatus
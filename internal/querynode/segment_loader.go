@@ -15,20 +15,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	localkv "github.com/milvus-io/milvus/internal/kv/local"
 	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/retry"
 )
 
 const (
@@ -38,6 +44,7 @@ const (
 
 // segmentLoader is only responsible for loading the field data from binlog
 type segmentLoader struct {
+	ctx               context.Context
 	historicalReplica ReplicaInterface
 
 	dataCoord types.DataCoord
@@ -45,7 +52,18 @@ type segmentLoader struct {
 	minioKV kv.BaseKV // minio minioKV
 	etcdKV  *etcdkv.EtcdKV
 
+	// used to read the column chunks of segment files by ranges
+	remoteChunkManager storage.ChunkManager
+	// downloads the binlogs in parallel
+	downloader *storage.DownloadManager
+	// decrypts the binlogs, nil if encryption is disabled
+	keyManager *storage.KeyManager
+
 	indexLoader *indexLoader
+
+	// tiered load mode, nil if disabled
+	segmentLRU        *segmentLRU
+	localChunkManager storage.ChunkManager
 }
 
 func (loader *segmentLoader) loadSegmentOfConditionHandOff(req *querypb.LoadSegmentsRequest) error {
@@ -99,7 +117,12 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 			return err
 		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentTypeSealed, onService)
-		err = loader.loadSegmentInternal(collectionID, segment, info)
+		if loader.segmentLRU != nil {
+			// only register the segment, field data would be loaded on first access
+			segment.setLazyLoadInfo(info, req.LoadFieldIDs)
+		} else {
+			err = loader.loadSegmentInternal(collectionID, segment, info, req.LoadFieldIDs)
+		}
 		if err != nil {
 			deleteSegment(segment)
 			log.Warn(err.Error())
@@ -141,7 +164,7 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 	return loader.indexLoader.sendQueryNodeStats()
 }
 
-func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment *Segment, segmentLoadInfo *querypb.SegmentLoadInfo) error {
+func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment *Segment, segmentLoadInfo *querypb.SegmentLoadInfo, loadFieldIDs []int64) error {
 	vectorFieldIDs, err := loader.historicalReplica.getVecFieldIDsByCollectionID(collectionID)
 	if err != nil {
 		return err
@@ -150,8 +173,38 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 		return fmt.Errorf("no vector field in collection %d", collectionID)
 	}
 
+	// the deletes of the segment are applied by the primary keys, so the primary key field is always loaded
+	var deleteData *storage.DeleteData
+	if len(segmentLoadInfo.Deltalogs) > 0 {
+		col, err := loader.historicalReplica.getCollectionByID(collectionID)
+		if err != nil {
+			return err
+		}
+		pkFieldID := primaryKeyFieldID(col.Schema())
+		if len(loadFieldIDs) > 0 && !funcutil.SliceContain(loadFieldIDs, pkFieldID) {
+			loadFieldIDs = append(append([]int64{}, loadFieldIDs...), pkFieldID)
+		}
+		log.Debug("loading deltalogs...", zap.Int64("segmentID", segment.segmentID), zap.Int("num", len(segmentLoadInfo.Deltalogs)))
+		deleteData, err = loader.loadDeltalogs(segmentLoadInfo.Deltalogs)
+		if err != nil {
+			return err
+		}
+	}
+
+	// only the requested fields are loaded if load fields are specified
+	binlogPaths := loader.selectFieldBinlogs(segmentLoadInfo.BinlogPaths, loadFieldIDs)
+	if len(loadFieldIDs) > 0 {
+		loadVectorFieldIDs := make([]int64, 0)
+		for _, vecFieldID := range vectorFieldIDs {
+			if funcutil.SliceContain(loadFieldIDs, vecFieldID) {
+				loadVectorFieldIDs = append(loadVectorFieldIDs, vecFieldID)
+			}
+		}
+		vectorFieldIDs = loadVectorFieldIDs
+	}
+
 	// add VectorFieldInfo for vector fields
-	for _, fieldBinlog := range segmentLoadInfo.BinlogPaths {
+	for _, fieldBinlog := range binlogPaths {
 		if funcutil.SliceContain(vectorFieldIDs, fieldBinlog.FieldID) {
 			vectorFieldInfo := newVectorFieldInfo(fieldBinlog)
 			segment.setVectorFieldInfo(fieldBinlog.FieldID, vectorFieldInfo)
@@ -169,10 +222,10 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 	}
 
 	// we don't need to load raw data for indexed vector field
-	fieldBinlogs := loader.filterFieldBinlogs(segmentLoadInfo.BinlogPaths, indexedFieldIDs)
+	fieldBinlogs := loader.filterFieldBinlogs(binlogPaths, indexedFieldIDs)
 
 	log.Debug("loading insert...")
-	err = loader.loadSegmentFieldsData(segment, fieldBinlogs)
+	err = loader.loadSegmentFieldsData(segment, fieldBinlogs, loader.loadSchema(collectionID, loadFieldIDs), deleteData)
 	if err != nil {
 		return err
 	}
@@ -187,6 +240,108 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 	return nil
 }
 
+// acquireSegment makes sure the field data of a lazy loaded segment is resident and pins it
+// until releaseSegment is called, it does nothing for segments loaded in the normal way.
+func (loader *segmentLoader) acquireSegment(segment *Segment) error {
+	if loader.segmentLRU == nil || !segment.isLazy() {
+		return nil
+	}
+	evicted, err := loader.loadLazySegment(segment)
+	loader.evictSegments(evicted)
+	return err
+}
+
+// releaseSegments unpins the segments acquired before, segments not loaded lazily are ignored
+func (loader *segmentLoader) releaseSegments(segmentIDs []UniqueID) {
+	if loader.segmentLRU == nil {
+		return
+	}
+	for _, segmentID := range segmentIDs {
+		loader.evictSegments(loader.segmentLRU.unpin(segmentID))
+	}
+}
+
+func (loader *segmentLoader) loadLazySegment(segment *Segment) ([]*Segment, error) {
+	segment.lazyMu.Lock()
+	defer segment.lazyMu.Unlock()
+	if loader.segmentLRU.pin(segment.ID()) {
+		return nil, nil
+	}
+
+	log.Debug("load lazy segment", zap.Int64("segmentID", segment.ID()))
+	err := loader.loadSegmentInternal(segment.collectionID, segment, segment.lazyLoadInfo, segment.lazyLoadFieldIDs)
+	if err != nil {
+		loader.resetSegment(segment)
+		return nil, err
+	}
+	return loader.segmentLRU.add(segment, segment.getMemSize()), nil
+}
+
+// evictSegments drops the field data of cold segments, segments which have been released
+// from the replica are skipped since their segcore segments are deleted already
+func (loader *segmentLoader) evictSegments(segments []*Segment) {
+	for _, segment := range segments {
+		segment.lazyMu.Lock()
+		// the segment may be acquired again after it was chosen to evict
+		if loader.segmentLRU.evict(segment.ID()) {
+			log.Debug("evict lazy segment", zap.Int64("segmentID", segment.ID()))
+			loader.resetSegment(segment)
+		}
+		segment.lazyMu.Unlock()
+	}
+}
+
+func (loader *segmentLoader) resetSegment(segment *Segment) {
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		log.Warn(err.Error())
+		return
+	}
+	segment.resetSealedSegment(collection)
+}
+
+// readBinlogs reads the binlogs from the object storage in parallel, the binlogs are also cached on local disk in
+// tiered load mode so that evicted segments are loaded again without downloading.
+func (loader *segmentLoader) readBinlogs(paths []string) ([][]byte, error) {
+	return readWithLocalCache(loader.ctx, loader.downloader, loader.localChunkManager, paths)
+}
+
+// readWithLocalCache downloads the files of paths in parallel, the files found in localChunkManager are read from
+// the local disk instead, and the downloaded files are written into it. localChunkManager is nil if there is no
+// local cache.
+func readWithLocalCache(ctx context.Context, downloader *storage.DownloadManager, localChunkManager storage.ChunkManager,
+	paths []string) ([][]byte, error) {
+	files := make([][]byte, len(paths))
+	var downloadIdxs []int
+	var downloadPaths []string
+	for i, path := range paths {
+		if localChunkManager != nil && localChunkManager.Exist(path) {
+			file, err := localChunkManager.Read(path)
+			if err != nil {
+				return nil, err
+			}
+			files[i] = file
+			continue
+		}
+		downloadIdxs = append(downloadIdxs, i)
+		downloadPaths = append(downloadPaths, path)
+	}
+	downloaded, err := downloader.Download(ctx, downloadPaths)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range downloadIdxs {
+		files[i] = downloaded[j]
+		if localChunkManager != nil {
+			err = localChunkManager.Write(paths[i], downloaded[j])
+			if err != nil {
+				log.Warn("failed to cache file on local disk", zap.String("path", paths[i]), zap.Error(err))
+			}
+		}
+	}
+	return files, nil
+}
+
 //func (loader *segmentLoader) GetSegmentStates(segmentID UniqueID) (*datapb.GetSegmentStatesResponse, error) {
 //	ctx := context.TODO()
 //	if loader.dataCoord == nil {
@@ -217,8 +372,140 @@ func (loader *segmentLoader) filterFieldBinlogs(fieldBinlogs []*datapb.FieldBinl
 	return result
 }
 
-func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlogs []*datapb.FieldBinlog) error {
-	iCodec := storage.InsertCodec{}
+// selectFieldBinlogs keeps the binlogs of system fields and of the fields in loadFieldIDs,
+// all binlogs are kept if loadFieldIDs is empty
+func (loader *segmentLoader) selectFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog, loadFieldIDs []int64) []*datapb.FieldBinlog {
+	if len(loadFieldIDs) == 0 {
+		return fieldBinlogs
+	}
+	result := make([]*datapb.FieldBinlog, 0)
+	for _, fieldBinlog := range fieldBinlogs {
+		if fieldBinlog.FieldID < rootcoord.StartOfUserFieldID || funcutil.SliceContain(loadFieldIDs, fieldBinlog.FieldID) {
+			result = append(result, fieldBinlog)
+		}
+	}
+	return result
+}
+
+// loadSchema returns the schema of the fields to load, it's used to fill the fields which are added to the
+// collection after the binlogs are written
+func (loader *segmentLoader) loadSchema(collectionID UniqueID, loadFieldIDs []int64) *etcdpb.CollectionMeta {
+	col, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil
+	}
+	schema := &schemapb.CollectionSchema{
+		Name:   col.Schema().Name,
+		AutoID: col.Schema().AutoID,
+	}
+	for _, field := range col.Schema().Fields {
+		if len(loadFieldIDs) == 0 || field.FieldID < rootcoord.StartOfUserFieldID || funcutil.SliceContain(loadFieldIDs, field.FieldID) {
+			schema.Fields = append(schema.Fields, field)
+		}
+	}
+	return &etcdpb.CollectionMeta{
+		ID:     collectionID,
+		Schema: schema,
+	}
+}
+
+// readSegmentFile reads the column chunks of the fields from a segment file, only the ranges of the columns are
+// downloaded, unless the whole file is cached on local disk in tiered load mode. It returns the number of row
+// groups of the file as well.
+func (loader *segmentLoader) readSegmentFile(path string, fieldIDs []int64) ([]*storage.Blob, int, error) {
+	cm := loader.remoteChunkManager
+	if loader.localChunkManager != nil {
+		if !loader.localChunkManager.Exist(path) {
+			if _, err := loader.readBinlogs([]string{path}); err != nil {
+				return nil, 0, err
+			}
+		}
+		if loader.localChunkManager.Exist(path) {
+			cm = loader.localChunkManager
+		}
+	}
+	reader, err := storage.NewSegmentFileReader(cm, path)
+	if err != nil {
+		return nil, 0, err
+	}
+	blobs, err := reader.ReadColumns(fieldIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	return blobs, len(reader.Footer().RowGroups), nil
+}
+
+// binlogRowSizes returns the number of rows of every timestamp binlog, numRows is the number of rows of every
+// insert event, a segment file has an event for each of its row groups
+func binlogRowSizes(fieldBinlogs []*datapb.FieldBinlog, numRows []int64, rowGroups map[string]int) []int64 {
+	for _, fieldBinlog := range fieldBinlogs {
+		if fieldBinlog.FieldID != rootcoord.TimeStampField {
+			continue
+		}
+		rowSizes := make([]int64, 0, len(fieldBinlog.Binlogs))
+		i := 0
+		for _, path := range fieldBinlog.Binlogs {
+			events := 1
+			if n, ok := rowGroups[path]; ok {
+				events = n
+			}
+			if i+events > len(numRows) {
+				return numRows
+			}
+			var rows int64
+			for _, n := range numRows[i : i+events] {
+				rows += n
+			}
+			rowSizes = append(rowSizes, rows)
+			i += events
+		}
+		if i == len(numRows) {
+			return rowSizes
+		}
+	}
+	return numRows
+}
+
+// loadDeltalogs reads the deletes of a segment from its delta logs
+func (loader *segmentLoader) loadDeltalogs(deltalogs []string) (*storage.DeleteData, error) {
+	deleteCodec := storage.NewDeleteCodec()
+	deleteCodec.KeyManager = loader.keyManager
+	defer func() {
+		err := deleteCodec.Close()
+		if err != nil {
+			log.Warn(err.Error())
+		}
+	}()
+	values, err := loader.readBinlogs(deltalogs)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, 0, len(deltalogs))
+	for i, path := range deltalogs {
+		blobs = append(blobs, &storage.Blob{
+			Key:   path,
+			Value: values[i],
+		})
+	}
+	_, _, deleteData, err := deleteCodec.Deserialize(blobs)
+	return deleteData, err
+}
+
+// primaryKeyFieldID returns the field of the primary keys deletes refer to, which is the row id if the
+// collection has no int64 primary key field
+func primaryKeyFieldID(schema *schemapb.CollectionSchema) int64 {
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey && field.DataType == schemapb.DataType_Int64 {
+			return field.FieldID
+		}
+	}
+	return rootcoord.RowIDField
+}
+
+func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlogs []*datapb.FieldBinlog, schema *etcdpb.CollectionMeta,
+	deleteData *storage.DeleteData) error {
+	iCodec := storage.NewInsertCodec(schema)
+	iCodec.KeyManager = loader.keyManager
 	defer func() {
 		err := iCodec.Close()
 		if err != nil {
@@ -226,6 +513,10 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		}
 	}()
 	blobs := make([]*storage.Blob, 0)
+	binlogPaths := make([]string, 0)
+	// fields of the segment files, which are shared by all the fields of a flush
+	segmentFiles := make([]string, 0)
+	segmentFileFields := make(map[string][]int64)
 	for _, fb := range fieldBinlogs {
 		log.Debug("load segment fields data",
 			zap.Int64("segmentID", segment.segmentID),
@@ -233,26 +524,41 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 			zap.String("paths", fmt.Sprintln(fb.Binlogs)),
 		)
 		for _, path := range fb.Binlogs {
-			p := path
-			binLog, err := loader.minioKV.Load(path)
-			if err != nil {
-				// TODO: return or continue?
-				return err
-			}
-			blob := &storage.Blob{
-				Key:   p,
-				Value: []byte(binLog),
+			if storage.IsSegmentFileKey(path) {
+				if _, ok := segmentFileFields[path]; !ok {
+					segmentFiles = append(segmentFiles, path)
+				}
+				segmentFileFields[path] = append(segmentFileFields[path], fb.FieldID)
+				continue
 			}
-			blobs = append(blobs, blob)
+			binlogPaths = append(binlogPaths, path)
 		}
 	}
+	binlogs, err := loader.readBinlogs(binlogPaths)
+	if err != nil {
+		return err
+	}
+	for i, path := range binlogPaths {
+		blobs = append(blobs, &storage.Blob{
+			Key:   path,
+			Value: binlogs[i],
+		})
+	}
+	rowGroups := make(map[string]int, len(segmentFiles))
+	for _, path := range segmentFiles {
+		fileBlobs, numRowGroups, err := loader.readSegmentFile(path, segmentFileFields[path])
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, fileBlobs...)
+		rowGroups[path] = numRowGroups
+	}
 
 	_, _, insertData, err := iCodec.Deserialize(blobs)
 	if err != nil {
 		log.Warn(err.Error())
 		return err
 	}
-
 	for fieldID, value := range insertData.Data {
 		var numRows []int64
 		var data interface{}
@@ -291,7 +597,7 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 			return errors.New("unexpected field data type")
 		}
 		if fieldID == rootcoord.TimeStampField {
-			segment.setIDBinlogRowSizes(numRows)
+			segment.setIDBinlogRowSizes(binlogRowSizes(fieldBinlogs, numRows, rowGroups))
 		}
 		totalNumRows := int64(0)
 		for _, numRow := range numRows {
@@ -304,10 +610,21 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		}
 	}
 
+	// the deletes are applied by segcore with their own timestamps, after the primary keys and timestamps are loaded
+	if deleteData != nil {
+		if err := segment.segmentLoadDeletedRecord(deleteData.Pks, deleteData.Tss); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface, etcdKV *etcdkv.EtcdKV) *segmentLoader {
+// newStorageKV connects to the object storage where the binlogs and index files are saved, which is the local file
+// system if storage.type is local, or MinIO otherwise
+func newStorageKV(ctx context.Context) (kv.BaseKV, error) {
+	if Params.StorageType == paramtable.StorageTypeLocal {
+		return localkv.NewLocalKV(Params.StoragePath)
+	}
 	option := &minioKV.Option{
 		Address:           Params.MinioEndPoint,
 		AccessKeyID:       Params.MinioAccessKeyID,
@@ -315,20 +632,61 @@ func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord
 		UseSSL:            Params.MinioUseSSLStr,
 		CreateBucket:      true,
 		BucketName:        Params.MinioBucketName,
+		ColdBucketName:    Params.ColdBucketName,
+		ColdRootPath:      Params.ColdRootPath,
 	}
+	return minioKV.NewMinIOKV(ctx, option)
+}
 
-	client, err := minioKV.NewMinIOKV(ctx, option)
+// newRemoteChunkManager returns the ChunkManager of the object storage which client connects to
+func newRemoteChunkManager(client kv.BaseKV) storage.ChunkManager {
+	if minioClient, ok := client.(*minioKV.MinIOKV); ok {
+		return storage.NewMinioChunkManager(minioClient)
+	}
+	// the files are laid out the same as LocalKV does
+	return storage.NewLocalChunkManager(Params.StoragePath)
+}
+
+// newDownloadManager returns the DownloadManager downloading the binlogs and index files from cm
+func newDownloadManager(cm storage.ChunkManager) *storage.DownloadManager {
+	return storage.NewDownloadManager(cm,
+		storage.DownloadParallelism(Params.DownloadParallelism),
+		storage.DownloadPartSize(Params.DownloadPartSize),
+		storage.DownloadRetry(retry.Attempts(Params.DownloadRetryAttempts)))
+}
+
+func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface, etcdKV *etcdkv.EtcdKV) *segmentLoader {
+	client, err := newStorageKV(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	keyManager, err := storage.NewKeyManagerFromKeyFile(Params.EncryptionKeyFile)
 	if err != nil {
 		panic(err)
 	}
 
+	remoteChunkManager := newRemoteChunkManager(client)
+	downloader := newDownloadManager(remoteChunkManager)
 	iLoader := newIndexLoader(ctx, rootCoord, indexCoord, replica)
-	return &segmentLoader{
+	iLoader.keyManager = keyManager
+	loader := &segmentLoader{
+		ctx:               ctx,
 		historicalReplica: replica,
 
 		minioKV: client,
 		etcdKV:  etcdKV,
 
+		remoteChunkManager: remoteChunkManager,
+		downloader:         downloader,
+		keyManager:         keyManager,
+
 		indexLoader: iLoader,
 	}
+	if Params.TieredLoadEnabled {
+		loader.segmentLRU = newSegmentLRU(Params.TieredLoadMemoryBudget)
+		loader.localChunkManager = newLocalCache(path.Join(Params.LocalStoragePath, tieredLoadCacheDir), Params.TieredLoadCacheSize)
+		iLoader.localChunkManager = loader.localChunkManager
+	}
+	return loader
 }
@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestBinlogRowSizes(t *testing.T) {
+	fieldBinlogs := []*datapb.FieldBinlog{
+		{FieldID: rootcoord.RowIDField, Binlogs: []string{"log/0/1", "log/segment/2", "log/0/3"}},
+		{FieldID: rootcoord.TimeStampField, Binlogs: []string{"log/1/1", "log/segment/2", "log/1/3"}},
+	}
+	rowGroups := map[string]int{"log/segment/2": 3}
+
+	// the segment file has 3 row groups
+	rowSizes := binlogRowSizes(fieldBinlogs, []int64{10, 4, 4, 2, 5}, rowGroups)
+	assert.Equal(t, []int64{10, 10, 5}, rowSizes)
+
+	// the events are returned if they don't match the binlogs
+	rowSizes = binlogRowSizes(fieldBinlogs, []int64{10, 4, 4, 2, 5, 1}, rowGroups)
+	assert.Equal(t, []int64{10, 4, 4, 2, 5, 1}, rowSizes)
+
+	rowSizes = binlogRowSizes(fieldBinlogs, []int64{10, 4, 4}, rowGroups)
+	assert.Equal(t, []int64{10, 4, 4}, rowSizes)
+
+	rowSizes = binlogRowSizes(fieldBinlogs, []int64{10, 10, 5}, nil)
+	assert.Equal(t, []int64{10, 10, 5}, rowSizes)
+}
+
+func TestPrimaryKeyFieldID(t *testing.T) {
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: rootcoord.RowIDField, DataType: schemapb.DataType_Int64},
+		{FieldID: rootcoord.TimeStampField, DataType: schemapb.DataType_Int64},
+		{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+	}}
+	assert.Equal(t, int64(100), primaryKeyFieldID(schema))
+	schema.Fields[2].IsPrimaryKey = false
+	assert.Equal(t, int64(rootcoord.RowIDField), primaryKeyFieldID(schema))
+}
+
+// noIndexRootCoord reports that no segment has an index
+type noIndexRootCoord struct {
+	types.RootCoord
+}
+
+func (rc *noIndexRootCoord) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	return &milvuspb.DescribeSegmentResponse{
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		EnableIndex: false,
+	}, nil
+}
+
+// genLazySegment writes the binlogs of a segment with numRows rows into cm, and registers the segment as lazy loaded
+func genLazySegment(t *testing.T, cm storage.ChunkManager, collection *Collection, segmentID UniqueID, numRows int) *Segment {
+	const dim = 16
+	insertData := &storage.InsertData{Data: map[int64]storage.FieldData{
+		rootcoord.RowIDField:     &storage.Int64FieldData{NumRows: []int64{int64(numRows)}, Data: make([]int64, numRows)},
+		rootcoord.TimeStampField: &storage.Int64FieldData{NumRows: []int64{int64(numRows)}, Data: make([]int64, numRows)},
+		100:                      &storage.FloatVectorFieldData{NumRows: []int64{int64(numRows)}, Data: make([]float32, numRows*dim), Dim: dim},
+		101:                      &storage.Int32FieldData{NumRows: []int64{int64(numRows)}, Data: make([]int32, numRows)},
+	}}
+	for i := 0; i < numRows; i++ {
+		insertData.Data[rootcoord.RowIDField].(*storage.Int64FieldData).Data[i] = int64(i)
+		insertData.Data[rootcoord.TimeStampField].(*storage.Int64FieldData).Data[i] = int64(i + 1)
+	}
+	inCodec := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: collection.ID(), Schema: collection.Schema()})
+	binlogs, _, err := inCodec.Serialize(defaultPartitionID, segmentID, insertData)
+	assert.NoError(t, err)
+
+	info := &querypb.SegmentLoadInfo{
+		SegmentID:    segmentID,
+		PartitionID:  defaultPartitionID,
+		CollectionID: collection.ID(),
+		NumOfRows:    int64(numRows),
+	}
+	for _, blob := range binlogs {
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		assert.NoError(t, err)
+		key := path.Join("insert_log", strconv.FormatInt(segmentID, 10), blob.Key)
+		assert.NoError(t, cm.Write(key, blob.Value))
+		info.BinlogPaths = append(info.BinlogPaths, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []string{key}})
+	}
+
+	segment := newSegment(collection, segmentID, defaultPartitionID, collection.ID(), "", segmentTypeSealed, true)
+	segment.setLazyLoadInfo(info, nil)
+	return segment
+}
+
+func TestSegmentLoader_AcquireSegment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_segment_loader")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	const collectionID = UniqueID(0)
+	replica := newCollectionReplica(nil)
+	schema := genTestCollectionSchema(collectionID, false, 16)
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: rootcoord.RowIDField, Name: "RowID", DataType: schemapb.DataType_Int64},
+		&schemapb.FieldSchema{FieldID: rootcoord.TimeStampField, Name: "Timestamp", DataType: schemapb.DataType_Int64})
+	assert.NoError(t, replica.addCollection(collectionID, schema))
+	collection, err := replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+
+	remote := storage.NewLocalChunkManager(path.Join(dir, "remote"))
+	local := storage.NewLocalChunkManager(path.Join(dir, "local"))
+	loader := &segmentLoader{
+		ctx:                context.Background(),
+		historicalReplica:  replica,
+		remoteChunkManager: remote,
+		downloader:         storage.NewDownloadManager(remote),
+		indexLoader: &indexLoader{
+			ctx:               context.Background(),
+			replica:           replica,
+			fieldIndexes:      make(map[string][]*internalpb.IndexStats),
+			fieldStatsChan:    make(chan []*internalpb.FieldStats, 1),
+			rootCoord:         &noIndexRootCoord{},
+			downloader:        storage.NewDownloadManager(remote),
+			localChunkManager: local,
+		},
+		// no segment stays resident once it's unpinned
+		segmentLRU:        newSegmentLRU(0),
+		localChunkManager: local,
+	}
+
+	segment1 := genLazySegment(t, remote, collection, 1, 100)
+	defer deleteSegment(segment1)
+	segment2 := genLazySegment(t, remote, collection, 2, 200)
+	defer deleteSegment(segment2)
+
+	// the number of rows is reported before the field data is loaded
+	assert.Equal(t, int64(100), segment1.getRowCount())
+	assert.False(t, loader.segmentLRU.contains(1))
+
+	// acquired segments are pinned, so they're not evicted though the budget is exceeded
+	assert.NoError(t, loader.acquireSegment(segment1))
+	assert.NoError(t, loader.acquireSegment(segment2))
+	assert.True(t, loader.segmentLRU.contains(1))
+	assert.True(t, loader.segmentLRU.contains(2))
+	assert.Equal(t, int64(100), segment1.getRowCount())
+	assert.Equal(t, int64(200), segment2.getRowCount())
+	for _, fieldBinlog := range segment1.lazyLoadInfo.BinlogPaths {
+		assert.True(t, local.Exist(fieldBinlog.Binlogs[0]))
+	}
+
+	// a released segment is evicted, and still reports its number of rows
+	loader.releaseSegments([]UniqueID{1})
+	assert.False(t, loader.segmentLRU.contains(1))
+	assert.True(t, loader.segmentLRU.contains(2))
+	assert.Equal(t, int64(100), segment1.getRowCount())
+
+	// an evicted segment is loaded again from the local cache
+	assert.NoError(t, os.RemoveAll(path.Join(dir, "remote")))
+	assert.NoError(t, loader.acquireSegment(segment1))
+	assert.True(t, loader.segmentLRU.contains(1))
+	loader.releaseSegments([]UniqueID{1, 2})
+	assert.False(t, loader.segmentLRU.contains(1))
+	assert.False(t, loader.segmentLRU.contains(2))
+
+	// segments loaded in the normal way are ignored
+	segment3 := newSegment(collection, 3, defaultPartitionID, collectionID, "", segmentTypeSealed, true)
+	defer deleteSegment(segment3)
+	assert.NoError(t, loader.acquireSegment(segment3))
+	assert.False(t, loader.segmentLRU.contains(3))
+}
+
+func TestReadWithLocalCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_local_cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	remote := storage.NewLocalChunkManager(path.Join(dir, "remote"))
+	local := storage.NewLocalChunkManager(path.Join(dir, "local"))
+	assert.NoError(t, remote.Write("index/1", []byte("index1")))
+	assert.NoError(t, remote.Write("index/2", []byte("index2")))
+	downloader := storage.NewDownloadManager(remote)
+
+	files, err := readWithLocalCache(context.Background(), downloader, local, []string{"index/1", "index/2"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("index1"), []byte("index2")}, files)
+	assert.True(t, local.Exist("index/1"))
+	assert.True(t, local.Exist("index/2"))
+
+	// the cached files are read from the local disk
+	assert.NoError(t, os.RemoveAll(path.Join(dir, "remote")))
+	files, err = readWithLocalCache(context.Background(), downloader, local, []string{"index/2", "index/1"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("index2"), []byte("index1")}, files)
+
+	// nothing is cached without the local chunk manager
+	_, err = readWithLocalCache(context.Background(), downloader, nil, []string{"index/1"})
+	assert.Error(t, err)
+}
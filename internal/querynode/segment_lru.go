@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"container/list"
+	"sync"
+)
+
+type lruSegment struct {
+	segment *Segment
+	memSize int64
+	pinned  int
+	// chosen to evict, the field data stays resident until the segment is evicted
+	evicting bool
+}
+
+// segmentLRU tracks the sealed segments whose field data is resident in memory in tiered load mode,
+// the least recently used segments which are not pinned by a search or query are evicted once the
+// memory size of resident segments exceeds the budget.
+//
+// The segments chosen to evict are only marked as evicting, they're removed by evict, which is called
+// under the lazyMu of the segment together with resetting its field data. A segment pinned again
+// before that is kept resident.
+type segmentLRU struct {
+	mu      sync.Mutex
+	budget  int64
+	memSize int64
+	ll      *list.List
+	items   map[UniqueID]*list.Element
+}
+
+func newSegmentLRU(budget int64) *segmentLRU {
+	return &segmentLRU{
+		budget: budget,
+		ll:     list.New(),
+		items:  make(map[UniqueID]*list.Element),
+	}
+}
+
+// pin marks a resident segment as in use, false is returned if the segment isn't resident
+func (lru *segmentLRU) pin(segmentID UniqueID) bool {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	elem, ok := lru.items[segmentID]
+	if !ok {
+		return false
+	}
+	entry := elem.Value.(*lruSegment)
+	if entry.evicting {
+		entry.evicting = false
+		lru.memSize += entry.memSize
+	}
+	entry.pinned++
+	lru.ll.MoveToFront(elem)
+	return true
+}
+
+// unpin releases a segment pinned before, and returns the segments to evict
+func (lru *segmentLRU) unpin(segmentID UniqueID) []*Segment {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	if elem, ok := lru.items[segmentID]; ok {
+		entry := elem.Value.(*lruSegment)
+		if entry.pinned > 0 {
+			entry.pinned--
+		}
+	}
+	return lru.shrink()
+}
+
+// add records a newly loaded segment as resident and pinned, and returns the segments to evict
+func (lru *segmentLRU) add(segment *Segment, memSize int64) []*Segment {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	if elem, ok := lru.items[segment.ID()]; ok {
+		lru.removeElement(elem)
+	}
+	lru.items[segment.ID()] = lru.ll.PushFront(&lruSegment{
+		segment: segment,
+		memSize: memSize,
+		pinned:  1,
+	})
+	lru.memSize += memSize
+	return lru.shrink()
+}
+
+func (lru *segmentLRU) contains(segmentID UniqueID) bool {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	elem, ok := lru.items[segmentID]
+	return ok && !elem.Value.(*lruSegment).evicting
+}
+
+// evict removes a segment chosen to evict, false is returned if it has been pinned again since then
+func (lru *segmentLRU) evict(segmentID UniqueID) bool {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	elem, ok := lru.items[segmentID]
+	if !ok || !elem.Value.(*lruSegment).evicting {
+		return false
+	}
+	lru.removeElement(elem)
+	return true
+}
+
+func (lru *segmentLRU) getMemSize() int64 {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	return lru.memSize
+}
+
+func (lru *segmentLRU) shrink() []*Segment {
+	evicted := make([]*Segment, 0)
+	for elem := lru.ll.Back(); elem != nil && lru.memSize > lru.budget; {
+		prev := elem.Prev()
+		entry := elem.Value.(*lruSegment)
+		if entry.pinned == 0 && !entry.evicting {
+			entry.evicting = true
+			lru.memSize -= entry.memSize
+			evicted = append(evicted, entry.segment)
+		}
+		elem = prev
+	}
+	return evicted
+}
+
+func (lru *segmentLRU) removeElement(elem *list.Element) {
+	entry := elem.Value.(*lruSegment)
+	lru.ll.Remove(elem)
+	delete(lru.items, entry.segment.ID())
+	if !entry.evicting {
+		lru.memSize -= entry.memSize
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentLRU(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	defer deleteCollection(collection)
+
+	segments := make([]*Segment, 0)
+	for i := 0; i < 3; i++ {
+		segment := newSegment(collection, UniqueID(i), defaultPartitionID, collectionID, "", segmentTypeSealed, true)
+		defer deleteSegment(segment)
+		segments = append(segments, segment)
+	}
+
+	lru := newSegmentLRU(200)
+	assert.False(t, lru.pin(segments[0].ID()))
+
+	evicted := lru.add(segments[0], 100)
+	assert.Len(t, evicted, 0)
+	evicted = lru.add(segments[1], 100)
+	assert.Len(t, evicted, 0)
+	assert.Equal(t, int64(200), lru.getMemSize())
+
+	// pinned segments are never evicted
+	evicted = lru.add(segments[2], 100)
+	assert.Len(t, evicted, 0)
+	assert.Equal(t, int64(300), lru.getMemSize())
+
+	// segment is evicted once it is no longer pinned
+	assert.True(t, lru.pin(segments[0].ID()))
+	evicted = lru.unpin(segments[0].ID())
+	assert.Len(t, evicted, 0)
+	evicted = lru.unpin(segments[0].ID())
+	assert.Len(t, evicted, 1)
+	assert.Equal(t, segments[0].ID(), evicted[0].ID())
+	assert.False(t, lru.contains(segments[0].ID()))
+	assert.Equal(t, int64(200), lru.getMemSize())
+	assert.True(t, lru.evict(segments[0].ID()))
+	assert.False(t, lru.evict(segments[0].ID()))
+	assert.False(t, lru.pin(segments[0].ID()))
+	assert.Equal(t, int64(200), lru.getMemSize())
+
+	assert.True(t, lru.pin(segments[1].ID()))
+	assert.True(t, lru.contains(segments[2].ID()))
+
+	// a segment pinned again before it's evicted stays resident
+	lru = newSegmentLRU(0)
+	assert.Len(t, lru.add(segments[0], 100), 0)
+	evicted = lru.unpin(segments[0].ID())
+	assert.Len(t, evicted, 1)
+	assert.False(t, lru.contains(segments[0].ID()))
+	assert.Equal(t, int64(0), lru.getMemSize())
+	assert.True(t, lru.pin(segments[0].ID()))
+	assert.Equal(t, int64(100), lru.getMemSize())
+	assert.False(t, lru.evict(segments[0].ID()))
+	assert.True(t, lru.contains(segments[0].ID()))
+}
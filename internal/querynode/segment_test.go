@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 )
 
 //-------------------------------------------------------------------------------------- constructor and destructor
@@ -579,6 +580,43 @@ func TestSegment_segmentLoadFieldData(t *testing.T) {
 	deleteCollection(collection)
 }
 
+func TestSegment_segmentLoadDeletedRecord(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	assert.Equal(t, collection.ID(), collectionID)
+
+	segment := newSegment(collection, UniqueID(0), UniqueID(0), collectionID, "", segmentTypeSealed, true)
+
+	// the row ids are the primary keys of the collection with auto id
+	pks := []int64{1, 3}
+	tss := []Timestamp{5, 2}
+	err := segment.segmentLoadDeletedRecord(pks, tss[:1])
+	assert.Error(t, err)
+	err = segment.segmentLoadDeletedRecord(nil, nil)
+	assert.NoError(t, err)
+	// the primary keys and timestamps are not loaded yet
+	err = segment.segmentLoadDeletedRecord(pks, tss)
+	assert.Error(t, err)
+
+	const N = 4
+	err = segment.segmentLoadFieldData(rootcoord.RowIDField, N, []int64{0, 1, 2, 3})
+	assert.NoError(t, err)
+	err = segment.segmentLoadFieldData(rootcoord.TimeStampField, N, []int64{0, 1, 2, 3})
+	assert.NoError(t, err)
+	err = segment.segmentLoadDeletedRecord(pks, tss)
+	assert.NoError(t, err)
+
+	growing := newSegment(collection, UniqueID(1), UniqueID(0), collectionID, "", segmentTypeGrowing, true)
+	err = growing.segmentLoadDeletedRecord(pks, tss)
+	assert.Error(t, err)
+
+	deleteSegment(growing)
+	deleteSegment(segment)
+	deleteCollection(collection)
+}
+
 func TestSegment_ConcurrentOperation(t *testing.T) {
 	const N = 16
 	var ages = []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
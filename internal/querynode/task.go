@@ -70,6 +70,12 @@ type releasePartitionsTask struct {
 	node *QueryNode
 }
 
+type removeDmChannelsTask struct {
+	baseTask
+	req  *queryPb.RemoveDmChannelsRequest
+	node *QueryNode
+}
+
 func (b *baseTask) ID() UniqueID {
 	return b.id
 }
@@ -226,6 +232,14 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	for _, channel := range vChannels {
 		w.node.streaming.tSafeReplica.addTSafe(channel)
 	}
+	// the query collection is registered already when the channels of a resharded collection are watched
+	if w.node.queryService != nil {
+		if qc, ok := w.node.queryService.getQueryCollection(collectionID); ok {
+			for _, channel := range vChannels {
+				qc.addTSafeWatcher(channel)
+			}
+		}
+	}
 
 	// add flow graph
 	if loadPartition {
@@ -592,3 +606,86 @@ func (r *releasePartitionsTask) Execute(ctx context.Context) error {
 func (r *releasePartitionsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+// removeDmChannelsTask
+func (r *removeDmChannelsTask) Timestamp() Timestamp {
+	if r.req.Base == nil {
+		log.Warn("nil base req in removeDmChannelsTask", zap.Any("collectionID", r.req.CollectionID))
+		return 0
+	}
+	return r.req.Base.Timestamp
+}
+
+func (r *removeDmChannelsTask) OnEnqueue() error {
+	if r.req == nil || r.req.Base == nil {
+		r.SetID(rand.Int63n(100000000000))
+	} else {
+		r.SetID(r.req.Base.MsgID)
+	}
+	return nil
+}
+
+func (r *removeDmChannelsTask) PreExecute(ctx context.Context) error {
+	return nil
+}
+
+// Execute stops consuming the dm channels and releases the growing segments on them, the other channels
+// of the collection are kept. The segments on the channels are expected to be loaded as sealed segments already.
+func (r *removeDmChannelsTask) Execute(ctx context.Context) error {
+	collectionID := r.req.CollectionID
+	log.Debug("receive remove dm channels task",
+		zap.Any("collectionID", collectionID),
+		zap.Any("channels", r.req.Channels))
+	sCol, err := r.node.streaming.replica.getCollectionByID(collectionID)
+	if err != nil {
+		log.Warn(err.Error())
+		return err
+	}
+	hCol, err := r.node.historical.replica.getCollectionByID(collectionID)
+	if err != nil {
+		log.Warn(err.Error())
+		return err
+	}
+
+	r.node.streaming.dataSyncService.removeChannelFlowGraphs(r.req.Channels)
+	// stop watching the tSafes before removing them, a closed tSafe means the collection is released
+	if r.node.queryService != nil {
+		if qc, ok := r.node.queryService.getQueryCollection(collectionID); ok {
+			for _, channel := range r.req.Channels {
+				qc.removeTSafeWatcher(channel)
+			}
+		}
+	}
+	for _, channel := range r.req.Channels {
+		r.node.streaming.tSafeReplica.removeTSafe(channel)
+	}
+
+	partitionIDs, err := r.node.streaming.replica.getPartitionIDs(collectionID)
+	if err != nil {
+		return err
+	}
+	for _, partitionID := range partitionIDs {
+		for _, channel := range r.req.Channels {
+			segmentIDs, err := r.node.streaming.replica.getSegmentIDsByVChannel(partitionID, channel)
+			if err != nil {
+				return err
+			}
+			for _, segmentID := range segmentIDs {
+				if err := r.node.streaming.replica.removeSegment(segmentID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	sCol.removeVChannels(r.req.Channels)
+	hCol.removeVChannels(r.req.Channels)
+
+	log.Debug("RemoveDmChannels done",
+		zap.Any("collectionID", collectionID),
+		zap.Any("channels", r.req.Channels))
+	return nil
+}
+
+func (r *removeDmChannelsTask) PostExecute(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// DDLAuditPrefix is where the audit records of DDLs are saved, the records are never modified or removed
+const DDLAuditPrefix = ComponentPrefix + "/ddl-audit"
+
+// ddlAuditLog is an append-only log of the DDLs executed by root coordinator,
+// the records are saved as ddl-audit/{database}/{collection}/{timestamp}
+type ddlAuditLog struct {
+	client kv.BaseKV
+}
+
+func newDDLAuditLog(client kv.BaseKV) *ddlAuditLog {
+	return &ddlAuditLog{client: client}
+}
+
+func ddlAuditDatabase(dbName string) string {
+	if dbName == "" {
+		return Params.DefaultDatabaseName
+	}
+	return dbName
+}
+
+func ddlAuditKey(dbName, collName string, ts typeutil.Timestamp) string {
+	return fmt.Sprintf("%s/%s/%s/%d", DDLAuditPrefix, ddlAuditDatabase(dbName), collName, ts)
+}
+
+// Append saves the audit record of a DDL
+func (l *ddlAuditLog) Append(record *milvuspb.DDLHistoryRecord) error {
+	key := ddlAuditKey(record.DbName, record.CollectionName, record.Timestamp)
+	return l.client.Save(key, proto.MarshalTextString(record))
+}
+
+// List returns the audit records of the collection executed at or after sinceTs ordered by timestamp,
+// all the collections of the database are included if collName is empty
+func (l *ddlAuditLog) List(dbName, collName string, sinceTs typeutil.Timestamp) ([]*milvuspb.DDLHistoryRecord, error) {
+	prefix := fmt.Sprintf("%s/%s/", DDLAuditPrefix, ddlAuditDatabase(dbName))
+	if collName != "" {
+		prefix += collName + "/"
+	}
+	_, values, err := l.client.LoadWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*milvuspb.DDLHistoryRecord, 0, len(values))
+	for _, value := range values {
+		record := &milvuspb.DDLHistoryRecord{}
+		if err := proto.UnmarshalText(value, record); err != nil {
+			return nil, fmt.Errorf("RootCoord UnmarshalText milvuspb.DDLHistoryRecord err:%w", err)
+		}
+		// the prefix of a collection also matches the collections whose names start with it
+		if collName != "" && record.CollectionName != collName {
+			continue
+		}
+		if record.Timestamp < sinceTs {
+			continue
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Timestamp < records[j].Timestamp })
+	return records, nil
+}
+
+// recordDDL appends the audit record of a DDL which has been executed, the failure is only logged
+// because the DDL has taken effect already
+func (c *Core) recordDDL(ctx context.Context, ddType string, ts typeutil.Timestamp, base *commonpb.MsgBase, dbName, collName string, req proto.Message) {
+	record := &milvuspb.DDLHistoryRecord{
+		Timestamp:      ts,
+		DdlType:        ddType,
+		ProxyID:        base.GetSourceID(),
+		Caller:         funcutil.GetCallerFromIncomingContext(ctx),
+		DbName:         ddlAuditDatabase(dbName),
+		CollectionName: collName,
+		Request:        proto.CompactTextString(req),
+	}
+	if err := c.ddlAudit.Append(record); err != nil {
+		log.Warn("append ddl audit record failed", zap.String("ddl type", ddType), zap.String("collection name", collName),
+			zap.Uint64("ts", ts), zap.Error(err))
+	}
+}
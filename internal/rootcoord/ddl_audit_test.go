@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDDLAuditLog(t *testing.T) {
+	Params.Init()
+	c := &Core{ddlAudit: newDDLAuditLog(memkv.NewMemoryKV())}
+
+	ctx := context.Background()
+	md, _ := metadata.FromOutgoingContext(funcutil.AppendCallerToOutgoingContext(ctx, "alice"))
+	ctx = metadata.NewIncomingContext(ctx, md)
+	base := &commonpb.MsgBase{SourceID: 100}
+
+	c.recordDDL(ctx, CreateCollectionDDType, 30, base, "", "coll", &milvuspb.CreateCollectionRequest{CollectionName: "coll"})
+	c.recordDDL(ctx, CreateIndexDDType, 20, base, "", "coll", &milvuspb.CreateIndexRequest{CollectionName: "coll"})
+	c.recordDDL(ctx, CreateCollectionDDType, 10, base, "", "coll2", &milvuspb.CreateCollectionRequest{CollectionName: "coll2"})
+	c.recordDDL(ctx, CreateCollectionDDType, 40, base, "db1", "coll", &milvuspb.CreateCollectionRequest{DbName: "db1", CollectionName: "coll"})
+
+	t.Run("collection", func(t *testing.T) {
+		records, err := c.ddlAudit.List(Params.DefaultDatabaseName, "coll", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(records))
+		assert.Equal(t, uint64(20), records[0].Timestamp)
+		assert.Equal(t, CreateIndexDDType, records[0].DdlType)
+		assert.Equal(t, uint64(30), records[1].Timestamp)
+		assert.Equal(t, "alice", records[1].Caller)
+		assert.Equal(t, int64(100), records[1].ProxyID)
+		assert.Equal(t, Params.DefaultDatabaseName, records[1].DbName)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		records, err := c.ddlAudit.List("", "", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(records))
+		assert.Equal(t, "coll2", records[0].CollectionName)
+
+		records, err = c.ddlAudit.List("db1", "", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(records))
+		assert.Equal(t, uint64(40), records[0].Timestamp)
+	})
+
+	t.Run("since ts", func(t *testing.T) {
+		records, err := c.ddlAudit.List("", "", 20)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(records))
+
+		records, err = c.ddlAudit.List("", "coll", 31)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(records))
+	})
+}
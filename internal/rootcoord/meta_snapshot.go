@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
 	"go.uber.org/zap"
 )
 
@@ -44,6 +45,10 @@ type metaSnapshot struct {
 	minPos int
 	maxPos int
 	numTs  int
+
+	// the revisions before compactedRev have been compacted, the snapshots older than compactedTs can't be read
+	compactedRev int64
+	compactedTs  typeutil.Timestamp
 }
 
 func newMetaSnapshot(cli *clientv3.Client, root, tsKey string, bufSize int) (*metaSnapshot, error) {
@@ -94,6 +99,9 @@ func (ms *metaSnapshot) loadTs() error {
 		}
 		revision--
 		resp, err = ms.cli.Get(ctx, key, clientv3.WithRev(revision))
+		if err == rpctypes.ErrCompacted {
+			return nil
+		}
 		if err != nil {
 			return err
 		}
@@ -249,6 +257,9 @@ func (ms *metaSnapshot) getRevOnEtcd(ts typeutil.Timestamp, rev int64) int64 {
 }
 
 func (ms *metaSnapshot) getRev(ts typeutil.Timestamp) (int64, error) {
+	if ts < ms.compactedTs {
+		return 0, fmt.Errorf("the snapshot on ts=%d has been compacted, the earliest readable ts=%d", ts, ms.compactedTs)
+	}
 	rev := ms.getRevOnCache(ts)
 	if rev > 0 {
 		return rev, nil
@@ -406,3 +417,40 @@ func (ms *metaSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string, re
 	ms.putTs(resp.Header.Revision, ts)
 	return nil
 }
+
+// Compact drops the revisions older than the snapshot on ts from etcd, the snapshot on ts is kept.
+// Note that etcd compacts the whole key space, the history of the keys outside the root is dropped as well
+func (ms *metaSnapshot) Compact(ts typeutil.Timestamp) error {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	if ts <= ms.compactedTs {
+		return nil
+	}
+	rev, err := ms.getRev(ts)
+	if err != nil {
+		// nothing is older than ts
+		log.Debug("no meta snapshot to compact", zap.Uint64("ts", ts), zap.Error(err))
+		return nil
+	}
+	if rev > ms.compactedRev {
+		ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+		defer cancel()
+		if _, err = ms.cli.Compact(ctx, rev); err != nil && err != rpctypes.ErrCompacted {
+			return err
+		}
+		ms.compactedRev = rev
+	}
+	ms.compactedTs = ts
+
+	// drop the cached revisions which are compacted
+	for ms.numTs > 1 && ms.ts2Rev[ms.minPos].rev < rev {
+		ms.minPos++
+		if ms.minPos == len(ms.ts2Rev) {
+			ms.minPos = 0
+		}
+		ms.numTs--
+	}
+	log.Debug("compact meta snapshot", zap.Int64("rev", rev), zap.Uint64("ts", ts))
+	return nil
+}
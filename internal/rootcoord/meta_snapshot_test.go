@@ -256,6 +256,53 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestCompact(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+	tsKey := "timestamp"
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	ms, err := newMetaSnapshot(etcdCli, rootPath, tsKey, 7)
+	assert.Nil(t, err)
+	assert.NotNil(t, ms)
+
+	for i := 0; i < 20; i++ {
+		err = ms.Save("key", fmt.Sprintf("value-%d", i), typeutil.Timestamp(100+i*5))
+		assert.Nil(t, err)
+	}
+
+	err = ms.Compact(152)
+	assert.Nil(t, err)
+	_, err = ms.Load("key", 151)
+	assert.NotNil(t, err)
+	for i := 11; i < 20; i++ {
+		val, err := ms.Load("key", typeutil.Timestamp(100+i*5+2))
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), val)
+	}
+	val, err := ms.Load("key", 152)
+	assert.Nil(t, err)
+	assert.Equal(t, "value-10", val)
+
+	// compact the same ts again
+	err = ms.Compact(152)
+	assert.Nil(t, err)
+
+	// the history before the compacted revision is skipped on reload
+	ms, err = newMetaSnapshot(etcdCli, rootPath, tsKey, 30)
+	assert.Nil(t, err)
+	assert.NotNil(t, ms)
+	val, err = ms.Load("key", 192)
+	assert.Nil(t, err)
+	assert.Equal(t, "value-18", val)
+}
+
 func TestMultiSave(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	randVal := rand.Int()
@@ -14,6 +14,7 @@ package rootcoord
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -24,7 +25,10 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -32,34 +36,75 @@ const (
 	ComponentPrefix        = "root-coord"
 	TenantMetaPrefix       = ComponentPrefix + "/tenant"
 	ProxyMetaPrefix        = ComponentPrefix + "/proxy"
-	CollectionMetaPrefix   = ComponentPrefix + "/collection"
+	DatabaseMetaPrefix     = ComponentPrefix + "/database"
+	DBInfoMetaPrefix       = DatabaseMetaPrefix + "/db-info"
+	CollectionMetaPrefix   = DatabaseMetaPrefix + "/collection-info"
 	SegmentIndexMetaPrefix = ComponentPrefix + "/segment-index"
 	IndexMetaPrefix        = ComponentPrefix + "/index"
 
+	// DroppedCollectionMetaPrefix is the recycle bin, where soft dropped collections are kept until they are purged
+	DroppedCollectionMetaPrefix = DatabaseMetaPrefix + "/dropped-collection-info"
+
+	// LegacyCollectionMetaPrefix is where collection metas were saved before databases were introduced,
+	// these collections are moved under the default database by initDefaultDatabase
+	LegacyCollectionMetaPrefix = ComponentPrefix + "/collection"
+
 	TimestampPrefix = ComponentPrefix + "/timestamp"
 
+	// credentials and access control metas are saved without snapshots
+	CredentialPrefix         = ComponentPrefix + "/credential"
+	CredentialUserPrefix     = CredentialPrefix + "/users"
+	CredentialRolePrefix     = CredentialPrefix + "/roles"
+	CredentialUserRolePrefix = CredentialPrefix + "/user-role"
+	CredentialGrantPrefix    = CredentialPrefix + "/grants"
+
 	DDOperationPrefix = ComponentPrefix + "/dd-operation"
 	DDMsgSendPrefix   = ComponentPrefix + "/dd-msg-send"
 
-	CreateCollectionDDType = "CreateCollection"
-	DropCollectionDDType   = "DropCollection"
-	CreatePartitionDDType  = "CreatePartition"
-	DropPartitionDDType    = "DropPartition"
+	CreateCollectionDDType  = "CreateCollection"
+	DropCollectionDDType    = "DropCollection"
+	CreatePartitionDDType   = "CreatePartition"
+	DropPartitionDDType     = "DropPartition"
+	AddFieldDDType          = "AddField"
+	RenameCollectionDDType  = "RenameCollection"
+	CreateIndexDDType       = "CreateIndex"
+	DropIndexDDType         = "DropIndex"
+	AlterCollectionDDType   = "AlterCollection"
+	RecoverCollectionDDType = "RecoverCollection"
+	ReshardCollectionDDType = "ReshardCollection"
+
+	// DefaultDatabaseID is the id of the default database, which can't be dropped
+	DefaultDatabaseID = typeutil.UniqueID(1)
+
+	// RootUserName is the name of the super user, which has all privileges and can't be deleted
+	RootUserName = "root"
 )
 
 type metaTable struct {
 	client          kv.SnapShotKV                                                   // client of a reliable kv service, i.e. etcd client
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
+	dbID2Meta       map[typeutil.UniqueID]pb.DatabaseInfo                           // database id -> meta
+	dbName2ID       map[string]typeutil.UniqueID                                    // database name to database id
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
+	collName2ID     map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection name -> collection id
+	droppedColl     map[typeutil.UniqueID]pb.CollectionInfo                         // soft dropped collection_id -> meta
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
 
+	legacyCollIDs []typeutil.UniqueID // collections loaded from LegacyCollectionMetaPrefix
+
+	credClient    kv.TxnKV                        // client of the kv where credentials and access control metas are saved
+	userName2Cred map[string]pb.CredentialInfo    // user name -> credential
+	roleNames     map[string]struct{}             // role names
+	user2Roles    map[string]map[string]struct{}  // user name -> role names
+	grants        map[string]milvuspb.GrantEntity // grant key -> grant
+
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 func NewMetaTable(kv kv.SnapShotKV) (*metaTable, error) {
@@ -80,8 +125,12 @@ func (mt *metaTable) reloadFromKV() error {
 
 	mt.tenantID2Meta = make(map[typeutil.UniqueID]pb.TenantMeta)
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
+	mt.dbID2Meta = make(map[typeutil.UniqueID]pb.DatabaseInfo)
+	mt.dbName2ID = make(map[string]typeutil.UniqueID)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
+	mt.collName2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
+	mt.droppedColl = make(map[typeutil.UniqueID]pb.CollectionInfo)
+	mt.legacyCollIDs = make([]typeutil.UniqueID, 0)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
@@ -114,6 +163,27 @@ func (mt *metaTable) reloadFromKV() error {
 		mt.proxyID2Meta[proxyMeta.ID] = proxyMeta
 	}
 
+	_, values, err = mt.client.LoadWithPrefix(DBInfoMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		dbInfo := pb.DatabaseInfo{}
+		err = proto.UnmarshalText(value, &dbInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.DatabaseInfo err:%w", err)
+		}
+		mt.unlockAddDatabase(dbInfo)
+	}
+	if _, ok := mt.dbID2Meta[DefaultDatabaseID]; !ok {
+		// the default database is saved into etcd by initDefaultDatabase
+		mt.unlockAddDatabase(pb.DatabaseInfo{
+			ID:   DefaultDatabaseID,
+			Name: Params.DefaultDatabaseName,
+		})
+	}
+
 	_, values, err = mt.client.LoadWithPrefix(CollectionMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -125,8 +195,37 @@ func (mt *metaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("RootCoord UnmarshalText pb.CollectionInfo err:%w", err)
 		}
-		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		mt.unlockAddCollection(collInfo)
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(LegacyCollectionMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		collInfo := pb.CollectionInfo{}
+		err = proto.UnmarshalText(value, &collInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.CollectionInfo err:%w", err)
+		}
+		collInfo.DbID = DefaultDatabaseID
+		mt.unlockAddCollection(collInfo)
+		mt.legacyCollIDs = append(mt.legacyCollIDs, collInfo.ID)
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(DroppedCollectionMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		collInfo := pb.CollectionInfo{}
+		err = proto.UnmarshalText(value, &collInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.CollectionInfo err:%w", err)
+		}
+		mt.droppedColl[collInfo.ID] = collInfo
 	}
 
 	_, values, err = mt.client.LoadWithPrefix(SegmentIndexMetaPrefix, 0)
@@ -191,6 +290,539 @@ func (mt *metaTable) getAdditionKV(op func(ts typeutil.Timestamp) (string, error
 	}
 }
 
+func (mt *metaTable) unlockAddDatabase(db pb.DatabaseInfo) {
+	mt.dbID2Meta[db.ID] = db
+	mt.dbName2ID[db.Name] = db.ID
+	if _, ok := mt.collName2ID[db.ID]; !ok {
+		mt.collName2ID[db.ID] = make(map[string]typeutil.UniqueID)
+	}
+}
+
+func (mt *metaTable) unlockAddCollection(coll pb.CollectionInfo) {
+	mt.collID2Meta[coll.ID] = coll
+	if _, ok := mt.collName2ID[coll.DbID]; !ok {
+		mt.collName2ID[coll.DbID] = make(map[string]typeutil.UniqueID)
+	}
+	mt.collName2ID[coll.DbID][coll.Schema.Name] = coll.ID
+}
+
+func hasPartitionKey(coll *pb.CollectionInfo) bool {
+	for _, field := range coll.Schema.GetFields() {
+		if field.IsPartitionKey {
+			return true
+		}
+	}
+	return false
+}
+
+// unlockGetDatabaseID returns the id of a database, empty name refers to the default database
+func (mt *metaTable) unlockGetDatabaseID(dbName string) (typeutil.UniqueID, error) {
+	if dbName == "" {
+		return DefaultDatabaseID, nil
+	}
+	dbID, ok := mt.dbName2ID[dbName]
+	if !ok {
+		return 0, fmt.Errorf("can't find database: %s", dbName)
+	}
+	return dbID, nil
+}
+
+func (mt *metaTable) unlockGetCollectionID(dbName, collName string) (typeutil.UniqueID, bool) {
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return 0, false
+	}
+	collID, ok := mt.collName2ID[dbID][collName]
+	return collID, ok
+}
+
+func collectionMetaKey(dbID, collID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d", CollectionMetaPrefix, dbID, collID)
+}
+
+func droppedCollectionMetaKey(dbID, collID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d", DroppedCollectionMetaPrefix, dbID, collID)
+}
+
+// loadCollections loads the collection metas at ts, including the ones saved before databases were introduced
+func (mt *metaTable) loadCollections(ts typeutil.Timestamp) ([]*pb.CollectionInfo, error) {
+	colls := make([]*pb.CollectionInfo, 0)
+	for _, prefix := range []string{CollectionMetaPrefix, LegacyCollectionMetaPrefix} {
+		_, vals, err := mt.client.LoadWithPrefix(prefix, ts)
+		if err != nil {
+			return nil, err
+		}
+		for _, val := range vals {
+			collMeta := pb.CollectionInfo{}
+			err = proto.UnmarshalText(val, &collMeta)
+			if err != nil {
+				log.Debug("unmarshal collection info failed", zap.Error(err))
+				continue
+			}
+			if collMeta.DbID == 0 {
+				collMeta.DbID = DefaultDatabaseID
+			}
+			colls = append(colls, &collMeta)
+		}
+	}
+	return colls, nil
+}
+
+// loadCollectionByID loads the collection meta at ts
+func (mt *metaTable) loadCollectionByID(collID typeutil.UniqueID, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+	if coll, ok := mt.collID2Meta[collID]; ok {
+		val, err := mt.client.Load(collectionMetaKey(coll.DbID, collID), ts)
+		if err == nil {
+			collMeta := pb.CollectionInfo{}
+			err = proto.UnmarshalText(val, &collMeta)
+			if err != nil {
+				return nil, err
+			}
+			return &collMeta, nil
+		}
+	}
+	colls, err := mt.loadCollections(ts)
+	if err != nil {
+		return nil, err
+	}
+	for _, collMeta := range colls {
+		if collMeta.ID == collID {
+			return collMeta, nil
+		}
+	}
+	return nil, fmt.Errorf("can't find collection id : %d, at timestamp = %d", collID, ts)
+}
+
+// initDefaultDatabase saves the default database into etcd on the first start,
+// and moves the collections saved before databases were introduced under it
+func (mt *metaTable) initDefaultDatabase(ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	saves := make(map[string]string)
+	removals := make([]string, 0, len(mt.legacyCollIDs))
+
+	db := mt.dbID2Meta[DefaultDatabaseID]
+	if db.CreateTime == 0 {
+		db.CreateTime = ts
+		saves[fmt.Sprintf("%s/%d", DBInfoMetaPrefix, db.ID)] = proto.MarshalTextString(&db)
+	}
+	for _, collID := range mt.legacyCollIDs {
+		coll, ok := mt.collID2Meta[collID]
+		if !ok {
+			continue
+		}
+		saves[collectionMetaKey(coll.DbID, collID)] = proto.MarshalTextString(&coll)
+		removals = append(removals, fmt.Sprintf("%s/%d", LegacyCollectionMetaPrefix, collID))
+	}
+	if len(saves) == 0 {
+		return nil
+	}
+
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saves, removals, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		return err
+	}
+	if len(mt.legacyCollIDs) > 0 {
+		log.Debug("migrate collections into default database", zap.Int64s("collection ids", mt.legacyCollIDs))
+	}
+	mt.dbID2Meta[DefaultDatabaseID] = db
+	mt.legacyCollIDs = mt.legacyCollIDs[:0]
+	return nil
+}
+
+func (mt *metaTable) AddDatabase(db *pb.DatabaseInfo, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.dbName2ID[db.Name]; ok {
+		return fmt.Errorf("database %s exist", db.Name)
+	}
+	db.CreateTime = ts
+	k := fmt.Sprintf("%s/%d", DBInfoMetaPrefix, db.ID)
+	v := proto.MarshalTextString(db)
+
+	err := mt.client.Save(k, v, ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.unlockAddDatabase(*db)
+	return nil
+}
+
+func (mt *metaTable) DeleteDatabase(dbName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if dbID == DefaultDatabaseID {
+		return fmt.Errorf("default database cannot be deleted")
+	}
+	if len(mt.collName2ID[dbID]) > 0 {
+		return fmt.Errorf("database %s is not empty, drop its collections first", dbName)
+	}
+
+	delMetaKeys := []string{fmt.Sprintf("%s/%d", DBInfoMetaPrefix, dbID)}
+	err = mt.client.MultiSaveAndRemoveWithPrefix(map[string]string{}, delMetaKeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	delete(mt.dbID2Meta, dbID)
+	delete(mt.dbName2ID, dbName)
+	delete(mt.collName2ID, dbID)
+	return nil
+}
+
+func (mt *metaTable) GetDatabaseByName(dbName string) (*pb.DatabaseInfo, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
+	db, ok := mt.dbID2Meta[dbID]
+	if !ok {
+		return nil, fmt.Errorf("can't find database: %s", dbName)
+	}
+	return proto.Clone(&db).(*pb.DatabaseInfo), nil
+}
+
+func (mt *metaTable) ListDatabases() []*pb.DatabaseInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbs := make([]*pb.DatabaseInfo, 0, len(mt.dbID2Meta))
+	for _, db := range mt.dbID2Meta {
+		dbCopy := proto.Clone(&db)
+		dbs = append(dbs, dbCopy.(*pb.DatabaseInfo))
+	}
+	return dbs
+}
+
+func credentialUserKey(username string) string {
+	return fmt.Sprintf("%s/%s", CredentialUserPrefix, username)
+}
+
+func credentialRoleKey(roleName string) string {
+	return fmt.Sprintf("%s/%s", CredentialRolePrefix, roleName)
+}
+
+func credentialUserRoleKey(username, roleName string) string {
+	return fmt.Sprintf("%s/%s/%s", CredentialUserRolePrefix, username, roleName)
+}
+
+func credentialGrantKey(entity *milvuspb.GrantEntity) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", CredentialGrantPrefix, entity.RoleName, entity.ObjectType.String(),
+		entity.DbName, entity.ObjectName, entity.Privilege.String())
+}
+
+// initCredential loads users, roles and grants from the credential kv, the root user is created with
+// the default password if it doesn't exist.
+func (mt *metaTable) initCredential(credClient kv.TxnKV) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	mt.credClient = credClient
+	mt.userName2Cred = make(map[string]pb.CredentialInfo)
+	mt.roleNames = make(map[string]struct{})
+	mt.user2Roles = make(map[string]map[string]struct{})
+	mt.grants = make(map[string]milvuspb.GrantEntity)
+
+	_, values, err := credClient.LoadWithPrefix(CredentialUserPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		cred := pb.CredentialInfo{}
+		if err = proto.UnmarshalText(value, &cred); err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.CredentialInfo err:%w", err)
+		}
+		mt.userName2Cred[cred.Username] = cred
+	}
+
+	_, values, err = credClient.LoadWithPrefix(CredentialRolePrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		mt.roleNames[value] = struct{}{}
+	}
+
+	_, values, err = credClient.LoadWithPrefix(CredentialUserRolePrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		userRole := rootcoordpb.UserRole{}
+		if err = proto.UnmarshalText(value, &userRole); err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText rootcoordpb.UserRole err:%w", err)
+		}
+		if _, ok := mt.user2Roles[userRole.Username]; !ok {
+			mt.user2Roles[userRole.Username] = make(map[string]struct{})
+		}
+		mt.user2Roles[userRole.Username][userRole.RoleName] = struct{}{}
+	}
+
+	_, values, err = credClient.LoadWithPrefix(CredentialGrantPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		grant := milvuspb.GrantEntity{}
+		if err = proto.UnmarshalText(value, &grant); err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText milvuspb.GrantEntity err:%w", err)
+		}
+		mt.grants[credentialGrantKey(&grant)] = grant
+	}
+
+	if _, ok := mt.userName2Cred[RootUserName]; !ok {
+		encrypted, err := crypto.PasswordEncrypt(Params.DefaultRootPassword)
+		if err != nil {
+			return err
+		}
+		cred := pb.CredentialInfo{Username: RootUserName, EncryptedPassword: encrypted}
+		if err = credClient.Save(credentialUserKey(RootUserName), proto.MarshalTextString(&cred)); err != nil {
+			return err
+		}
+		mt.userName2Cred[RootUserName] = cred
+	}
+	return nil
+}
+
+func (mt *metaTable) AddCredential(cred *pb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Cred[cred.Username]; ok {
+		return fmt.Errorf("user %s already exists", cred.Username)
+	}
+	if err := mt.credClient.Save(credentialUserKey(cred.Username), proto.MarshalTextString(cred)); err != nil {
+		return err
+	}
+	mt.userName2Cred[cred.Username] = *cred
+	return nil
+}
+
+func (mt *metaTable) AlterCredential(cred *pb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Cred[cred.Username]; !ok {
+		return fmt.Errorf("user %s doesn't exist", cred.Username)
+	}
+	if err := mt.credClient.Save(credentialUserKey(cred.Username), proto.MarshalTextString(cred)); err != nil {
+		return err
+	}
+	mt.userName2Cred[cred.Username] = *cred
+	return nil
+}
+
+// DeleteCredential deletes a user and the roles bound to it
+func (mt *metaTable) DeleteCredential(username string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if username == RootUserName {
+		return fmt.Errorf("user %s can't be deleted", RootUserName)
+	}
+	if _, ok := mt.userName2Cred[username]; !ok {
+		return fmt.Errorf("user %s doesn't exist", username)
+	}
+	removals := []string{credentialUserKey(username)}
+	for roleName := range mt.user2Roles[username] {
+		removals = append(removals, credentialUserRoleKey(username, roleName))
+	}
+	if err := mt.credClient.MultiRemove(removals); err != nil {
+		return err
+	}
+	delete(mt.userName2Cred, username)
+	delete(mt.user2Roles, username)
+	return nil
+}
+
+func (mt *metaTable) GetCredential(username string) (*pb.CredentialInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	cred, ok := mt.userName2Cred[username]
+	if !ok {
+		return nil, fmt.Errorf("user %s doesn't exist", username)
+	}
+	return proto.Clone(&cred).(*pb.CredentialInfo), nil
+}
+
+func (mt *metaTable) ListCredentialUsers() []string {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	usernames := make([]string, 0, len(mt.userName2Cred))
+	for username := range mt.userName2Cred {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+func (mt *metaTable) CreateRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.roleNames[roleName]; ok {
+		return fmt.Errorf("role %s already exists", roleName)
+	}
+	if err := mt.credClient.Save(credentialRoleKey(roleName), roleName); err != nil {
+		return err
+	}
+	mt.roleNames[roleName] = struct{}{}
+	return nil
+}
+
+// DropRole deletes a role, together with its grants and the bindings of users to it
+func (mt *metaTable) DropRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.roleNames[roleName]; !ok {
+		return fmt.Errorf("role %s doesn't exist", roleName)
+	}
+	removals := []string{credentialRoleKey(roleName)}
+	for username, roles := range mt.user2Roles {
+		if _, ok := roles[roleName]; ok {
+			removals = append(removals, credentialUserRoleKey(username, roleName))
+		}
+	}
+	for key, grant := range mt.grants {
+		if grant.RoleName == roleName {
+			removals = append(removals, key)
+		}
+	}
+	if err := mt.credClient.MultiRemove(removals); err != nil {
+		return err
+	}
+	delete(mt.roleNames, roleName)
+	for _, roles := range mt.user2Roles {
+		delete(roles, roleName)
+	}
+	for key, grant := range mt.grants {
+		if grant.RoleName == roleName {
+			delete(mt.grants, key)
+		}
+	}
+	return nil
+}
+
+func (mt *metaTable) OperateUserRole(username, roleName string, opType milvuspb.OperateUserRoleType) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Cred[username]; !ok {
+		return fmt.Errorf("user %s doesn't exist", username)
+	}
+	if _, ok := mt.roleNames[roleName]; !ok {
+		return fmt.Errorf("role %s doesn't exist", roleName)
+	}
+	_, bound := mt.user2Roles[username][roleName]
+	key := credentialUserRoleKey(username, roleName)
+	switch opType {
+	case milvuspb.OperateUserRoleType_AddUserToRole:
+		if bound {
+			return nil
+		}
+		userRole := &rootcoordpb.UserRole{Username: username, RoleName: roleName}
+		if err := mt.credClient.Save(key, proto.MarshalTextString(userRole)); err != nil {
+			return err
+		}
+		if _, ok := mt.user2Roles[username]; !ok {
+			mt.user2Roles[username] = make(map[string]struct{})
+		}
+		mt.user2Roles[username][roleName] = struct{}{}
+	case milvuspb.OperateUserRoleType_RemoveUserFromRole:
+		if !bound {
+			return nil
+		}
+		if err := mt.credClient.Remove(key); err != nil {
+			return err
+		}
+		delete(mt.user2Roles[username], roleName)
+	default:
+		return fmt.Errorf("invalid operate user role type %s", opType.String())
+	}
+	return nil
+}
+
+func (mt *metaTable) OperatePrivilege(entity *milvuspb.GrantEntity, opType milvuspb.OperatePrivilegeType) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.roleNames[entity.RoleName]; !ok {
+		return fmt.Errorf("role %s doesn't exist", entity.RoleName)
+	}
+	key := credentialGrantKey(entity)
+	_, granted := mt.grants[key]
+	switch opType {
+	case milvuspb.OperatePrivilegeType_Grant:
+		if granted {
+			return nil
+		}
+		if err := mt.credClient.Save(key, proto.MarshalTextString(entity)); err != nil {
+			return err
+		}
+		mt.grants[key] = *entity
+	case milvuspb.OperatePrivilegeType_Revoke:
+		if !granted {
+			return nil
+		}
+		if err := mt.credClient.Remove(key); err != nil {
+			return err
+		}
+		delete(mt.grants, key)
+	default:
+		return fmt.Errorf("invalid operate privilege type %s", opType.String())
+	}
+	return nil
+}
+
+func (mt *metaTable) SelectGrant(roleName string) ([]*milvuspb.GrantEntity, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	if _, ok := mt.roleNames[roleName]; !ok {
+		return nil, fmt.Errorf("role %s doesn't exist", roleName)
+	}
+	entities := make([]*milvuspb.GrantEntity, 0)
+	for _, grant := range mt.grants {
+		if grant.RoleName == roleName {
+			entities = append(entities, proto.Clone(&grant).(*milvuspb.GrantEntity))
+		}
+	}
+	return entities, nil
+}
+
+// ListPolicy returns the roles of all users and the grants of all roles
+func (mt *metaTable) ListPolicy() ([]*rootcoordpb.UserRole, []*milvuspb.GrantEntity) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	userRoles := make([]*rootcoordpb.UserRole, 0)
+	for username, roles := range mt.user2Roles {
+		for roleName := range roles {
+			userRoles = append(userRoles, &rootcoordpb.UserRole{Username: username, RoleName: roleName})
+		}
+	}
+	grants := make([]*milvuspb.GrantEntity, 0, len(mt.grants))
+	for _, grant := range mt.grants {
+		grants = append(grants, proto.Clone(&grant).(*milvuspb.GrantEntity))
+	}
+	return userRoles, grants
+}
+
 func (mt *metaTable) AddTenant(te *pb.TenantMeta, ts typeutil.Timestamp) error {
 	mt.tenantLock.Lock()
 	defer mt.tenantLock.Unlock()
@@ -203,79 +835,353 @@ func (mt *metaTable) AddTenant(te *pb.TenantMeta, ts typeutil.Timestamp) error {
 		log.Error("SnapShotKV Save fail", zap.Error(err))
 		panic("SnapShotKV Save fail")
 	}
-	mt.tenantID2Meta[te.ID] = *te
-	return nil
+	mt.tenantID2Meta[te.ID] = *te
+	return nil
+}
+
+func (mt *metaTable) AddProxy(po *pb.ProxyMeta, ts typeutil.Timestamp) error {
+	mt.proxyLock.Lock()
+	defer mt.proxyLock.Unlock()
+
+	k := fmt.Sprintf("%s/%d", ProxyMetaPrefix, po.ID)
+	v := proto.MarshalTextString(po)
+
+	err := mt.client.Save(k, v, ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.proxyID2Meta[po.ID] = *po
+	return nil
+}
+
+func (mt *metaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestamp, idx []*pb.IndexInfo, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if len(coll.PartitionIDs) != len(coll.PartitionNames) ||
+		len(coll.PartitionIDs) != len(coll.PartitionCreatedTimestamps) {
+		return fmt.Errorf("PartitionIDs, PartitionNames and PartitionCreatedTimestmaps' length mis-match when creating collection")
+	}
+	// only collections with a partition key own more than one partition at creation
+	keyField, err := typeutil.GetPartitionKeyField(coll.Schema)
+	if err != nil {
+		return err
+	}
+	if keyField == nil && len(coll.PartitionIDs) > 1 {
+		return fmt.Errorf("collection without partition key can't be created with %d partitions", len(coll.PartitionIDs))
+	}
+	if int64(len(coll.PartitionIDs)) > Params.MaxPartitionNum {
+		return fmt.Errorf("maximum partition's number should be limit to %d", Params.MaxPartitionNum)
+	}
+	if _, ok := mt.dbID2Meta[coll.DbID]; !ok {
+		return fmt.Errorf("can't find database id = %d", coll.DbID)
+	}
+	if _, ok := mt.collName2ID[coll.DbID][coll.Schema.Name]; ok {
+		return fmt.Errorf("collection %s exist", coll.Schema.Name)
+	}
+	if len(coll.FieldIndexes) != len(idx) {
+		return fmt.Errorf("incorrect index id when creating collection")
+	}
+
+	for _, i := range idx {
+		mt.indexID2Meta[i.IndexID] = *i
+	}
+
+	meta := make(map[string]string)
+
+	for _, i := range idx {
+		k := fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, coll.ID, i.IndexID)
+		v := proto.MarshalTextString(i)
+		meta[k] = v
+	}
+
+	// save ddOpStr into etcd
+	addition := mt.getAdditionKV(ddOpStr, meta)
+	saveColl := func(ts typeutil.Timestamp) (string, string, error) {
+		coll.CreateTime = ts
+		for i := range coll.PartitionCreatedTimestamps {
+			coll.PartitionCreatedTimestamps[i] = ts
+		}
+		mt.unlockAddCollection(*coll)
+		k1 := collectionMetaKey(coll.DbID, coll.ID)
+		v1 := proto.MarshalTextString(coll)
+		meta[k1] = v1
+		return k1, v1, nil
+	}
+
+	err = mt.client.MultiSave(meta, ts, addition, saveColl)
+	if err != nil {
+		log.Error("SnapShotKV MultiSave fail", zap.Error(err))
+		panic("SnapShotKV MultiSave fail")
+	}
+
+	return nil
+}
+
+func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+	if collMeta.DeletionProtection {
+		return fmt.Errorf("collection %s is protected from deletion", collMeta.Schema.Name)
+	}
+
+	delete(mt.collID2Meta, collID)
+	delete(mt.collName2ID[collMeta.DbID], collMeta.Schema.Name)
+	mt.unlockDeleteCollectionIndexes(&collMeta)
+
+	delMetakeys := []string{
+		collectionMetaKey(collMeta.DbID, collID),
+		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
+		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
+	}
+
+	// save ddOpStr into etcd
+	var saveMeta = map[string]string{}
+	addition := mt.getAdditionKV(ddOpStr, saveMeta)
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetakeys, ts, addition)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+
+	return nil
+}
+
+// unlockDeleteCollectionIndexes removes the index metas and the segment index metas of a collection from memory
+func (mt *metaTable) unlockDeleteCollectionIndexes(collMeta *pb.CollectionInfo) {
+	partIDs := append(append([]typeutil.UniqueID{}, collMeta.PartitionIDs...), collMeta.DroppedPartitionIDs...)
+	for _, partID := range partIDs {
+		// update segID2IndexMeta
+		if segIDMap, ok := mt.partID2SegID[partID]; ok {
+			for segID := range segIDMap {
+				delete(mt.segID2IndexMeta, segID)
+			}
+		}
+		// update partID2SegID
+		delete(mt.partID2SegID, partID)
+	}
+
+	for _, idxInfo := range collMeta.FieldIndexes {
+		_, ok := mt.indexID2Meta[idxInfo.IndexID]
+		if !ok {
+			log.Warn("index id not exist", zap.Int64("index id", idxInfo.IndexID))
+			continue
+		}
+		delete(mt.indexID2Meta, idxInfo.IndexID)
+	}
+}
+
+// SoftDeleteCollection moves a collection into the recycle bin, it can't be found by name any more.
+// The meta, index metas and segment index metas are kept until PurgeCollection, or restored by RecoverCollection.
+func (mt *metaTable) SoftDeleteCollection(collID typeutil.UniqueID, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+	if collMeta.DeletionProtection {
+		return fmt.Errorf("collection %s is protected from deletion", collMeta.Schema.Name)
+	}
+	collMeta.DropTime = ts
+
+	saveMeta := map[string]string{droppedCollectionMetaKey(collMeta.DbID, collID): proto.MarshalTextString(&collMeta)}
+	delMetaKeys := []string{collectionMetaKey(collMeta.DbID, collID)}
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetaKeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+
+	delete(mt.collID2Meta, collID)
+	delete(mt.collName2ID[collMeta.DbID], collMeta.Schema.Name)
+	mt.droppedColl[collID] = collMeta
+	return nil
+}
+
+// RecoverCollection moves a soft dropped collection out of the recycle bin, it fails if
+// the database has been dropped or another collection with the same name has been created
+func (mt *metaTable) RecoverCollection(dbName string, collID typeutil.UniqueID, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
+	collMeta, ok := mt.droppedColl[collID]
+	if !ok || collMeta.DbID != dbID {
+		return nil, fmt.Errorf("can't find dropped collection. id = %d", collID)
+	}
+	if _, ok := mt.collName2ID[dbID][collMeta.Schema.Name]; ok {
+		return nil, fmt.Errorf("collection %s exist, rename it before recovering the dropped one", collMeta.Schema.Name)
+	}
+	collMeta.DropTime = 0
+
+	saveMeta := map[string]string{collectionMetaKey(collMeta.DbID, collID): proto.MarshalTextString(&collMeta)}
+	delMetaKeys := []string{droppedCollectionMetaKey(collMeta.DbID, collID)}
+	err = mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetaKeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+
+	delete(mt.droppedColl, collID)
+	mt.unlockAddCollection(collMeta)
+	return proto.Clone(&collMeta).(*pb.CollectionInfo), nil
+}
+
+// PurgeCollection deletes a soft dropped collection irreversibly
+func (mt *metaTable) PurgeCollection(collID typeutil.UniqueID, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, ok := mt.droppedColl[collID]
+	if !ok {
+		return fmt.Errorf("can't find dropped collection. id = %d", collID)
+	}
+
+	delete(mt.droppedColl, collID)
+	mt.unlockDeleteCollectionIndexes(&collMeta)
+
+	delMetakeys := []string{
+		droppedCollectionMetaKey(collMeta.DbID, collID),
+		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
+		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
+	}
+
+	// save ddOpStr into etcd
+	var saveMeta = map[string]string{}
+	addition := mt.getAdditionKV(ddOpStr, saveMeta)
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetakeys, ts, addition)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	return nil
+}
+
+// ListDroppedCollections returns the soft dropped collections of a database ordered by drop time
+func (mt *metaTable) ListDroppedCollections(dbName string) ([]*pb.CollectionInfo, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
+	colls := make([]*pb.CollectionInfo, 0)
+	for _, coll := range mt.droppedColl {
+		if coll.DbID == dbID {
+			colls = append(colls, proto.Clone(&coll).(*pb.CollectionInfo))
+		}
+	}
+	sort.Slice(colls, func(i, j int) bool { return colls[i].DropTime < colls[j].DropTime })
+	return colls, nil
+}
+
+// ListExpiredDrops returns the collections which were soft dropped before expireTs,
+// and the collections owning partitions which were soft dropped before expireTs
+func (mt *metaTable) ListExpiredDrops(expireTs typeutil.Timestamp) ([]*pb.CollectionInfo, []*pb.CollectionInfo) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	colls := make([]*pb.CollectionInfo, 0)
+	for _, coll := range mt.droppedColl {
+		if coll.DropTime < expireTs {
+			colls = append(colls, proto.Clone(&coll).(*pb.CollectionInfo))
+		}
+	}
+	partColls := make([]*pb.CollectionInfo, 0)
+	for _, coll := range mt.collID2Meta {
+		for _, dropTime := range coll.PartitionDroppedTimestamps {
+			if dropTime < expireTs {
+				partColls = append(partColls, proto.Clone(&coll).(*pb.CollectionInfo))
+				break
+			}
+		}
+	}
+	return colls, partColls
 }
 
-func (mt *metaTable) AddProxy(po *pb.ProxyMeta, ts typeutil.Timestamp) error {
-	mt.proxyLock.Lock()
-	defer mt.proxyLock.Unlock()
+// SetDeletionProtection changes the deletion protection of a collection
+func (mt *metaTable) SetDeletionProtection(collID typeutil.UniqueID, protect bool, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
 
-	k := fmt.Sprintf("%s/%d", ProxyMetaPrefix, po.ID)
-	v := proto.MarshalTextString(po)
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+	collMeta.DeletionProtection = protect
 
-	err := mt.client.Save(k, v, ts)
+	err := mt.client.Save(collectionMetaKey(collMeta.DbID, collID), proto.MarshalTextString(&collMeta), ts)
 	if err != nil {
 		log.Error("SnapShotKV Save fail", zap.Error(err))
 		panic("SnapShotKV Save fail")
 	}
-	mt.proxyID2Meta[po.ID] = *po
+	mt.collID2Meta[collID] = collMeta
 	return nil
 }
 
-func (mt *metaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestamp, idx []*pb.IndexInfo, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+// ReshardCollection replaces the channels of a collection, the old channels are retired and kept
+// until ReleaseRetiredChannels. A collection can't be resharded again before its retired channels are released.
+func (mt *metaTable) ReshardCollection(collID typeutil.UniqueID, vchanNames, pchanNames []string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	if len(coll.PartitionIDs) != len(coll.PartitionNames) ||
-		len(coll.PartitionIDs) != len(coll.PartitionCreatedTimestamps) ||
-		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
-		return fmt.Errorf("PartitionIDs, PartitionNames and PartitionCreatedTimestmaps' length mis-match when creating collection")
-	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
-		return fmt.Errorf("collection %s exist", coll.Schema.Name)
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
 	}
-	if len(coll.FieldIndexes) != len(idx) {
-		return fmt.Errorf("incorrect index id when creating collection")
+	if len(collMeta.RetiredVirtualChannelNames) > 0 {
+		return fmt.Errorf("collection %s is being resharded, its retired channels are not released yet", collMeta.Schema.Name)
 	}
+	collMeta.RetiredVirtualChannelNames = collMeta.VirtualChannelNames
+	collMeta.RetiredPhysicalChannelNames = collMeta.PhysicalChannelNames
+	collMeta.RetiredSegmentIDs = nil
+	collMeta.VirtualChannelNames = vchanNames
+	collMeta.PhysicalChannelNames = pchanNames
 
-	for _, i := range idx {
-		mt.indexID2Meta[i.IndexID] = *i
+	err := mt.client.Save(collectionMetaKey(collMeta.DbID, collID), proto.MarshalTextString(&collMeta), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
 	}
+	mt.collID2Meta[collID] = collMeta
+	return nil
+}
 
-	meta := make(map[string]string)
-
-	for _, i := range idx {
-		k := fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, coll.ID, i.IndexID)
-		v := proto.MarshalTextString(i)
-		meta[k] = v
-	}
+// SetRetiredSegments records the segments sealed on the retired channels of a collection,
+// the retired channels are released after these segments are flushed
+func (mt *metaTable) SetRetiredSegments(collID typeutil.UniqueID, segIDs []typeutil.UniqueID, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
 
-	// save ddOpStr into etcd
-	addition := mt.getAdditionKV(ddOpStr, meta)
-	saveColl := func(ts typeutil.Timestamp) (string, string, error) {
-		coll.CreateTime = ts
-		if len(coll.PartitionCreatedTimestamps) == 1 {
-			coll.PartitionCreatedTimestamps[0] = ts
-		}
-		mt.collID2Meta[coll.ID] = *coll
-		mt.collName2ID[coll.Schema.Name] = coll.ID
-		k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, coll.ID)
-		v1 := proto.MarshalTextString(coll)
-		meta[k1] = v1
-		return k1, v1, nil
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
 	}
+	collMeta.RetiredSegmentIDs = segIDs
 
-	err := mt.client.MultiSave(meta, ts, addition, saveColl)
+	err := mt.client.Save(collectionMetaKey(collMeta.DbID, collID), proto.MarshalTextString(&collMeta), ts)
 	if err != nil {
-		log.Error("SnapShotKV MultiSave fail", zap.Error(err))
-		panic("SnapShotKV MultiSave fail")
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
 	}
-
+	mt.collID2Meta[collID] = collMeta
 	return nil
 }
 
-func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+// ReleaseRetiredChannels forgets the retired channels of a collection
+func (mt *metaTable) ReleaseRetiredChannels(collID typeutil.UniqueID, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
@@ -283,49 +1189,31 @@ func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	if !ok {
 		return fmt.Errorf("can't find collection. id = %d", collID)
 	}
+	collMeta.RetiredVirtualChannelNames = nil
+	collMeta.RetiredPhysicalChannelNames = nil
+	collMeta.RetiredSegmentIDs = nil
 
-	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
-
-	// update segID2IndexMeta
-	for partID := range collMeta.PartitionIDs {
-		if segIDMap, ok := mt.partID2SegID[typeutil.UniqueID(partID)]; ok {
-			for segID := range segIDMap {
-				delete(mt.segID2IndexMeta, segID)
-			}
-		}
+	err := mt.client.Save(collectionMetaKey(collMeta.DbID, collID), proto.MarshalTextString(&collMeta), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
 	}
+	mt.collID2Meta[collID] = collMeta
+	return nil
+}
 
-	// update partID2SegID
-	for partID := range collMeta.PartitionIDs {
-		delete(mt.partID2SegID, typeutil.UniqueID(partID))
-	}
+// ListReshardingCollections returns the collections which have retired channels
+func (mt *metaTable) ListReshardingCollections() []*pb.CollectionInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
 
-	for _, idxInfo := range collMeta.FieldIndexes {
-		_, ok := mt.indexID2Meta[idxInfo.IndexID]
-		if !ok {
-			log.Warn("index id not exist", zap.Int64("index id", idxInfo.IndexID))
-			continue
+	colls := make([]*pb.CollectionInfo, 0)
+	for _, coll := range mt.collID2Meta {
+		if len(coll.RetiredVirtualChannelNames) > 0 {
+			colls = append(colls, proto.Clone(&coll).(*pb.CollectionInfo))
 		}
-		delete(mt.indexID2Meta, idxInfo.IndexID)
-	}
-
-	delMetakeys := []string{
-		fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID),
-		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
-		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
-	}
-
-	// save ddOpStr into etcd
-	var saveMeta = map[string]string{}
-	addition := mt.getAdditionKV(ddOpStr, saveMeta)
-	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetakeys, ts, addition)
-	if err != nil {
-		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
-		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
 	}
-
-	return nil
+	return colls
 }
 
 func (mt *metaTable) HasCollection(collID typeutil.UniqueID, ts typeutil.Timestamp) bool {
@@ -335,8 +1223,7 @@ func (mt *metaTable) HasCollection(collID typeutil.UniqueID, ts typeutil.Timesta
 		_, ok := mt.collID2Meta[collID]
 		return ok
 	}
-	key := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
-	_, err := mt.client.Load(key, ts)
+	_, err := mt.loadCollectionByID(collID, ts)
 	return err == nil
 }
 
@@ -352,25 +1239,19 @@ func (mt *metaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 		colCopy := proto.Clone(&col)
 		return colCopy.(*pb.CollectionInfo), nil
 	}
-	key := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collectionID)
-	val, err := mt.client.Load(key, ts)
-	if err != nil {
-		return nil, err
-	}
-	colMeta := pb.CollectionInfo{}
-	err = proto.UnmarshalText(val, &colMeta)
-	if err != nil {
-		return nil, err
-	}
-	return &colMeta, nil
+	return mt.loadCollectionByID(collectionID, ts)
 }
 
-func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+func (mt *metaTable) GetCollectionByName(dbName string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.collName2ID[dbID][collectionName]
 		if !ok {
 			return nil, fmt.Errorf("can't find collection: " + collectionName)
 		}
@@ -381,54 +1262,55 @@ func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 		colCopy := proto.Clone(&col)
 		return colCopy.(*pb.CollectionInfo), nil
 	}
-	_, vals, err := mt.client.LoadWithPrefix(CollectionMetaPrefix, ts)
+	colls, err := mt.loadCollections(ts)
 	if err != nil {
 		return nil, err
 	}
-	for _, val := range vals {
-		collMeta := pb.CollectionInfo{}
-		err = proto.UnmarshalText(val, &collMeta)
-		if err != nil {
-			log.Debug("unmarshal collection info failed", zap.Error(err))
-			continue
-		}
-		if collMeta.Schema.Name == collectionName {
-			return &collMeta, nil
+	for _, collMeta := range colls {
+		if collMeta.DbID == dbID && collMeta.Schema.Name == collectionName {
+			return collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-func (mt *metaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
+func (mt *metaTable) ListCollections(dbName string, ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]*pb.CollectionInfo)
 
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		for collName, collID := range mt.collName2ID {
+		for collName, collID := range mt.collName2ID[dbID] {
 			coll := mt.collID2Meta[collID]
 			colCopy := proto.Clone(&coll)
 			colls[collName] = colCopy.(*pb.CollectionInfo)
 		}
 		return colls, nil
 	}
-	_, vals, err := mt.client.LoadWithPrefix(CollectionMetaPrefix, ts)
+	vals, err := mt.loadCollections(ts)
 	if err != nil {
 		log.Debug("load with prefix error", zap.Uint64("timestamp", ts), zap.Error(err))
-		return nil, nil
+		return nil, err
 	}
-	for _, val := range vals {
-		collMeta := pb.CollectionInfo{}
-		err := proto.UnmarshalText(val, &collMeta)
-		if err != nil {
-			log.Debug("unmarshal collection info failed", zap.Error(err))
+	for _, collMeta := range vals {
+		if collMeta.DbID == dbID {
+			colls[collMeta.Schema.Name] = collMeta
 		}
-		colls[collMeta.Schema.Name] = &collMeta
 	}
 	return colls, nil
 }
 
-// ListCollectionVirtualChannels list virtual channel of all the collection
+// CompactSnapshot drops the meta snapshots older than ts, the meta can't be read before ts afterwards
+func (mt *metaTable) CompactSnapshot(ts typeutil.Timestamp) error {
+	return mt.client.Compact(ts)
+}
+
+// ListCollectionVirtualChannels list virtual channel of all the collection,
+// the channels of soft dropped collections and the retired channels of resharded collections are included
 func (mt *metaTable) ListCollectionVirtualChannels() []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -436,11 +1318,17 @@ func (mt *metaTable) ListCollectionVirtualChannels() []string {
 
 	for _, c := range mt.collID2Meta {
 		vlist = append(vlist, c.VirtualChannelNames...)
+		vlist = append(vlist, c.RetiredVirtualChannelNames...)
+	}
+	for _, c := range mt.droppedColl {
+		vlist = append(vlist, c.VirtualChannelNames...)
+		vlist = append(vlist, c.RetiredVirtualChannelNames...)
 	}
 	return vlist
 }
 
-// ListCollectionPhysicalChannels list physical channel of all the collection
+// ListCollectionPhysicalChannels list physical channel of all the collection,
+// the channels of soft dropped collections and the retired channels of resharded collections are included
 func (mt *metaTable) ListCollectionPhysicalChannels() []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -448,10 +1336,111 @@ func (mt *metaTable) ListCollectionPhysicalChannels() []string {
 
 	for _, c := range mt.collID2Meta {
 		plist = append(plist, c.PhysicalChannelNames...)
+		plist = append(plist, c.RetiredPhysicalChannelNames...)
+	}
+	for _, c := range mt.droppedColl {
+		plist = append(plist, c.PhysicalChannelNames...)
+		plist = append(plist, c.RetiredPhysicalChannelNames...)
 	}
 	return plist
 }
 
+// AddField appends a field to the schema of a collection and bumps the schema version,
+// the field ID is assigned by the caller
+func (mt *metaTable) AddField(collID typeutil.UniqueID, field *schemapb.FieldSchema, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+
+	for _, f := range coll.Schema.Fields {
+		if f.FieldID == field.FieldID {
+			return fmt.Errorf("field id = %d already exists", field.FieldID)
+		}
+		if f.Name == field.Name {
+			return fmt.Errorf("field name = %s already exists", field.Name)
+		}
+	}
+	meta := make(map[string]string)
+
+	// save ddOpStr into etcd
+	addition := mt.getAdditionKV(ddOpStr, meta)
+
+	saveColl := func(ts typeutil.Timestamp) (string, string, error) {
+		// the schema is shared with the callers of get methods, so modify a copy of it
+		schema := proto.Clone(coll.Schema).(*schemapb.CollectionSchema)
+		schema.Fields = append(schema.Fields, field)
+		coll.Schema = schema
+		coll.SchemaVersion++
+		mt.collID2Meta[collID] = coll
+
+		k1 := collectionMetaKey(coll.DbID, collID)
+		v1 := proto.MarshalTextString(&coll)
+		meta[k1] = v1
+
+		return k1, v1, nil
+	}
+
+	err := mt.client.MultiSave(meta, ts, addition, saveColl)
+	if err != nil {
+		log.Error("SnapShotKV MultiSave fail", zap.Error(err))
+		panic("SnapShotKV MultiSave fail")
+	}
+	return nil
+}
+
+// RenameCollection renames a collection in its database, the collection meta is saved under its id,
+// so only the value of the collection key is rewritten together with the dd operation
+func (mt *metaTable) RenameCollection(dbName, oldName, newName string, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.unlockGetDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	collID, ok := mt.collName2ID[dbID][oldName]
+	if !ok {
+		return fmt.Errorf("can't find collection: %s", oldName)
+	}
+	if _, ok := mt.collName2ID[dbID][newName]; ok {
+		return fmt.Errorf("collection %s already exists", newName)
+	}
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+	meta := make(map[string]string)
+
+	// save ddOpStr into etcd
+	addition := mt.getAdditionKV(ddOpStr, meta)
+
+	saveColl := func(ts typeutil.Timestamp) (string, string, error) {
+		// the schema is shared with the callers of get methods, so modify a copy of it
+		schema := proto.Clone(coll.Schema).(*schemapb.CollectionSchema)
+		schema.Name = newName
+		coll.Schema = schema
+		mt.collID2Meta[collID] = coll
+		delete(mt.collName2ID[dbID], oldName)
+		mt.collName2ID[dbID][newName] = collID
+
+		k1 := collectionMetaKey(coll.DbID, collID)
+		v1 := proto.MarshalTextString(&coll)
+		meta[k1] = v1
+
+		return k1, v1, nil
+	}
+
+	err = mt.client.MultiSaveAndRemoveWithPrefix(meta, nil, ts, addition, saveColl)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	return nil
+}
+
 func (mt *metaTable) AddPartition(collID typeutil.UniqueID, partitionName string, partitionID typeutil.UniqueID, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -459,6 +1448,9 @@ func (mt *metaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 	if !ok {
 		return fmt.Errorf("can't find collection. id = %d", collID)
 	}
+	if hasPartitionKey(&coll) {
+		return fmt.Errorf("can't create partition in collection %s, its partitions are managed by the partition key", coll.Schema.Name)
+	}
 
 	// number of partition tags (except _default) should be limited to 4096 by default
 	if int64(len(coll.PartitionIDs)) >= Params.MaxPartitionNum {
@@ -497,7 +1489,7 @@ func (mt *metaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 		coll.PartitionCreatedTimestamps = append(coll.PartitionCreatedTimestamps, ts)
 		mt.collID2Meta[collID] = coll
 
-		k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+		k1 := collectionMetaKey(coll.DbID, collID)
 		v1 := proto.MarshalTextString(&coll)
 		meta[k1] = v1
 
@@ -513,9 +1505,9 @@ func (mt *metaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 }
 
 func (mt *metaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) (string, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
 	if ts == 0 {
-		mt.ddLock.RLock()
-		defer mt.ddLock.RUnlock()
 		collMeta, ok := mt.collID2Meta[collID]
 		if !ok {
 			return "", fmt.Errorf("can't find collection id = %d", collID)
@@ -527,13 +1519,7 @@ func (mt *metaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID,
 		}
 		return "", fmt.Errorf("partition %d does not exist", partitionID)
 	}
-	collKey := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
-	collVal, err := mt.client.Load(collKey, ts)
-	if err != nil {
-		return "", err
-	}
-	collMeta := pb.CollectionInfo{}
-	err = proto.UnmarshalText(collVal, &collMeta)
+	collMeta, err := mt.loadCollectionByID(collID, ts)
 	if err != nil {
 		return "", err
 	}
@@ -558,13 +1544,7 @@ func (mt *metaTable) getPartitionByName(collID typeutil.UniqueID, partitionName
 		}
 		return 0, fmt.Errorf("partition %s does not exist", partitionName)
 	}
-	collKey := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
-	collVal, err := mt.client.Load(collKey, ts)
-	if err != nil {
-		return 0, err
-	}
-	collMeta := pb.CollectionInfo{}
-	err = proto.UnmarshalText(collVal, &collMeta)
+	collMeta, err := mt.loadCollectionByID(collID, ts)
 	if err != nil {
 		return 0, err
 	}
@@ -601,6 +1581,20 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 	if !ok {
 		return 0, fmt.Errorf("can't find collection id = %d", collID)
 	}
+	partID, err := removePartition(&collMeta, partitionName)
+	if err != nil {
+		return 0, err
+	}
+	mt.collID2Meta[collID] = collMeta
+	mt.unlockDeletePartitionIndexes(&collMeta, partID, ts, ddOpStr)
+	return partID, nil
+}
+
+// removePartition removes a partition from the collection meta and returns its id
+func removePartition(collMeta *pb.CollectionInfo, partitionName string) (typeutil.UniqueID, error) {
+	if hasPartitionKey(collMeta) {
+		return 0, fmt.Errorf("can't drop partition of collection %s, its partitions are managed by the partition key", collMeta.Schema.Name)
+	}
 
 	// check tag exists
 	exist := false
@@ -625,8 +1619,12 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 	collMeta.PartitionIDs = pd
 	collMeta.PartitionNames = pn
 	collMeta.PartitionCreatedTimestamps = pts
-	mt.collID2Meta[collID] = collMeta
+	return partID, nil
+}
 
+// unlockDeletePartitionIndexes removes the segment index metas of a partition,
+// and saves the collection meta in the same transaction
+func (mt *metaTable) unlockDeletePartitionIndexes(collMeta *pb.CollectionInfo, partID typeutil.UniqueID, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) {
 	// update segID2IndexMeta and partID2SegID
 	if segIDMap, ok := mt.partID2SegID[partID]; ok {
 		for segID := range segIDMap {
@@ -635,7 +1633,7 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 	}
 	delete(mt.partID2SegID, partID)
 
-	meta := map[string]string{path.Join(CollectionMetaPrefix, strconv.FormatInt(collID, 10)): proto.MarshalTextString(&collMeta)}
+	meta := map[string]string{collectionMetaKey(collMeta.DbID, collMeta.ID): proto.MarshalTextString(collMeta)}
 	delMetaKeys := []string{}
 	for _, idxInfo := range collMeta.FieldIndexes {
 		k := fmt.Sprintf("%s/%d/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, idxInfo.IndexID, partID)
@@ -650,9 +1648,73 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
 		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
 	}
+}
+
+// SoftDeletePartition moves a partition into the dropped partitions of the collection,
+// its segment index metas are kept until PurgePartition
+func (mt *metaTable) SoftDeletePartition(collID typeutil.UniqueID, partitionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if partitionName == Params.DefaultPartitionName {
+		return 0, fmt.Errorf("default partition cannot be deleted")
+	}
+
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return 0, fmt.Errorf("can't find collection id = %d", collID)
+	}
+	partID, err := removePartition(&collMeta, partitionName)
+	if err != nil {
+		return 0, err
+	}
+	collMeta.DroppedPartitionIDs = append(collMeta.DroppedPartitionIDs, partID)
+	collMeta.DroppedPartitionNames = append(collMeta.DroppedPartitionNames, partitionName)
+	collMeta.PartitionDroppedTimestamps = append(collMeta.PartitionDroppedTimestamps, ts)
+
+	err = mt.client.Save(collectionMetaKey(collMeta.DbID, collID), proto.MarshalTextString(&collMeta), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.collID2Meta[collID] = collMeta
 	return partID, nil
 }
 
+// PurgePartition deletes a soft dropped partition irreversibly
+func (mt *metaTable) PurgePartition(collID typeutil.UniqueID, partID typeutil.UniqueID, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection id = %d", collID)
+	}
+
+	exist := false
+	pd := make([]typeutil.UniqueID, 0, len(collMeta.DroppedPartitionIDs))
+	pn := make([]string, 0, len(collMeta.DroppedPartitionNames))
+	pts := make([]uint64, 0, len(collMeta.PartitionDroppedTimestamps))
+	for idx := range collMeta.DroppedPartitionIDs {
+		if collMeta.DroppedPartitionIDs[idx] == partID {
+			exist = true
+		} else {
+			pd = append(pd, collMeta.DroppedPartitionIDs[idx])
+			pn = append(pn, collMeta.DroppedPartitionNames[idx])
+			pts = append(pts, collMeta.PartitionDroppedTimestamps[idx])
+		}
+	}
+	if !exist {
+		return fmt.Errorf("can't find dropped partition id = %d", partID)
+	}
+	collMeta.DroppedPartitionIDs = pd
+	collMeta.DroppedPartitionNames = pn
+	collMeta.PartitionDroppedTimestamps = pts
+	mt.collID2Meta[collID] = collMeta
+	mt.unlockDeletePartitionIndexes(&collMeta, partID, ts, ddOpStr)
+	return nil
+}
+
 func (mt *metaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -708,11 +1770,11 @@ func (mt *metaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo, ts typeutil.Times
 }
 
 //return timestamp, index id, is dropped, error
-func (mt *metaTable) DropIndex(collName, fieldName, indexName string, ts typeutil.Timestamp) (typeutil.UniqueID, bool, error) {
+func (mt *metaTable) DropIndex(dbName, collName, fieldName, indexName string, ts typeutil.Timestamp) (typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return 0, false, fmt.Errorf("collection name = %s not exist", collName)
 	}
@@ -720,7 +1782,7 @@ func (mt *metaTable) DropIndex(collName, fieldName, indexName string, ts typeuti
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return 0, false, err
 	}
@@ -751,7 +1813,7 @@ func (mt *metaTable) DropIndex(collName, fieldName, indexName string, ts typeuti
 	}
 	collMeta.FieldIndexes = fieldIdxInfo
 	mt.collID2Meta[collID] = collMeta
-	saveMeta := map[string]string{path.Join(CollectionMetaPrefix, strconv.FormatInt(collID, 10)): proto.MarshalTextString(&collMeta)}
+	saveMeta := map[string]string{collectionMetaKey(collMeta.DbID, collID): proto.MarshalTextString(&collMeta)}
 
 	delete(mt.indexID2Meta, dropIdxID)
 
@@ -822,15 +1884,15 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 	return pb.SegmentIndexInfo{}, fmt.Errorf("can't find index name = %s on segment = %d, with filed id = %d", idxName, segID, filedID)
 }
 
-func (mt *metaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *metaTable) GetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(dbName, collName, fieldName)
 }
 
-func (mt *metaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
+func (mt *metaTable) unlockGetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
@@ -877,14 +1939,14 @@ func (mt *metaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 }
 
 // return segment ids, type params, error
-func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *metaTable) GetNotIndexedSegments(dbName string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	if idxInfo.IndexParams == nil {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
 	}
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
@@ -892,7 +1954,7 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return nil, fieldSchema, err
 	}
@@ -928,7 +1990,7 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 		}
 		collMeta.FieldIndexes = append(collMeta.FieldIndexes, idx)
 		mt.collID2Meta[collMeta.ID] = collMeta
-		k1 := path.Join(CollectionMetaPrefix, strconv.FormatInt(collMeta.ID, 10))
+		k1 := collectionMetaKey(collMeta.DbID, collMeta.ID)
 		v1 := proto.MarshalTextString(&collMeta)
 
 		mt.indexID2Meta[idx.IndexID] = *idxInfo
@@ -984,11 +2046,11 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	return rstID, fieldSchema, nil
 }
 
-func (mt *metaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *metaTable) GetIndexByName(dbName, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
 	}
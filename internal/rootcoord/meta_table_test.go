@@ -12,14 +12,17 @@ package rootcoord
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -54,6 +57,10 @@ func (m *mockTestKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, remov
 	return m.multiSaveAndRemoveWithPrefix(saves, removals, ts, additions...)
 }
 
+func (m *mockTestKV) Compact(ts typeutil.Timestamp) error {
+	return nil
+}
+
 func Test_MockKV(t *testing.T) {
 	k1 := &mockTestKV{}
 	prefix := make(map[string][]string)
@@ -86,6 +93,15 @@ func Test_MockKV(t *testing.T) {
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
 
+	prefix[DBInfoMetaPrefix] = []string{"db-info"}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "RootCoord UnmarshalText pb.DatabaseInfo err:line 1.0: unknown field name \"db-info\" in milvus.proto.etcd.DatabaseInfo")
+
+	prefix[DBInfoMetaPrefix] = []string{proto.MarshalTextString(&pb.DatabaseInfo{})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
 	prefix[CollectionMetaPrefix] = []string{"collection-meta"}
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
@@ -95,6 +111,10 @@ func Test_MockKV(t *testing.T) {
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
 
+	prefix[LegacyCollectionMetaPrefix] = []string{proto.MarshalTextString(&pb.CollectionInfo{Schema: &schemapb.CollectionSchema{}})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
 	prefix[SegmentIndexMetaPrefix] = []string{"segment-index-meta"}
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
@@ -174,7 +194,8 @@ func TestMetaTable(t *testing.T) {
 	assert.Nil(t, err)
 
 	collInfo := &pb.CollectionInfo{
-		ID: collID,
+		ID:   collID,
+		DbID: DefaultDatabaseID,
 		Schema: &schemapb.CollectionSchema{
 			Name:        "testColl",
 			Description: "",
@@ -250,7 +271,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), ts)
 
-		collMeta, err := mt.GetCollectionByName("testColl", 0)
+		collMeta, err := mt.GetCollectionByName("", "testColl", 0)
 		assert.Nil(t, err)
 		assert.Equal(t, collMeta.CreateTime, ts)
 		assert.Equal(t, collMeta.PartitionCreatedTimestamps[0], ts)
@@ -259,7 +280,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema("testColl", "field110")
+		field, err := mt.GetFieldSchema("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -337,9 +358,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil)
 		assert.NotNil(t, err)
-		seg, field, err := mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err := mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(seg))
 		assert.Equal(t, segID2, seg[0])
@@ -355,7 +376,7 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		seg, field, err = mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err = mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(seg))
 		assert.Equal(t, segID, seg[0])
@@ -365,7 +386,7 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("get index by name", func(t *testing.T) {
-		_, idx, err := mt.GetIndexByName("testColl", "field110")
+		_, idx, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, indexID, idx[0].IndexID)
@@ -381,7 +402,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName("testColl", "idx201")
+		_, idx, err = mt.GetIndexByName("", "testColl", "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
@@ -403,20 +424,20 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("drop index", func(t *testing.T) {
-		idx, ok, err := mt.DropIndex("testColl", "field110", "field110", 0)
+		idx, ok, err := mt.DropIndex("", "testColl", "field110", "field110", 0)
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, indexID, idx)
 
-		_, ok, err = mt.DropIndex("testColl", "field110", "field110-error", 0)
+		_, ok, err = mt.DropIndex("", "testColl", "field110", "field110-error", 0)
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName("testColl", "field110")
+		_, idxs, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName("testColl", "field110-1")
+		_, idxs, err = mt.GetIndexByName("", "testColl", "field110-1")
 		assert.Nil(t, err)
 		assert.Equal(t, len(idxs), 1)
 		assert.Equal(t, idxs[0].IndexID, int64(2001))
@@ -450,6 +471,296 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, "false", flag)
 	})
 
+	t.Run("add and drop database", func(t *testing.T) {
+		ts := ftso()
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 1000, Name: "testDB", CreateTime: ts}, ts)
+		assert.Nil(t, err)
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 1001, Name: "testDB", CreateTime: ts}, ts)
+		assert.NotNil(t, err)
+
+		db, err := mt.GetDatabaseByName("testDB")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1000), db.ID)
+		db, err = mt.GetDatabaseByName("")
+		assert.Nil(t, err)
+		assert.Equal(t, DefaultDatabaseID, db.ID)
+		assert.Equal(t, 2, len(mt.ListDatabases()))
+
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		coll.DbID = 1000
+		ts = ftso()
+		err = mt.AddCollection(coll, ts, idxInfo, ddOp)
+		assert.Nil(t, err)
+		_, err = mt.GetCollectionByName("", collName, 0)
+		assert.NotNil(t, err)
+		collMeta, err := mt.GetCollectionByName("testDB", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1000), collMeta.DbID)
+
+		err = mt.DeleteDatabase("testDB", ftso())
+		assert.EqualError(t, err, "database testDB is not empty, drop its collections first")
+		err = mt.DeleteDatabase(Params.DefaultDatabaseName, ftso())
+		assert.NotNil(t, err)
+
+		err = mt.DeleteCollection(collID, ftso(), nil)
+		assert.Nil(t, err)
+		err = mt.DeleteDatabase("testDB", ftso())
+		assert.Nil(t, err)
+		_, err = mt.GetDatabaseByName("testDB")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("add field", func(t *testing.T) {
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		err = mt.AddCollection(coll, ftso(), idxInfo, ddOp)
+		assert.Nil(t, err)
+
+		field := &schemapb.FieldSchema{
+			FieldID:      110,
+			Name:         "added_field",
+			DataType:     schemapb.DataType_Int64,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+		}
+		err = mt.AddField(collID, field, ftso(), ddOp)
+		assert.Nil(t, err)
+		err = mt.AddField(collID, field, ftso(), ddOp)
+		assert.NotNil(t, err)
+		err = mt.AddField(collID+1, field, ftso(), ddOp)
+		assert.NotNil(t, err)
+
+		collMeta, err := mt.GetCollectionByID(collID, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), collMeta.SchemaVersion)
+		assert.Equal(t, len(collInfo.Schema.Fields)+1, len(collMeta.Schema.Fields))
+		assert.Equal(t, "added_field", collMeta.Schema.Fields[len(collMeta.Schema.Fields)-1].Name)
+
+		err = mt.DeleteCollection(collID, ftso(), nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("rename collection", func(t *testing.T) {
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		err = mt.AddCollection(coll, ftso(), idxInfo, ddOp)
+		assert.Nil(t, err)
+
+		err = mt.RenameCollection("", collName, "renamedColl", ftso(), ddOp)
+		assert.Nil(t, err)
+		err = mt.RenameCollection("", collName, "renamedColl2", ftso(), ddOp)
+		assert.NotNil(t, err)
+		err = mt.RenameCollection("", "renamedColl", "renamedColl", ftso(), ddOp)
+		assert.NotNil(t, err)
+
+		_, err = mt.GetCollectionByName("", collName, 0)
+		assert.NotNil(t, err)
+		collMeta, err := mt.GetCollectionByName("", "renamedColl", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID, collMeta.ID)
+		assert.Equal(t, "renamedColl", collMeta.Schema.Name)
+
+		// the renamed meta is reloaded from etcd
+		mt2, err := NewMetaTable(skv)
+		assert.Nil(t, err)
+		collMeta, err = mt2.GetCollectionByName("", "renamedColl", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID, collMeta.ID)
+
+		err = mt.DeleteCollection(collID, ftso(), nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("partition key collection", func(t *testing.T) {
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		coll.PartitionIDs = []typeutil.UniqueID{partIDDefault, partIDDefault + 1}
+		coll.PartitionNames = []string{
+			typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 0),
+			typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 1),
+		}
+		coll.PartitionCreatedTimestamps = []uint64{0, 0}
+		err = mt.AddCollection(coll, ftso(), idxInfo, ddOp)
+		assert.NotNil(t, err)
+
+		coll.Schema.Fields = append(coll.Schema.Fields, &schemapb.FieldSchema{
+			FieldID:        fieldID + 1,
+			Name:           "tenant",
+			DataType:       schemapb.DataType_Int64,
+			IsPartitionKey: true,
+		})
+		ts := ftso()
+		err = mt.AddCollection(coll, ts, idxInfo, ddOp)
+		assert.Nil(t, err)
+		collMeta, err := mt.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{ts, ts}, collMeta.PartitionCreatedTimestamps)
+
+		// partitions are managed by the partition key
+		err = mt.AddPartition(collID, partName, partID, ftso(), ddOp)
+		assert.NotNil(t, err)
+		_, err = mt.DeletePartition(collID, coll.PartitionNames[1], ftso(), ddOp)
+		assert.NotNil(t, err)
+
+		err = mt.DeleteCollection(collID, ftso(), nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("soft drop and recover", func(t *testing.T) {
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		coll.PartitionIDs = []typeutil.UniqueID{partIDDefault, partID}
+		coll.PartitionNames = []string{Params.DefaultPartitionName, partName}
+		coll.PartitionCreatedTimestamps = []uint64{0, 0}
+		err = mt.AddCollection(coll, ftso(), idxInfo, ddOp)
+		assert.Nil(t, err)
+
+		err = mt.SetDeletionProtection(collID, true, ftso())
+		assert.Nil(t, err)
+		err = mt.SoftDeleteCollection(collID, ftso())
+		assert.NotNil(t, err)
+		err = mt.DeleteCollection(collID, ftso(), nil)
+		assert.NotNil(t, err)
+		err = mt.SetDeletionProtection(collID, false, ftso())
+		assert.Nil(t, err)
+
+		id, err := mt.SoftDeletePartition(collID, partName, ftso())
+		assert.Nil(t, err)
+		assert.Equal(t, partID, id)
+		assert.False(t, mt.HasPartition(collID, partName, 0))
+		colls, partColls := mt.ListExpiredDrops(math.MaxUint64)
+		assert.Equal(t, 0, len(colls))
+		assert.Equal(t, 1, len(partColls))
+		assert.Equal(t, []typeutil.UniqueID{partID}, partColls[0].DroppedPartitionIDs)
+		err = mt.PurgePartition(collID, partID, ftso(), ddOp)
+		assert.Nil(t, err)
+		err = mt.PurgePartition(collID, partID, ftso(), ddOp)
+		assert.NotNil(t, err)
+
+		dropTs := ftso()
+		err = mt.SoftDeleteCollection(collID, dropTs)
+		assert.Nil(t, err)
+		_, err = mt.GetCollectionByName("", collName, 0)
+		assert.NotNil(t, err)
+		dropped, err := mt.ListDroppedCollections("")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(dropped))
+		assert.Equal(t, dropTs, dropped[0].DropTime)
+		colls, _ = mt.ListExpiredDrops(dropTs)
+		assert.Equal(t, 0, len(colls))
+
+		// the dropped collection is reloaded from etcd
+		mt2, err := NewMetaTable(skv)
+		assert.Nil(t, err)
+		dropped, err = mt2.ListDroppedCollections("")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(dropped))
+
+		recovered, err := mt.RecoverCollection("", collID, ftso())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), recovered.DropTime)
+		_, err = mt.RecoverCollection("", collID, ftso())
+		assert.NotNil(t, err)
+		collMeta, err := mt.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID, collMeta.ID)
+
+		err = mt.SoftDeleteCollection(collID, ftso())
+		assert.Nil(t, err)
+		colls, _ = mt.ListExpiredDrops(math.MaxUint64)
+		assert.Equal(t, 1, len(colls))
+		err = mt.PurgeCollection(collID, ftso(), ddOp)
+		assert.Nil(t, err)
+		dropped, err = mt.ListDroppedCollections("")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(dropped))
+	})
+
+	t.Run("reshard collection", func(t *testing.T) {
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		coll.VirtualChannelNames = []string{"c_0_v"}
+		coll.PhysicalChannelNames = []string{"c_0"}
+		err = mt.AddCollection(coll, ftso(), idxInfo, ddOp)
+		assert.Nil(t, err)
+
+		err = mt.ReshardCollection(collID, []string{"c_1_v", "c_2_v"}, []string{"c_1", "c_2"}, ftso())
+		assert.Nil(t, err)
+		err = mt.ReshardCollection(collID, []string{"c_3_v"}, []string{"c_3"}, ftso())
+		assert.NotNil(t, err)
+		assert.ElementsMatch(t, []string{"c_0", "c_1", "c_2"}, mt.ListCollectionPhysicalChannels())
+		assert.ElementsMatch(t, []string{"c_0_v", "c_1_v", "c_2_v"}, mt.ListCollectionVirtualChannels())
+
+		err = mt.SetRetiredSegments(collID, []typeutil.UniqueID{segID}, ftso())
+		assert.Nil(t, err)
+		colls := mt.ListReshardingCollections()
+		assert.Equal(t, 1, len(colls))
+		assert.Equal(t, []string{"c_0_v"}, colls[0].RetiredVirtualChannelNames)
+		assert.Equal(t, []typeutil.UniqueID{segID}, colls[0].RetiredSegmentIDs)
+
+		err = mt.ReleaseRetiredChannels(collID, ftso())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(mt.ListReshardingCollections()))
+		assert.ElementsMatch(t, []string{"c_1", "c_2"}, mt.ListCollectionPhysicalChannels())
+
+		err = mt.DeleteCollection(collID, ftso(), nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("credential and policy", func(t *testing.T) {
+		credKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, rootPath+"/credential")
+		assert.Nil(t, err)
+		defer credKV.Close()
+		err = mt.initCredential(credKV)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{RootUserName}, mt.ListCredentialUsers())
+		err = mt.DeleteCredential(RootUserName)
+		assert.NotNil(t, err)
+
+		err = mt.AddCredential(&pb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd"})
+		assert.Nil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd"})
+		assert.NotNil(t, err)
+		err = mt.AlterCredential(&pb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd2"})
+		assert.Nil(t, err)
+		cred, err := mt.GetCredential("user1")
+		assert.Nil(t, err)
+		assert.Equal(t, "pwd2", cred.EncryptedPassword)
+
+		err = mt.CreateRole("role1")
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role2", milvuspb.OperateUserRoleType_AddUserToRole)
+		assert.NotNil(t, err)
+		entity := &milvuspb.GrantEntity{
+			RoleName:   "role1",
+			ObjectType: commonpb.ObjectType_Collection,
+			DbName:     "default",
+			ObjectName: "*",
+			Privilege:  commonpb.ObjectPrivilege_PrivilegeSearch,
+		}
+		err = mt.OperatePrivilege(entity, milvuspb.OperatePrivilegeType_Grant)
+		assert.Nil(t, err)
+		grants, err := mt.SelectGrant("role1")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(grants))
+
+		// reload from etcd
+		mt2 := &metaTable{}
+		err = mt2.initCredential(credKV)
+		assert.Nil(t, err)
+		userRoles, grants := mt2.ListPolicy()
+		assert.Equal(t, 1, len(userRoles))
+		assert.Equal(t, "role1", userRoles[0].RoleName)
+		assert.Equal(t, 1, len(grants))
+
+		err = mt.OperatePrivilege(entity, milvuspb.OperatePrivilegeType_Revoke)
+		assert.Nil(t, err)
+		err = mt.DeleteCredential("user1")
+		assert.Nil(t, err)
+		err = mt.DropRole("role1")
+		assert.Nil(t, err)
+		userRoles, grants = mt.ListPolicy()
+		assert.Equal(t, 0, len(userRoles))
+		assert.Equal(t, 0, len(grants))
+		assert.Equal(t, []string{RootUserName}, mt.ListCredentialUsers())
+	})
+
 	/////////////////////////// these tests should run at last, it only used to hit the error lines ////////////////////////
 	mockKV := &mockTestKV{}
 	mt.client = mockKV
@@ -509,7 +820,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection: %s", collInfo.Schema.Name))
 
@@ -739,16 +1050,16 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		ts = ftso()
-		_, _, err = mt.DropIndex("abc", "abc", "abc", ts)
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name = abc not exist")
 
-		mt.collName2ID["abc"] = 2
-		_, _, err = mt.DropIndex("abc", "abc", "abc", ts)
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 2
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc", ts)
+		_, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -766,7 +1077,7 @@ func TestMetaTable(t *testing.T) {
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
 		ts = ftso()
-		idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
+		idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -783,8 +1094,10 @@ func TestMetaTable(t *testing.T) {
 			return fmt.Errorf("multi save and remove with prefix error")
 		}
 		ts = ftso()
-		assert.Panics(t, func() { mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts) })
-		//_, _, err = mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
+		assert.Panics(t, func() {
+			mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
+		})
+		//_, _, err = mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save and remove with prefix error")
 	})
@@ -865,12 +1178,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -915,8 +1228,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil)
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 123
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -941,13 +1254,13 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, nil)
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
 		bakMeta := mt.indexID2Meta
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 		mt.indexID2Meta = bakMeta
@@ -955,8 +1268,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp, addition ...func(ts typeutil.Timestamp) (string, string, error)) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 
@@ -994,8 +1307,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp, addition ...func(ts typeutil.Timestamp) (string, string, error)) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 	})
@@ -1007,8 +1320,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 123
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1033,7 +1346,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, nil)
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1077,7 +1390,8 @@ func TestMetaWithTimestamp(t *testing.T) {
 	assert.Nil(t, err)
 
 	collInfo := &pb.CollectionInfo{
-		ID: 1,
+		ID:   1,
+		DbID: DefaultDatabaseID,
 		Schema: &schemapb.CollectionSchema{
 			Name: collName1,
 		},
@@ -1137,29 +1451,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]*pb.CollectionInfo) []string {
@@ -1170,22 +1484,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
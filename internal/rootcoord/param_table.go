@@ -41,13 +41,19 @@ type ParamTable struct {
 	StatisticsChannel             string
 
 	MaxPartitionNum             int64
+	DefaultPartitionKeyNum      int64
 	DefaultPartitionName        string
 	DefaultIndexName            string
+	DefaultDatabaseName         string
+	DefaultRootPassword         string
 	MinSegmentSizeToEnableIndex int64
 
 	Timeout          int
 	TimeTickInterval int
 
+	MetaSnapshotRetentionInHours int64
+	DropRetentionInHours         int64
+
 	Log log.Config
 
 	RoleName string
@@ -73,12 +79,17 @@ func (p *ParamTable) Init() {
 		p.initStatisticsChannelName()
 
 		p.initMaxPartitionNum()
+		p.initDefaultPartitionKeyNum()
 		p.initMinSegmentSizeToEnableIndex()
 		p.initDefaultPartitionName()
 		p.initDefaultIndexName()
+		p.initDefaultDatabaseName()
+		p.initDefaultRootPassword()
 
 		p.initTimeout()
 		p.initTimeTickInterval()
+		p.initMetaSnapshotRetentionInHours()
+		p.initDropRetentionInHours()
 
 		p.initLogCfg()
 		p.initRoleName()
@@ -165,6 +176,10 @@ func (p *ParamTable) initMaxPartitionNum() {
 	p.MaxPartitionNum = p.ParseInt64("rootcoord.maxPartitionNum")
 }
 
+func (p *ParamTable) initDefaultPartitionKeyNum() {
+	p.DefaultPartitionKeyNum = p.ParseInt64("rootcoord.defaultPartitionKeyNum")
+}
+
 func (p *ParamTable) initMinSegmentSizeToEnableIndex() {
 	p.MinSegmentSizeToEnableIndex = p.ParseInt64("rootcoord.minSegmentSizeToEnableIndex")
 }
@@ -185,6 +200,22 @@ func (p *ParamTable) initDefaultIndexName() {
 	p.DefaultIndexName = name
 }
 
+func (p *ParamTable) initDefaultDatabaseName() {
+	name, err := p.Load("common.defaultDatabaseName")
+	if err != nil {
+		panic(err)
+	}
+	p.DefaultDatabaseName = name
+}
+
+func (p *ParamTable) initDefaultRootPassword() {
+	password, err := p.Load("common.security.defaultRootPassword")
+	if err != nil {
+		panic(err)
+	}
+	p.DefaultRootPassword = password
+}
+
 func (p *ParamTable) initTimeout() {
 	p.Timeout = p.ParseInt("rootcoord.timeout")
 }
@@ -193,6 +224,14 @@ func (p *ParamTable) initTimeTickInterval() {
 	p.TimeTickInterval = p.ParseInt("rootcoord.timeTickInterval")
 }
 
+func (p *ParamTable) initMetaSnapshotRetentionInHours() {
+	p.MetaSnapshotRetentionInHours = p.ParseInt64("rootcoord.metaSnapshotRetentionInHours")
+}
+
+func (p *ParamTable) initDropRetentionInHours() {
+	p.DropRetentionInHours = p.ParseInt64("rootcoord.dropRetentionInHours")
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.Log = log.Config{}
 	format, err := p.Load("log.format")
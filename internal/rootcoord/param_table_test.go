@@ -44,6 +44,9 @@ func TestParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.MaxPartitionNum, 0)
 	t.Logf("master MaxPartitionNum = %d", Params.MaxPartitionNum)
 
+	assert.NotZero(t, Params.DefaultPartitionKeyNum)
+	t.Logf("default partition key num = %d", Params.DefaultPartitionKeyNum)
+
 	assert.NotEqual(t, Params.MinSegmentSizeToEnableIndex, 0)
 	t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex)
 
@@ -58,4 +61,11 @@ func TestParamTable(t *testing.T) {
 
 	assert.NotZero(t, Params.TimeTickInterval)
 	t.Logf("master timetickerInterval = %d", Params.TimeTickInterval)
+
+	// disabled by default, the compaction drops the history of the whole etcd
+	assert.Zero(t, Params.MetaSnapshotRetentionInHours)
+	t.Logf("meta snapshot retention = %d hours", Params.MetaSnapshotRetentionInHours)
+
+	assert.NotZero(t, Params.DropRetentionInHours)
+	t.Logf("drop retention = %d hours", Params.DropRetentionInHours)
 }
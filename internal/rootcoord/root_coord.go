@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -65,6 +66,12 @@ const (
 	MetricRequestsSuccess = "success"
 )
 
+// segmentFlushCheckInterval is the interval of checking whether the sealed segments are flushed
+const segmentFlushCheckInterval = time.Second
+
+// reshardFlushTimeout bounds the wait for the segments on the retired channels to be flushed after a reshard
+const reshardFlushTimeout = 10 * time.Minute
+
 func metricProxy(v int64) string {
 	return fmt.Sprintf("client_%d", v)
 }
@@ -89,6 +96,12 @@ type Core struct {
 	//DDL lock
 	ddlLock sync.Mutex
 
+	//serializes the releases of retired channels by retiredChannelsLoop and by ReshardCollection
+	retiredChannelsMu sync.Mutex
+
+	//audit records of the executed DDLs
+	ddlAudit *ddlAuditLog
+
 	//setMsgStreams, send time tick into dd channel and time tick channel
 	SendTimeTick func(t typeutil.Timestamp, reason string) error
 
@@ -98,6 +111,12 @@ type Core struct {
 	//setMsgStreams, send drop collection into dd channel, and notify the proxy to delete this collection
 	SendDdDropCollectionReq func(ctx context.Context, req *internalpb.DropCollectionRequest, channelNames []string) error
 
+	//setMsgStreams, send add field into dd channel
+	SendDdAddFieldReq func(ctx context.Context, req *internalpb.AddFieldRequest, channelNames []string) error
+
+	//setMsgStreams, send rename collection into dd channel
+	SendDdRenameCollectionReq func(ctx context.Context, req *internalpb.RenameCollectionRequest, channelNames []string) error
+
 	//setMsgStreams, send create partition into dd channel
 	SendDdCreatePartitionReq func(ctx context.Context, req *internalpb.CreatePartitionRequest, channelNames []string) error
 
@@ -108,6 +127,11 @@ type Core struct {
 	CallGetBinlogFilePathsService func(ctx context.Context, segID typeutil.UniqueID, fieldID typeutil.UniqueID) ([]string, error)
 	CallGetNumRowsService         func(ctx context.Context, segID typeutil.UniqueID, isFromFlushedChan bool) (int64, error)
 	CallGetFlushedSegmentsService func(ctx context.Context, collID, partID typeutil.UniqueID) ([]typeutil.UniqueID, error)
+	CallGetSegmentInfoService     func(ctx context.Context, segIDs []typeutil.UniqueID) ([]*datapb.SegmentInfo, error)
+
+	//seal the growing segments of a collection on the virtual channels, all of them if channels is empty,
+	//return the sealed segments which are not flushed yet
+	CallFlushService func(ctx context.Context, dbID, collID typeutil.UniqueID, channels []string) ([]typeutil.UniqueID, error)
 
 	//call index builder's client to build index, return build id
 	CallBuildIndexService func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error)
@@ -118,6 +142,8 @@ type Core struct {
 	//query service interface, notify query service to release collection
 	CallReleaseCollectionService func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID) error
 	CallReleasePartitionService  func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+	//notify query service to watch the new channels of a resharded collection, and to hand off the retired channels if any
+	CallReshardCollectionService func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, retiredChannels []string) error
 
 	//dml channels
 	dmlChannels *dmlChannels
@@ -195,6 +221,12 @@ func (c *Core) checkInit() error {
 	if c.SendDdDropCollectionReq == nil {
 		return fmt.Errorf("SendDdDropCollectionReq is nil")
 	}
+	if c.SendDdAddFieldReq == nil {
+		return fmt.Errorf("SendDdAddFieldReq is nil")
+	}
+	if c.SendDdRenameCollectionReq == nil {
+		return fmt.Errorf("SendDdRenameCollectionReq is nil")
+	}
 	if c.SendDdCreatePartitionReq == nil {
 		return fmt.Errorf("SendDdCreatePartitionReq is nil")
 	}
@@ -216,6 +248,12 @@ func (c *Core) checkInit() error {
 	if c.CallGetFlushedSegmentsService == nil {
 		return fmt.Errorf("CallGetFlushedSegments is nil")
 	}
+	if c.CallGetSegmentInfoService == nil {
+		return fmt.Errorf("CallGetSegmentInfoService is nil")
+	}
+	if c.CallFlushService == nil {
+		return fmt.Errorf("CallFlushService is nil")
+	}
 	if c.NewProxyClient == nil {
 		return fmt.Errorf("NewProxyClient is nil")
 	}
@@ -225,6 +263,9 @@ func (c *Core) checkInit() error {
 	if c.CallReleasePartitionService == nil {
 		return fmt.Errorf("CallReleasePartitionService is nil")
 	}
+	if c.CallReshardCollectionService == nil {
+		return fmt.Errorf("CallReshardCollectionService is nil")
+	}
 
 	return nil
 }
@@ -288,6 +329,312 @@ func (c *Core) sessionLoop() {
 	}
 }
 
+// metaSnapshotGCLoop compacts the meta snapshots which are older than the retention, it's disabled unless the
+// retention is set, since the etcd compaction drops the history of all the keys in etcd, not only the meta
+func (c *Core) metaSnapshotGCLoop() {
+	if Params.MetaSnapshotRetentionInHours <= 0 {
+		return
+	}
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done,exit metaSnapshotGCLoop")
+			return
+		case <-ticker.C:
+			expire := time.Now().Add(-time.Duration(Params.MetaSnapshotRetentionInHours) * time.Hour)
+			ts := tsoutil.ComposeTS(expire.UnixNano()/int64(time.Millisecond), 0)
+			if err := c.MetaTable.CompactSnapshot(ts); err != nil {
+				log.Warn("compact meta snapshot failed", zap.Uint64("ts", ts), zap.Error(err))
+			}
+		}
+	}
+}
+
+// dropGCLoop purges the collections and partitions which have been soft dropped for longer than the retention
+func (c *Core) dropGCLoop() {
+	if Params.DropRetentionInHours <= 0 {
+		return
+	}
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done,exit dropGCLoop")
+			return
+		case <-ticker.C:
+			expire := time.Now().Add(-time.Duration(Params.DropRetentionInHours) * time.Hour)
+			c.purgeExpiredDrops(tsoutil.ComposeTS(expire.UnixNano()/int64(time.Millisecond), 0))
+		}
+	}
+}
+
+// purgeExpiredDrops purges the collections and partitions which were soft dropped before expireTs
+func (c *Core) purgeExpiredDrops(expireTs typeutil.Timestamp) {
+	colls, partColls := c.MetaTable.ListExpiredDrops(expireTs)
+	for _, collMeta := range colls {
+		if err := c.purgeCollection(collMeta); err != nil {
+			log.Warn("purge dropped collection failed", zap.String("collection name", collMeta.Schema.Name),
+				zap.Int64("collection id", collMeta.ID), zap.Error(err))
+		}
+	}
+	for _, collMeta := range partColls {
+		for i, partID := range collMeta.DroppedPartitionIDs {
+			if collMeta.PartitionDroppedTimestamps[i] >= expireTs {
+				continue
+			}
+			if err := c.purgePartition(collMeta, partID, collMeta.DroppedPartitionNames[i]); err != nil {
+				log.Warn("purge dropped partition failed", zap.String("collection name", collMeta.Schema.Name),
+					zap.Int64("partition id", partID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// retiredChannelsLoop releases the channels retired by ReshardCollection after the data on them are flushed
+func (c *Core) retiredChannelsLoop() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done,exit retiredChannelsLoop")
+			return
+		case <-ticker.C:
+			for _, collMeta := range c.MetaTable.ListReshardingCollections() {
+				if err := c.releaseRetiredChannels(collMeta); err != nil {
+					log.Warn("release retired channels failed", zap.String("collection name", collMeta.Schema.Name),
+						zap.Int64("collection id", collMeta.ID), zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// sealRetiredSegments seals the growing segments on the retired channels of a collection, and returns
+// the sealed segments on them which are not flushed yet
+func (c *Core) sealRetiredSegments(collMeta *etcdpb.CollectionInfo) ([]typeutil.UniqueID, error) {
+	return c.CallFlushService(c.ctx, collMeta.DbID, collMeta.ID, collMeta.RetiredVirtualChannelNames)
+}
+
+// waitSegmentsFlushed checks the states of the segments until all of them are flushed or ctx is done
+func (c *Core) waitSegmentsFlushed(ctx context.Context, segIDs []typeutil.UniqueID) error {
+	ticker := time.NewTicker(segmentFlushCheckInterval)
+	defer ticker.Stop()
+	for {
+		infos, err := c.CallGetSegmentInfoService(ctx, segIDs)
+		if err != nil {
+			return err
+		}
+		flushed := true
+		for _, info := range infos {
+			if info.State != commonpb.SegmentState_Flushed {
+				flushed = false
+				break
+			}
+		}
+		if flushed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for segments to be flushed, error = %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// releaseRetiredChannelsAfterFlushed releases the retired channels of a resharded collection once the segments
+// sealed on them are flushed, the channels are left to retiredChannelsLoop if it takes longer than reshardFlushTimeout
+func (c *Core) releaseRetiredChannelsAfterFlushed(collID typeutil.UniqueID, segIDs []typeutil.UniqueID) {
+	ctx, cancel := context.WithTimeout(c.ctx, reshardFlushTimeout)
+	defer cancel()
+	if err := c.waitSegmentsFlushed(ctx, segIDs); err != nil {
+		log.Warn("segments on retired channels are not flushed yet", zap.Int64("collection id", collID),
+			zap.Int64s("segment ids", segIDs), zap.Error(err))
+		return
+	}
+	collMeta, err := c.MetaTable.GetCollectionByID(collID, 0)
+	if err != nil {
+		log.Warn("release retired channels failed", zap.Int64("collection id", collID), zap.Error(err))
+		return
+	}
+	if err := c.releaseRetiredChannels(collMeta); err != nil {
+		log.Warn("release retired channels failed", zap.Int64("collection id", collID), zap.Error(err))
+	}
+}
+
+// releaseRetiredChannels stops producing on the retired channels of a collection, once the segments
+// sealed on them are flushed and no more segments are left on them.
+// The segments are sealed again before releasing, in case some inserts had been routed to the retired
+// channels before the proxies refreshed the channels of the collection. Query nodes load the flushed
+// segments of the retired channels before they stop consuming the channels.
+func (c *Core) releaseRetiredChannels(collMeta *etcdpb.CollectionInfo) error {
+	c.retiredChannelsMu.Lock()
+	defer c.retiredChannelsMu.Unlock()
+
+	// the channels may be released since collMeta was got
+	collMeta, err := c.MetaTable.GetCollectionByID(collMeta.ID, 0)
+	if err != nil {
+		return err
+	}
+	if len(collMeta.RetiredVirtualChannelNames) == 0 {
+		return nil
+	}
+	if len(collMeta.RetiredSegmentIDs) > 0 {
+		infos, err := c.CallGetSegmentInfoService(c.ctx, collMeta.RetiredSegmentIDs)
+		if err != nil {
+			return err
+		}
+		for _, info := range infos {
+			if info.State != commonpb.SegmentState_Flushed {
+				return nil
+			}
+		}
+	}
+
+	segIDs, err := c.sealRetiredSegments(collMeta)
+	if err != nil {
+		return err
+	}
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if len(segIDs) > 0 {
+		c.ddlLock.Lock()
+		defer c.ddlLock.Unlock()
+		return c.MetaTable.SetRetiredSegments(collMeta.ID, segIDs, ts)
+	}
+
+	if err := c.CallReshardCollectionService(c.ctx, ts, collMeta.DbID, collMeta.ID, collMeta.RetiredVirtualChannelNames); err != nil {
+		return fmt.Errorf("hand off retired channels failed, error = %w", err)
+	}
+
+	c.ddlLock.Lock()
+	defer c.ddlLock.Unlock()
+	if err := c.MetaTable.ReleaseRetiredChannels(collMeta.ID, ts); err != nil {
+		return err
+	}
+	c.dmlChannels.RemoveProducerChannels(collMeta.RetiredPhysicalChannelNames...)
+	log.Debug("release retired channels", zap.String("collection name", collMeta.Schema.Name),
+		zap.Int64("collection id", collMeta.ID), zap.Strings("channels", collMeta.RetiredVirtualChannelNames))
+	return nil
+}
+
+// purgeCollection deletes the meta of a soft dropped collection, and sends the drop collection msg
+// to its dml channels, so that data nodes release the collection
+func (c *Core) purgeCollection(collMeta *etcdpb.CollectionInfo) error {
+	ddReq := internalpb.DropCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DropCollection,
+			SourceID: c.session.ServerID,
+		},
+		CollectionName: collMeta.Schema.Name,
+		DbID:           collMeta.DbID,
+		CollectionID:   collMeta.ID,
+	}
+	ddOp := func(ts typeutil.Timestamp) (string, error) {
+		ddReq.Base.Timestamp = ts
+		return EncodeDdOperation(&ddReq, DropCollectionDDType)
+	}
+
+	reason := fmt.Sprintf("purge collection %d", collMeta.ID)
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	purgeCollectionFn := func() error {
+		// lock for ddl operation
+		c.ddlLock.Lock()
+		defer c.ddlLock.Unlock()
+
+		c.chanTimeTick.AddDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer c.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+
+		if err := c.MetaTable.PurgeCollection(collMeta.ID, ts, ddOp); err != nil {
+			return err
+		}
+		if err := c.SendDdDropCollectionReq(c.ctx, &ddReq, AllPhysicalChannels(collMeta)); err != nil {
+			return err
+		}
+
+		c.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+		c.SendTimeTick(ts, reason)
+
+		// remove dml channel after send dd msg
+		c.dmlChannels.RemoveProducerChannels(AllPhysicalChannels(collMeta)...)
+		return nil
+	}
+	if err := purgeCollectionFn(); err != nil {
+		return err
+	}
+	log.Debug("purge dropped collection", zap.String("collection name", collMeta.Schema.Name),
+		zap.Int64("collection id", collMeta.ID), zap.Uint64("drop time", collMeta.DropTime))
+
+	// Update DDOperation in etcd
+	return c.setDdMsgSendFlag(true)
+}
+
+// purgePartition deletes a soft dropped partition from the collection meta, and sends the drop partition msg
+// to the dml channels of the collection
+func (c *Core) purgePartition(collMeta *etcdpb.CollectionInfo, partID typeutil.UniqueID, partName string) error {
+	ddReq := internalpb.DropPartitionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DropPartition,
+			SourceID: c.session.ServerID,
+		},
+		CollectionName: collMeta.Schema.Name,
+		PartitionName:  partName,
+		DbID:           collMeta.DbID,
+		CollectionID:   collMeta.ID,
+		PartitionID:    partID,
+	}
+	ddOp := func(ts typeutil.Timestamp) (string, error) {
+		ddReq.Base.Timestamp = ts
+		return EncodeDdOperation(&ddReq, DropPartitionDDType)
+	}
+
+	reason := fmt.Sprintf("purge partition %d", partID)
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	purgePartitionFn := func() error {
+		// lock for ddl operation
+		c.ddlLock.Lock()
+		defer c.ddlLock.Unlock()
+
+		c.chanTimeTick.AddDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer c.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+
+		if err := c.MetaTable.PurgePartition(collMeta.ID, partID, ts, ddOp); err != nil {
+			return err
+		}
+		if err := c.SendDdDropPartitionReq(c.ctx, &ddReq, collMeta.PhysicalChannelNames); err != nil {
+			return err
+		}
+
+		c.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+		c.SendTimeTick(ts, reason)
+		return nil
+	}
+	if err := purgePartitionFn(); err != nil {
+		return err
+	}
+	log.Debug("purge dropped partition", zap.String("collection name", collMeta.Schema.Name),
+		zap.String("partition name", partName), zap.Int64("partition id", partID))
+
+	// Update DDOperation in etcd
+	return c.setDdMsgSendFlag(true)
+}
+
 func (c *Core) checkFlushedSegmentsLoop() {
 	ticker := time.NewTicker(10 * time.Minute)
 	for {
@@ -509,6 +856,38 @@ func (c *Core) setMsgStreams() error {
 		return c.dmlChannels.BroadcastAll(channelNames, &msgPack)
 	}
 
+	c.SendDdAddFieldReq = func(ctx context.Context, req *internalpb.AddFieldRequest, channelNames []string) error {
+		msgPack := ms.MsgPack{}
+		baseMsg := ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Base.Timestamp,
+			EndTimestamp:   req.Base.Timestamp,
+			HashValues:     []uint32{0},
+		}
+		msg := &ms.AddFieldMsg{
+			BaseMsg:         baseMsg,
+			AddFieldRequest: *req,
+		}
+		msgPack.Msgs = append(msgPack.Msgs, msg)
+		return c.dmlChannels.BroadcastAll(channelNames, &msgPack)
+	}
+
+	c.SendDdRenameCollectionReq = func(ctx context.Context, req *internalpb.RenameCollectionRequest, channelNames []string) error {
+		msgPack := ms.MsgPack{}
+		baseMsg := ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Base.Timestamp,
+			EndTimestamp:   req.Base.Timestamp,
+			HashValues:     []uint32{0},
+		}
+		msg := &ms.RenameCollectionMsg{
+			BaseMsg:                 baseMsg,
+			RenameCollectionRequest: *req,
+		}
+		msgPack.Msgs = append(msgPack.Msgs, msg)
+		return c.dmlChannels.BroadcastAll(channelNames, &msgPack)
+	}
+
 	c.SendDdCreatePartitionReq = func(ctx context.Context, req *internalpb.CreatePartitionRequest, channelNames []string) error {
 		msgPack := ms.MsgPack{}
 		baseMsg := ms.BaseMsg{
@@ -696,6 +1075,60 @@ func (c *Core) SetDataCoord(ctx context.Context, s types.DataCoord) error {
 		return
 	}
 
+	c.CallGetSegmentInfoService = func(ctx context.Context, segIDs []typeutil.UniqueID) (retInfos []*datapb.SegmentInfo, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retInfos = nil
+				retErr = fmt.Errorf("get segment info from data coord panic, msg = %v", err)
+				return
+			}
+		}()
+		<-initCh
+		rsp, err := s.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   0, //TODO,msg type
+				MsgID:     0,
+				Timestamp: 0,
+				SourceID:  c.session.ServerID,
+			},
+			SegmentIDs: segIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("get segment info from data coord failed, reason = %s", rsp.Status.Reason)
+		}
+		return rsp.Infos, nil
+	}
+
+	c.CallFlushService = func(ctx context.Context, dbID, collID typeutil.UniqueID, channels []string) (retSegIDs []typeutil.UniqueID, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retSegIDs = nil
+				retErr = fmt.Errorf("flush collection panic, msg = %v", err)
+				return
+			}
+		}()
+		<-initCh
+		rsp, err := s.Flush(ctx, &datapb.FlushRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Flush,
+				SourceID: c.session.ServerID,
+			},
+			DbID:         dbID,
+			CollectionID: collID,
+			Channels:     channels,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("flush collection from data coord failed, reason = %s", rsp.Status.Reason)
+		}
+		return rsp.SegmentIDs, nil
+	}
+
 	return nil
 }
 
@@ -815,6 +1248,33 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 		retErr = nil
 		return
 	}
+	c.CallReshardCollectionService = func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, retiredChannels []string) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("reshard collection from query service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &querypb.ReshardCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ReshardCollection,
+				MsgID:     0, //TODO, msg ID
+				Timestamp: ts,
+				SourceID:  c.session.ServerID,
+			},
+			DbID:            dbID,
+			CollectionID:    collectionID,
+			RetiredChannels: retiredChannels,
+		}
+		rsp, err := s.ReshardCollection(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("ReshardCollection from query service failed, error = %s", rsp.Reason)
+		}
+		return nil
+	}
 	c.CallReleasePartitionService = func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) (retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -906,6 +1366,14 @@ func (c *Core) Init() error {
 			if c.kvBase, initError = etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.KvRootPath); initError != nil {
 				return initError
 			}
+			var metaKV *etcdkv.EtcdKV
+			if metaKV, initError = etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath); initError != nil {
+				return initError
+			}
+			if initError = c.MetaTable.initCredential(metaKV); initError != nil {
+				return initError
+			}
+			c.ddlAudit = newDDLAuditLog(metaKV)
 
 			return nil
 		}
@@ -946,6 +1414,15 @@ func (c *Core) Init() error {
 			return tsoAllocator.UpdateTSO()
 		}
 
+		log.Debug("RootCoord, init default database")
+		var ts typeutil.Timestamp
+		if ts, initError = c.TSOAllocator(1); initError != nil {
+			return
+		}
+		if initError = c.MetaTable.initDefaultDatabase(ts); initError != nil {
+			return
+		}
+
 		m := map[string]interface{}{
 			"PulsarAddress":  Params.PulsarAddress,
 			"ReceiveBufSize": 1024,
@@ -1011,7 +1488,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1023,7 +1500,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1042,16 +1519,16 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		}
 		c.proxyClientManager.InvalidateCollectionMetaCache(c.ctx, &req)
 
-	case CreatePartitionDDType:
-		var ddReq = internalpb.CreatePartitionRequest{}
+	case AddFieldDDType:
+		var ddReq = internalpb.AddFieldRequest{}
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
-		if err = c.SendDdCreatePartitionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
+		if err = c.SendDdAddFieldReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
 			return err
 		}
 		req := proxypb.InvalidateCollMetaCacheRequest{
@@ -1065,21 +1542,69 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 			CollectionName: ddReq.CollectionName,
 		}
 		c.proxyClientManager.InvalidateCollectionMetaCache(c.ctx, &req)
-	case DropPartitionDDType:
-		var ddReq = internalpb.DropPartitionRequest{}
+	case RenameCollectionDDType:
+		var ddReq = internalpb.RenameCollectionRequest{}
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByID(ddReq.CollectionID, 0)
 		if err != nil {
 			return err
 		}
-		if err = c.SendDdDropPartitionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
+		if err = c.SendDdRenameCollectionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
 			return err
 		}
-		req := proxypb.InvalidateCollMetaCacheRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:   0, //TODO, msg type
+		for _, collName := range []string{ddReq.OldName, ddReq.NewName} {
+			req := proxypb.InvalidateCollMetaCacheRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:   0, //TODO, msg type
+					MsgID:     0, //TODO, msg id
+					Timestamp: ddReq.Base.Timestamp,
+					SourceID:  c.session.ServerID,
+				},
+				DbName:         ddReq.DbName,
+				CollectionName: collName,
+			}
+			c.proxyClientManager.InvalidateCollectionMetaCache(c.ctx, &req)
+		}
+	case CreatePartitionDDType:
+		var ddReq = internalpb.CreatePartitionRequest{}
+		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
+			return err
+		}
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
+		if err != nil {
+			return err
+		}
+		if err = c.SendDdCreatePartitionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
+			return err
+		}
+		req := proxypb.InvalidateCollMetaCacheRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   0, //TODO, msg type
+				MsgID:     0, //TODO, msg id
+				Timestamp: ddReq.Base.Timestamp,
+				SourceID:  c.session.ServerID,
+			},
+			DbName:         ddReq.DbName,
+			CollectionName: ddReq.CollectionName,
+		}
+		c.proxyClientManager.InvalidateCollectionMetaCache(c.ctx, &req)
+	case DropPartitionDDType:
+		var ddReq = internalpb.DropPartitionRequest{}
+		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
+			return err
+		}
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
+		if err != nil {
+			return err
+		}
+		if err = c.SendDdDropPartitionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
+			return err
+		}
+		req := proxypb.InvalidateCollMetaCacheRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   0, //TODO, msg type
 				MsgID:     0, //TODO, msg id
 				Timestamp: ddReq.Base.Timestamp,
 				SourceID:  c.session.ServerID,
@@ -1119,6 +1644,9 @@ func (c *Core) Start() error {
 		go c.sessionLoop()
 		go c.chanTimeTick.StartWatch()
 		go c.checkFlushedSegmentsLoop()
+		go c.metaSnapshotGCLoop()
+		go c.dropGCLoop()
+		go c.retiredChannelsLoop()
 		c.stateCode.Store(internalpb.StateCode_Healthy)
 	})
 	log.Debug(typeutil.RootCoordRole, zap.String("State Code", internalpb.StateCode_name[int32(internalpb.StateCode_Healthy)]))
@@ -1373,6 +1901,214 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 	return t.Rsp, nil
 }
 
+func (c *Core) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	metrics.RootCoordAddFieldCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AddField", zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()), zap.Int64("msgID", in.Base.MsgID))
+	t := &AddFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AddField Failed", zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()), zap.Int64("msgID", in.Base.MsgID))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "add field failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AddField Success", zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordAddFieldCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordRenameCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("RenameCollection", zap.String("old name", in.OldName), zap.String("new name", in.NewName), zap.Int64("msgID", in.Base.MsgID))
+	t := &RenameCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("RenameCollection Failed", zap.String("old name", in.OldName), zap.String("new name", in.NewName), zap.Int64("msgID", in.Base.MsgID))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "rename collection failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("RenameCollection Success", zap.String("old name", in.OldName), zap.String("new name", in.NewName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordRenameCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordAlterCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AlterCollection", zap.String("collection name", in.CollectionName), zap.Bool("deletion protection", in.DeletionProtection), zap.Int64("msgID", in.Base.MsgID))
+	t := &AlterCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AlterCollection Failed", zap.String("collection name", in.CollectionName), zap.Bool("deletion protection", in.DeletionProtection), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "alter collection failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AlterCollection Success", zap.String("collection name", in.CollectionName), zap.Bool("deletion protection", in.DeletionProtection), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordAlterCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListDroppedCollections(ctx context.Context, in *milvuspb.ListDroppedCollectionsRequest) (*milvuspb.ListDroppedCollectionsResponse, error) {
+	metrics.RootCoordListDroppedCollectionsCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListDroppedCollections", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDroppedCollectionsReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDroppedCollectionsResponse{
+			CollectionNames:   []string{},
+			CollectionIds:     []int64{},
+			DroppedTimestamps: []uint64{},
+		},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListDroppedCollections failed", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDroppedCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "list dropped collections failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListDroppedCollections Success", zap.String("db name", in.DbName), zap.Strings("collection names", t.Rsp.CollectionNames), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordListDroppedCollectionsCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordRecoverCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("RecoverCollection", zap.String("db name", in.DbName), zap.Int64("collection id", in.CollectionID), zap.Int64("msgID", in.Base.MsgID))
+	t := &RecoverCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("RecoverCollection Failed", zap.String("db name", in.DbName), zap.Int64("collection id", in.CollectionID), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "recover collection failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("RecoverCollection Success", zap.String("db name", in.DbName), zap.Int64("collection id", in.CollectionID), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordRecoverCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ReshardCollection(ctx context.Context, in *milvuspb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordReshardCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("ReshardCollection", zap.String("collection name", in.CollectionName), zap.Int32("shards num", in.ShardsNum), zap.Int64("msgID", in.Base.MsgID))
+	t := &ReshardCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ReshardCollection Failed", zap.String("collection name", in.CollectionName), zap.Int32("shards num", in.ShardsNum), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "reshard collection failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("ReshardCollection Success", zap.String("collection name", in.CollectionName), zap.Int32("shards num", in.ShardsNum), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordReshardCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	metrics.RootCoordCreatePartitionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
 	code := c.stateCode.Load().(internalpb.StateCode)
@@ -1531,6 +2267,556 @@ func (c *Core) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRe
 	return t.Rsp, nil
 }
 
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	metrics.RootCoordCreateDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateDatabase", zap.String("name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateDatabase failed", zap.String("name", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "create database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateDatabase Success", zap.String("name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordCreateDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDropDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropDatabase", zap.String("name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropDatabase failed", zap.String("name", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "drop database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropDatabase Success", zap.String("name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordDropDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	metrics.RootCoordListDatabasesCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases", zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDatabasesReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{
+			DbNames:          []string{},
+			CreatedTimestamp: []uint64{},
+		},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListDatabases failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "list databases failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases Success", zap.Strings("db names", t.Rsp.DbNames), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordListDatabasesCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) ListDDLHistory(ctx context.Context, in *milvuspb.ListDDLHistoryRequest) (*milvuspb.ListDDLHistoryResponse, error) {
+	metrics.RootCoordListDDLHistoryCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDDLHistoryResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListDDLHistory", zap.String("db name", in.DbName), zap.String("collection name", in.CollectionName),
+		zap.Uint64("since ts", in.SinceTs), zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDDLHistoryReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDDLHistoryResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListDDLHistory failed", zap.String("db name", in.DbName), zap.String("collection name", in.CollectionName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDDLHistoryResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "list ddl history failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListDDLHistory Success", zap.String("db name", in.DbName), zap.String("collection name", in.CollectionName),
+		zap.Int("num records", len(t.Rsp.Records)), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordListDDLHistoryCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+// expireCredCache notifies proxies to drop the cached credential of a user
+func (c *Core) expireCredCache(ctx context.Context, username string) {
+	req := proxypb.InvalidateCredCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: 0,
+			SourceID:  c.session.ServerID,
+		},
+		Username: username,
+	}
+	c.proxyClientManager.InvalidateCredentialCache(ctx, &req)
+}
+
+// refreshPolicyInfoCache notifies proxies to reload the roles of users and the grants of roles
+func (c *Core) refreshPolicyInfoCache(ctx context.Context) {
+	req := proxypb.RefreshPolicyInfoCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: 0,
+			SourceID:  c.session.ServerID,
+		},
+	}
+	c.proxyClientManager.RefreshPolicyInfoCache(ctx, &req)
+}
+
+func (c *Core) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	metrics.RootCoordCreateCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateCredential", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	err := func() error {
+		if in.Username == "" || in.Password == "" {
+			return fmt.Errorf("username and password should not be empty")
+		}
+		encrypted, err := crypto.PasswordEncrypt(in.Password)
+		if err != nil {
+			return err
+		}
+		return c.MetaTable.AddCredential(&etcdpb.CredentialInfo{Username: in.Username, EncryptedPassword: encrypted})
+	}()
+	if err != nil {
+		log.Debug("CreateCredential failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "create credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateCredential Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordCreateCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	metrics.RootCoordUpdateCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("UpdateCredential", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	err := func() error {
+		cred, err := c.MetaTable.GetCredential(in.Username)
+		if err != nil {
+			return err
+		}
+		if !crypto.PasswordVerify(in.OldPassword, cred.EncryptedPassword) {
+			return fmt.Errorf("old password of user %s is incorrect", in.Username)
+		}
+		if in.NewPassword == "" {
+			return fmt.Errorf("new password should not be empty")
+		}
+		encrypted, err := crypto.PasswordEncrypt(in.NewPassword)
+		if err != nil {
+			return err
+		}
+		cred.EncryptedPassword = encrypted
+		if err = c.MetaTable.AlterCredential(cred); err != nil {
+			return err
+		}
+		c.expireCredCache(ctx, in.Username)
+		return nil
+	}()
+	if err != nil {
+		log.Debug("UpdateCredential failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "update credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("UpdateCredential Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordUpdateCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDeleteCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DeleteCredential", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	err := c.MetaTable.DeleteCredential(in.Username)
+	if err == nil {
+		c.expireCredCache(ctx, in.Username)
+		c.refreshPolicyInfoCache(ctx)
+	}
+	if err != nil {
+		log.Debug("DeleteCredential failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "delete credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DeleteCredential Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordDeleteCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	metrics.RootCoordListUsersCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListUsers", zap.String("role", Params.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	rsp := &milvuspb.ListUsersResponse{
+		Usernames: c.MetaTable.ListCredentialUsers(),
+	}
+	var err error
+	if err != nil {
+		log.Debug("ListUsers failed", zap.String("role", Params.RoleName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "list users failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListUsers Success", zap.String("role", Params.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordListUsersCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return rsp, nil
+}
+
+func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	rsp := &rootcoordpb.GetCredentialResponse{
+		Username: in.Username,
+	}
+	cred, err := c.MetaTable.GetCredential(in.Username)
+	if err == nil {
+		rsp.EncryptedPassword = cred.EncryptedPassword
+	}
+	if err != nil {
+		log.Debug("GetCredential failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "get credential failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return rsp, nil
+}
+
+func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	metrics.RootCoordCreateRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateRole", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	err := func() error {
+		if in.RoleName == "" {
+			return fmt.Errorf("role name should not be empty")
+		}
+		return c.MetaTable.CreateRole(in.RoleName)
+	}()
+	if err != nil {
+		log.Debug("CreateRole failed", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "create role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateRole Success", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordCreateRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDropRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropRole", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	err := c.MetaTable.DropRole(in.RoleName)
+	if err == nil {
+		c.refreshPolicyInfoCache(ctx)
+	}
+	if err != nil {
+		log.Debug("DropRole failed", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "drop role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropRole Success", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordDropRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	metrics.RootCoordOperateUserRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("OperateUserRole", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	err := c.MetaTable.OperateUserRole(in.Username, in.RoleName, in.Type)
+	if err == nil {
+		c.refreshPolicyInfoCache(ctx)
+	}
+	if err != nil {
+		log.Debug("OperateUserRole failed", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "operate user role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("OperateUserRole Success", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordOperateUserRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	metrics.RootCoordOperatePrivilegeCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("OperatePrivilege", zap.Any("entity", in.Entity), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	err := func() error {
+		if in.Entity == nil {
+			return fmt.Errorf("grant entity should not be empty")
+		}
+		if in.Entity.ObjectName == "" {
+			return fmt.Errorf("object name should not be empty")
+		}
+		return c.MetaTable.OperatePrivilege(in.Entity, in.Type)
+	}()
+	if err == nil {
+		c.refreshPolicyInfoCache(ctx)
+	}
+	if err != nil {
+		log.Debug("OperatePrivilege failed", zap.Any("entity", in.Entity), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "operate privilege failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("OperatePrivilege Success", zap.Any("entity", in.Entity), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordOperatePrivilegeCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	metrics.RootCoordSelectGrantCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("SelectGrant", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	rsp := &milvuspb.SelectGrantResponse{}
+	entities, err := c.MetaTable.SelectGrant(in.RoleName)
+	if err == nil {
+		rsp.Entities = entities
+	}
+	if err != nil {
+		log.Debug("SelectGrant failed", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "select grant failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("SelectGrant Success", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordSelectGrantCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return rsp, nil
+}
+
+func (c *Core) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListPolicy", zap.String("role", Params.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	rsp := &rootcoordpb.ListPolicyResponse{}
+	rsp.UserRoles, rsp.Grants = c.MetaTable.ListPolicy()
+	var err error
+	if err != nil {
+		log.Debug("ListPolicy failed", zap.String("role", Params.RoleName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &rootcoordpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "list policy failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListPolicy Success", zap.String("role", Params.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return rsp, nil
+}
+
 func (c *Core) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	metrics.RootCoordCreateIndexCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
 	code := c.stateCode.Load().(internalpb.StateCode)
@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand"
 	"path"
 	"sync"
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -67,9 +69,10 @@ func (p *proxyMock) GetCollArray() []string {
 
 type dataMock struct {
 	types.DataCoord
-	randVal int
-	mu      sync.Mutex
-	segs    []typeutil.UniqueID
+	randVal       int
+	mu            sync.Mutex
+	segs          []typeutil.UniqueID
+	flushChannels []string
 }
 
 func (d *dataMock) Init() error {
@@ -132,10 +135,25 @@ func (d *dataMock) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushe
 	return rsp, nil
 }
 
+func (d *dataMock) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+	d.mu.Lock()
+	d.flushChannels = req.Channels
+	d.mu.Unlock()
+	return &datapb.FlushResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		DbID:         req.DbID,
+		CollectionID: req.CollectionID,
+	}, nil
+}
+
 type queryMock struct {
 	types.QueryCoord
-	collID []typeutil.UniqueID
-	mutex  sync.Mutex
+	collID          []typeutil.UniqueID
+	retiredChannels []string
+	mutex           sync.Mutex
 }
 
 func (q *queryMock) Init() error {
@@ -156,6 +174,17 @@ func (q *queryMock) ReleaseCollection(ctx context.Context, req *querypb.ReleaseC
 	}, nil
 }
 
+func (q *queryMock) ReshardCollection(ctx context.Context, req *querypb.ReshardCollectionRequest) (*commonpb.Status, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.collID = append(q.collID, req.CollectionID)
+	q.retiredChannels = req.RetiredChannels
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (q *queryMock) ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -420,7 +449,7 @@ func TestRootCoord(t *testing.T) {
 		assert.True(t, ok)
 		createMsg, ok := (msgPack.Msgs[0]).(*msgstream.CreateCollectionMsg)
 		assert.True(t, ok)
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, createMeta.ID, createMsg.CollectionID)
 		assert.Equal(t, 1, len(createMeta.PartitionIDs))
@@ -549,7 +578,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -604,7 +633,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -658,7 +687,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -679,7 +708,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -721,7 +750,7 @@ func TestRootCoord(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -732,7 +761,7 @@ func TestRootCoord(t *testing.T) {
 		files := im.getFileArray()
 		assert.Equal(t, 3, len(files))
 		assert.ElementsMatch(t, files, []string{"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -746,7 +775,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -806,7 +835,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -862,7 +891,7 @@ func TestRootCoord(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		oldIdx := collMeta.FieldIndexes[0].IndexID
@@ -872,7 +901,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.FieldIndexes))
 		assert.Equal(t, oldIdx, collMeta.FieldIndexes[0].IndexID)
@@ -900,7 +929,7 @@ func TestRootCoord(t *testing.T) {
 			FieldName:      "vector",
 			IndexName:      Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName("", collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -913,7 +942,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName("", collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -930,18 +959,25 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
 		assert.Nil(t, err)
 		assert.Equal(t, Params.DefaultPartitionName, partName)
+		assert.Equal(t, []int64{dropPartID}, collMeta.DroppedPartitionIDs)
+
+		// the partition is soft dropped, the drop partition msg is sent when it's purged
+		core.purgeExpiredDrops(math.MaxUint64)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(collMeta.DroppedPartitionIDs))
 
 		msgs := getNotTtMsg(ctx, 1, dmlStream.Chan())
 		assert.Equal(t, 1, len(msgs))
@@ -982,7 +1018,7 @@ func TestRootCoord(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.Nil(t, err)
@@ -991,6 +1027,20 @@ func TestRootCoord(t *testing.T) {
 		vChanName := collMeta.VirtualChannelNames[0]
 		assert.Equal(t, collMeta.PhysicalChannelNames[0], ToPhysicalChannel(vChanName))
 
+		// the collection is soft dropped, the drop collection msg is sent when it's purged
+		rsp, err := core.ListDroppedCollections(ctx, &milvuspb.ListDroppedCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ListDroppedCollections,
+				SourceID: 230,
+			},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, []string{collName}, rsp.CollectionNames)
+		assert.Equal(t, []int64{collMeta.ID}, rsp.CollectionIds)
+		core.purgeExpiredDrops(math.MaxUint64)
+
 		msgs := getNotTtMsg(ctx, 1, dmlStream.Chan())
 		assert.Equal(t, 1, len(msgs))
 		dmsg, ok := (msgs[0]).(*msgstream.DropCollectionMsg)
@@ -1041,6 +1091,94 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, collMeta.ID, ddReq.CollectionID)
 	})
 
+	t.Run("reshard collection", func(t *testing.T) {
+		const reshardCollName = "reshardColl"
+		schema := schemapb.CollectionSchema{
+			Name: reshardCollName,
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:  100,
+					Name:     "vector",
+					DataType: schemapb.DataType_FloatVector,
+				},
+			},
+		}
+		sbf, err := proto.Marshal(&schema)
+		assert.Nil(t, err)
+		status, err := core.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateCollection,
+				MsgID:     240,
+				Timestamp: 240,
+				SourceID:  240,
+			},
+			CollectionName: reshardCollName,
+			Schema:         sbf,
+			ShardsNum:      1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		collMeta, err := core.MetaTable.GetCollectionByName("", reshardCollName, 0)
+		assert.Nil(t, err)
+
+		req := &milvuspb.ReshardCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ReshardCollection,
+				MsgID:     241,
+				Timestamp: 241,
+				SourceID:  241,
+			},
+			CollectionName: reshardCollName,
+			ShardsNum:      1,
+		}
+		status, err = core.ReshardCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		req.ShardsNum = 2
+		status, err = core.ReshardCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		newMeta, err := core.MetaTable.GetCollectionByName("", reshardCollName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(newMeta.VirtualChannelNames))
+		assert.Equal(t, collMeta.VirtualChannelNames, newMeta.RetiredVirtualChannelNames)
+		channels := core.dmlChannels.ListChannels()
+		for _, pchan := range AllPhysicalChannels(newMeta) {
+			assert.True(t, funcutil.SliceContain(channels, pchan))
+		}
+		// the collection is not released, query nodes watch the new channels
+		qm.mutex.Lock()
+		assert.Equal(t, collMeta.ID, qm.collID[len(qm.collID)-1])
+		assert.Equal(t, 0, len(qm.retiredChannels))
+		qm.mutex.Unlock()
+		// only the segments on the retired channels are sealed
+		dm.mu.Lock()
+		assert.Equal(t, collMeta.VirtualChannelNames, dm.flushChannels)
+		dm.mu.Unlock()
+
+		// the collection can't be resharded before the retired channels are released
+		req.ShardsNum = 3
+		status, err = core.ReshardCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		err = core.releaseRetiredChannels(newMeta)
+		assert.Nil(t, err)
+		// the retired channels are handed off before they are released
+		qm.mutex.Lock()
+		assert.Equal(t, collMeta.VirtualChannelNames, qm.retiredChannels)
+		qm.mutex.Unlock()
+		err = core.releaseRetiredChannels(newMeta)
+		assert.Nil(t, err)
+		newMeta, err = core.MetaTable.GetCollectionByName("", reshardCollName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(newMeta.RetiredVirtualChannelNames))
+		assert.Equal(t, 0, len(core.MetaTable.ListReshardingCollections()))
+		assert.False(t, funcutil.SliceContain(core.dmlChannels.ListChannels(), collMeta.PhysicalChannelNames[0]))
+	})
+
 	t.Run("context_cancel", func(t *testing.T) {
 		ctx2, cancel2 := context.WithTimeout(ctx, time.Millisecond*100)
 		defer cancel2()
@@ -1849,6 +1987,18 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
+	c.SendDdAddFieldReq = func(context.Context, *internalpb.AddFieldRequest, []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.SendDdRenameCollectionReq = func(context.Context, *internalpb.RenameCollectionRequest, []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
 	c.SendDdCreatePartitionReq = func(context.Context, *internalpb.CreatePartitionRequest, []string) error {
 		return nil
 	}
@@ -1879,6 +2029,18 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
+	c.CallGetSegmentInfoService = func(ctx context.Context, segIDs []typeutil.UniqueID) ([]*datapb.SegmentInfo, error) {
+		return nil, nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallFlushService = func(ctx context.Context, dbID, collID typeutil.UniqueID, channels []string) ([]typeutil.UniqueID, error) {
+		return nil, nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
 	c.CallBuildIndexService = func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error) {
 		return 0, nil
 	}
@@ -1907,5 +2069,96 @@ func TestCheckInit(t *testing.T) {
 		return nil
 	}
 	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallReshardCollectionService = func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, retiredChannels []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.Nil(t, err)
+}
+
+func TestWaitSegmentsFlushed(t *testing.T) {
+	state := commonpb.SegmentState_Sealed
+	var mu sync.Mutex
+	c := &Core{}
+	c.CallGetSegmentInfoService = func(ctx context.Context, segIDs []typeutil.UniqueID) ([]*datapb.SegmentInfo, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		infos := make([]*datapb.SegmentInfo, 0, len(segIDs))
+		for _, segID := range segIDs {
+			infos = append(infos, &datapb.SegmentInfo{ID: segID, State: state})
+		}
+		return infos, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := c.waitSegmentsFlushed(ctx, []typeutil.UniqueID{1, 2})
+	assert.NotNil(t, err)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		mu.Lock()
+		state = commonpb.SegmentState_Flushed
+		mu.Unlock()
+	}()
+	err = c.waitSegmentsFlushed(context.Background(), []typeutil.UniqueID{1, 2})
+	assert.Nil(t, err)
+
+	c.CallGetSegmentInfoService = func(ctx context.Context, segIDs []typeutil.UniqueID) ([]*datapb.SegmentInfo, error) {
+		return nil, fmt.Errorf("mock error")
+	}
+	err = c.waitSegmentsFlushed(context.Background(), []typeutil.UniqueID{1, 2})
+	assert.NotNil(t, err)
+}
+
+func TestCheckPartitionKeyDDL(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64},
+		},
+	}
+	assert.Nil(t, checkPartitionKeyDDL(schema, "create"))
+
+	schema.Fields[1].IsPartitionKey = true
+	schema.NumPartitions = 4
+	assert.NotNil(t, checkPartitionKeyDDL(schema, "create"))
+	assert.NotNil(t, checkPartitionKeyDDL(schema, "drop"))
+}
+
+func TestCheckAddedField(t *testing.T) {
+	err := checkAddedField(nil)
+	assert.NotNil(t, err)
+
+	err = checkAddedField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64})
+	assert.NotNil(t, err)
+
+	err = checkAddedField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true, IsPrimaryKey: true})
+	assert.NotNil(t, err)
+
+	err = checkAddedField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true, IsPartitionKey: true})
+	assert.NotNil(t, err)
+
+	err = checkAddedField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector, Nullable: true})
+	assert.NotNil(t, err)
+
+	err = checkAddedField(&schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+	})
+	assert.NotNil(t, err)
+
+	err = checkAddedField(&schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 1}},
+	})
+	assert.Nil(t, err)
+
+	err = checkAddedField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Double, Nullable: true})
 	assert.Nil(t, err)
 }
@@ -100,6 +100,25 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		t.Req.ShardsNum = defaultShardsNum
 	}
 
+	keyField, err := typeutil.GetPartitionKeyField(&schema)
+	if err != nil {
+		return err
+	}
+	numPartitions := int64(1)
+	if keyField != nil {
+		numPartitions = t.Req.NumPartitions
+		if numPartitions <= 0 {
+			numPartitions = Params.DefaultPartitionKeyNum
+		}
+		if numPartitions > Params.MaxPartitionNum {
+			return fmt.Errorf("maximum partition's number should be limit to %d", Params.MaxPartitionNum)
+		}
+		// the proxy hashes the key against the number kept in the schema
+		schema.NumPartitions = numPartitions
+	} else {
+		schema.NumPartitions = 0
+	}
+
 	for idx, field := range schema.Fields {
 		field.FieldID = int64(idx + StartOfUserFieldID)
 	}
@@ -119,19 +138,38 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	}
 	schema.Fields = append(schema.Fields, rowIDField, timeStampField)
 
+	dbInfo, err := t.core.MetaTable.GetDatabaseByName(t.Req.DbName)
+	if err != nil {
+		return err
+	}
+
 	collID, _, err := t.core.IDAllocator(1)
 	if err != nil {
 		return fmt.Errorf("alloc collection id error = %w", err)
 	}
-	partID, _, err := t.core.IDAllocator(1)
+	partID, _, err := t.core.IDAllocator(uint32(numPartitions))
 	if err != nil {
 		return fmt.Errorf("alloc partition id error = %w", err)
 	}
 
+	// a collection with partition key owns numPartitions hidden partitions instead of the default one,
+	// the proxy routes rows to them by hashing the key
+	partIDs := []typeutil.UniqueID{partID}
+	partNames := []string{Params.DefaultPartitionName}
+	if keyField != nil {
+		partIDs = make([]typeutil.UniqueID, numPartitions)
+		partNames = make([]string, numPartitions)
+		for i := range partIDs {
+			partIDs[i] = partID + typeutil.UniqueID(i)
+			partNames[i] = typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, i)
+		}
+	}
+
 	log.Debug("collection name -> id",
 		zap.String("collection name", t.Req.CollectionName),
 		zap.Int64("collection_id", collID),
-		zap.Int64("default partition id", partID))
+		zap.Int64("default partition id", partID),
+		zap.Int64("num partitions", numPartitions))
 
 	vchanNames := make([]string, t.Req.ShardsNum)
 	chanNames := make([]string, t.Req.ShardsNum)
@@ -142,13 +180,16 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
+		DbID:                       dbInfo.ID,
 		Schema:                     &schema,
-		PartitionIDs:               []typeutil.UniqueID{partID},
-		PartitionNames:             []string{Params.DefaultPartitionName},
+		PartitionIDs:               partIDs,
+		PartitionNames:             partNames,
 		FieldIndexes:               make([]*etcdpb.FieldIndexInfo, 0, 16),
 		VirtualChannelNames:        vchanNames,
 		PhysicalChannelNames:       chanNames,
-		PartitionCreatedTimestamps: []uint64{0},
+		PartitionCreatedTimestamps: make([]uint64, len(partIDs)),
+		ConsistencyLevel:           t.Req.ConsistencyLevel,
+		DeletionProtection:         t.Req.DeletionProtection,
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -164,10 +205,10 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		Base:                 t.Req.Base,
 		DbName:               t.Req.DbName,
 		CollectionName:       t.Req.CollectionName,
-		PartitionName:        Params.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
+		PartitionName:        partNames[0],
+		DbID:                 dbInfo.ID,
 		CollectionID:         collID,
-		PartitionID:          partID,
+		PartitionID:          partIDs[0],
 		Schema:               schemaBytes,
 		VirtualChannelNames:  vchanNames,
 		PhysicalChannelNames: chanNames,
@@ -218,6 +259,7 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.core.recordDDL(ctx, CreateCollectionDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
 
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
@@ -237,7 +279,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -246,7 +288,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		Base:           t.Req.Base,
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
-		DbID:           0, //not used
+		DbID:           collMeta.DbID,
 		CollectionID:   collMeta.ID,
 	}
 
@@ -278,7 +320,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 			return err
 		}
 
-		err = t.core.SendDdDropCollectionReq(ctx, &ddReq, collMeta.PhysicalChannelNames)
+		err = t.core.SendDdDropCollectionReq(ctx, &ddReq, AllPhysicalChannels(collMeta))
 		if err != nil {
 			return err
 		}
@@ -287,17 +329,26 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		t.core.SendTimeTick(ts, reason)
 
 		// remove dml channel after send dd msg
-		t.core.dmlChannels.RemoveProducerChannels(collMeta.PhysicalChannelNames...)
+		t.core.dmlChannels.RemoveProducerChannels(AllPhysicalChannels(collMeta)...)
 		return nil
 	}
 
-	err = dropCollectionFn()
+	// keep the data and the dml channels of the collection in the recycle bin until it's purged
+	softDrop := Params.DropRetentionInHours > 0
+	if softDrop {
+		t.core.ddlLock.Lock()
+		err = t.core.MetaTable.SoftDeleteCollection(collMeta.ID, ts)
+		t.core.ddlLock.Unlock()
+	} else {
+		err = dropCollectionFn()
+	}
 	if err != nil {
 		return err
 	}
+	t.core.recordDDL(ctx, DropCollectionDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
 
 	//notify query service to release collection
-	if err = t.core.CallReleaseCollectionService(t.core.ctx, ts, 0, collMeta.ID); err != nil {
+	if err = t.core.CallReleaseCollectionService(t.core.ctx, ts, collMeta.DbID, collMeta.ID); err != nil {
 		log.Error("CallReleaseCollectionService failed", zap.String("error", err.Error()))
 		return err
 	}
@@ -315,6 +366,9 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	// error doesn't matter here
 	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 
+	if softDrop {
+		return nil
+	}
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
 }
@@ -333,7 +387,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -360,7 +414,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -383,10 +437,13 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 
 	t.Rsp.VirtualChannelNames = collInfo.VirtualChannelNames
 	t.Rsp.PhysicalChannelNames = collInfo.PhysicalChannelNames
+	t.Rsp.ShardsNum = int32(len(collInfo.VirtualChannelNames))
 
 	t.Rsp.CreatedTimestamp = collInfo.CreateTime
 	createdPhysicalTime, _ := tsoutil.ParseHybridTs(collInfo.CreateTime)
 	t.Rsp.CreatedUtcTimestamp = createdPhysicalTime
+	t.Rsp.ConsistencyLevel = collInfo.ConsistencyLevel
+	t.Rsp.DeletionProtection = collInfo.DeletionProtection
 
 	return nil
 }
@@ -405,7 +462,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -419,6 +476,255 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	return nil
 }
 
+// checkPartitionKeyDDL rejects creating or dropping partitions of a collection with partition key, the
+// rows are hashed to its hidden partitions by the number of partitions fixed at creation
+func checkPartitionKeyDDL(schema *schemapb.CollectionSchema, op string) error {
+	keyField, err := typeutil.GetPartitionKeyField(schema)
+	if err != nil {
+		return err
+	}
+	if keyField != nil {
+		return fmt.Errorf("can't %s partition of collection %s, its partitions are managed by the partition key", op, schema.GetName())
+	}
+	return nil
+}
+
+// checkAddedField checks the schema of a field added to an existing collection, only fixed size scalar
+// fields are supported, and the field must be nullable or have a default value which matches its type
+func checkAddedField(field *schemapb.FieldSchema) error {
+	if field == nil {
+		return fmt.Errorf("field schema is empty")
+	}
+	if field.IsPrimaryKey || field.AutoID || field.IsPartitionKey {
+		return fmt.Errorf("field %s can't be added as primary key, auto id or partition key field", field.Name)
+	}
+	if field.DefaultValue == nil && !field.Nullable {
+		return fmt.Errorf("field %s added to an existing collection must be nullable or have a default value", field.Name)
+	}
+	var match bool
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		_, match = field.DefaultValue.GetData().(*schemapb.ValueField_BoolData)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		_, match = field.DefaultValue.GetData().(*schemapb.ValueField_IntData)
+	case schemapb.DataType_Int64:
+		_, match = field.DefaultValue.GetData().(*schemapb.ValueField_LongData)
+	case schemapb.DataType_Float:
+		_, match = field.DefaultValue.GetData().(*schemapb.ValueField_FloatData)
+	case schemapb.DataType_Double:
+		_, match = field.DefaultValue.GetData().(*schemapb.ValueField_DoubleData)
+	default:
+		return fmt.Errorf("data type %s of field %s is not supported by add field", field.DataType.String(), field.Name)
+	}
+	if field.DefaultValue != nil && !match {
+		return fmt.Errorf("default value of field %s doesn't match data type %s", field.Name, field.DataType.String())
+	}
+	return nil
+}
+
+type AddFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddFieldRequest
+}
+
+func (t *AddFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *AddFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddField {
+		return fmt.Errorf("add field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if err := checkAddedField(t.Req.Field); err != nil {
+		return err
+	}
+
+	// lock for ddl operation
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+
+	// field ids are never reused, the added field takes the next id of the largest one
+	field := proto.Clone(t.Req.Field).(*schemapb.FieldSchema)
+	field.FieldID = StartOfUserFieldID
+	for _, f := range collMeta.Schema.Fields {
+		if f.FieldID >= field.FieldID {
+			field.FieldID = f.FieldID + 1
+		}
+	}
+	schema := proto.Clone(collMeta.Schema).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, field)
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("marshal schema error = %w", err)
+	}
+
+	ddReq := internalpb.AddFieldRequest{
+		Base:           t.Req.Base,
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		DbID:           collMeta.DbID,
+		CollectionID:   collMeta.ID,
+		Schema:         schemaBytes,
+		SchemaVersion:  collMeta.SchemaVersion + 1,
+	}
+
+	// build DdOperation and save it into etcd, when ddmsg send fail,
+	// system can restore ddmsg from etcd and re-send
+	ddOp := func(ts typeutil.Timestamp) (string, error) {
+		ddReq.Base.Timestamp = ts
+		return EncodeDdOperation(&ddReq, AddFieldDDType)
+	}
+
+	reason := fmt.Sprintf("add field %s", field.Name)
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	addFieldFn := func() error {
+		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+
+		err = t.core.MetaTable.AddField(collMeta.ID, field, ts, ddOp)
+		if err != nil {
+			return err
+		}
+
+		err = t.core.SendDdAddFieldReq(ctx, &ddReq, collMeta.PhysicalChannelNames)
+		if err != nil {
+			return err
+		}
+
+		t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+		t.core.SendTimeTick(ts, reason)
+		return nil
+	}
+
+	err = addFieldFn()
+	if err != nil {
+		return err
+	}
+	t.core.recordDDL(ctx, AddFieldDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
+
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+
+	// Update DDOperation in etcd
+	return t.core.setDdMsgSendFlag(true)
+}
+
+type RenameCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.RenameCollectionRequest
+}
+
+func (t *RenameCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *RenameCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RenameCollection {
+		return fmt.Errorf("rename collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.OldName == t.Req.NewName {
+		return fmt.Errorf("the new name of collection %s is the same as the old one", t.Req.OldName)
+	}
+
+	// lock for ddl operation
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.OldName, 0)
+	if err != nil {
+		return err
+	}
+
+	ddReq := internalpb.RenameCollectionRequest{
+		Base:         t.Req.Base,
+		DbName:       t.Req.DbName,
+		OldName:      t.Req.OldName,
+		NewName:      t.Req.NewName,
+		DbID:         collMeta.DbID,
+		CollectionID: collMeta.ID,
+	}
+
+	// build DdOperation and save it into etcd, when ddmsg send fail,
+	// system can restore ddmsg from etcd and re-send
+	ddOp := func(ts typeutil.Timestamp) (string, error) {
+		ddReq.Base.Timestamp = ts
+		return EncodeDdOperation(&ddReq, RenameCollectionDDType)
+	}
+
+	reason := fmt.Sprintf("rename collection %s to %s", t.Req.OldName, t.Req.NewName)
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	renameCollectionFn := func() error {
+		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+
+		err = t.core.MetaTable.RenameCollection(t.Req.DbName, t.Req.OldName, t.Req.NewName, ts, ddOp)
+		if err != nil {
+			return err
+		}
+
+		err = t.core.SendDdRenameCollectionReq(ctx, &ddReq, collMeta.PhysicalChannelNames)
+		if err != nil {
+			return err
+		}
+
+		t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+		t.core.SendTimeTick(ts, reason)
+		return nil
+	}
+
+	err = renameCollectionFn()
+	if err != nil {
+		return err
+	}
+	t.core.recordDDL(ctx, RenameCollectionDDType, ts, t.Req.Base, t.Req.DbName, t.Req.NewName, t.Req)
+
+	// proxies cache the collection meta by name, the entries of both names are stale now
+	for _, collName := range []string{t.Req.OldName, t.Req.NewName} {
+		req := proxypb.InvalidateCollMetaCacheRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   0, //TODO, msg type
+				MsgID:     0, //TODO, msg id
+				Timestamp: ts,
+				SourceID:  t.core.session.ServerID,
+			},
+			DbName:         t.Req.DbName,
+			CollectionName: collName,
+		}
+		// error doesn't matter here
+		t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+	}
+
+	// Update DDOperation in etcd
+	return t.core.setDdMsgSendFlag(true)
+}
+
 type CreatePartitionReqTask struct {
 	baseReqTask
 	Req *milvuspb.CreatePartitionRequest
@@ -432,10 +738,13 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
+	if err := checkPartitionKeyDDL(collMeta.Schema, "create"); err != nil {
+		return err
+	}
 	partID, _, err := t.core.IDAllocator(1)
 	if err != nil {
 		return err
@@ -446,7 +755,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, // todo, not used
+		DbID:           collMeta.DbID,
 		CollectionID:   collMeta.ID,
 		PartitionID:    partID,
 	}
@@ -493,6 +802,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.core.recordDDL(ctx, CreatePartitionDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
 
 	req := proxypb.InvalidateCollMetaCacheRequest{
 		Base: &commonpb.MsgBase{
@@ -524,10 +834,13 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
+	if err := checkPartitionKeyDDL(collInfo.Schema, "drop"); err != nil {
+		return err
+	}
 	partID, err := t.core.MetaTable.GetPartitionByName(collInfo.ID, t.Req.PartitionName, 0)
 	if err != nil {
 		return err
@@ -538,7 +851,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, //todo,not used
+		DbID:           collInfo.DbID,
 		CollectionID:   collInfo.ID,
 		PartitionID:    partID,
 	}
@@ -581,10 +894,19 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		return nil
 	}
 
-	err = dropPartitionFn()
+	// keep the data of the partition until it's purged
+	softDrop := Params.DropRetentionInHours > 0
+	if softDrop {
+		t.core.ddlLock.Lock()
+		_, err = t.core.MetaTable.SoftDeletePartition(collInfo.ID, t.Req.PartitionName, ts)
+		t.core.ddlLock.Unlock()
+	} else {
+		err = dropPartitionFn()
+	}
 	if err != nil {
 		return err
 	}
+	t.core.recordDDL(ctx, DropPartitionDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
 
 	req := proxypb.InvalidateCollMetaCacheRequest{
 		Base: &commonpb.MsgBase{
@@ -600,11 +922,14 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
 
 	//notify query service to release partition
-	if err = t.core.CallReleasePartitionService(t.core.ctx, ts, 0, collInfo.ID, []typeutil.UniqueID{partID}); err != nil {
+	if err = t.core.CallReleasePartitionService(t.core.ctx, ts, collInfo.DbID, collInfo.ID, []typeutil.UniqueID{partID}); err != nil {
 		log.Error("CallReleaseCollectionService failed", zap.String("error", err.Error()))
 		return err
 	}
 
+	if softDrop {
+		return nil
+	}
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
 }
@@ -623,7 +948,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -648,9 +973,9 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	var coll *etcdpb.CollectionInfo
 	var err error
 	if t.Req.CollectionName == "" {
-		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
+		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, t.Req.TimeStamp)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	}
 	if err != nil {
 		return err
@@ -777,7 +1102,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -791,7 +1116,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
@@ -822,6 +1147,11 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		}
 	}
 
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	t.core.recordDDL(ctx, CreateIndexDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
 	return nil
 }
 
@@ -839,7 +1169,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -873,7 +1203,7 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		log.Warn("GetIndexByName failed,", zap.String("collection name", t.Req.CollectionName), zap.String("field name", t.Req.FieldName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 		return err
@@ -889,6 +1219,327 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 	ts, _ := t.core.TSOAllocator(1)
-	_, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
-	return err
+	_, _, err = t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
+	if err != nil {
+		return err
+	}
+	t.core.recordDDL(ctx, DropIndexDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
+	return nil
+}
+
+type CreateDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+func (t *CreateDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateDatabase {
+		return fmt.Errorf("create database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.DbName == "" {
+		return fmt.Errorf("database name is empty")
+	}
+	dbID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc database id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	dbInfo := etcdpb.DatabaseInfo{
+		ID:   dbID,
+		Name: t.Req.DbName,
+	}
+	return t.core.MetaTable.AddDatabase(&dbInfo, ts)
+}
+
+type DropDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropDatabaseRequest
+}
+
+func (t *DropDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *DropDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropDatabase {
+		return fmt.Errorf("drop database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	return t.core.MetaTable.DeleteDatabase(t.Req.DbName, ts)
+}
+
+type ListDatabasesReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+func (t *ListDatabasesReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListDatabasesReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDatabases {
+		return fmt.Errorf("list databases, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for _, db := range t.core.MetaTable.ListDatabases() {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, db.Name)
+		t.Rsp.CreatedTimestamp = append(t.Rsp.CreatedTimestamp, db.CreateTime)
+	}
+	return nil
+}
+
+type ListDDLHistoryReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDDLHistoryRequest
+	Rsp *milvuspb.ListDDLHistoryResponse
+}
+
+func (t *ListDDLHistoryReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListDDLHistoryReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDDLHistory {
+		return fmt.Errorf("list ddl history, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	records, err := t.core.ddlAudit.List(t.Req.DbName, t.Req.CollectionName, t.Req.SinceTs)
+	if err != nil {
+		return err
+	}
+	t.Rsp.Records = records
+	return nil
+}
+
+type AlterCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.AlterCollectionRequest
+}
+
+func (t *AlterCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *AlterCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AlterCollection {
+		return fmt.Errorf("alter collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	t.core.ddlLock.Lock()
+	err = t.core.MetaTable.SetDeletionProtection(collMeta.ID, t.Req.DeletionProtection, ts)
+	t.core.ddlLock.Unlock()
+	if err != nil {
+		return err
+	}
+	t.core.recordDDL(ctx, AlterCollectionDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
+	return nil
+}
+
+type ListDroppedCollectionsReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDroppedCollectionsRequest
+	Rsp *milvuspb.ListDroppedCollectionsResponse
+}
+
+func (t *ListDroppedCollectionsReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListDroppedCollectionsReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDroppedCollections {
+		return fmt.Errorf("list dropped collections, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	colls, err := t.core.MetaTable.ListDroppedCollections(t.Req.DbName)
+	if err != nil {
+		return err
+	}
+	for _, coll := range colls {
+		t.Rsp.CollectionNames = append(t.Rsp.CollectionNames, coll.Schema.Name)
+		t.Rsp.CollectionIds = append(t.Rsp.CollectionIds, coll.ID)
+		t.Rsp.DroppedTimestamps = append(t.Rsp.DroppedTimestamps, coll.DropTime)
+	}
+	return nil
+}
+
+type RecoverCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.RecoverCollectionRequest
+}
+
+func (t *RecoverCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *RecoverCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RecoverCollection {
+		return fmt.Errorf("recover collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	t.core.ddlLock.Lock()
+	collMeta, err := t.core.MetaTable.RecoverCollection(t.Req.DbName, t.Req.CollectionID, ts)
+	t.core.ddlLock.Unlock()
+	if err != nil {
+		return err
+	}
+	t.core.recordDDL(ctx, RecoverCollectionDDType, ts, t.Req.Base, t.Req.DbName, collMeta.Schema.Name, t.Req)
+
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: collMeta.Schema.Name,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+	return nil
+}
+
+type ReshardCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.ReshardCollectionRequest
+}
+
+func (t *ReshardCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ReshardCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ReshardCollection {
+		return fmt.Errorf("reshard collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.ShardsNum <= 0 {
+		return fmt.Errorf("shards num should be positive, got %d", t.Req.ShardsNum)
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	if int(t.Req.ShardsNum) == len(collMeta.VirtualChannelNames) {
+		return fmt.Errorf("collection %s has %d shards already", t.Req.CollectionName, t.Req.ShardsNum)
+	}
+
+	reason := fmt.Sprintf("reshard collection %d", collMeta.ID)
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// the timestamp tells the new channels apart from the ones of previous reshards
+	vchanNames := make([]string, t.Req.ShardsNum)
+	chanNames := make([]string, t.Req.ShardsNum)
+	for i := int32(0); i < t.Req.ShardsNum; i++ {
+		vchanNames[i] = fmt.Sprintf("%s_%d_%d_%d_v", t.Req.CollectionName, collMeta.ID, ts, i)
+		chanNames[i] = ToPhysicalChannel(vchanNames[i])
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	reshardCollectionFn := func() error {
+		// lock for ddl operation
+		t.core.ddlLock.Lock()
+		defer t.core.ddlLock.Unlock()
+
+		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+
+		err = t.core.MetaTable.ReshardCollection(collMeta.ID, vchanNames, chanNames, ts)
+		if err != nil {
+			return err
+		}
+
+		// the retired channels are still produced, data nodes flush the segments on them
+		t.core.dmlChannels.AddProducerChannels(chanNames...)
+
+		t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+		t.core.SendTimeTick(ts, reason)
+		return nil
+	}
+
+	err = reshardCollectionFn()
+	if err != nil {
+		return err
+	}
+	t.core.recordDDL(ctx, ReshardCollectionDDType, ts, t.Req.Base, t.Req.DbName, t.Req.CollectionName, t.Req)
+
+	// query nodes of a loaded collection watch the new channels before the inserts are routed to them.
+	// The proxies are refreshed even if it fails, the new channels are watched again when the retired
+	// channels are released.
+	if err = t.core.CallReshardCollectionService(t.core.ctx, ts, collMeta.DbID, collMeta.ID, nil); err != nil {
+		log.Warn("CallReshardCollectionService failed", zap.Int64("collection id", collMeta.ID), zap.Error(err))
+	}
+
+	// proxies route the inserts to the new channels after the cache and the dml stream are dropped
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		CollectionID:   collMeta.ID,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+
+	// seal the segments on the retired channels, the channels are released after they are flushed.
+	// The channels are replaced already when any of the following fails, the sealing is retried by retiredChannelsLoop.
+	collMeta, err = t.core.MetaTable.GetCollectionByID(collMeta.ID, 0)
+	if err != nil {
+		return err
+	}
+	segIDs, err := t.core.sealRetiredSegments(collMeta)
+	if err != nil {
+		return fmt.Errorf("seal segments on retired channels failed, error = %w", err)
+	}
+	if len(segIDs) == 0 {
+		return nil
+	}
+	if ts, err = t.core.TSOAllocator(1); err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	t.core.ddlLock.Lock()
+	err = t.core.MetaTable.SetRetiredSegments(collMeta.ID, segIDs, ts)
+	t.core.ddlLock.Unlock()
+	if err != nil {
+		return err
+	}
+	// query nodes serve the data on the retired channels until they are flushed and handed off
+	go t.core.releaseRetiredChannelsAfterFlushed(collMeta.ID, segIDs)
+	return nil
 }
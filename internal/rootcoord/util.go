@@ -132,3 +132,10 @@ func ToPhysicalChannel(vchannel string) string {
 	}
 	return vchannel[:idx]
 }
+
+// AllPhysicalChannels returns the physical channels of a collection, including the retired ones of a reshard
+func AllPhysicalChannels(collMeta *etcdpb.CollectionInfo) []string {
+	chans := make([]string, 0, len(collMeta.PhysicalChannelNames)+len(collMeta.RetiredPhysicalChannelNames))
+	chans = append(chans, collMeta.PhysicalChannelNames...)
+	return append(chans, collMeta.RetiredPhysicalChannelNames...)
+}
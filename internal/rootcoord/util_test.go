@@ -98,3 +98,12 @@ func Test_ToPhysicalChannel(t *testing.T) {
 	assert.Equal(t, "abc__", ToPhysicalChannel("abc___defgsg"))
 	assert.Equal(t, "abcdef", ToPhysicalChannel("abcdef"))
 }
+
+func Test_AllPhysicalChannels(t *testing.T) {
+	coll := &etcdpb.CollectionInfo{
+		PhysicalChannelNames:        []string{"c_1", "c_2"},
+		RetiredPhysicalChannelNames: []string{"c_0"},
+	}
+	assert.Equal(t, []string{"c_1", "c_2", "c_0"}, AllPhysicalChannels(coll))
+	assert.Equal(t, []string{"c_1", "c_2"}, coll.PhysicalChannelNames)
+}
@@ -0,0 +1,336 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// BinlogDescriptor is the machine readable descriptor event of a binlog
+type BinlogDescriptor struct {
+	BinlogVersion   int16  `json:"binlog_version"`
+	ServerVersion   int64  `json:"server_version"`
+	CommitID        int64  `json:"commit_id"`
+	CollectionID    int64  `json:"collection_id"`
+	PartitionID     int64  `json:"partition_id"`
+	SegmentID       int64  `json:"segment_id"`
+	FieldID         int64  `json:"field_id"`
+	StartTimestamp  uint64 `json:"start_timestamp"`
+	EndTimestamp    uint64 `json:"end_timestamp"`
+	PayloadDataType string `json:"payload_data_type"`
+	Compression     string `json:"compression"`
+	KeyID           string `json:"key_id,omitempty"`
+}
+
+// BinlogEvent is the machine readable event of a binlog, Values are the payload values of the dumped rows, whose
+// indexes in the binlog start from FirstRow
+type BinlogEvent struct {
+	TypeCode       string        `json:"type_code"`
+	Timestamp      uint64        `json:"timestamp"`
+	EventLength    int32         `json:"event_length"`
+	NextPosition   int32         `json:"next_position"`
+	StartTimestamp uint64        `json:"start_timestamp"`
+	EndTimestamp   uint64        `json:"end_timestamp"`
+	Checksum       uint32        `json:"checksum"`
+	Rows           int           `json:"rows"`
+	FirstRow       int           `json:"first_row"`
+	Values         []interface{} `json:"values"`
+}
+
+// BinlogDump is the machine readable content of a binlog
+type BinlogDump struct {
+	Descriptor BinlogDescriptor `json:"descriptor"`
+	Events     []BinlogEvent    `json:"events"`
+}
+
+// BinlogSummary summarises the rows, timestamps and sizes of a binlog without decoding the payload values
+type BinlogSummary struct {
+	BinlogDescriptor
+	Events       int    `json:"events"`
+	Rows         int    `json:"rows"`
+	MinTimestamp uint64 `json:"min_timestamp"`
+	MaxTimestamp uint64 `json:"max_timestamp"`
+	Size         int    `json:"size"`
+	PayloadSize  int    `json:"payload_size"`
+}
+
+func newBinlogDescriptor(data *descriptorEventData) BinlogDescriptor {
+	return BinlogDescriptor{
+		BinlogVersion:   data.BinlogVersion,
+		ServerVersion:   data.ServerVersion,
+		CommitID:        data.CommitID,
+		CollectionID:    data.CollectionID,
+		PartitionID:     data.PartitionID,
+		SegmentID:       data.SegmentID,
+		FieldID:         data.FieldID,
+		StartTimestamp:  data.StartTimestamp,
+		EndTimestamp:    data.EndTimestamp,
+		PayloadDataType: data.PayloadDataType.String(),
+		Compression:     data.Compression.String(),
+		KeyID:           data.KeyID,
+	}
+}
+
+// eventTimestamps returns the start and end timestamp in the fixed part of an event
+func eventTimestamps(data eventData) (Timestamp, Timestamp, error) {
+	switch evd := data.(type) {
+	case *insertEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, nil
+	case *deleteEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, nil
+	case *createCollectionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, nil
+	case *dropCollectionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, nil
+	case *createPartitionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, nil
+	case *dropPartitionEventData:
+		return evd.StartTimestamp, evd.EndTimestamp, nil
+	default:
+		return 0, 0, errors.New("incorrect event data type")
+	}
+}
+
+// DumpBinlog decodes a binlog, only the payload values of the rows in [start, end) are decoded, a negative end
+// means to the last row
+func DumpBinlog(data []byte, start, end int) (*BinlogDump, error) {
+	if start < 0 {
+		return nil, fmt.Errorf("invalid start row %d", start)
+	}
+	r, err := NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	dump := &BinlogDump{
+		Descriptor: newBinlogDescriptor(&r.descriptorEvent.descriptorEventData),
+		Events:     []BinlogEvent{},
+	}
+	dataType := r.descriptorEvent.PayloadDataType
+	offset := 0
+	for {
+		event, err := r.NextEventReader()
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			break
+		}
+		startTs, endTs, err := eventTimestamps(event.eventData)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := event.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+
+		// the rows to dump in this event
+		from, to := start-offset, rows
+		if end >= 0 && end-offset < to {
+			to = end - offset
+		}
+		if from < 0 {
+			from = 0
+		}
+		values := []interface{}{}
+		if from < to {
+			values, err = readPayloadRows(event.TypeCode, dataType, event.PayloadReaderInterface, from, to)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		dump.Events = append(dump.Events, BinlogEvent{
+			TypeCode:       event.TypeCode.String(),
+			Timestamp:      event.eventHeader.Timestamp,
+			EventLength:    event.EventLength,
+			NextPosition:   event.NextPosition,
+			StartTimestamp: startTs,
+			EndTimestamp:   endTs,
+			Checksum:       event.GetChecksum(),
+			Rows:           rows,
+			FirstRow:       offset + from,
+			Values:         values,
+		})
+		offset += rows
+	}
+	return dump, nil
+}
+
+// SummarizeBinlog reads through a binlog and summarises its events
+func SummarizeBinlog(data []byte) (*BinlogSummary, error) {
+	r, err := NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	summary := &BinlogSummary{
+		BinlogDescriptor: newBinlogDescriptor(&r.descriptorEvent.descriptorEventData),
+		Size:             len(data),
+	}
+	for {
+		event, err := r.NextEventReader()
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			break
+		}
+		startTs, endTs, err := eventTimestamps(event.eventData)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := event.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+		if summary.Events == 0 || startTs < summary.MinTimestamp {
+			summary.MinTimestamp = startTs
+		}
+		if endTs > summary.MaxTimestamp {
+			summary.MaxTimestamp = endTs
+		}
+		summary.Events++
+		summary.Rows += rows
+		summary.PayloadSize += int(event.EventLength - event.eventHeader.GetMemoryUsageInBytes() - event.fixPartLength)
+	}
+	return summary, nil
+}
+
+// readPayloadRows returns the values of the rows in [from, to) of a payload, a binary vector is returned as a hex
+// string and a DDL request is returned as the decoded proto message
+func readPayloadRows(eventType EventTypeCode, dataType schemapb.DataType, reader PayloadReaderInterface, from, to int) ([]interface{}, error) {
+	values := make([]interface{}, 0, to-from)
+	switch dataType {
+	case schemapb.DataType_Bool:
+		val, err := reader.GetBoolFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val[from:to] {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int8:
+		val, err := reader.GetInt8FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val[from:to] {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int16:
+		val, err := reader.GetInt16FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val[from:to] {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int32:
+		val, err := reader.GetInt32FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val[from:to] {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int64:
+		val, err := reader.GetInt64FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val[from:to] {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Float:
+		val, err := reader.GetFloatFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val[from:to] {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Double:
+		val, err := reader.GetDoubleFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val[from:to] {
+			values = append(values, v)
+		}
+	case schemapb.DataType_String:
+		for i := from; i < to; i++ {
+			val, err := reader.GetOneStringFromPayload(i)
+			if err != nil {
+				return nil, err
+			}
+			if eventType == InsertEventType || eventType == DeleteEventType {
+				values = append(values, val)
+				continue
+			}
+			req, err := unmarshalDDLRequest(eventType, []byte(val))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, req)
+		}
+	case schemapb.DataType_BinaryVector:
+		val, dim, err := reader.GetBinaryVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		dim = dim / 8
+		for i := from; i < to; i++ {
+			values = append(values, hex.EncodeToString(val[i*dim:(i+1)*dim]))
+		}
+	case schemapb.DataType_FloatVector:
+		val, dim, err := reader.GetFloatVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for i := from; i < to; i++ {
+			values = append(values, val[i*dim:(i+1)*dim])
+		}
+	default:
+		return nil, errors.New("undefined data type")
+	}
+	return values, nil
+}
+
+func unmarshalDDLRequest(eventType EventTypeCode, data []byte) (proto.Message, error) {
+	var req proto.Message
+	switch eventType {
+	case CreateCollectionEventType:
+		req = &internalpb.CreateCollectionRequest{}
+	case DropCollectionEventType:
+		req = &internalpb.DropCollectionRequest{}
+	case CreatePartitionEventType:
+		req = &internalpb.CreatePartitionRequest{}
+	case DropPartitionEventType:
+		req = &internalpb.DropPartitionRequest{}
+	default:
+		return nil, fmt.Errorf("undefined ddl event type %d", eventType)
+	}
+	if err := proto.Unmarshal(data, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
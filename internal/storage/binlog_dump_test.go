@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestInsertBinlog(t *testing.T) []byte {
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	defer w.Close()
+	e1, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e1.AddDataToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	e1.SetEventTimestamp(100, 200)
+	e2, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e2.AddDataToPayload([]int64{4, 5, 6, 7})
+	assert.Nil(t, err)
+	e2.SetEventTimestamp(300, 400)
+	w.SetEventTimeStamp(100, 400)
+	err = w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+	return buf
+}
+
+func TestDumpBinlog(t *testing.T) {
+	buf := newTestInsertBinlog(t)
+
+	dump, err := DumpBinlog(buf, 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), dump.Descriptor.CollectionID)
+	assert.Equal(t, int64(20), dump.Descriptor.PartitionID)
+	assert.Equal(t, int64(30), dump.Descriptor.SegmentID)
+	assert.Equal(t, int64(40), dump.Descriptor.FieldID)
+	assert.Equal(t, "Int64", dump.Descriptor.PayloadDataType)
+	assert.Equal(t, 2, len(dump.Events))
+	assert.Equal(t, InsertEventType.String(), dump.Events[0].TypeCode)
+	assert.Equal(t, uint64(100), dump.Events[0].StartTimestamp)
+	assert.Equal(t, uint64(400), dump.Events[1].EndTimestamp)
+	assert.Equal(t, []interface{}{int64(1), int64(2), int64(3)}, dump.Events[0].Values)
+	assert.Equal(t, 3, dump.Events[1].FirstRow)
+	assert.Equal(t, []interface{}{int64(4), int64(5), int64(6), int64(7)}, dump.Events[1].Values)
+
+	dump, err = DumpBinlog(buf, 2, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, dump.Events[0].FirstRow)
+	assert.Equal(t, []interface{}{int64(3)}, dump.Events[0].Values)
+	assert.Equal(t, 3, dump.Events[1].FirstRow)
+	assert.Equal(t, []interface{}{int64(4), int64(5)}, dump.Events[1].Values)
+
+	dump, err = DumpBinlog(buf, 4, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, dump.Events[0].Rows)
+	assert.Equal(t, 0, len(dump.Events[0].Values))
+	assert.Equal(t, []interface{}{int64(5), int64(6), int64(7)}, dump.Events[1].Values)
+
+	_, err = DumpBinlog(buf, -1, -1)
+	assert.NotNil(t, err)
+	_, err = DumpBinlog(buf[:len(buf)-1], 0, -1)
+	assert.NotNil(t, err)
+}
+
+func TestDumpDDLBinlog(t *testing.T) {
+	w := NewDDLBinlogWriter(schemapb.DataType_String, 10)
+	defer w.Close()
+	e, err := w.NextCreateCollectionEventWriter()
+	assert.Nil(t, err)
+	req := &internalpb.CreateCollectionRequest{CollectionName: "test", CollectionID: 10}
+	data, err := proto.Marshal(req)
+	assert.Nil(t, err)
+	err = e.AddOneStringToPayload(string(data))
+	assert.Nil(t, err)
+	e.SetEventTimestamp(100, 200)
+	w.SetEventTimeStamp(100, 200)
+	err = w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+
+	dump, err := DumpBinlog(buf, 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(dump.Events))
+	assert.Equal(t, 1, len(dump.Events[0].Values))
+	decoded, ok := dump.Events[0].Values[0].(*internalpb.CreateCollectionRequest)
+	assert.True(t, ok)
+	assert.True(t, proto.Equal(req, decoded))
+}
+
+func TestSummarizeBinlog(t *testing.T) {
+	buf := newTestInsertBinlog(t)
+
+	summary, err := SummarizeBinlog(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(40), summary.FieldID)
+	assert.Equal(t, 2, summary.Events)
+	assert.Equal(t, 7, summary.Rows)
+	assert.Equal(t, uint64(100), summary.MinTimestamp)
+	assert.Equal(t, uint64(400), summary.MaxTimestamp)
+	assert.Equal(t, len(buf), summary.Size)
+	assert.Greater(t, summary.PayloadSize, 0)
+	assert.Less(t, summary.PayloadSize, summary.Size)
+
+	_, err = SummarizeBinlog(buf[:len(buf)-1])
+	assert.NotNil(t, err)
+}
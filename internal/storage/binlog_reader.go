@@ -26,6 +26,9 @@ type BinlogReader struct {
 	buffer    *bytes.Buffer
 	eventList []*EventReader
 	isClose   bool
+
+	keyManager *KeyManager
+	dataKey    []byte
 }
 
 func (reader *BinlogReader) NextEventReader() (*EventReader, error) {
@@ -35,7 +38,17 @@ func (reader *BinlogReader) NextEventReader() (*EventReader, error) {
 	if reader.buffer.Len() <= 0 {
 		return nil, nil
 	}
-	eventReader, err := newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer)
+	if reader.KeyID != "" && reader.dataKey == nil {
+		if reader.keyManager == nil {
+			return nil, fmt.Errorf("binlog is encrypted by master key %s, but encryption is not enabled", reader.KeyID)
+		}
+		dataKey, err := reader.keyManager.UnwrapDataKey(reader.KeyID, reader.WrappedKey)
+		if err != nil {
+			return nil, err
+		}
+		reader.dataKey = dataKey
+	}
+	eventReader, err := newEventReaderWithKey(reader.descriptorEvent.PayloadDataType, reader.buffer, &reader.descriptorEvent.descriptorEventData, reader.dataKey)
 	if err != nil {
 		return nil, err
 	}
@@ -77,10 +90,17 @@ func (reader *BinlogReader) Close() error {
 }
 
 func NewBinlogReader(data []byte) (*BinlogReader, error) {
+	return NewBinlogReaderWithKeyManager(data, nil)
+}
+
+// NewBinlogReaderWithKeyManager returns the BinlogReader which decrypts the payloads by the data key unwrapped by
+// keyManager, keyManager may be nil if the binlog isn't encrypted
+func NewBinlogReaderWithKeyManager(data []byte, keyManager *KeyManager) (*BinlogReader, error) {
 	reader := &BinlogReader{
-		buffer:    bytes.NewBuffer(data),
-		eventList: []*EventReader{},
-		isClose:   false,
+		buffer:     bytes.NewBuffer(data),
+		eventList:  []*EventReader{},
+		isClose:    false,
+		keyManager: keyManager,
 	}
 
 	if _, err := reader.readMagicNumber(); err != nil {
@@ -91,3 +111,19 @@ func NewBinlogReader(data []byte) (*BinlogReader, error) {
 	}
 	return reader, nil
 }
+
+// VerifyBinlog reads through all the events of a binlog, it returns an error if the binlog is truncated or
+// any event fails to pass the checksum verification. The payloads are not decoded, so the encrypted binlogs can
+// be verified without the keys.
+func VerifyBinlog(data []byte) error {
+	reader, err := NewBinlogReader(data)
+	if err != nil {
+		return err
+	}
+	for reader.buffer.Len() > 0 {
+		if _, _, err := readEvent(reader.buffer, &reader.descriptorEventData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -152,6 +152,10 @@ func TestInsertBinlog(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression
+	assert.Equal(t, CompressionNone, CompressionType(buf[pos]))
+	pos++
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -190,6 +194,11 @@ func TestInsertBinlog(t *testing.T) {
 	assert.Equal(t, e1et, int64(200))
 	pos += int(unsafe.Sizeof(e1et))
 
+	//insert e1 data, checksum
+	e1cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e1cs, int32(0))
+	pos += int(unsafe.Sizeof(e1cs))
+
 	//insert e1, payload
 	e1Payload := buf[pos:e1NxtPos]
 	e1r, err := NewPayloadReader(schemapb.DataType_Int64, e1Payload)
@@ -238,6 +247,11 @@ func TestInsertBinlog(t *testing.T) {
 	assert.Equal(t, e2et, int64(400))
 	pos += int(unsafe.Sizeof(e2et))
 
+	//insert e2 data, checksum
+	e2cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e2cs, int32(0))
+	pos += int(unsafe.Sizeof(e2cs))
+
 	//insert e2, payload
 	e2Payload := buf[pos:]
 	e2r, err := NewPayloadReader(schemapb.DataType_Int64, e2Payload)
@@ -408,6 +422,10 @@ func TestDeleteBinlog(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression
+	assert.Equal(t, CompressionNone, CompressionType(buf[pos]))
+	pos++
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -446,6 +464,11 @@ func TestDeleteBinlog(t *testing.T) {
 	assert.Equal(t, e1et, int64(200))
 	pos += int(unsafe.Sizeof(e1et))
 
+	//insert e1 data, checksum
+	e1cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e1cs, int32(0))
+	pos += int(unsafe.Sizeof(e1cs))
+
 	//insert e1, payload
 	e1Payload := buf[pos:e1NxtPos]
 	e1r, err := NewPayloadReader(schemapb.DataType_Int64, e1Payload)
@@ -494,6 +517,11 @@ func TestDeleteBinlog(t *testing.T) {
 	assert.Equal(t, e2et, int64(400))
 	pos += int(unsafe.Sizeof(e2et))
 
+	//insert e2 data, checksum
+	e2cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e2cs, int32(0))
+	pos += int(unsafe.Sizeof(e2cs))
+
 	//insert e2, payload
 	e2Payload := buf[pos:]
 	e2r, err := NewPayloadReader(schemapb.DataType_Int64, e2Payload)
@@ -664,6 +692,10 @@ func TestDDLBinlog1(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression
+	assert.Equal(t, CompressionNone, CompressionType(buf[pos]))
+	pos++
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -702,6 +734,11 @@ func TestDDLBinlog1(t *testing.T) {
 	assert.Equal(t, e1et, int64(200))
 	pos += int(unsafe.Sizeof(e1et))
 
+	//insert e1 data, checksum
+	e1cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e1cs, int32(0))
+	pos += int(unsafe.Sizeof(e1cs))
+
 	//insert e1, payload
 	e1Payload := buf[pos:e1NxtPos]
 	e1r, err := NewPayloadReader(schemapb.DataType_Int64, e1Payload)
@@ -750,6 +787,11 @@ func TestDDLBinlog1(t *testing.T) {
 	assert.Equal(t, e2et, int64(400))
 	pos += int(unsafe.Sizeof(e2et))
 
+	//insert e2 data, checksum
+	e2cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e2cs, int32(0))
+	pos += int(unsafe.Sizeof(e2cs))
+
 	//insert e2, payload
 	e2Payload := buf[pos:]
 	e2r, err := NewPayloadReader(schemapb.DataType_Int64, e2Payload)
@@ -920,6 +962,10 @@ func TestDDLBinlog2(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression
+	assert.Equal(t, CompressionNone, CompressionType(buf[pos]))
+	pos++
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -958,6 +1004,11 @@ func TestDDLBinlog2(t *testing.T) {
 	assert.Equal(t, e1et, int64(200))
 	pos += int(unsafe.Sizeof(e1et))
 
+	//insert e1 data, checksum
+	e1cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e1cs, int32(0))
+	pos += int(unsafe.Sizeof(e1cs))
+
 	//insert e1, payload
 	e1Payload := buf[pos:e1NxtPos]
 	e1r, err := NewPayloadReader(schemapb.DataType_Int64, e1Payload)
@@ -1006,6 +1057,11 @@ func TestDDLBinlog2(t *testing.T) {
 	assert.Equal(t, e2et, int64(400))
 	pos += int(unsafe.Sizeof(e2et))
 
+	//insert e2 data, checksum
+	e2cs := UnsafeReadInt32(buf, pos)
+	assert.NotEqual(t, e2cs, int32(0))
+	pos += int(unsafe.Sizeof(e2cs))
+
 	//insert e2, payload
 	e2Payload := buf[pos:]
 	e2r, err := NewPayloadReader(schemapb.DataType_Int64, e2Payload)
@@ -1236,6 +1292,10 @@ func (e *testEvent) GetPayloadLengthFromWriter() (int, error) {
 	return 0, nil
 }
 
+func (e *testEvent) GetPayloadRawSizeFromWriter() (int, error) {
+	return 0, nil
+}
+
 func (e *testEvent) ReleasePayloadWriter() error {
 	if e.releasePayloadError {
 		return fmt.Errorf("releasePayload error")
@@ -1274,3 +1334,34 @@ func TestWriterListError(t *testing.T) {
 	err = insertWriter.Close()
 	assert.NotNil(t, err)
 }
+
+func TestVerifyBinlog(t *testing.T) {
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	defer w.Close()
+	for i := 0; i < 2; i++ {
+		e, err := w.NextInsertEventWriter()
+		assert.Nil(t, err)
+		err = e.AddDataToPayload([]int64{1, 2, 3})
+		assert.Nil(t, err)
+		e.SetEventTimestamp(100, 200)
+	}
+	w.SetEventTimeStamp(1000, 2000)
+	err := w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+
+	err = VerifyBinlog(buf)
+	assert.Nil(t, err)
+
+	// flip a byte in the first event
+	corrupted := make([]byte, len(buf))
+	copy(corrupted, buf)
+	corrupted[int(w.descriptorEvent.NextPosition)+binary.Size(eventHeader{})] ^= 0xff
+	err = VerifyBinlog(corrupted)
+	assert.NotNil(t, err)
+
+	// truncate the last event
+	err = VerifyBinlog(buf[:len(buf)-1])
+	assert.NotNil(t, err)
+}
@@ -22,7 +22,7 @@ import (
 const (
 	// todo : put to param table
 	ServerID      = 1
-	BinlogVersion = 1
+	BinlogVersion = 2
 	CommitID      = 1
 	ServerVersion = 1
 )
@@ -45,6 +45,8 @@ type baseBinlogWriter struct {
 	eventWriters []EventWriter
 	buffer       *bytes.Buffer
 	length       int32
+	rawSize      int
+	dataKey      []byte
 }
 
 func (writer *baseBinlogWriter) isClosed() bool {
@@ -71,6 +73,46 @@ func (writer *baseBinlogWriter) GetRowNums() (int32, error) {
 	return int32(length), nil
 }
 
+// GetRawSize returns the size of the payloads before they are encoded and compressed
+func (writer *baseBinlogWriter) GetRawSize() (int, error) {
+	if writer.isClosed() {
+		return writer.rawSize, nil
+	}
+
+	size := 0
+	for _, e := range writer.eventWriters {
+		rawSize, err := e.GetPayloadRawSizeFromWriter()
+		if err != nil {
+			return 0, err
+		}
+		size += rawSize
+	}
+	return size, nil
+}
+
+// SetCompression sets the codec of the payloads, it must be called before any event writer is created
+func (writer *baseBinlogWriter) SetCompression(compression CompressionType) error {
+	if len(writer.eventWriters) > 0 {
+		return fmt.Errorf("can't change compression after event writers are created")
+	}
+	writer.Compression = compression
+	return nil
+}
+
+// SetDataKey sets the data key encrypting the payloads, the key id and the wrapped data key are recorded in the
+// descriptor event, it must be called before any event writer is created
+func (writer *baseBinlogWriter) SetDataKey(key *DataKey) error {
+	if len(writer.eventWriters) > 0 {
+		return fmt.Errorf("can't change data key after event writers are created")
+	}
+	writer.dataKey = key.Key
+	writer.KeyID = key.KeyID
+	writer.WrappedKey = key.WrappedKey
+	writer.descriptorEventHeader.EventLength = writer.descriptorEvent.GetMemoryUsageInBytes()
+	writer.descriptorEventHeader.NextPosition = int32(binary.Size(MagicNumber)) + writer.descriptorEventHeader.EventLength
+	return nil
+}
+
 func (writer *baseBinlogWriter) GetBinlogType() BinlogType {
 	return writer.binlogType
 }
@@ -104,6 +146,7 @@ func (writer *baseBinlogWriter) Close() error {
 	offset += writer.descriptorEvent.GetMemoryUsageInBytes()
 
 	writer.length = 0
+	writer.rawSize = 0
 	for _, w := range writer.eventWriters {
 		w.SetOffset(offset)
 		if err := w.Finish(); err != nil {
@@ -122,6 +165,11 @@ func (writer *baseBinlogWriter) Close() error {
 			return err
 		}
 		writer.length += int32(rows)
+		rawSize, err := w.GetPayloadRawSizeFromWriter()
+		if err != nil {
+			return err
+		}
+		writer.rawSize += rawSize
 		if err := w.ReleasePayloadWriter(); err != nil {
 			return err
 		}
@@ -141,6 +189,10 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter() (*insertEventWriter, e
 	if err != nil {
 		return nil, err
 	}
+	if err := event.SetPayloadCompression(writer.Compression); err != nil {
+		return nil, err
+	}
+	event.dataKey = writer.dataKey
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
@@ -157,6 +209,10 @@ func (writer *DeleteBinlogWriter) NextDeleteEventWriter() (*deleteEventWriter, e
 	if err != nil {
 		return nil, err
 	}
+	if err := event.SetPayloadCompression(writer.Compression); err != nil {
+		return nil, err
+	}
+	event.dataKey = writer.dataKey
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
@@ -173,6 +229,10 @@ func (writer *DDLBinlogWriter) NextCreateCollectionEventWriter() (*createCollect
 	if err != nil {
 		return nil, err
 	}
+	if err := event.SetPayloadCompression(writer.Compression); err != nil {
+		return nil, err
+	}
+	event.dataKey = writer.dataKey
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
@@ -185,6 +245,10 @@ func (writer *DDLBinlogWriter) NextDropCollectionEventWriter() (*dropCollectionE
 	if err != nil {
 		return nil, err
 	}
+	if err := event.SetPayloadCompression(writer.Compression); err != nil {
+		return nil, err
+	}
+	event.dataKey = writer.dataKey
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
@@ -197,6 +261,10 @@ func (writer *DDLBinlogWriter) NextCreatePartitionEventWriter() (*createPartitio
 	if err != nil {
 		return nil, err
 	}
+	if err := event.SetPayloadCompression(writer.Compression); err != nil {
+		return nil, err
+	}
+	event.dataKey = writer.dataKey
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
@@ -209,6 +277,10 @@ func (writer *DDLBinlogWriter) NextDropPartitionEventWriter() (*dropPartitionEve
 	if err != nil {
 		return nil, err
 	}
+	if err := event.SetPayloadCompression(writer.Compression); err != nil {
+		return nil, err
+	}
+	event.dataKey = writer.dataKey
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
@@ -67,3 +67,37 @@ func TestBinlogWriterReader(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Nil(t, reader)
 }
+
+func TestBinlogWriterCompression(t *testing.T) {
+	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_Int64, 10)
+	err := binlogWriter.SetCompression(CompressionSnappy)
+	assert.Nil(t, err)
+	eventWriter, err := binlogWriter.NextDeleteEventWriter()
+	assert.Nil(t, err)
+	err = binlogWriter.SetCompression(CompressionZstd)
+	assert.NotNil(t, err)
+	err = eventWriter.AddInt64ToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	eventWriter.SetEventTimestamp(1000, 2000)
+	binlogWriter.SetEventTimeStamp(1000, 2000)
+	size, err := binlogWriter.GetRawSize()
+	assert.Nil(t, err)
+	assert.Equal(t, 24, size)
+	err = binlogWriter.Close()
+	assert.Nil(t, err)
+	size, err = binlogWriter.GetRawSize()
+	assert.Nil(t, err)
+	assert.Equal(t, 24, size)
+
+	buffer, err := binlogWriter.GetBuffer()
+	assert.Nil(t, err)
+	binlogReader, err := NewBinlogReader(buffer)
+	assert.Nil(t, err)
+	defer binlogReader.Close()
+	assert.Equal(t, CompressionSnappy, binlogReader.Compression)
+	eventReader, err := binlogReader.NextEventReader()
+	assert.Nil(t, err)
+	payload, err := eventReader.GetInt64FromPayload()
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, payload)
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CompressionType is the codec used to compress the payloads of a binlog,
+// the values are the same as CompressionType in cwrapper/ColumnType.h
+type CompressionType int8
+
+const (
+	CompressionNone CompressionType = iota
+	CompressionSnappy
+	CompressionZstd
+	CompressionLZ4
+)
+
+var compressionNames = map[CompressionType]string{
+	CompressionNone:   "none",
+	CompressionSnappy: "snappy",
+	CompressionZstd:   "zstd",
+	CompressionLZ4:    "lz4",
+}
+
+func (c CompressionType) String() string {
+	if name, ok := compressionNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseCompressionType converts the name of a codec into CompressionType, an empty name means no compression
+func ParseCompressionType(name string) (CompressionType, error) {
+	if name == "" {
+		return CompressionNone, nil
+	}
+	for c, n := range compressionNames {
+		if strings.EqualFold(n, name) {
+			return c, nil
+		}
+	}
+	return CompressionNone, fmt.Errorf("unknown binlog compression %s", name)
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompressionType(t *testing.T) {
+	for _, c := range []CompressionType{CompressionNone, CompressionSnappy, CompressionZstd, CompressionLZ4} {
+		parsed, err := ParseCompressionType(c.String())
+		assert.Nil(t, err)
+		assert.Equal(t, c, parsed)
+	}
+
+	c, err := ParseCompressionType("")
+	assert.Nil(t, err)
+	assert.Equal(t, CompressionNone, c)
+	c, err = ParseCompressionType("ZSTD")
+	assert.Nil(t, err)
+	assert.Equal(t, CompressionZstd, c)
+	_, err = ParseCompressionType("gzip")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "unknown", CompressionType(100).String())
+}
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License.
 
 #include "ParquetWrapper.h"
+#include <functional>
 #include "PayloadStream.h"
 
 static const char *ErrorMsg(const std::string &msg) {
@@ -26,6 +27,7 @@ CPayloadWriter NewPayloadWriter(int columnType) {
   p->builder = nullptr;
   p->schema = nullptr;
   p->output = nullptr;
+  p->compression = CompressionType::UNCOMPRESSED;
   p->dimension = wrapper::EMPTY_DIMENSION;
   p->rows = 0;
   switch (static_cast<ColumnType>(columnType)) {
@@ -286,6 +288,44 @@ CStatus AddFloatVectorToPayload(CPayloadWriter payloadWriter, float *values, int
   return st;
 }
 
+extern "C"
+CStatus SetPayloadWriterCompression(CPayloadWriter payloadWriter, int compression) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  switch (static_cast<CompressionType>(compression)) {
+    case CompressionType::UNCOMPRESSED:
+    case CompressionType::SNAPPY:
+    case CompressionType::ZSTD:
+    case CompressionType::LZ4:
+      p->compression = static_cast<CompressionType>(compression);
+      break;
+    default:
+      st.error_code = static_cast<int>(ErrorCode::ILLEGAL_ARGUMENT);
+      st.error_msg = ErrorMsg("unknown compression type");
+  }
+  return st;
+}
+
+static arrow::Compression::type ParquetCompression(CompressionType compression) {
+  switch (compression) {
+    case CompressionType::SNAPPY:
+      return arrow::Compression::SNAPPY;
+    case CompressionType::ZSTD:
+      return arrow::Compression::ZSTD;
+    case CompressionType::LZ4:
+      return arrow::Compression::LZ4;
+    default:
+      return arrow::Compression::UNCOMPRESSED;
+  }
+}
+
 extern "C"
 CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
   CStatus st;
@@ -307,7 +347,8 @@ CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
     }
     auto table = arrow::Table::Make(p->schema, {array});
     p->output = std::make_shared<wrapper::PayloadOutputStream>();
-    ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024 * 1024);
+    auto props = parquet::WriterProperties::Builder().compression(ParquetCompression(p->compression))->build();
+    ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024 * 1024, props);
     if (!ast.ok()) {
       st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
       st.error_msg = ErrorMsg(ast.message());
@@ -535,3 +576,220 @@ CStatus ReleasePayloadReader(CPayloadReader payloadReader) {
   delete p;
   return st;
 }
+
+extern "C"
+CTableWriter NewTableWriter() {
+  auto p = new wrapper::TableWriter;
+  p->compression = CompressionType::UNCOMPRESSED;
+  p->output = nullptr;
+  return reinterpret_cast<CTableWriter>(p);
+}
+
+extern "C"
+CStatus SetTableWriterCompression(CTableWriter tableWriter, int compression) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::TableWriter *>(tableWriter);
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("table has finished");
+    return st;
+  }
+  switch (static_cast<CompressionType>(compression)) {
+    case CompressionType::UNCOMPRESSED:
+    case CompressionType::SNAPPY:
+    case CompressionType::ZSTD:
+    case CompressionType::LZ4:
+      p->compression = static_cast<CompressionType>(compression);
+      break;
+    default:
+      st.error_code = static_cast<int>(ErrorCode::ILLEGAL_ARGUMENT);
+      st.error_msg = ErrorMsg("unknown compression type");
+  }
+  return st;
+}
+
+static CStatus AddColumnToTable(CTableWriter tableWriter,
+                                const char *name,
+                                const std::shared_ptr<arrow::ArrayBuilder> &builder,
+                                const std::function<arrow::Status()> &append,
+                                const std::function<arrow::Result<std::shared_ptr<arrow::Array>>(
+                                    std::shared_ptr<arrow::Array>)> &wrap = nullptr) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::TableWriter *>(tableWriter);
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("table has finished");
+    return st;
+  }
+  auto ast = append();
+  std::shared_ptr<arrow::Array> array;
+  if (ast.ok()) {
+    ast = builder->Finish(&array);
+  }
+  if (ast.ok() && wrap != nullptr) {
+    auto result = wrap(array);
+    if (result.ok()) {
+      array = *result;
+    } else {
+      ast = result.status();
+    }
+  }
+  if (!ast.ok()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg(ast.message());
+    return st;
+  }
+  if (!p->columns.empty() && p->columns[0]->length() != array->length()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("the number of rows of the columns are not equal");
+    return st;
+  }
+  p->fields.push_back(arrow::field(name, array->type(), false));
+  p->columns.push_back(array);
+  return st;
+}
+
+template<typename DT, typename BT>
+CStatus AddValuesColumnToTable(CTableWriter tableWriter, const char *name, DT *values, int length) {
+  auto builder = std::make_shared<BT>();
+  return AddColumnToTable(tableWriter, name, builder, [&]() {
+    return builder->AppendValues(values, values + length);
+  });
+}
+
+extern "C"
+CStatus AddBooleanColumnToTable(CTableWriter tableWriter, const char *name, bool *values, int length) {
+  return AddValuesColumnToTable<bool, arrow::BooleanBuilder>(tableWriter, name, values, length);
+}
+
+extern "C"
+CStatus AddInt8ColumnToTable(CTableWriter tableWriter, const char *name, int8_t *values, int length) {
+  return AddValuesColumnToTable<int8_t, arrow::Int8Builder>(tableWriter, name, values, length);
+}
+
+extern "C"
+CStatus AddInt16ColumnToTable(CTableWriter tableWriter, const char *name, int16_t *values, int length) {
+  return AddValuesColumnToTable<int16_t, arrow::Int16Builder>(tableWriter, name, values, length);
+}
+
+extern "C"
+CStatus AddInt32ColumnToTable(CTableWriter tableWriter, const char *name, int32_t *values, int length) {
+  return AddValuesColumnToTable<int32_t, arrow::Int32Builder>(tableWriter, name, values, length);
+}
+
+extern "C"
+CStatus AddInt64ColumnToTable(CTableWriter tableWriter, const char *name, int64_t *values, int length) {
+  return AddValuesColumnToTable<int64_t, arrow::Int64Builder>(tableWriter, name, values, length);
+}
+
+extern "C"
+CStatus AddFloatColumnToTable(CTableWriter tableWriter, const char *name, float *values, int length) {
+  return AddValuesColumnToTable<float, arrow::FloatBuilder>(tableWriter, name, values, length);
+}
+
+extern "C"
+CStatus AddDoubleColumnToTable(CTableWriter tableWriter, const char *name, double *values, int length) {
+  return AddValuesColumnToTable<double, arrow::DoubleBuilder>(tableWriter, name, values, length);
+}
+
+extern "C"
+CStatus AddStringColumnToTable(CTableWriter tableWriter, const char *name, char *data, int32_t *offsets, int length) {
+  auto builder = std::make_shared<arrow::StringBuilder>();
+  return AddColumnToTable(tableWriter, name, builder, [&]() {
+    for (int i = 0; i < length; i++) {
+      auto ast = builder->Append(data + offsets[i], offsets[i + 1] - offsets[i]);
+      if (!ast.ok()) return ast;
+    }
+    return arrow::Status::OK();
+  });
+}
+
+extern "C"
+CStatus AddBinaryVectorColumnToTable(CTableWriter tableWriter,
+                                     const char *name,
+                                     uint8_t *values,
+                                     int dimension,
+                                     int length) {
+  if ((dimension % 8) || (dimension <= 0)) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect dimension value");
+    return st;
+  }
+  auto builder = std::make_shared<arrow::UInt8Builder>();
+  return AddColumnToTable(tableWriter, name, builder, [&]() {
+    return builder->AppendValues(values, values + length * dimension / 8);
+  }, [&](std::shared_ptr<arrow::Array> array) {
+    return arrow::FixedSizeListArray::FromArrays(array, dimension / 8);
+  });
+}
+
+extern "C"
+CStatus AddFloatVectorColumnToTable(CTableWriter tableWriter, const char *name, float *values, int dimension, int length) {
+  if (dimension <= 0) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect dimension value");
+    return st;
+  }
+  auto builder = std::make_shared<arrow::FloatBuilder>();
+  return AddColumnToTable(tableWriter, name, builder, [&]() {
+    return builder->AppendValues(values, values + length * dimension);
+  }, [&](std::shared_ptr<arrow::Array> array) {
+    return arrow::FixedSizeListArray::FromArrays(array, dimension);
+  });
+}
+
+extern "C"
+CStatus FinishTableWriter(CTableWriter tableWriter) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::TableWriter *>(tableWriter);
+  if (p->columns.empty()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("table has no column");
+    return st;
+  }
+  if (p->output == nullptr) {
+    auto table = arrow::Table::Make(arrow::schema(p->fields), p->columns);
+    p->output = std::make_shared<wrapper::PayloadOutputStream>();
+    auto props = parquet::WriterProperties::Builder().compression(ParquetCompression(p->compression))->build();
+    auto ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024, props);
+    if (!ast.ok()) {
+      st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+      st.error_msg = ErrorMsg(ast.message());
+      return st;
+    }
+  }
+  return st;
+}
+
+extern "C"
+CBuffer GetTableBufferFromWriter(CTableWriter tableWriter) {
+  CBuffer buf;
+  auto p = reinterpret_cast<wrapper::TableWriter *>(tableWriter);
+  if (p->output == nullptr) {
+    buf.length = 0;
+    buf.data = nullptr;
+    return buf;
+  }
+  auto &output = p->output->Buffer();
+  buf.length = static_cast<int>(output.size());
+  buf.data = (char *) (output.data());
+  return buf;
+}
+
+extern "C"
+CStatus ReleaseTableWriter(CTableWriter tableWriter) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::TableWriter *>(tableWriter);
+  if (p != nullptr) delete p;
+  return st;
+}
@@ -31,6 +31,7 @@ typedef struct CStatus {
 //============= payload writer ======================
 typedef void *CPayloadWriter;
 CPayloadWriter NewPayloadWriter(int columnType);
+CStatus SetPayloadWriterCompression(CPayloadWriter payloadWriter, int compression);
 CStatus AddBooleanToPayload(CPayloadWriter payloadWriter, bool *values, int length);
 CStatus AddInt8ToPayload(CPayloadWriter payloadWriter, int8_t *values, int length);
 CStatus AddInt16ToPayload(CPayloadWriter payloadWriter, int16_t *values, int length);
@@ -64,6 +65,27 @@ CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values,
 int GetPayloadLengthFromReader(CPayloadReader payloadReader);
 CStatus ReleasePayloadReader(CPayloadReader payloadReader);
 
+//============= table writer ======================
+// a table writer writes a parquet file with a named column per field, vectors are written as fixed size lists
+typedef void *CTableWriter;
+CTableWriter NewTableWriter();
+CStatus SetTableWriterCompression(CTableWriter tableWriter, int compression);
+CStatus AddBooleanColumnToTable(CTableWriter tableWriter, const char *name, bool *values, int length);
+CStatus AddInt8ColumnToTable(CTableWriter tableWriter, const char *name, int8_t *values, int length);
+CStatus AddInt16ColumnToTable(CTableWriter tableWriter, const char *name, int16_t *values, int length);
+CStatus AddInt32ColumnToTable(CTableWriter tableWriter, const char *name, int32_t *values, int length);
+CStatus AddInt64ColumnToTable(CTableWriter tableWriter, const char *name, int64_t *values, int length);
+CStatus AddFloatColumnToTable(CTableWriter tableWriter, const char *name, float *values, int length);
+CStatus AddDoubleColumnToTable(CTableWriter tableWriter, const char *name, double *values, int length);
+// the i-th string is data[offsets[i], offsets[i+1])
+CStatus AddStringColumnToTable(CTableWriter tableWriter, const char *name, char *data, int32_t *offsets, int length);
+CStatus AddBinaryVectorColumnToTable(CTableWriter tableWriter, const char *name, uint8_t *values, int dimension, int length);
+CStatus AddFloatVectorColumnToTable(CTableWriter tableWriter, const char *name, float *values, int dimension, int length);
+
+CStatus FinishTableWriter(CTableWriter tableWriter);
+CBuffer GetTableBufferFromWriter(CTableWriter tableWriter);
+CStatus ReleaseTableWriter(CTableWriter tableWriter);
+
 #ifdef __cplusplus
 }
 #endif
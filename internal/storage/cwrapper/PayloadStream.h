@@ -26,6 +26,7 @@ constexpr int EMPTY_DIMENSION = -1;
 
 struct PayloadWriter {
   ColumnType columnType;
+  CompressionType compression;
   int dimension; // binary vector, float vector
   std::shared_ptr<arrow::ArrayBuilder> builder;
   std::shared_ptr<arrow::Schema> schema;
@@ -43,6 +44,13 @@ struct PayloadReader {
   bool *bValues;
 };
 
+struct TableWriter {
+  CompressionType compression;
+  std::vector<std::shared_ptr<arrow::Field>> fields;
+  std::vector<std::shared_ptr<arrow::Array>> columns;
+  std::shared_ptr<PayloadOutputStream> output;
+};
+
 class PayloadOutputStream : public arrow::io::OutputStream {
  public:
   PayloadOutputStream();
@@ -314,3 +314,100 @@ TEST(wrapper, int8_2) {
   ASSERT_EQ(bool_array->Value(2), -100);
   ASSERT_EQ(bool_array->Value(3), 100);
 }
+
+TEST(wrapper, compression) {
+  int compressions[] = {CompressionType::UNCOMPRESSED, CompressionType::SNAPPY, CompressionType::ZSTD,
+                        CompressionType::LZ4};
+  std::vector<int64_t> data(10000, 100);
+  for (auto compression : compressions) {
+    auto payload = NewPayloadWriter(ColumnType::INT64);
+    auto st = SetPayloadWriterCompression(payload, compression);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = AddInt64ToPayload(payload, data.data(), data.size());
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = FinishPayloadWriter(payload);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = SetPayloadWriterCompression(payload, compression);
+    ASSERT_NE(st.error_code, ErrorCode::SUCCESS);
+    free((void *) st.error_msg);
+    auto cb = GetPayloadBufferFromWriter(payload);
+    ASSERT_GT(cb.length, 0);
+
+    auto reader = NewPayloadReader(ColumnType::INT64, (uint8_t *) cb.data, cb.length);
+    int64_t *values;
+    int length;
+    st = GetInt64FromPayload(reader, &values, &length);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    ASSERT_EQ(length, static_cast<int>(data.size()));
+    for (int i = 0; i < length; i++) {
+      ASSERT_EQ(values[i], data[i]);
+    }
+
+    st = ReleasePayloadWriter(payload);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = ReleasePayloadReader(reader);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  }
+
+  auto payload = NewPayloadWriter(ColumnType::INT64);
+  auto st = SetPayloadWriterCompression(payload, 100);
+  ASSERT_EQ(st.error_code, ErrorCode::ILLEGAL_ARGUMENT);
+  free((void *) st.error_msg);
+  st = ReleasePayloadWriter(payload);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+}
+
+TEST(wrapper, table) {
+  std::vector<int64_t> ids = {1, 2, 3};
+  std::string strs = "abcdef";
+  std::vector<int32_t> offsets = {0, 1, 3, 6};
+  std::vector<uint8_t> bin_vectors = {1, 2, 3};
+  std::vector<float> float_vectors = {1, 2, 3, 4, 5, 6};
+
+  auto writer = NewTableWriter();
+  auto st = SetTableWriterCompression(writer, CompressionType::SNAPPY);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddInt64ColumnToTable(writer, "id", ids.data(), ids.size());
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddStringColumnToTable(writer, "name", (char *) strs.data(), offsets.data(), 3);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddBinaryVectorColumnToTable(writer, "bin_vec", bin_vectors.data(), 8, 3);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddFloatVectorColumnToTable(writer, "float_vec", float_vectors.data(), 2, 3);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddInt64ColumnToTable(writer, "short", ids.data(), 2);
+  ASSERT_NE(st.error_code, ErrorCode::SUCCESS);
+  free((void *) st.error_msg);
+  st = FinishTableWriter(writer);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  auto cb = GetTableBufferFromWriter(writer);
+  ASSERT_GT(cb.length, 0);
+  WriteToFile(cb);
+  st = ReleaseTableWriter(writer);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+
+  auto table = ReadFromFile();
+  ASSERT_NE(table, nullptr);
+  ASSERT_EQ(table->num_columns(), 4);
+  ASSERT_EQ(table->num_rows(), 3);
+  ASSERT_EQ(table->schema()->field(0)->name(), "id");
+  ASSERT_EQ(table->schema()->field(3)->name(), "float_vec");
+
+  auto names = std::dynamic_pointer_cast<arrow::StringArray>(table->column(1)->chunk(0));
+  ASSERT_NE(names, nullptr);
+  ASSERT_EQ(names->GetString(1), "bc");
+
+  // the fixed size list is read back as a list if the arrow schema is not restored
+  auto chunk = table->column(3)->chunk(0);
+  std::shared_ptr<arrow::Array> slice;
+  if (auto list = std::dynamic_pointer_cast<arrow::ListArray>(chunk)) {
+    slice = list->value_slice(2);
+  } else if (auto fixed_list = std::dynamic_pointer_cast<arrow::FixedSizeListArray>(chunk)) {
+    slice = fixed_list->value_slice(2);
+  }
+  auto values = std::dynamic_pointer_cast<arrow::FloatArray>(slice);
+  ASSERT_NE(values, nullptr);
+  ASSERT_EQ(values->length(), 2);
+  ASSERT_EQ(values->Value(0), 5);
+  ASSERT_EQ(values->Value(1), 6);
+}
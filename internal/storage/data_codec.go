@@ -12,6 +12,7 @@
 package storage
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -41,6 +42,8 @@ const InvalidUniqueID = UniqueID(-1)
 type Blob struct {
 	Key   string
 	Value []byte
+	// RawSize is the size of the payloads in Value before they are encoded and compressed
+	RawSize int64
 }
 
 type BlobList []*Blob
@@ -137,7 +140,12 @@ type InsertData struct {
 // Blob key example:
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
 type InsertCodec struct {
-	Schema          *etcdpb.CollectionMeta
+	Schema *etcdpb.CollectionMeta
+	// Compression is used if the collection schema doesn't specify the binlog compression
+	Compression CompressionType
+	// KeyManager encrypts the payloads by the data key of the segment and decrypts the encrypted payloads,
+	// the payloads are not encrypted if it's nil
+	KeyManager      *KeyManager
 	readerCloseFunc []func() error
 }
 
@@ -156,6 +164,16 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 	ts := timeFieldData.(*Int64FieldData).Data
 	startTs := ts[0]
 	endTs := ts[len(ts)-1]
+	compression, err := insertCodec.getCompression()
+	if err != nil {
+		return nil, nil, err
+	}
+	var dataKey *DataKey
+	if insertCodec.KeyManager != nil {
+		if dataKey, err = insertCodec.KeyManager.SegmentDataKey(segmentID); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	dataSorter := &DataSorter{
 		InsertCodec: insertCodec,
@@ -168,6 +186,14 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// encode fields
 		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
+		if err := writer.SetCompression(compression); err != nil {
+			return nil, nil, err
+		}
+		if dataKey != nil {
+			if err := writer.SetDataKey(dataKey); err != nil {
+				return nil, nil, err
+			}
+		}
 		eventWriter, err := writer.NextInsertEventWriter()
 		if err != nil {
 			return nil, nil, err
@@ -217,31 +243,145 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		if err != nil {
 			return nil, nil, err
 		}
+		rawSize, err := writer.GetRawSize()
+		if err != nil {
+			return nil, nil, err
+		}
 		blobKey := fmt.Sprintf("%d", field.FieldID)
 		blobs = append(blobs, &Blob{
-			Key:   blobKey,
-			Value: buffer,
+			Key:     blobKey,
+			Value:   buffer,
+			RawSize: int64(rawSize),
 		})
 
 		// stats fields
-		statsWriter := &StatsWriter{}
-		switch field.DataType {
-		case schemapb.DataType_Int64:
-			err = statsWriter.StatsInt64(singleData.(*Int64FieldData).Data)
-		}
+		statsBlob, err := fieldStatsBlob(field, singleData)
 		if err != nil {
 			return nil, nil, err
 		}
-		statsBuffer := statsWriter.GetBuffer()
-		statsBlobs = append(statsBlobs, &Blob{
-			Key:   blobKey,
-			Value: statsBuffer,
-		})
+		statsBlobs = append(statsBlobs, statsBlob)
 	}
 
 	return blobs, statsBlobs, nil
 }
 
+func fieldStatsBlob(field *schemapb.FieldSchema, data FieldData) (*Blob, error) {
+	statsWriter := &StatsWriter{}
+	var err error
+	switch field.DataType {
+	case schemapb.DataType_Int64:
+		err = statsWriter.StatsInt64(data.(*Int64FieldData).Data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Key:   fmt.Sprintf("%d", field.FieldID),
+		Value: statsWriter.GetBuffer(),
+	}, nil
+}
+
+// SerializeSegmentFile packs all the fields of data into one segment file, the rows are split into row groups
+// of at most rowGroupSize rows, a non-positive rowGroupSize puts all the rows into one row group. The stats
+// blobs are the same as Serialize returns.
+func (insertCodec *InsertCodec) SerializeSegmentFile(partitionID UniqueID, segmentID UniqueID, data *InsertData, rowGroupSize int) (*Blob, []*Blob, error) {
+	timeFieldData, ok := data.Data[rootcoord.TimeStampField]
+	if !ok {
+		return nil, nil, fmt.Errorf("data doesn't contains timestamp field")
+	}
+	numRows := len(timeFieldData.(*Int64FieldData).Data)
+	if rowGroupSize <= 0 || rowGroupSize > numRows {
+		rowGroupSize = numRows
+	}
+
+	// sort all the rows before they are split, every row group is sorted again by Serialize
+	dataSorter := &DataSorter{
+		InsertCodec: insertCodec,
+		InsertData:  data,
+	}
+	sort.Sort(dataSorter)
+
+	writer := NewSegmentFileWriter()
+	var rawSize int64
+	for start := 0; start < numRows; start += rowGroupSize {
+		end := start + rowGroupSize
+		if end > numRows {
+			end = numRows
+		}
+		rowGroup, err := sliceInsertData(data, start, end)
+		if err != nil {
+			return nil, nil, err
+		}
+		blobs, _, err := insertCodec.Serialize(partitionID, segmentID, rowGroup)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := writer.AddRowGroup(int64(end-start), blobs); err != nil {
+			return nil, nil, err
+		}
+		for _, blob := range blobs {
+			rawSize += blob.RawSize
+		}
+	}
+	buffer, err := writer.Finish()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statsBlobs := make([]*Blob, 0, len(insertCodec.Schema.Schema.Fields))
+	for _, field := range insertCodec.Schema.Schema.Fields {
+		statsBlob, err := fieldStatsBlob(field, data.Data[field.FieldID])
+		if err != nil {
+			return nil, nil, err
+		}
+		statsBlobs = append(statsBlobs, statsBlob)
+	}
+	return &Blob{Value: buffer, RawSize: rawSize}, statsBlobs, nil
+}
+
+// sliceInsertData returns the rows in [start, end) of data, the slices share the memory of data
+func sliceInsertData(data *InsertData, start, end int) (*InsertData, error) {
+	result := &InsertData{Data: make(map[FieldID]FieldData, len(data.Data))}
+	numRows := []int64{int64(end - start)}
+	for fieldID, value := range data.Data {
+		switch fieldData := value.(type) {
+		case *BoolFieldData:
+			result.Data[fieldID] = &BoolFieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *Int8FieldData:
+			result.Data[fieldID] = &Int8FieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *Int16FieldData:
+			result.Data[fieldID] = &Int16FieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *Int32FieldData:
+			result.Data[fieldID] = &Int32FieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *Int64FieldData:
+			result.Data[fieldID] = &Int64FieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *FloatFieldData:
+			result.Data[fieldID] = &FloatFieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *DoubleFieldData:
+			result.Data[fieldID] = &DoubleFieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *StringFieldData:
+			result.Data[fieldID] = &StringFieldData{NumRows: numRows, Data: fieldData.Data[start:end]}
+		case *BinaryVectorFieldData:
+			dim := fieldData.Dim / 8
+			result.Data[fieldID] = &BinaryVectorFieldData{NumRows: numRows, Data: fieldData.Data[start*dim : end*dim], Dim: fieldData.Dim}
+		case *FloatVectorFieldData:
+			dim := fieldData.Dim
+			result.Data[fieldID] = &FloatVectorFieldData{NumRows: numRows, Data: fieldData.Data[start*dim : end*dim], Dim: fieldData.Dim}
+		default:
+			return nil, fmt.Errorf("unsupported field data %T", value)
+		}
+	}
+	return result, nil
+}
+
+// getCompression returns the binlog compression of the collection, Compression is used if it isn't specified
+func (insertCodec *InsertCodec) getCompression() (CompressionType, error) {
+	if name := insertCodec.Schema.GetSchema().GetBinlogCompression(); name != "" {
+		return ParseCompressionType(name)
+	}
+	return insertCodec.Compression, nil
+}
+
 func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID, segmentID UniqueID, data *InsertData, err error) {
 	if len(blobs) == 0 {
 		return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("blobs is empty")
@@ -250,15 +390,21 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 		return func() error { return reader.Close() }
 	}
 
+	// segment files are decoded as the binlogs of their column chunks
+	blobs, err = expandSegmentFiles(blobs)
+	if err != nil {
+		return InvalidUniqueID, InvalidUniqueID, nil, err
+	}
+	// the column chunks of a segment file share its key, keep them in the order of the row groups
 	var blobList BlobList = blobs
-	sort.Sort(blobList)
+	sort.Stable(blobList)
 
 	var pID UniqueID
 	var sID UniqueID
 	resultData := &InsertData{}
 	resultData.Data = make(map[FieldID]FieldData)
 	for _, blob := range blobList {
-		binlogReader, err := NewBinlogReader(blob.Value)
+		binlogReader, err := NewBinlogReaderWithKeyManager(blob.Value, insertCodec.KeyManager)
 		if err != nil {
 			return InvalidUniqueID, InvalidUniqueID, nil, err
 		}
@@ -465,9 +611,87 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 		insertCodec.readerCloseFunc = append(insertCodec.readerCloseFunc, readerClose(binlogReader))
 	}
 
+	if insertCodec.Schema != nil {
+		FillDefaultFieldsData(insertCodec.Schema.Schema, resultData)
+	}
+
 	return pID, sID, resultData, nil
 }
 
+// FillDefaultFieldsData fills the fields which are added to the collection after the data is written,
+// every row gets the default value of the field, or the zero value if the field is nullable.
+func FillDefaultFieldsData(schema *schemapb.CollectionSchema, data *InsertData) {
+	if schema == nil {
+		return
+	}
+	tsFieldData, ok := data.Data[rootcoord.TimeStampField].(*Int64FieldData)
+	if !ok {
+		return
+	}
+	numRows := len(tsFieldData.Data)
+	for _, field := range schema.Fields {
+		if _, ok := data.Data[field.FieldID]; ok {
+			continue
+		}
+		if field.DefaultValue == nil && !field.Nullable {
+			continue
+		}
+		if fieldData := defaultFieldData(field, numRows); fieldData != nil {
+			data.Data[field.FieldID] = fieldData
+		}
+	}
+}
+
+func defaultFieldData(field *schemapb.FieldSchema, numRows int) FieldData {
+	rows := []int64{int64(numRows)}
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		fieldData := &BoolFieldData{NumRows: rows, Data: make([]bool, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = field.DefaultValue.GetBoolData()
+		}
+		return fieldData
+	case schemapb.DataType_Int8:
+		fieldData := &Int8FieldData{NumRows: rows, Data: make([]int8, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = int8(field.DefaultValue.GetIntData())
+		}
+		return fieldData
+	case schemapb.DataType_Int16:
+		fieldData := &Int16FieldData{NumRows: rows, Data: make([]int16, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = int16(field.DefaultValue.GetIntData())
+		}
+		return fieldData
+	case schemapb.DataType_Int32:
+		fieldData := &Int32FieldData{NumRows: rows, Data: make([]int32, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = field.DefaultValue.GetIntData()
+		}
+		return fieldData
+	case schemapb.DataType_Int64:
+		fieldData := &Int64FieldData{NumRows: rows, Data: make([]int64, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = field.DefaultValue.GetLongData()
+		}
+		return fieldData
+	case schemapb.DataType_Float:
+		fieldData := &FloatFieldData{NumRows: rows, Data: make([]float32, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = field.DefaultValue.GetFloatData()
+		}
+		return fieldData
+	case schemapb.DataType_Double:
+		fieldData := &DoubleFieldData{NumRows: rows, Data: make([]float64, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = field.DefaultValue.GetDoubleData()
+		}
+		return fieldData
+	default:
+		return nil
+	}
+}
+
 func (insertCodec *InsertCodec) Close() error {
 	for _, closeFunc := range insertCodec.readerCloseFunc {
 		err := closeFunc()
@@ -478,11 +702,155 @@ func (insertCodec *InsertCodec) Close() error {
 	return nil
 }
 
+// DeleteData is the primary keys deleted from a segment, Tss[i] is the timestamp Pks[i] is deleted at
+type DeleteData struct {
+	Pks []int64
+	Tss []Timestamp
+}
+
+// Append appends a deleted primary key and the timestamp it's deleted at
+func (data *DeleteData) Append(pk int64, ts Timestamp) {
+	data.Pks = append(data.Pks, pk)
+	data.Tss = append(data.Tss, ts)
+}
+
+func (data *DeleteData) RowCount() int {
+	return len(data.Pks)
+}
+
+// DeleteCodec serializes the deletes of a segment into a delta log, which is a delete binlog of two delete events,
+// the payload of the first event is the primary keys and the payload of the second is the timestamps
+//
+// Blob key example:
+// ${tenant}/delta_log/${collection_id}/${partition_id}/${segment_id}/${log_idx}
+type DeleteCodec struct {
+	Compression CompressionType
+	// KeyManager encrypts the payloads by the data key of the segment and decrypts the encrypted payloads,
+	// the payloads are not encrypted if it's nil
+	KeyManager      *KeyManager
+	readerCloseFunc []func() error
+}
+
+func NewDeleteCodec() *DeleteCodec {
+	return &DeleteCodec{}
+}
+
+func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
+	if data.RowCount() == 0 || len(data.Tss) != data.RowCount() {
+		return nil, fmt.Errorf("invalid delete data of %d primary keys and %d timestamps", len(data.Pks), len(data.Tss))
+	}
+	writer := NewDeleteBinlogWriter(schemapb.DataType_Int64, collectionID)
+	writer.PartitionID = partitionID
+	writer.SegmentID = segmentID
+	if err := writer.SetCompression(deleteCodec.Compression); err != nil {
+		return nil, err
+	}
+	if deleteCodec.KeyManager != nil {
+		dataKey, err := deleteCodec.KeyManager.SegmentDataKey(segmentID)
+		if err != nil {
+			return nil, err
+		}
+		if err := writer.SetDataKey(dataKey); err != nil {
+			return nil, err
+		}
+	}
+
+	startTs, endTs := data.Tss[0], data.Tss[0]
+	tss := make([]int64, 0, len(data.Tss))
+	for _, ts := range data.Tss {
+		if ts < startTs {
+			startTs = ts
+		}
+		if ts > endTs {
+			endTs = ts
+		}
+		tss = append(tss, int64(ts))
+	}
+	for _, payload := range [][]int64{data.Pks, tss} {
+		eventWriter, err := writer.NextDeleteEventWriter()
+		if err != nil {
+			return nil, err
+		}
+		if err := eventWriter.AddInt64ToPayload(payload); err != nil {
+			return nil, err
+		}
+		eventWriter.SetEventTimestamp(startTs, endTs)
+	}
+	writer.SetEventTimeStamp(startTs, endTs)
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	buffer, err := writer.GetBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Key:     strconv.FormatInt(segmentID, 10),
+		Value:   buffer,
+		RawSize: int64(2 * binary.Size(data.Pks)),
+	}, nil
+}
+
+// Deserialize reads the deletes from the delta logs of a segment
+func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID, segmentID UniqueID, data *DeleteData, err error) {
+	if len(blobs) == 0 {
+		return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("blobs is empty")
+	}
+	readerClose := func(reader *BinlogReader) func() error {
+		return func() error { return reader.Close() }
+	}
+
+	data = &DeleteData{}
+	for _, blob := range blobs {
+		binlogReader, err := NewBinlogReaderWithKeyManager(blob.Value, deleteCodec.KeyManager)
+		if err != nil {
+			return InvalidUniqueID, InvalidUniqueID, nil, err
+		}
+		deleteCodec.readerCloseFunc = append(deleteCodec.readerCloseFunc, readerClose(binlogReader))
+		if binlogReader.PayloadDataType != schemapb.DataType_Int64 {
+			return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("invalid payload data type %s of delta log %s", binlogReader.PayloadDataType, blob.Key)
+		}
+		partitionID, segmentID = binlogReader.PartitionID, binlogReader.SegmentID
+
+		var payloads [2][]int64
+		for i := range payloads {
+			eventReader, err := binlogReader.NextEventReader()
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			if eventReader == nil || eventReader.TypeCode != DeleteEventType {
+				return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("delta log %s doesn't have the delete events of primary keys and timestamps", blob.Key)
+			}
+			if payloads[i], err = eventReader.GetInt64FromPayload(); err != nil {
+				return InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+		}
+		if len(payloads[0]) != len(payloads[1]) {
+			return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("delta log %s has %d primary keys and %d timestamps", blob.Key, len(payloads[0]), len(payloads[1]))
+		}
+		for i, pk := range payloads[0] {
+			data.Append(pk, Timestamp(payloads[1][i]))
+		}
+	}
+	return partitionID, segmentID, data, nil
+}
+
+func (deleteCodec *DeleteCodec) Close() error {
+	for _, closeFunc := range deleteCodec.readerCloseFunc {
+		err := closeFunc()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
 // ${tenant}/data_definition_log/${collection_id}/ddl/${log_idx}
 type DataDefinitionCodec struct {
 	collectionID    int64
+	Compression     CompressionType
 	readerCloseFunc []func() error
 }
 
@@ -492,6 +860,9 @@ func NewDataDefinitionCodec(collectionID int64) *DataDefinitionCodec {
 
 func (dataDefinitionCodec *DataDefinitionCodec) Serialize(ts []Timestamp, ddRequests []string, eventTypes []EventTypeCode) ([]*Blob, error) {
 	writer := NewDDLBinlogWriter(schemapb.DataType_Int64, dataDefinitionCodec.collectionID)
+	if err := writer.SetCompression(dataDefinitionCodec.Compression); err != nil {
+		return nil, err
+	}
 
 	var blobs []*Blob
 
@@ -523,6 +894,9 @@ func (dataDefinitionCodec *DataDefinitionCodec) Serialize(ts []Timestamp, ddRequ
 	})
 
 	writer = NewDDLBinlogWriter(schemapb.DataType_String, dataDefinitionCodec.collectionID)
+	if err := writer.SetCompression(dataDefinitionCodec.Compression); err != nil {
+		return nil, err
+	}
 
 	for pos, req := range ddRequests {
 		switch eventTypes[pos] {
@@ -665,6 +1039,9 @@ func (dataDefinitionCodec *DataDefinitionCodec) Close() error {
 //func (indexCodec *IndexCodec) Deserialize(blobs []*Blob) ([]*Blob, error) {}
 
 type IndexCodec struct {
+	// KeyManager encrypts the index files by a new data key and decrypts the encrypted index files, the index
+	// files are not encrypted if it's nil
+	KeyManager *KeyManager
 }
 
 func NewIndexCodec() *IndexCodec {
@@ -684,6 +1061,21 @@ func (indexCodec *IndexCodec) Serialize(blobs []*Blob, params map[string]string,
 	if err != nil {
 		return nil, err
 	}
+	if indexCodec.KeyManager != nil {
+		dataKey, err := indexCodec.KeyManager.NewDataKey()
+		if err != nil {
+			return nil, err
+		}
+		encryptedBlobs := make([]*Blob, 0, len(blobs)+1)
+		for _, blob := range blobs {
+			value, err := encryptIndexFile(dataKey, blob.Value)
+			if err != nil {
+				return nil, err
+			}
+			encryptedBlobs = append(encryptedBlobs, &Blob{Key: blob.Key, Value: value})
+		}
+		blobs = encryptedBlobs
+	}
 	blobs = append(blobs, &Blob{Key: IndexParamsFile, Value: paramsBytes})
 	return blobs, nil
 }
@@ -709,6 +1101,17 @@ func (indexCodec *IndexCodec) Deserialize(blobs []*Blob) ([]*Blob, map[string]st
 	if err := json.Unmarshal(file.Value, &info); err != nil {
 		return nil, nil, "", InvalidUniqueID, fmt.Errorf("json unmarshal error: %s", err.Error())
 	}
+	indexBlobs := make([]*Blob, 0, len(blobs))
+	for _, blob := range blobs {
+		if IsEncryptedIndexFile(blob.Value) {
+			value, err := decryptIndexFile(indexCodec.KeyManager, blob.Value)
+			if err != nil {
+				return nil, nil, "", InvalidUniqueID, err
+			}
+			blob = &Blob{Key: blob.Key, Value: value}
+		}
+		indexBlobs = append(indexBlobs, blob)
+	}
 
-	return blobs, info.Params, info.IndexName, info.IndexID, nil
+	return indexBlobs, info.Params, info.IndexName, info.IndexID, nil
 }
@@ -297,6 +297,135 @@ func TestInsertCodec(t *testing.T) {
 	_, _, _, err = insertCodec.Deserialize(blobs)
 	assert.NotNil(t, err)
 }
+func TestInsertCodecDefaultFields(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+		{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+		{FieldID: Int64Field, Name: "field_int64", DataType: schemapb.DataType_Int64},
+	}
+	schema := &etcdpb.CollectionMeta{
+		ID:     CollectionID,
+		Schema: &schemapb.CollectionSchema{Name: "schema", Fields: fields},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			TimestampField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			Int64Field:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+		},
+	}
+	blobs, _, err := NewInsertCodec(schema).Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+
+	// fields added to the collection after the binlogs were written
+	newSchema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: append(fields,
+				&schemapb.FieldSchema{
+					FieldID:      DoubleField,
+					Name:         "field_double",
+					DataType:     schemapb.DataType_Double,
+					DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 1.5}},
+				},
+				&schemapb.FieldSchema{
+					FieldID:  Int32Field,
+					Name:     "field_int32",
+					DataType: schemapb.DataType_Int32,
+					Nullable: true,
+				},
+				&schemapb.FieldSchema{
+					FieldID:  FloatField,
+					Name:     "field_float",
+					DataType: schemapb.DataType_Float,
+				},
+			),
+		},
+	}
+	insertCodec := NewInsertCodec(newSchema)
+	_, _, resultData, err := insertCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, resultData.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, []float64{1.5, 1.5}, resultData.Data[DoubleField].(*DoubleFieldData).Data)
+	assert.Equal(t, []int64{2}, resultData.Data[DoubleField].(*DoubleFieldData).NumRows)
+	assert.Equal(t, []int32{0, 0}, resultData.Data[Int32Field].(*Int32FieldData).Data)
+	_, ok := resultData.Data[FloatField]
+	assert.False(t, ok)
+	assert.Nil(t, insertCodec.Close())
+}
+
+func TestInsertCodecCompression(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+		{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+		{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_String},
+	}
+	ids := make([]int64, 10000)
+	strs := make([]string, 10000)
+	for i := range ids {
+		ids[i] = int64(i)
+		strs[i] = "a scalar field of many repeated values"
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{10000}, Data: ids},
+			TimestampField: &Int64FieldData{NumRows: []int64{10000}, Data: ids},
+			StringField:    &StringFieldData{NumRows: []int64{10000}, Data: strs},
+		},
+	}
+	serialize := func(t *testing.T, schema *schemapb.CollectionSchema, compression CompressionType) []*Blob {
+		insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{ID: CollectionID, Schema: schema})
+		insertCodec.Compression = compression
+		blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(blobs))
+		return blobs
+	}
+	size := func(blobs []*Blob) int {
+		total := 0
+		for _, blob := range blobs {
+			total += len(blob.Value)
+		}
+		return total
+	}
+
+	uncompressed := serialize(t, &schemapb.CollectionSchema{Name: "schema", Fields: fields}, CompressionNone)
+	assert.EqualValues(t, 10000*8, uncompressed[0].RawSize)
+	for _, compression := range []CompressionType{CompressionSnappy, CompressionZstd, CompressionLZ4} {
+		blobs := serialize(t, &schemapb.CollectionSchema{Name: "schema", Fields: fields}, compression)
+		assert.Less(t, size(blobs), size(uncompressed))
+		for i := range blobs {
+			assert.Equal(t, uncompressed[i].RawSize, blobs[i].RawSize)
+			reader, err := NewBinlogReader(blobs[i].Value)
+			assert.Nil(t, err)
+			assert.Equal(t, compression, reader.Compression)
+			assert.Nil(t, reader.Close())
+		}
+
+		insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{ID: CollectionID, Schema: &schemapb.CollectionSchema{Name: "schema", Fields: fields}})
+		_, _, resultData, err := insertCodec.Deserialize(blobs)
+		assert.Nil(t, err)
+		assert.Equal(t, ids, resultData.Data[RowIDField].(*Int64FieldData).Data)
+		assert.Equal(t, strs, resultData.Data[StringField].(*StringFieldData).Data)
+		assert.Nil(t, insertCodec.Close())
+	}
+
+	// the compression of the collection takes precedence
+	blobs := serialize(t, &schemapb.CollectionSchema{Name: "schema", Fields: fields, BinlogCompression: "zstd"}, CompressionSnappy)
+	reader, err := NewBinlogReader(blobs[0].Value)
+	assert.Nil(t, err)
+	assert.Equal(t, CompressionZstd, reader.Compression)
+	assert.Nil(t, reader.Close())
+
+	insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{
+		ID:     CollectionID,
+		Schema: &schemapb.CollectionSchema{Name: "schema", Fields: fields, BinlogCompression: "gzip"},
+	})
+	_, _, err = insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.NotNil(t, err)
+}
+
 func TestDDCodec(t *testing.T) {
 	dataDefinitionCodec := NewDataDefinitionCodec(int64(1))
 	ts := []Timestamp{1, 2, 3, 4}
@@ -323,25 +452,90 @@ func TestDDCodec(t *testing.T) {
 	assert.Equal(t, resultRequests, ddRequests)
 	assert.Nil(t, dataDefinitionCodec.Close())
 
+	dataDefinitionCodec = NewDataDefinitionCodec(int64(1))
+	dataDefinitionCodec.Compression = CompressionLZ4
+	blobs, err = dataDefinitionCodec.Serialize(ts, ddRequests, eventTypeCodes)
+	assert.Nil(t, err)
+	for _, blob := range blobs {
+		reader, err := NewBinlogReader(blob.Value)
+		assert.Nil(t, err)
+		assert.Equal(t, CompressionLZ4, reader.Compression)
+		assert.Nil(t, reader.Close())
+		blob.Key = fmt.Sprintf("1/data_definition/3/4/5/%d", 99)
+	}
+	resultTs, resultRequests, err = dataDefinitionCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, resultTs, ts)
+	assert.Equal(t, resultRequests, ddRequests)
+	assert.Nil(t, dataDefinitionCodec.Close())
+
 	blobs = []*Blob{}
 	_, _, err = dataDefinitionCodec.Deserialize(blobs)
 	assert.NotNil(t, err)
 }
 
+func TestDeleteCodec(t *testing.T) {
+	deleteCodec := NewDeleteCodec()
+	data := &DeleteData{}
+	data.Append(1, 43757345)
+	data.Append(2, 23578294723)
+	data.Append(1, 43757346)
+	blob, err := deleteCodec.Serialize(CollectionID, PartitionID, SegmentID, data)
+	assert.Nil(t, err)
+
+	reader, err := NewBinlogReader(blob.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(CollectionID), reader.CollectionID)
+	assert.Equal(t, Timestamp(43757345), reader.StartTimestamp)
+	assert.Equal(t, Timestamp(23578294723), reader.EndTimestamp)
+	assert.Nil(t, reader.Close())
+
+	partitionID, segmentID, resultData, err := deleteCodec.Deserialize([]*Blob{blob, blob})
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(PartitionID), partitionID)
+	assert.Equal(t, UniqueID(SegmentID), segmentID)
+	assert.Equal(t, []int64{1, 2, 1, 1, 2, 1}, resultData.Pks)
+	assert.Equal(t, []Timestamp{43757345, 23578294723, 43757346, 43757345, 23578294723, 43757346}, resultData.Tss)
+	assert.Nil(t, deleteCodec.Close())
+
+	_, err = deleteCodec.Serialize(CollectionID, PartitionID, SegmentID, &DeleteData{})
+	assert.NotNil(t, err)
+	_, _, _, err = deleteCodec.Deserialize([]*Blob{})
+	assert.NotNil(t, err)
+
+	// an insert binlog is not a delta log
+	insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+			{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+		}},
+	})
+	binlogs, _, err := insertCodec.Serialize(PartitionID, SegmentID, &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{1}, Data: []int64{1}},
+			TimestampField: &Int64FieldData{NumRows: []int64{1}, Data: []int64{1}},
+		},
+	})
+	assert.Nil(t, err)
+	_, _, _, err = deleteCodec.Deserialize(binlogs)
+	assert.NotNil(t, err)
+}
+
 func TestIndexCodec(t *testing.T) {
 	indexCodec := NewIndexCodec()
 	blobs := []*Blob{
 		{
-			"12345",
-			[]byte{1, 2, 3, 4, 5, 6, 7, 1, 2, 3, 4, 5, 6, 7},
+			Key:   "12345",
+			Value: []byte{1, 2, 3, 4, 5, 6, 7, 1, 2, 3, 4, 5, 6, 7},
 		},
 		{
-			"6666",
-			[]byte{6, 6, 6, 6, 6, 1, 2, 3, 4, 5, 6, 7},
+			Key:   "6666",
+			Value: []byte{6, 6, 6, 6, 6, 1, 2, 3, 4, 5, 6, 7},
 		},
 		{
-			"8885",
-			[]byte{8, 8, 8, 8, 8, 8, 8, 8, 2, 3, 4, 5, 6, 7},
+			Key:   "8885",
+			Value: []byte{8, 8, 8, 8, 8, 8, 8, 8, 2, 3, 4, 5, 6, 7},
 		},
 	}
 	indexParams := map[string]string{
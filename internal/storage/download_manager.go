@@ -0,0 +1,199 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	defaultDownloadParallelism   = 16
+	defaultDownloadPartSize      = 64 << 20
+	defaultDownloadRetryAttempts = 5
+)
+
+// DownloadManager downloads files from a ChunkManager in parallel. A file larger than the part size is split into
+// parts downloaded by ranged reads, the parts of all the files share at most parallelism concurrent reads, and
+// every read is retried with backoff. The size of a file is read first, so its parts are read into a pre-sized
+// buffer directly.
+type DownloadManager struct {
+	cm           ChunkManager
+	parallelism  int
+	partSize     int64
+	retryOptions []retry.Option
+}
+
+type DownloadOption func(dm *DownloadManager)
+
+// DownloadParallelism sets the max number of concurrent reads
+func DownloadParallelism(parallelism int) DownloadOption {
+	return func(dm *DownloadManager) {
+		if parallelism > 0 {
+			dm.parallelism = parallelism
+		}
+	}
+}
+
+// DownloadPartSize sets the size of the ranged reads a file is split into
+func DownloadPartSize(partSize int64) DownloadOption {
+	return func(dm *DownloadManager) {
+		if partSize > 0 {
+			dm.partSize = partSize
+		}
+	}
+}
+
+// DownloadRetry sets the retry options of every read
+func DownloadRetry(opts ...retry.Option) DownloadOption {
+	return func(dm *DownloadManager) {
+		dm.retryOptions = opts
+	}
+}
+
+func NewDownloadManager(cm ChunkManager, opts ...DownloadOption) *DownloadManager {
+	dm := &DownloadManager{
+		cm:           cm,
+		parallelism:  defaultDownloadParallelism,
+		partSize:     defaultDownloadPartSize,
+		retryOptions: []retry.Option{retry.Attempts(defaultDownloadRetryAttempts)},
+	}
+	for _, opt := range opts {
+		opt(dm)
+	}
+	return dm
+}
+
+// downloadPart is a range of a file, which is read into buf
+type downloadPart struct {
+	key string
+	off int64
+	buf []byte
+}
+
+// Download downloads the files of keys, the i-th result is the content of keys[i]
+func (dm *DownloadManager) Download(ctx context.Context, keys []string) ([][]byte, error) {
+	start := time.Now()
+	results := make([][]byte, len(keys))
+	err := dm.run(ctx, len(keys), func(ctx context.Context, i int) error {
+		var size int64
+		err := retry.Do(ctx, func() error {
+			var err error
+			size, err = dm.cm.Size(keys[i])
+			return err
+		}, dm.retryOptions...)
+		if err != nil {
+			return fmt.Errorf("failed to get size of %s: %w", keys[i], err)
+		}
+		results[i] = make([]byte, size)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []downloadPart
+	var total int64
+	for i, key := range keys {
+		size := int64(len(results[i]))
+		for off := int64(0); off < size; off += dm.partSize {
+			end := off + dm.partSize
+			if end > size {
+				end = size
+			}
+			parts = append(parts, downloadPart{key: key, off: off, buf: results[i][off:end]})
+		}
+		total += size
+	}
+	err = dm.run(ctx, len(parts), func(ctx context.Context, i int) error {
+		return dm.readPart(ctx, parts[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("download files done", zap.Int("files", len(keys)), zap.Int("parts", len(parts)),
+		zap.Int64("size", total), zap.Duration("time cost", time.Since(start)))
+	return results, nil
+}
+
+func (dm *DownloadManager) readPart(ctx context.Context, part downloadPart) error {
+	err := retry.Do(ctx, func() error {
+		n, err := dm.cm.ReadAt(part.key, part.buf, part.off)
+		if err == io.EOF && n == len(part.buf) {
+			return nil
+		}
+		if err == nil && n < len(part.buf) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}, dm.retryOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to read %s from %d: %w", part.key, part.off, err)
+	}
+	return nil
+}
+
+// run calls f for 0 to total-1 by at most parallelism goroutines, it stops at the first error
+func (dm *DownloadManager) run(ctx context.Context, total int, f func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := dm.parallelism
+	if workers > total {
+		workers = total
+	}
+	tasks := make(chan int)
+	errCh := make(chan error, 1)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range tasks {
+				if err := f(ctx, i); err != nil {
+					select {
+					case errCh <- err:
+					default:
+					}
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+loop:
+	for i := 0; i < total; i++ {
+		select {
+		case tasks <- i:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(tasks)
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+	}
+	return ctx.Err()
+}
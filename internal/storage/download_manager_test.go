@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyChunkManager fails the first read of every range, and records the max number of concurrent reads
+type flakyChunkManager struct {
+	ChunkManager
+	mu         sync.Mutex
+	failed     map[string]bool
+	running    int64
+	maxRunning int64
+}
+
+func (cm *flakyChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
+	running := atomic.AddInt64(&cm.running, 1)
+	defer atomic.AddInt64(&cm.running, -1)
+	time.Sleep(time.Millisecond)
+
+	cm.mu.Lock()
+	if running > cm.maxRunning {
+		cm.maxRunning = running
+	}
+	if cm.failed == nil {
+		cm.failed = make(map[string]bool)
+	}
+	r := fmt.Sprintf("%s:%d:%d", key, off, len(p))
+	fail := !cm.failed[r]
+	cm.failed[r] = true
+	cm.mu.Unlock()
+
+	if fail {
+		return 0, errors.New("connection reset")
+	}
+	return cm.ChunkManager.ReadAt(key, p, off)
+}
+
+func TestDownloadManager(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_download")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	lcm := NewLocalChunkManager(dir)
+
+	files := map[string][]byte{
+		"small":  []byte("0123456789"),
+		"exact":  bytes.Repeat([]byte{1}, 16),
+		"large":  bytes.Repeat([]byte("abcdefg"), 100),
+		"a/b/c":  []byte("nested"),
+		"empty":  {},
+		"single": {2},
+	}
+	keys := make([]string, 0, len(files))
+	for key, content := range files {
+		assert.Nil(t, lcm.Write(key, content))
+		keys = append(keys, key)
+	}
+
+	cm := &flakyChunkManager{ChunkManager: lcm}
+	dm := NewDownloadManager(cm,
+		DownloadParallelism(4),
+		DownloadPartSize(16),
+		DownloadRetry(retry.Attempts(3), retry.Sleep(time.Millisecond)))
+	results, err := dm.Download(context.Background(), keys)
+	assert.Nil(t, err)
+	assert.Equal(t, len(keys), len(results))
+	for i, key := range keys {
+		assert.Equal(t, len(files[key]), len(results[i]))
+		assert.True(t, bytes.Equal(files[key], results[i]), key)
+	}
+	assert.LessOrEqual(t, cm.maxRunning, int64(4))
+	assert.Greater(t, cm.maxRunning, int64(1))
+
+	results, err = dm.Download(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Empty(t, results)
+
+	_, err = dm.Download(context.Background(), []string{"small", "not_exist"})
+	assert.NotNil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = dm.Download(ctx, keys)
+	assert.NotNil(t, err)
+}
+
+func TestDownloadManager_RetryExhausted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_download")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	lcm := NewLocalChunkManager(dir)
+	assert.Nil(t, lcm.Write("key", []byte("value")))
+
+	// the first read of every range fails, so the download fails without retry
+	dm := NewDownloadManager(&flakyChunkManager{ChunkManager: lcm}, DownloadRetry(retry.Attempts(1)))
+	_, err = dm.Download(context.Background(), []string{"key"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,518 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// DataKeySize is the size of the AES-256 data keys and master keys
+const DataKeySize = 32
+
+// EncryptedIndexMagicNumber is the magic number of the encrypted index files
+const EncryptedIndexMagicNumber int32 = 0xfffabe
+
+// EncryptedCacheMagicNumber is the magic number of the encrypted local cache files
+const EncryptedCacheMagicNumber int32 = 0xfffabf
+
+// cacheBlockSize is the size of the blocks the local cache files are encrypted in, every block is encrypted on its
+// own, so reading a few rows only decrypts the blocks they're in
+const cacheBlockSize = 64 << 10
+
+// keyCacheCapacity is the max number of the segment data keys and the unwrapped data keys a KeyManager caches
+const keyCacheCapacity = 4096
+
+// KeyProvider provides the master keys wrapping the data keys, a data key is stored wrapped next to the data it
+// encrypts, together with the id of the master key, so the master key can be rotated by wrapping the new data
+// keys with a new master key while the old ones are kept to unwrap the existing data keys
+type KeyProvider interface {
+	// CurrentKeyID returns the id of the master key new data keys are wrapped with
+	CurrentKeyID() string
+	WrapKey(keyID string, dataKey []byte) ([]byte, error)
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// LocalKeyProvider is the KeyProvider of the master keys stored in a local key file, every line of the file is a
+// key id followed by the base64 encoded 32 bytes key, the key on the last line is the current key, so a key is
+// rotated by appending a new line. Empty lines and lines starting with # are ignored.
+type LocalKeyProvider struct {
+	keys         map[string][]byte
+	currentKeyID string
+}
+
+func NewLocalKeyProvider(keyFile string) (*LocalKeyProvider, error) {
+	file, err := os.Open(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	provider := &LocalKeyProvider{keys: make(map[string][]byte)}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid line in key file %s: %s", keyFile, line)
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s in key file %s: %s", fields[0], keyFile, err.Error())
+		}
+		if len(key) != DataKeySize {
+			return nil, fmt.Errorf("invalid size %d of key %s in key file %s, expected: %d", len(key), fields[0], keyFile, DataKeySize)
+		}
+		provider.keys[fields[0]] = key
+		provider.currentKeyID = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if provider.currentKeyID == "" {
+		return nil, fmt.Errorf("no key in key file %s", keyFile)
+	}
+	return provider, nil
+}
+
+func (provider *LocalKeyProvider) CurrentKeyID() string {
+	return provider.currentKeyID
+}
+
+func (provider *LocalKeyProvider) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	key, ok := provider.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown master key %s", keyID)
+	}
+	return encryptPayload(key, dataKey)
+}
+
+func (provider *LocalKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	key, ok := provider.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown master key %s", keyID)
+	}
+	return decryptPayload(key, wrappedKey)
+}
+
+// DataKey is the key encrypting the payloads, WrappedKey is Key wrapped by the master key KeyID
+type DataKey struct {
+	KeyID      string
+	Key        []byte
+	WrappedKey []byte
+}
+
+type keyCacheEntry struct {
+	key   interface{}
+	value interface{}
+}
+
+// keyCache is an LRU cache of the data keys, the least recently used key is dropped once it's full
+type keyCache struct {
+	capacity int
+	ll       *list.List
+	items    map[interface{}]*list.Element
+}
+
+func newKeyCache(capacity int) *keyCache {
+	return &keyCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[interface{}]*list.Element),
+	}
+}
+
+func (cache *keyCache) get(key interface{}) (interface{}, bool) {
+	elem, ok := cache.items[key]
+	if !ok {
+		return nil, false
+	}
+	cache.ll.MoveToFront(elem)
+	return elem.Value.(*keyCacheEntry).value, true
+}
+
+func (cache *keyCache) add(key interface{}, value interface{}) {
+	if elem, ok := cache.items[key]; ok {
+		elem.Value.(*keyCacheEntry).value = value
+		cache.ll.MoveToFront(elem)
+		return
+	}
+	cache.items[key] = cache.ll.PushFront(&keyCacheEntry{key: key, value: value})
+	for cache.ll.Len() > cache.capacity {
+		cache.remove(cache.ll.Back().Value.(*keyCacheEntry).key)
+	}
+}
+
+func (cache *keyCache) remove(key interface{}) {
+	if elem, ok := cache.items[key]; ok {
+		cache.ll.Remove(elem)
+		delete(cache.items, key)
+	}
+}
+
+func (cache *keyCache) len() int {
+	return cache.ll.Len()
+}
+
+// KeyManager generates the data keys of the segments and unwraps the data keys read from storage, the data keys
+// are cached in LRU caches of keyCacheCapacity, so the key provider is only asked once for every data key which
+// is in use. Every blob carries the wrapped data key it's encrypted by, so a segment whose data key is evicted just
+// gets a new data key for its later blobs.
+type KeyManager struct {
+	provider KeyProvider
+
+	mu          sync.Mutex
+	segmentKeys *keyCache // segment id -> *DataKey
+	dataKeys    *keyCache // key id/wrapped key -> unwrapped key
+}
+
+func NewKeyManager(provider KeyProvider) *KeyManager {
+	return &KeyManager{
+		provider:    provider,
+		segmentKeys: newKeyCache(keyCacheCapacity),
+		dataKeys:    newKeyCache(keyCacheCapacity),
+	}
+}
+
+// NewKeyManagerFromKeyFile returns the KeyManager of the master keys in keyFile, it returns nil if keyFile is empty,
+// which means encryption is disabled
+func NewKeyManagerFromKeyFile(keyFile string) (*KeyManager, error) {
+	if keyFile == "" {
+		return nil, nil
+	}
+	provider, err := NewLocalKeyProvider(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyManager(provider), nil
+}
+
+// NewDataKey generates a data key wrapped by the current master key
+func (manager *KeyManager) NewDataKey() (*DataKey, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	keyID := manager.provider.CurrentKeyID()
+	wrappedKey, err := manager.provider.WrapKey(keyID, key)
+	if err != nil {
+		return nil, err
+	}
+	return &DataKey{KeyID: keyID, Key: key, WrappedKey: wrappedKey}, nil
+}
+
+// SegmentDataKey returns the data key of the segment, it's generated the first time the segment is asked for
+func (manager *KeyManager) SegmentDataKey(segmentID UniqueID) (*DataKey, error) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	if key, ok := manager.segmentKeys.get(segmentID); ok {
+		return key.(*DataKey), nil
+	}
+	key, err := manager.NewDataKey()
+	if err != nil {
+		return nil, err
+	}
+	manager.segmentKeys.add(segmentID, key)
+	return key, nil
+}
+
+// ReleaseSegment drops the data key of a segment which is flushed or dropped, and won't be written any more
+func (manager *KeyManager) ReleaseSegment(segmentID UniqueID) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	manager.segmentKeys.remove(segmentID)
+}
+
+// UnwrapDataKey returns the data key wrapped by the master key keyID
+func (manager *KeyManager) UnwrapDataKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	cacheKey := keyID + "/" + string(wrappedKey)
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	if key, ok := manager.dataKeys.get(cacheKey); ok {
+		return key.([]byte), nil
+	}
+	key, err := manager.provider.UnwrapKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	manager.dataKeys.add(cacheKey, key)
+	return key, nil
+}
+
+// encryptPayload encrypts data by AES-GCM, the random nonce is prepended to the cipher text
+func encryptPayload(key []byte, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(data)+gcm.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// decryptPayload decrypts data encrypted by encryptPayload
+func decryptPayload(key []byte, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted payload is too short")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// writeEncryptionKey writes the key id and the wrapped data key, both are prefixed by their int16 length
+func writeEncryptionKey(buffer io.Writer, keyID string, wrappedKey []byte) error {
+	for _, b := range [][]byte{[]byte(keyID), wrappedKey} {
+		if err := binary.Write(buffer, binary.LittleEndian, int16(len(b))); err != nil {
+			return err
+		}
+		if err := binary.Write(buffer, binary.LittleEndian, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readEncryptionKey reads the key id and the wrapped data key written by writeEncryptionKey
+func readEncryptionKey(buffer io.Reader) (string, []byte, error) {
+	var fields [2][]byte
+	for i := range fields {
+		var length int16
+		if err := binary.Read(buffer, binary.LittleEndian, &length); err != nil {
+			return "", nil, err
+		}
+		if length < 0 {
+			return "", nil, fmt.Errorf("invalid length %d of encryption key", length)
+		}
+		fields[i] = make([]byte, length)
+		if _, err := io.ReadFull(buffer, fields[i]); err != nil {
+			return "", nil, err
+		}
+	}
+	return string(fields[0]), fields[1], nil
+}
+
+// encryptionKeySize returns the size of the key id and the wrapped data key written by writeEncryptionKey
+func encryptionKeySize(keyID string, wrappedKey []byte) int32 {
+	return int32(2*binary.Size(int16(0)) + len(keyID) + len(wrappedKey))
+}
+
+// encryptIndexFile encrypts the index file by the data key, the encrypted file starts with
+// EncryptedIndexMagicNumber, followed by the key id and the wrapped data key
+func encryptIndexFile(key *DataKey, data []byte) ([]byte, error) {
+	encrypted, err := encryptPayload(key.Key, data)
+	if err != nil {
+		return nil, err
+	}
+	buffer := new(bytes.Buffer)
+	if err := binary.Write(buffer, binary.LittleEndian, EncryptedIndexMagicNumber); err != nil {
+		return nil, err
+	}
+	if err := writeEncryptionKey(buffer, key.KeyID, key.WrappedKey); err != nil {
+		return nil, err
+	}
+	buffer.Write(encrypted)
+	return buffer.Bytes(), nil
+}
+
+// IsEncryptedIndexFile tells whether data is an index file encrypted by encryptIndexFile
+func IsEncryptedIndexFile(data []byte) bool {
+	var magic int32
+	if len(data) < binary.Size(magic) {
+		return false
+	}
+	return int32(binary.LittleEndian.Uint32(data)) == EncryptedIndexMagicNumber
+}
+
+// decryptIndexFile decrypts the index file encrypted by encryptIndexFile
+func decryptIndexFile(manager *KeyManager, data []byte) ([]byte, error) {
+	buffer := bytes.NewReader(data[binary.Size(EncryptedIndexMagicNumber):])
+	keyID, wrappedKey, err := readEncryptionKey(buffer)
+	if err != nil {
+		return nil, err
+	}
+	if manager == nil {
+		return nil, fmt.Errorf("index file is encrypted by master key %s, but encryption is not enabled", keyID)
+	}
+	key, err := manager.UnwrapDataKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return decryptPayload(key, data[len(data)-buffer.Len():])
+}
+
+// encryptCacheFile encrypts the data cached locally by the data key block by block, the encrypted file starts with
+// EncryptedCacheMagicNumber, followed by the key id, the wrapped data key and the size of data. The index of a block
+// is authenticated with it, so the blocks can't be reordered.
+func encryptCacheFile(key *DataKey, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key.Key)
+	if err != nil {
+		return nil, err
+	}
+	buffer := new(bytes.Buffer)
+	if err := binary.Write(buffer, binary.LittleEndian, EncryptedCacheMagicNumber); err != nil {
+		return nil, err
+	}
+	if err := writeEncryptionKey(buffer, key.KeyID, key.WrappedKey); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buffer, binary.LittleEndian, int64(len(data))); err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	for i := 0; i*cacheBlockSize < len(data); i++ {
+		end := (i + 1) * cacheBlockSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+		buffer.Write(nonce)
+		buffer.Write(gcm.Seal(nil, nonce, data[i*cacheBlockSize:end], cacheBlockIndex(i)))
+	}
+	return buffer.Bytes(), nil
+}
+
+func cacheBlockIndex(i int) []byte {
+	index := make([]byte, binary.Size(int64(0)))
+	binary.LittleEndian.PutUint64(index, uint64(i))
+	return index
+}
+
+// IsEncryptedCacheFile tells whether the file starting with data is a cache file encrypted by encryptCacheFile
+func IsEncryptedCacheFile(data []byte) bool {
+	var magic int32
+	if len(data) < binary.Size(magic) {
+		return false
+	}
+	return int32(binary.LittleEndian.Uint32(data)) == EncryptedCacheMagicNumber
+}
+
+// encryptedCacheFile is an encrypted cache file read by its io.ReaderAt, the blocks are decrypted on read
+type encryptedCacheFile struct {
+	reader     io.ReaderAt
+	gcm        cipher.AEAD
+	size       int64 // the size of the decrypted data
+	headerSize int64
+}
+
+// openEncryptedCacheFile reads the header of a cache file encrypted by encryptCacheFile and unwraps its data key
+func openEncryptedCacheFile(manager *KeyManager, reader io.ReaderAt) (*encryptedCacheFile, error) {
+	buffer := bufio.NewReader(io.NewSectionReader(reader, 0, 1<<62))
+	var magic int32
+	if err := binary.Read(buffer, binary.LittleEndian, &magic); err != nil {
+		return nil, err
+	}
+	if magic != EncryptedCacheMagicNumber {
+		return nil, fmt.Errorf("cache file is not encrypted")
+	}
+	keyID, wrappedKey, err := readEncryptionKey(buffer)
+	if err != nil {
+		return nil, err
+	}
+	var size int64
+	if err := binary.Read(buffer, binary.LittleEndian, &size); err != nil {
+		return nil, err
+	}
+	if manager == nil {
+		return nil, fmt.Errorf("cache file is encrypted by master key %s, but encryption is not enabled", keyID)
+	}
+	key, err := manager.UnwrapDataKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedCacheFile{
+		reader:     reader,
+		gcm:        gcm,
+		size:       size,
+		headerSize: int64(binary.Size(magic)+binary.Size(size)) + int64(encryptionKeySize(keyID, wrappedKey)),
+	}, nil
+}
+
+// ReadAt decrypts the blocks p overlaps and copies the decrypted data at off into p
+func (file *encryptedCacheFile) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 || off > file.size {
+		return 0, fmt.Errorf("invalid offset %d of cache file of size %d", off, file.size)
+	}
+	encryptedBlockSize := int64(file.gcm.NonceSize() + cacheBlockSize + file.gcm.Overhead())
+	n := 0
+	for n < len(p) && off < file.size {
+		i := off / cacheBlockSize
+		blockSize := file.size - i*cacheBlockSize
+		if blockSize > cacheBlockSize {
+			blockSize = cacheBlockSize
+		}
+		block := make([]byte, int64(file.gcm.NonceSize()+file.gcm.Overhead())+blockSize)
+		if read, err := file.reader.ReadAt(block, file.headerSize+i*encryptedBlockSize); read < len(block) {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return n, err
+		}
+		nonceSize := file.gcm.NonceSize()
+		decrypted, err := file.gcm.Open(nil, block[:nonceSize], block[nonceSize:], cacheBlockIndex(int(i)))
+		if err != nil {
+			return n, err
+		}
+		copied := copy(p[n:], decrypted[off-i*cacheBlockSize:])
+		n += copied
+		off += int64(copied)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// decryptCacheFile decrypts the cache file encrypted by encryptCacheFile
+func decryptCacheFile(manager *KeyManager, data []byte) ([]byte, error) {
+	file, err := openEncryptedCacheFile(manager, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	decrypted := make([]byte, file.size)
+	if _, err := file.ReadAt(decrypted, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return decrypted, nil
+}
@@ -0,0 +1,282 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeKeyFile(t *testing.T, dir string, keys map[string]byte, order ...string) string {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString("# master keys\n\n")
+	for _, id := range order {
+		buffer.WriteString(id + " " + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{keys[id]}, DataKeySize)) + "\n")
+	}
+	keyFile := path.Join(dir, "keyfile")
+	assert.Nil(t, ioutil.WriteFile(keyFile, buffer.Bytes(), 0600))
+	return keyFile
+}
+
+func TestLocalKeyProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_encryption")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	keyFile := writeKeyFile(t, dir, map[string]byte{"k1": 1}, "k1")
+	provider, err := NewLocalKeyProvider(keyFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "k1", provider.CurrentKeyID())
+	wrapped, err := provider.WrapKey("k1", []byte("data key"))
+	assert.Nil(t, err)
+	key, err := provider.UnwrapKey("k1", wrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("data key"), key)
+	_, err = provider.WrapKey("k2", []byte("data key"))
+	assert.NotNil(t, err)
+
+	// the key is rotated by appending a new key, the data keys wrapped by the old key can still be unwrapped
+	keyFile = writeKeyFile(t, dir, map[string]byte{"k1": 1, "k2": 2}, "k1", "k2")
+	provider, err = NewLocalKeyProvider(keyFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", provider.CurrentKeyID())
+	key, err = provider.UnwrapKey("k1", wrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("data key"), key)
+	_, err = provider.UnwrapKey("k2", wrapped)
+	assert.NotNil(t, err)
+
+	for _, content := range []string{"", "k1\n", "k1 !!!\n", "k1 " + base64.StdEncoding.EncodeToString([]byte("short")) + "\n"} {
+		assert.Nil(t, ioutil.WriteFile(keyFile, []byte(content), 0600))
+		_, err = NewLocalKeyProvider(keyFile)
+		assert.NotNil(t, err)
+	}
+	_, err = NewLocalKeyProvider(path.Join(dir, "not_exist"))
+	assert.NotNil(t, err)
+}
+
+func TestKeyManager(t *testing.T) {
+	manager, err := NewKeyManagerFromKeyFile("")
+	assert.Nil(t, err)
+	assert.Nil(t, manager)
+
+	dir, err := ioutil.TempDir("", "milvus_encryption")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	manager, err = NewKeyManagerFromKeyFile(writeKeyFile(t, dir, map[string]byte{"k1": 1}, "k1"))
+	assert.Nil(t, err)
+
+	key, err := manager.SegmentDataKey(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "k1", key.KeyID)
+	assert.Equal(t, DataKeySize, len(key.Key))
+	cached, err := manager.SegmentDataKey(1)
+	assert.Nil(t, err)
+	assert.Equal(t, key, cached)
+	other, err := manager.SegmentDataKey(2)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key.Key, other.Key)
+
+	unwrapped, err := manager.UnwrapDataKey(key.KeyID, key.WrappedKey)
+	assert.Nil(t, err)
+	assert.Equal(t, key.Key, unwrapped)
+	_, err = manager.UnwrapDataKey(key.KeyID, other.WrappedKey[1:])
+	assert.NotNil(t, err)
+
+	// a released segment gets a new data key
+	manager.ReleaseSegment(1)
+	renewed, err := manager.SegmentDataKey(1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key.Key, renewed.Key)
+
+	// the least recently used keys are evicted
+	for i := 0; i < keyCacheCapacity; i++ {
+		_, err = manager.SegmentDataKey(UniqueID(100 + i))
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, keyCacheCapacity, manager.segmentKeys.len())
+	_, ok := manager.segmentKeys.get(UniqueID(2))
+	assert.False(t, ok)
+}
+
+func TestKeyCache(t *testing.T) {
+	cache := newKeyCache(2)
+	cache.add(1, "a")
+	cache.add(2, "b")
+	_, ok := cache.get(1)
+	assert.True(t, ok)
+	cache.add(3, "c")
+	_, ok = cache.get(2)
+	assert.False(t, ok)
+	value, ok := cache.get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", value)
+	cache.add(1, "d")
+	value, _ = cache.get(1)
+	assert.Equal(t, "d", value)
+	assert.Equal(t, 2, cache.len())
+	cache.remove(1)
+	assert.Equal(t, 1, cache.len())
+}
+
+func TestInsertCodecEncryption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_encryption")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	keyFile := writeKeyFile(t, dir, map[string]byte{"k1": 1}, "k1")
+	manager, err := NewKeyManagerFromKeyFile(keyFile)
+	assert.Nil(t, err)
+
+	fields := []*schemapb.FieldSchema{
+		{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+		{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+		{FieldID: FloatVectorField, Name: "field_float_vector", DataType: schemapb.DataType_FloatVector},
+	}
+	schema := &etcdpb.CollectionMeta{
+		ID:     CollectionID,
+		Schema: &schemapb.CollectionSchema{Name: "schema", Fields: fields},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:       &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			TimestampField:   &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			FloatVectorField: &FloatVectorFieldData{NumRows: []int64{3}, Data: []float32{1, 1, 2, 2, 3, 3}, Dim: 2},
+		},
+	}
+	insertCodec := NewInsertCodec(schema)
+	insertCodec.KeyManager = manager
+	blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+
+	// the key id and the wrapped data key are recorded in the descriptor event
+	for _, blob := range blobs {
+		reader, err := NewBinlogReader(blob.Value)
+		assert.Nil(t, err)
+		assert.Equal(t, "k1", reader.KeyID)
+		assert.NotEmpty(t, reader.WrappedKey)
+		_, err = reader.NextEventReader()
+		assert.NotNil(t, err)
+		reader.Close()
+		assert.Nil(t, VerifyBinlog(blob.Value))
+	}
+
+	_, _, _, err = NewInsertCodec(schema).Deserialize(blobs)
+	assert.NotNil(t, err)
+
+	// a key manager loading the same key file decrypts the payloads
+	manager, err = NewKeyManagerFromKeyFile(keyFile)
+	assert.Nil(t, err)
+	decryptCodec := NewInsertCodec(schema)
+	decryptCodec.KeyManager = manager
+	defer decryptCodec.Close()
+	_, segmentID, resultData, err := decryptCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(SegmentID), segmentID)
+	assert.Equal(t, []int64{1, 2, 3}, resultData.Data[RowIDField].(*Int64FieldData).Data)
+	assert.Equal(t, []float32{1, 1, 2, 2, 3, 3}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+}
+
+func TestIndexCodecEncryption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_encryption")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	manager, err := NewKeyManagerFromKeyFile(writeKeyFile(t, dir, map[string]byte{"k1": 1}, "k1"))
+	assert.Nil(t, err)
+
+	indexCodec := NewIndexCodec()
+	indexCodec.KeyManager = manager
+	indexBlobs := []*Blob{
+		{Key: "IVF", Value: []byte{1, 2, 3, 4, 5, 6, 7}},
+		{Key: "SQ8", Value: []byte{8, 9, 10}},
+	}
+	blobs, err := indexCodec.Serialize(indexBlobs, map[string]string{"k": "v"}, "index_test_name", 1234)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(blobs))
+	for i, blob := range indexBlobs {
+		assert.True(t, IsEncryptedIndexFile(blobs[i].Value))
+		assert.NotEqual(t, blob.Value, blobs[i].Value)
+	}
+
+	_, _, _, _, err = NewIndexCodec().Deserialize(blobs)
+	assert.NotNil(t, err)
+
+	resultBlobs, params, indexName, indexID, err := indexCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, indexBlobs, resultBlobs)
+	assert.Equal(t, map[string]string{"k": "v"}, params)
+	assert.Equal(t, "index_test_name", indexName)
+	assert.Equal(t, UniqueID(1234), indexID)
+}
+
+func TestCacheFileEncryption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_encryption")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	manager, err := NewKeyManagerFromKeyFile(writeKeyFile(t, dir, map[string]byte{"k1": 1}, "k1"))
+	assert.Nil(t, err)
+	dataKey, err := manager.NewDataKey()
+	assert.Nil(t, err)
+
+	data := make([]byte, 2*cacheBlockSize+100)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	encrypted, err := encryptCacheFile(dataKey, data)
+	assert.Nil(t, err)
+	assert.True(t, IsEncryptedCacheFile(encrypted))
+	assert.False(t, bytes.Contains(encrypted, data[:1024]))
+
+	decrypted, err := decryptCacheFile(manager, encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, data, decrypted)
+	_, err = decryptCacheFile(nil, encrypted)
+	assert.NotNil(t, err)
+
+	// the reads across the blocks only decrypt the blocks they overlap
+	file, err := openEncryptedCacheFile(manager, bytes.NewReader(encrypted))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), file.size)
+	p := make([]byte, 200)
+	n, err := file.ReadAt(p, cacheBlockSize-100)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, n)
+	assert.Equal(t, data[cacheBlockSize-100:cacheBlockSize+100], p)
+	n, err = file.ReadAt(p, int64(len(data))-50)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 50, n)
+	assert.Equal(t, data[len(data)-50:], p[:n])
+	_, err = file.ReadAt(p, int64(len(data))+1)
+	assert.NotNil(t, err)
+
+	// the tampered or truncated blocks are not decrypted
+	tampered := append([]byte{}, encrypted...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = decryptCacheFile(manager, tampered)
+	assert.NotNil(t, err)
+	_, err = decryptCacheFile(manager, encrypted[:len(encrypted)-1])
+	assert.NotNil(t, err)
+
+	empty, err := encryptCacheFile(dataKey, nil)
+	assert.Nil(t, err)
+	decrypted, err = decryptCacheFile(manager, empty)
+	assert.Nil(t, err)
+	assert.Empty(t, decrypted)
+}
@@ -23,6 +23,13 @@ import (
 type descriptorEventData struct {
 	DescriptorEventDataFixPart
 	PostHeaderLengths []uint8
+	// Compression follows PostHeaderLengths, it's missing in the binlogs written before the payloads could be
+	// compressed, these binlogs are read as uncompressed
+	Compression CompressionType
+	// KeyID and WrappedKey follow Compression if the payloads are encrypted, WrappedKey is the data key encrypting
+	// the payloads wrapped by the master key KeyID, they're missing in the binlogs which aren't encrypted
+	KeyID      string
+	WrappedKey []byte
 }
 
 type DescriptorEventDataFixPart struct {
@@ -49,7 +56,11 @@ func (data *descriptorEventData) GetEventDataFixPartSize() int32 {
 }
 
 func (data *descriptorEventData) GetMemoryUsageInBytes() int32 {
-	return data.GetEventDataFixPartSize() + int32(binary.Size(data.PostHeaderLengths))
+	size := data.GetEventDataFixPartSize() + int32(binary.Size(data.PostHeaderLengths)) + int32(binary.Size(data.Compression))
+	if data.KeyID != "" {
+		size += encryptionKeySize(data.KeyID, data.WrappedKey)
+	}
+	return size
 }
 
 func (data *descriptorEventData) Write(buffer io.Writer) error {
@@ -59,10 +70,17 @@ func (data *descriptorEventData) Write(buffer io.Writer) error {
 	if err := binary.Write(buffer, binary.LittleEndian, data.PostHeaderLengths); err != nil {
 		return err
 	}
+	if err := binary.Write(buffer, binary.LittleEndian, data.Compression); err != nil {
+		return err
+	}
+	if data.KeyID != "" {
+		return writeEncryptionKey(buffer, data.KeyID, data.WrappedKey)
+	}
 	return nil
 }
 
-func readDescriptorEventData(buffer io.Reader) (*descriptorEventData, error) {
+// readDescriptorEventData reads the descriptor event data of the given length
+func readDescriptorEventData(buffer io.Reader, length int32) (*descriptorEventData, error) {
 	event := newDescriptorEventData()
 	if err := binary.Read(buffer, binary.LittleEndian, &event.DescriptorEventDataFixPart); err != nil {
 		return nil, err
@@ -70,19 +88,38 @@ func readDescriptorEventData(buffer io.Reader) (*descriptorEventData, error) {
 	if err := binary.Read(buffer, binary.LittleEndian, &event.PostHeaderLengths); err != nil {
 		return nil, err
 	}
+	if length < event.GetMemoryUsageInBytes() {
+		return event, nil
+	}
+	if err := binary.Read(buffer, binary.LittleEndian, &event.Compression); err != nil {
+		return nil, err
+	}
+	if length <= event.GetMemoryUsageInBytes() {
+		return event, nil
+	}
+	keyID, wrappedKey, err := readEncryptionKey(buffer)
+	if err != nil {
+		return nil, err
+	}
+	event.KeyID, event.WrappedKey = keyID, wrappedKey
 	return event, nil
 }
 
 type eventData interface {
 	GetEventDataFixPartSize() int32
 	WriteEventData(buffer io.Writer) error
+	GetChecksum() uint32
 }
 
-// all event types' fixed part only have start Timestamp and end Timestamp yet, but maybe different events will
-// have different fields later, so we just create a event data struct per event type.
+// all event types' fixed part only have start Timestamp, end Timestamp and Checksum yet, but maybe different events
+// will have different fields later, so we just create a event data struct per event type.
+//
+// Checksum is the CRC32C of the whole event with the checksum field itself zeroed, it's appended to the fixed part
+// since binlog version 2, so the post header lengths in the descriptor event tell whether a binlog carries it.
 type insertEventData struct {
 	StartTimestamp typeutil.Timestamp
 	EndTimestamp   typeutil.Timestamp
+	Checksum       uint32
 }
 
 func (data *insertEventData) SetEventTimestamp(start typeutil.Timestamp, end typeutil.Timestamp) {
@@ -90,6 +127,14 @@ func (data *insertEventData) SetEventTimestamp(start typeutil.Timestamp, end typ
 	data.EndTimestamp = end
 }
 
+func (data *insertEventData) SetChecksum(checksum uint32) {
+	data.Checksum = checksum
+}
+
+func (data *insertEventData) GetChecksum() uint32 {
+	return data.Checksum
+}
+
 func (data *insertEventData) GetEventDataFixPartSize() int32 {
 	return int32(binary.Size(data))
 }
@@ -107,6 +152,7 @@ func (data *insertEventData) WriteEventData(buffer io.Writer) error {
 type deleteEventData struct {
 	StartTimestamp typeutil.Timestamp
 	EndTimestamp   typeutil.Timestamp
+	Checksum       uint32
 }
 
 func (data *deleteEventData) SetEventTimestamp(start typeutil.Timestamp, end typeutil.Timestamp) {
@@ -114,6 +160,14 @@ func (data *deleteEventData) SetEventTimestamp(start typeutil.Timestamp, end typ
 	data.EndTimestamp = end
 }
 
+func (data *deleteEventData) SetChecksum(checksum uint32) {
+	data.Checksum = checksum
+}
+
+func (data *deleteEventData) GetChecksum() uint32 {
+	return data.Checksum
+}
+
 func (data *deleteEventData) GetEventDataFixPartSize() int32 {
 	return int32(binary.Size(data))
 }
@@ -131,6 +185,7 @@ func (data *deleteEventData) WriteEventData(buffer io.Writer) error {
 type createCollectionEventData struct {
 	StartTimestamp typeutil.Timestamp
 	EndTimestamp   typeutil.Timestamp
+	Checksum       uint32
 }
 
 func (data *createCollectionEventData) SetEventTimestamp(start typeutil.Timestamp, end typeutil.Timestamp) {
@@ -138,6 +193,14 @@ func (data *createCollectionEventData) SetEventTimestamp(start typeutil.Timestam
 	data.EndTimestamp = end
 }
 
+func (data *createCollectionEventData) SetChecksum(checksum uint32) {
+	data.Checksum = checksum
+}
+
+func (data *createCollectionEventData) GetChecksum() uint32 {
+	return data.Checksum
+}
+
 func (data *createCollectionEventData) GetEventDataFixPartSize() int32 {
 	return int32(binary.Size(data))
 }
@@ -155,6 +218,7 @@ func (data *createCollectionEventData) WriteEventData(buffer io.Writer) error {
 type dropCollectionEventData struct {
 	StartTimestamp typeutil.Timestamp
 	EndTimestamp   typeutil.Timestamp
+	Checksum       uint32
 }
 
 func (data *dropCollectionEventData) SetEventTimestamp(start typeutil.Timestamp, end typeutil.Timestamp) {
@@ -162,6 +226,14 @@ func (data *dropCollectionEventData) SetEventTimestamp(start typeutil.Timestamp,
 	data.EndTimestamp = end
 }
 
+func (data *dropCollectionEventData) SetChecksum(checksum uint32) {
+	data.Checksum = checksum
+}
+
+func (data *dropCollectionEventData) GetChecksum() uint32 {
+	return data.Checksum
+}
+
 func (data *dropCollectionEventData) GetEventDataFixPartSize() int32 {
 	return int32(binary.Size(data))
 }
@@ -179,6 +251,7 @@ func (data *dropCollectionEventData) WriteEventData(buffer io.Writer) error {
 type createPartitionEventData struct {
 	StartTimestamp typeutil.Timestamp
 	EndTimestamp   typeutil.Timestamp
+	Checksum       uint32
 }
 
 func (data *createPartitionEventData) SetEventTimestamp(start typeutil.Timestamp, end typeutil.Timestamp) {
@@ -186,6 +259,14 @@ func (data *createPartitionEventData) SetEventTimestamp(start typeutil.Timestamp
 	data.EndTimestamp = end
 }
 
+func (data *createPartitionEventData) SetChecksum(checksum uint32) {
+	data.Checksum = checksum
+}
+
+func (data *createPartitionEventData) GetChecksum() uint32 {
+	return data.Checksum
+}
+
 func (data *createPartitionEventData) GetEventDataFixPartSize() int32 {
 	return int32(binary.Size(data))
 }
@@ -203,6 +284,7 @@ func (data *createPartitionEventData) WriteEventData(buffer io.Writer) error {
 type dropPartitionEventData struct {
 	StartTimestamp typeutil.Timestamp
 	EndTimestamp   typeutil.Timestamp
+	Checksum       uint32
 }
 
 func (data *dropPartitionEventData) SetEventTimestamp(start typeutil.Timestamp, end typeutil.Timestamp) {
@@ -210,6 +292,14 @@ func (data *dropPartitionEventData) SetEventTimestamp(start typeutil.Timestamp,
 	data.EndTimestamp = end
 }
 
+func (data *dropPartitionEventData) SetChecksum(checksum uint32) {
+	data.Checksum = checksum
+}
+
+func (data *dropPartitionEventData) GetChecksum() uint32 {
+	return data.Checksum
+}
+
 func (data *dropPartitionEventData) GetEventDataFixPartSize() int32 {
 	return int32(binary.Size(data))
 }
@@ -260,6 +350,7 @@ func newDescriptorEventData() *descriptorEventData {
 			PayloadDataType: -1,
 		},
 		PostHeaderLengths: []uint8{},
+		Compression:       CompressionNone,
 	}
 	for i := DescriptorEventType; i < EventTypeEnd; i++ {
 		size := getEventFixPartSize(i)
@@ -13,28 +13,43 @@ package storage
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/crc32"
+	"io"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
+// checksumBinlogVersion is the first binlog version whose events carry a checksum
+const checksumBinlogVersion = 2
+
 type EventReader struct {
 	eventHeader
 	eventData
 	PayloadReaderInterface
-	buffer   *bytes.Buffer
-	isClosed bool
+	buffer            *bytes.Buffer
+	binlogVersion     int16
+	postHeaderLengths []uint8
+	event             []byte
+	fixPartLength     int32
+	isClosed          bool
 }
 
 func (reader *EventReader) readHeader() error {
 	if reader.isClosed {
 		return fmt.Errorf("event reader is closed")
 	}
+	event := reader.buffer.Bytes()
 	header, err := readEventHeader(reader.buffer)
 	if err != nil {
 		return err
 	}
+	if header.EventLength < header.GetMemoryUsageInBytes() || int(header.EventLength) > len(event) {
+		return fmt.Errorf("invalid event length %d, %d bytes left in binlog", header.EventLength, len(event))
+	}
 	reader.eventHeader = *header
+	reader.event = event[:header.EventLength]
 	return nil
 }
 
@@ -42,21 +57,39 @@ func (reader *EventReader) readData() error {
 	if reader.isClosed {
 		return fmt.Errorf("event reader is closed")
 	}
+	fixPartSize := getEventFixPartSize(reader.TypeCode)
+	if reader.TypeCode == DescriptorEventType || fixPartSize < 0 {
+		return fmt.Errorf("unknown header type code: %d", reader.TypeCode)
+	}
+	// the fixed part written by older binlog versions may be shorter, the missing trailing fields are left zero
+	length := fixPartSize
+	if int(reader.TypeCode) < len(reader.postHeaderLengths) {
+		length = int32(reader.postHeaderLengths[reader.TypeCode])
+	}
+	if length > fixPartSize || length > reader.EventLength-reader.eventHeader.GetMemoryUsageInBytes() {
+		return fmt.Errorf("invalid fix part length %d of %s", length, reader.TypeCode)
+	}
+	fixPart := make([]byte, fixPartSize)
+	if _, err := io.ReadFull(reader.buffer, fixPart[:length]); err != nil {
+		return err
+	}
+	buffer := bytes.NewReader(fixPart)
+
 	var data eventData
 	var err error
 	switch reader.TypeCode {
 	case InsertEventType:
-		data, err = readInsertEventDataFixPart(reader.buffer)
+		data, err = readInsertEventDataFixPart(buffer)
 	case DeleteEventType:
-		data, err = readDeleteEventDataFixPart(reader.buffer)
+		data, err = readDeleteEventDataFixPart(buffer)
 	case CreateCollectionEventType:
-		data, err = readCreateCollectionEventDataFixPart(reader.buffer)
+		data, err = readCreateCollectionEventDataFixPart(buffer)
 	case DropCollectionEventType:
-		data, err = readDropCollectionEventDataFixPart(reader.buffer)
+		data, err = readDropCollectionEventDataFixPart(buffer)
 	case CreatePartitionEventType:
-		data, err = readCreatePartitionEventDataFixPart(reader.buffer)
+		data, err = readCreatePartitionEventDataFixPart(buffer)
 	case DropPartitionEventType:
-		data, err = readDropPartitionEventDataFixPart(reader.buffer)
+		data, err = readDropPartitionEventDataFixPart(buffer)
 	default:
 		return fmt.Errorf("unknown header type code: %d", reader.TypeCode)
 	}
@@ -65,6 +98,27 @@ func (reader *EventReader) readData() error {
 	}
 
 	reader.eventData = data
+	reader.fixPartLength = length
+	if reader.binlogVersion >= checksumBinlogVersion {
+		return reader.verifyChecksum()
+	}
+	return nil
+}
+
+// verifyChecksum recomputes the CRC32C of the event with the checksum field zeroed, the checksum is the last
+// field of the fixed part
+func (reader *EventReader) verifyChecksum() error {
+	checksumSize := binary.Size(reader.GetChecksum())
+	offset := int(reader.eventHeader.GetMemoryUsageInBytes()+reader.fixPartLength) - checksumSize
+	if offset < 0 || offset+checksumSize > len(reader.event) {
+		return fmt.Errorf("event of %s is too short to carry a checksum", reader.TypeCode)
+	}
+	checksum := crc32.Checksum(reader.event[:offset], checksumTable)
+	checksum = crc32.Update(checksum, checksumTable, make([]byte, checksumSize))
+	checksum = crc32.Update(checksum, checksumTable, reader.event[offset+checksumSize:])
+	if checksum != reader.GetChecksum() {
+		return fmt.Errorf("checksum mismatch of %s, expected: %08x, actual: %08x", reader.TypeCode, reader.GetChecksum(), checksum)
+	}
 	return nil
 }
 
@@ -76,28 +130,52 @@ func (reader *EventReader) Close() error {
 	return nil
 }
 
-func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventReader, error) {
+// newEventReader reads the next event from buffer, descriptor tells the binlog version and the fixed part
+// lengths the event was written with
+func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer, descriptor *descriptorEventData) (*EventReader, error) {
+	return newEventReaderWithKey(datatype, buffer, descriptor, nil)
+}
+
+// newEventReaderWithKey reads the next event from buffer like newEventReader, the payload is decrypted by dataKey
+// if it's set
+func newEventReaderWithKey(datatype schemapb.DataType, buffer *bytes.Buffer, descriptor *descriptorEventData, dataKey []byte) (*EventReader, error) {
+	reader, payloadBuffer, err := readEvent(buffer, descriptor)
+	if err != nil {
+		return nil, err
+	}
+	if dataKey != nil {
+		if payloadBuffer, err = decryptPayload(dataKey, payloadBuffer); err != nil {
+			return nil, fmt.Errorf("failed to decrypt the payload of %s: %s", reader.TypeCode, err.Error())
+		}
+	}
+	payloadReader, err := NewPayloadReader(datatype, payloadBuffer)
+	if err != nil {
+		return nil, err
+	}
+	reader.PayloadReaderInterface = payloadReader
+	return reader, nil
+}
+
+// readEvent reads the header and the fixed part of the next event from buffer, it returns the payload without
+// decoding it
+func readEvent(buffer *bytes.Buffer, descriptor *descriptorEventData) (*EventReader, []byte, error) {
 	reader := &EventReader{
 		eventHeader: eventHeader{
 			baseEventHeader{},
 		},
-		buffer:   buffer,
-		isClosed: false,
+		buffer:            buffer,
+		binlogVersion:     descriptor.BinlogVersion,
+		postHeaderLengths: descriptor.PostHeaderLengths,
+		isClosed:          false,
 	}
 
 	if err := reader.readHeader(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := reader.readData(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	next := int(reader.EventLength - reader.eventHeader.GetMemoryUsageInBytes() - reader.GetEventDataFixPartSize())
-	payloadBuffer := buffer.Next(next)
-	payloadReader, err := NewPayloadReader(datatype, payloadBuffer)
-	if err != nil {
-		return nil, err
-	}
-	reader.PayloadReaderInterface = payloadReader
-	return reader, nil
+	next := int(reader.EventLength - reader.eventHeader.GetMemoryUsageInBytes() - reader.fixPartLength)
+	return reader, buffer.Next(next), nil
 }
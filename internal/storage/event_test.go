@@ -165,6 +165,31 @@ func TestDescriptorEvent(t *testing.T) {
 		size := getEventFixPartSize(i)
 		assert.Equal(t, hen, uint8(size))
 	}
+
+	compression := CompressionType(buffer[postHeadOffset+int(EventTypeEnd-DescriptorEventType)])
+	assert.Equal(t, CompressionNone, compression)
+	assert.Equal(t, int(elen), postHeadOffset+int(EventTypeEnd-DescriptorEventType)+1)
+}
+
+func TestDescriptorEventWithoutCompression(t *testing.T) {
+	// the descriptor event written before the payloads could be compressed
+	desc := newDescriptorEvent()
+	desc.Compression = CompressionZstd
+	desc.EventLength -= int32(binary.Size(desc.Compression))
+	var buf bytes.Buffer
+	err := desc.descriptorEventHeader.Write(&buf)
+	assert.Nil(t, err)
+	err = binary.Write(&buf, binary.LittleEndian, desc.DescriptorEventDataFixPart)
+	assert.Nil(t, err)
+	err = binary.Write(&buf, binary.LittleEndian, desc.PostHeaderLengths)
+	assert.Nil(t, err)
+	buf.WriteByte(0xff)
+
+	event, err := ReadDescriptorEvent(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, CompressionNone, event.Compression)
+	assert.Equal(t, desc.PostHeaderLengths, event.PostHeaderLengths)
+	assert.Equal(t, []byte{0xff}, buf.Bytes())
 }
 
 func TestInsertEvent(t *testing.T) {
@@ -210,7 +235,7 @@ func TestInsertEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(dt, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(dt, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -394,7 +419,7 @@ func TestInsertEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -457,7 +482,7 @@ func TestDeleteEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(dt, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(dt, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -641,7 +666,7 @@ func TestDeleteEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -704,7 +729,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -762,7 +787,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -825,7 +850,7 @@ func TestDropCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -883,7 +908,7 @@ func TestDropCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -946,7 +971,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -1004,7 +1029,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -1067,7 +1092,7 @@ func TestDropPartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -1125,7 +1150,7 @@ func TestDropPartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), newDescriptorEventData())
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -1232,13 +1257,20 @@ func TestReadFixPartError(t *testing.T) {
 	_, err = readDropPartitionEventDataFixPart(buf)
 	assert.NotNil(t, err)
 
-	_, err = readDescriptorEventData(buf)
+	_, err = readDescriptorEventData(buf, newDescriptorEventData().GetMemoryUsageInBytes())
 	assert.NotNil(t, err)
 
 	event := newDescriptorEventData()
 	err = binary.Write(buf, binary.LittleEndian, event.DescriptorEventDataFixPart)
 	assert.Nil(t, err)
-	_, err = readDescriptorEventData(buf)
+	_, err = readDescriptorEventData(buf, event.GetMemoryUsageInBytes())
+	assert.NotNil(t, err)
+
+	err = binary.Write(buf, binary.LittleEndian, event.DescriptorEventDataFixPart)
+	assert.Nil(t, err)
+	err = binary.Write(buf, binary.LittleEndian, event.PostHeaderLengths)
+	assert.Nil(t, err)
+	_, err = readDescriptorEventData(buf, event.GetMemoryUsageInBytes())
 	assert.NotNil(t, err)
 
 	size := getEventFixPartSize(EventTypeCode(10))
@@ -1247,7 +1279,7 @@ func TestReadFixPartError(t *testing.T) {
 
 func TestEventReaderError(t *testing.T) {
 	buf := new(bytes.Buffer)
-	r, err := newEventReader(schemapb.DataType_Int64, buf)
+	r, err := newEventReader(schemapb.DataType_Int64, buf, newDescriptorEventData())
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1255,7 +1287,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, newDescriptorEventData())
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1264,7 +1296,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, newDescriptorEventData())
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1281,7 +1313,7 @@ func TestEventReaderError(t *testing.T) {
 	err = binary.Write(buf, binary.LittleEndian, insertData)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, newDescriptorEventData())
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1303,7 +1335,7 @@ func TestEventClose(t *testing.T) {
 	assert.Nil(t, err)
 
 	wBuf := buf.Bytes()
-	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), newDescriptorEventData())
 	assert.Nil(t, err)
 
 	err = r.Close()
@@ -1316,3 +1348,94 @@ func TestEventClose(t *testing.T) {
 	err = r.readData()
 	assert.NotNil(t, err)
 }
+
+func TestEventChecksum(t *testing.T) {
+	w, err := newInsertEventWriter(schemapb.DataType_Int64)
+	assert.Nil(t, err)
+	defer w.Close()
+	w.SetEventTimestamp(tsoutil.ComposeTS(10, 0), tsoutil.ComposeTS(100, 0))
+	err = w.AddDataToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	err = w.Finish()
+	assert.Nil(t, err)
+	assert.NotEqual(t, uint32(0), w.Checksum)
+
+	var buf bytes.Buffer
+	err = w.Write(&buf)
+	assert.Nil(t, err)
+	wBuf := buf.Bytes()
+
+	r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), newDescriptorEventData())
+	assert.Nil(t, err)
+	assert.Equal(t, w.Checksum, r.GetChecksum())
+	err = r.Close()
+	assert.Nil(t, err)
+
+	// flip a byte of the payload
+	corrupted := make([]byte, len(wBuf))
+	copy(corrupted, wBuf)
+	corrupted[len(corrupted)-1] ^= 0xff
+	_, err = newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(corrupted), newDescriptorEventData())
+	assert.NotNil(t, err)
+
+	// flip a byte of the timestamps
+	copy(corrupted, wBuf)
+	corrupted[binary.Size(eventHeader{})] ^= 0xff
+	_, err = newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(corrupted), newDescriptorEventData())
+	assert.NotNil(t, err)
+
+	// truncated event
+	_, err = newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf[:len(wBuf)-1]), newDescriptorEventData())
+	assert.NotNil(t, err)
+}
+
+func TestEventReaderV1(t *testing.T) {
+	w, err := newInsertEventWriter(schemapb.DataType_Int64)
+	assert.Nil(t, err)
+	defer w.Close()
+	w.SetEventTimestamp(tsoutil.ComposeTS(10, 0), tsoutil.ComposeTS(100, 0))
+	err = w.AddDataToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	err = w.Finish()
+	assert.Nil(t, err)
+	payload, err := w.GetPayloadBufferFromWriter()
+	assert.Nil(t, err)
+
+	// binlog version 1 has no checksum in the fixed part
+	v1FixPart := struct {
+		StartTimestamp Timestamp
+		EndTimestamp   Timestamp
+	}{
+		StartTimestamp: w.StartTimestamp,
+		EndTimestamp:   w.EndTimestamp,
+	}
+	header := w.eventHeader
+	header.EventLength = int32(binary.Size(header) + binary.Size(v1FixPart) + len(payload))
+
+	var buf bytes.Buffer
+	err = header.Write(&buf)
+	assert.Nil(t, err)
+	err = binary.Write(&buf, binary.LittleEndian, v1FixPart)
+	assert.Nil(t, err)
+	buf.Write(payload)
+
+	descriptor := newDescriptorEventData()
+	descriptor.BinlogVersion = 1
+	for i := range descriptor.PostHeaderLengths {
+		if EventTypeCode(i) != DescriptorEventType {
+			descriptor.PostHeaderLengths[i] = uint8(binary.Size(v1FixPart))
+		}
+	}
+	r, err := newEventReader(schemapb.DataType_Int64, &buf, descriptor)
+	assert.Nil(t, err)
+	defer r.Close()
+	data, ok := r.eventData.(*insertEventData)
+	assert.True(t, ok)
+	assert.Equal(t, w.StartTimestamp, data.StartTimestamp)
+	assert.Equal(t, w.EndTimestamp, data.EndTimestamp)
+	assert.Equal(t, uint32(0), data.Checksum)
+	values, err := r.GetInt64FromPayload()
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, values)
+	assert.Equal(t, 0, buf.Len())
+}
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"hash/crc32"
 	"io"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -73,7 +74,7 @@ func ReadDescriptorEvent(buffer io.Reader) (*descriptorEvent, error) {
 	if err != nil {
 		return nil, err
 	}
-	data, err := readDescriptorEventData(buffer)
+	data, err := readDescriptorEventData(buffer, header.EventLength-header.GetMemoryUsageInBytes())
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +96,9 @@ type EventWriter interface {
 	SetOffset(offset int32)
 }
 
+// checksumTable is the CRC32C table used to compute the checksum of events
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
 type baseEventWriter struct {
 	eventHeader
 	PayloadWriterInterface
@@ -103,10 +107,22 @@ type baseEventWriter struct {
 	offset           int32
 	getEventDataSize func() int32
 	writeEventData   func(buffer io.Writer) error
+	setChecksum      func(checksum uint32)
+	// dataKey encrypts the payload when the event is finished if it's set
+	dataKey          []byte
+	encryptedPayload []byte
+}
+
+// getPayload returns the payload buffer, it's encrypted once the event is finished if the data key is set
+func (writer *baseEventWriter) getPayload() ([]byte, error) {
+	if writer.encryptedPayload != nil {
+		return writer.encryptedPayload, nil
+	}
+	return writer.GetPayloadBufferFromWriter()
 }
 
 func (writer *baseEventWriter) GetMemoryUsageInBytes() (int32, error) {
-	data, err := writer.GetPayloadBufferFromWriter()
+	data, err := writer.getPayload()
 	if err != nil {
 		return -1, err
 	}
@@ -121,7 +137,7 @@ func (writer *baseEventWriter) Write(buffer *bytes.Buffer) error {
 	if err := writer.writeEventData(buffer); err != nil {
 		return err
 	}
-	data, err := writer.GetPayloadBufferFromWriter()
+	data, err := writer.getPayload()
 	if err != nil {
 		return err
 	}
@@ -137,16 +153,50 @@ func (writer *baseEventWriter) Finish() error {
 		if err := writer.FinishPayloadWriter(); err != nil {
 			return err
 		}
+		if writer.dataKey != nil {
+			data, err := writer.GetPayloadBufferFromWriter()
+			if err != nil {
+				return err
+			}
+			if writer.encryptedPayload, err = encryptPayload(writer.dataKey, data); err != nil {
+				return err
+			}
+		}
 		eventLength, err := writer.GetMemoryUsageInBytes()
 		if err != nil {
 			return err
 		}
 		writer.EventLength = eventLength
 		writer.NextPosition = eventLength + writer.offset
+		checksum, err := writer.computeChecksum()
+		if err != nil {
+			return err
+		}
+		writer.setChecksum(checksum)
 	}
 	return nil
 }
 
+// computeChecksum returns the CRC32C of the serialized event, the checksum field is zeroed while computing,
+// so header and event data should not be modified after Finish. The encrypted payload is checksummed if the payload
+// is encrypted, so the binlog can be verified without the keys.
+func (writer *baseEventWriter) computeChecksum() (uint32, error) {
+	writer.setChecksum(0)
+	buffer := new(bytes.Buffer)
+	if err := writer.eventHeader.Write(buffer); err != nil {
+		return 0, err
+	}
+	if err := writer.writeEventData(buffer); err != nil {
+		return 0, err
+	}
+	data, err := writer.getPayload()
+	if err != nil {
+		return 0, err
+	}
+	checksum := crc32.Checksum(buffer.Bytes(), checksumTable)
+	return crc32.Update(checksum, checksumTable, data), nil
+}
+
 func (writer *baseEventWriter) Close() error {
 	if !writer.isClosed {
 		writer.isFinish = true
@@ -223,6 +273,7 @@ func newInsertEventWriter(dataType schemapb.DataType) (*insertEventWriter, error
 	}
 	writer.baseEventWriter.getEventDataSize = writer.insertEventData.GetEventDataFixPartSize
 	writer.baseEventWriter.writeEventData = writer.insertEventData.WriteEventData
+	writer.baseEventWriter.setChecksum = writer.insertEventData.SetChecksum
 	return writer, nil
 }
 
@@ -245,6 +296,7 @@ func newDeleteEventWriter(dataType schemapb.DataType) (*deleteEventWriter, error
 	}
 	writer.baseEventWriter.getEventDataSize = writer.deleteEventData.GetEventDataFixPartSize
 	writer.baseEventWriter.writeEventData = writer.deleteEventData.WriteEventData
+	writer.baseEventWriter.setChecksum = writer.deleteEventData.SetChecksum
 	return writer, nil
 }
 
@@ -271,6 +323,7 @@ func newCreateCollectionEventWriter(dataType schemapb.DataType) (*createCollecti
 	}
 	writer.baseEventWriter.getEventDataSize = writer.createCollectionEventData.GetEventDataFixPartSize
 	writer.baseEventWriter.writeEventData = writer.createCollectionEventData.WriteEventData
+	writer.baseEventWriter.setChecksum = writer.createCollectionEventData.SetChecksum
 	return writer, nil
 }
 
@@ -297,6 +350,7 @@ func newDropCollectionEventWriter(dataType schemapb.DataType) (*dropCollectionEv
 	}
 	writer.baseEventWriter.getEventDataSize = writer.dropCollectionEventData.GetEventDataFixPartSize
 	writer.baseEventWriter.writeEventData = writer.dropCollectionEventData.WriteEventData
+	writer.baseEventWriter.setChecksum = writer.dropCollectionEventData.SetChecksum
 	return writer, nil
 }
 
@@ -323,6 +377,7 @@ func newCreatePartitionEventWriter(dataType schemapb.DataType) (*createPartition
 	}
 	writer.baseEventWriter.getEventDataSize = writer.createPartitionEventData.GetEventDataFixPartSize
 	writer.baseEventWriter.writeEventData = writer.createPartitionEventData.WriteEventData
+	writer.baseEventWriter.setChecksum = writer.createPartitionEventData.SetChecksum
 	return writer, nil
 }
 
@@ -349,5 +404,6 @@ func newDropPartitionEventWriter(dataType schemapb.DataType) (*dropPartitionEven
 	}
 	writer.baseEventWriter.getEventDataSize = writer.dropPartitionEventData.GetEventDataFixPartSize
 	writer.baseEventWriter.writeEventData = writer.dropPartitionEventData.WriteEventData
+	writer.baseEventWriter.setChecksum = writer.dropPartitionEventData.SetChecksum
 	return writer, nil
 }
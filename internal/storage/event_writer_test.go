@@ -37,7 +37,7 @@ func TestSizeofStruct(t *testing.T) {
 	}
 	err = de.Write(&buf)
 	assert.Nil(t, err)
-	s3 := binary.Size(de.DescriptorEventDataFixPart) + binary.Size(de.PostHeaderLengths)
+	s3 := binary.Size(de.DescriptorEventDataFixPart) + binary.Size(de.PostHeaderLengths) + binary.Size(de.Compression)
 	assert.Equal(t, s3, buf.Len())
 }
 
@@ -58,6 +58,7 @@ func TestEventWriter(t *testing.T) {
 	nums, err := insertEvent.GetPayloadLengthFromWriter()
 	assert.Nil(t, err)
 	assert.EqualValues(t, 3, nums)
+	insertEvent.SetEventTimestamp(100, 200)
 	err = insertEvent.Finish()
 	assert.Nil(t, err)
 	length, err := insertEvent.GetMemoryUsageInBytes()
@@ -66,7 +67,6 @@ func TestEventWriter(t *testing.T) {
 	err = insertEvent.AddInt32ToPayload([]int32{1})
 	assert.NotNil(t, err)
 	buffer := new(bytes.Buffer)
-	insertEvent.SetEventTimestamp(100, 200)
 	err = insertEvent.Write(buffer)
 	assert.Nil(t, err)
 	length, err = insertEvent.GetMemoryUsageInBytes()
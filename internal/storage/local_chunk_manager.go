@@ -79,6 +79,14 @@ func (lcm *LocalChunkManager) Read(key string) ([]byte, error) {
 	return content, nil
 }
 
+func (lcm *LocalChunkManager) Size(key string) (int64, error) {
+	info, err := os.Stat(path.Join(lcm.localPath, key))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
 func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, err error) {
 	path := path.Join(lcm.localPath, key)
 	at, err := mmap.Open(path)
@@ -96,3 +104,11 @@ func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, er
 
 	return at.ReadAt(p, off)
 }
+
+func (lcm *LocalChunkManager) Remove(key string) error {
+	err := os.Remove(path.Join(lcm.localPath, key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -13,7 +13,6 @@ package storage
 
 import (
 	"errors"
-	"io"
 
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 )
@@ -48,19 +47,17 @@ func (mcm *MinioChunkManager) Read(key string) ([]byte, error) {
 	return []byte(results), err
 }
 
+// ReadAt reads len(p) bytes of the object from off, only the range is downloaded
 func (mcm *MinioChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
-	results, err := mcm.minio.Load(key)
-	if err != nil {
-		return -1, err
-	}
-
-	if off < 0 || int64(len([]byte(results))) < off {
+	if off < 0 {
 		return 0, errors.New("MinioChunkManager: invalid offset")
 	}
-	n := copy(p, []byte(results)[off:])
-	if n < len(p) {
-		return n, io.EOF
+	if len(p) == 0 {
+		return 0, nil
 	}
+	return mcm.minio.ReadAt(key, p, off)
+}
 
-	return n, nil
+func (mcm *MinioChunkManager) Size(key string) (int64, error) {
+	return mcm.minio.Size(key)
 }
@@ -0,0 +1,341 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/cwrapper
+
+#cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -lwrapper -lparquet -larrow -lthrift -lutf8proc -lsnappy -lzstd -llz4 -lstdc++ -lm
+#include <stdlib.h>
+#include "ParquetWrapper.h"
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+)
+
+// ParquetTableWriter writes a standard parquet file with a named column per field, which can be read by the
+// common analytic tools, vectors are written as fixed size lists of their elements
+type ParquetTableWriter struct {
+	tableWriterPtr C.CTableWriter
+}
+
+func NewParquetTableWriter() (*ParquetTableWriter, error) {
+	w := C.NewTableWriter()
+	if w == nil {
+		return nil, errors.New("create table writer failed")
+	}
+	return &ParquetTableWriter{tableWriterPtr: w}, nil
+}
+
+func tableWriterError(st C.CStatus) error {
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// SetCompression sets the codec of the file, it must be called before Finish
+func (w *ParquetTableWriter) SetCompression(compression CompressionType) error {
+	return tableWriterError(C.SetTableWriterCompression(w.tableWriterPtr, C.int(compression)))
+}
+
+// AddColumn adds the data of a field as a column, all the columns must have the same number of rows
+func (w *ParquetTableWriter) AddColumn(name string, data FieldData) error {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	var st C.CStatus
+	switch fieldData := data.(type) {
+	case *BoolFieldData:
+		var ptr *C.bool
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.bool)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddBooleanColumnToTable(w.tableWriterPtr, cName, ptr, C.int(len(fieldData.Data)))
+	case *Int8FieldData:
+		var ptr *C.int8_t
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.int8_t)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddInt8ColumnToTable(w.tableWriterPtr, cName, ptr, C.int(len(fieldData.Data)))
+	case *Int16FieldData:
+		var ptr *C.int16_t
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.int16_t)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddInt16ColumnToTable(w.tableWriterPtr, cName, ptr, C.int(len(fieldData.Data)))
+	case *Int32FieldData:
+		var ptr *C.int32_t
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.int32_t)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddInt32ColumnToTable(w.tableWriterPtr, cName, ptr, C.int(len(fieldData.Data)))
+	case *Int64FieldData:
+		var ptr *C.int64_t
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.int64_t)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddInt64ColumnToTable(w.tableWriterPtr, cName, ptr, C.int(len(fieldData.Data)))
+	case *FloatFieldData:
+		var ptr *C.float
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.float)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddFloatColumnToTable(w.tableWriterPtr, cName, ptr, C.int(len(fieldData.Data)))
+	case *DoubleFieldData:
+		var ptr *C.double
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.double)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddDoubleColumnToTable(w.tableWriterPtr, cName, ptr, C.int(len(fieldData.Data)))
+	case *StringFieldData:
+		// the strings are concatenated and passed with their offsets
+		offsets := make([]int32, 0, len(fieldData.Data)+1)
+		size := 0
+		for _, str := range fieldData.Data {
+			offsets = append(offsets, int32(size))
+			size += len(str)
+		}
+		offsets = append(offsets, int32(size))
+		buf := make([]byte, 0, size+1)
+		for _, str := range fieldData.Data {
+			buf = append(buf, str...)
+		}
+		// never pass a pointer to an empty slice
+		buf = append(buf, 0)
+		st = C.AddStringColumnToTable(w.tableWriterPtr, cName, (*C.char)(unsafe.Pointer(&buf[0])),
+			(*C.int32_t)(unsafe.Pointer(&offsets[0])), C.int(len(fieldData.Data)))
+	case *BinaryVectorFieldData:
+		if fieldData.Dim <= 0 {
+			return errors.New("dimension should be greater than 0")
+		}
+		var ptr *C.uint8_t
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.uint8_t)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddBinaryVectorColumnToTable(w.tableWriterPtr, cName, ptr, C.int(fieldData.Dim),
+			C.int(len(fieldData.Data)*8/fieldData.Dim))
+	case *FloatVectorFieldData:
+		if fieldData.Dim <= 0 {
+			return errors.New("dimension should be greater than 0")
+		}
+		var ptr *C.float
+		if len(fieldData.Data) > 0 {
+			ptr = (*C.float)(unsafe.Pointer(&fieldData.Data[0]))
+		}
+		st = C.AddFloatVectorColumnToTable(w.tableWriterPtr, cName, ptr, C.int(fieldData.Dim),
+			C.int(len(fieldData.Data)/fieldData.Dim))
+	default:
+		return fmt.Errorf("unsupported field data %T", data)
+	}
+	return tableWriterError(st)
+}
+
+// Finish writes the parquet file and returns its content
+func (w *ParquetTableWriter) Finish() ([]byte, error) {
+	if err := tableWriterError(C.FinishTableWriter(w.tableWriterPtr)); err != nil {
+		return nil, err
+	}
+	cb := C.GetTableBufferFromWriter(w.tableWriterPtr)
+	if cb.length <= 0 {
+		return nil, errors.New("empty buffer")
+	}
+	// the buffer is released with the writer, so copy it
+	return C.GoBytes(unsafe.Pointer(cb.data), cb.length), nil
+}
+
+func (w *ParquetTableWriter) Close() error {
+	return tableWriterError(C.ReleaseTableWriter(w.tableWriterPtr))
+}
+
+// ExportInsertData writes the rows of a segment inserted no later than ts as a parquet file, with a column per
+// user field named after the field, the system fields are left out. The rows deleted no later than ts by the
+// deletes of the segment, which may be nil, are left out too. It returns nil if no row is exported.
+func ExportInsertData(schema *schemapb.CollectionSchema, data *InsertData, deleteData *DeleteData, ts Timestamp, compression CompressionType) ([]byte, int, error) {
+	FillDefaultFieldsData(schema, data)
+	tsFieldData, ok := data.Data[rootcoord.TimeStampField].(*Int64FieldData)
+	if !ok {
+		return nil, 0, errors.New("no timestamp field in the insert data")
+	}
+	// the latest delete of each primary key no later than ts
+	deleteTss := make(map[int64]Timestamp)
+	if deleteData != nil {
+		for i, pk := range deleteData.Pks {
+			if deleteTs := deleteData.Tss[i]; (ts == 0 || deleteTs <= ts) && deleteTs > deleteTss[pk] {
+				deleteTss[pk] = deleteTs
+			}
+		}
+	}
+	var pks []int64
+	if len(deleteTss) > 0 {
+		for _, field := range schema.Fields {
+			if field.IsPrimaryKey {
+				if pkFieldData, ok := data.Data[field.FieldID].(*Int64FieldData); ok && len(pkFieldData.Data) == len(tsFieldData.Data) {
+					pks = pkFieldData.Data
+				}
+			}
+		}
+		if pks == nil {
+			return nil, 0, errors.New("no primary key field in the insert data to apply the deletes")
+		}
+	}
+	selected := make([]bool, len(tsFieldData.Data))
+	numRows := 0
+	for i, rowTs := range tsFieldData.Data {
+		if ts != 0 && Timestamp(rowTs) > ts {
+			continue
+		}
+		// a row is deleted if its primary key is deleted at or after it's inserted
+		if pks != nil {
+			if deleteTs, ok := deleteTss[pks[i]]; ok && Timestamp(rowTs) <= deleteTs {
+				continue
+			}
+		}
+		selected[i] = true
+		numRows++
+	}
+	if numRows == 0 {
+		return nil, 0, nil
+	}
+
+	w, err := NewParquetTableWriter()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer w.Close()
+	if err := w.SetCompression(compression); err != nil {
+		return nil, 0, err
+	}
+	for _, field := range schema.Fields {
+		if field.FieldID < rootcoord.StartOfUserFieldID {
+			continue
+		}
+		fieldData, ok := data.Data[field.FieldID]
+		if !ok {
+			return nil, 0, fmt.Errorf("no data of field %s", field.Name)
+		}
+		if numRows < len(selected) {
+			if fieldData, err = filterFieldData(fieldData, selected, numRows); err != nil {
+				return nil, 0, err
+			}
+		}
+		if err := w.AddColumn(field.Name, fieldData); err != nil {
+			return nil, 0, fmt.Errorf("field %s: %s", field.Name, err.Error())
+		}
+	}
+	buf, err := w.Finish()
+	if err != nil {
+		return nil, 0, err
+	}
+	return buf, numRows, nil
+}
+
+// filterFieldData returns the rows whose selected is true, numRows is the number of the selected rows
+func filterFieldData(data FieldData, selected []bool, numRows int) (FieldData, error) {
+	rows := []int64{int64(numRows)}
+	switch fieldData := data.(type) {
+	case *BoolFieldData:
+		result := &BoolFieldData{NumRows: rows, Data: make([]bool, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *Int8FieldData:
+		result := &Int8FieldData{NumRows: rows, Data: make([]int8, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *Int16FieldData:
+		result := &Int16FieldData{NumRows: rows, Data: make([]int16, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *Int32FieldData:
+		result := &Int32FieldData{NumRows: rows, Data: make([]int32, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *Int64FieldData:
+		result := &Int64FieldData{NumRows: rows, Data: make([]int64, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *FloatFieldData:
+		result := &FloatFieldData{NumRows: rows, Data: make([]float32, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *DoubleFieldData:
+		result := &DoubleFieldData{NumRows: rows, Data: make([]float64, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *StringFieldData:
+		result := &StringFieldData{NumRows: rows, Data: make([]string, 0, numRows)}
+		for i, v := range fieldData.Data {
+			if selected[i] {
+				result.Data = append(result.Data, v)
+			}
+		}
+		return result, nil
+	case *BinaryVectorFieldData:
+		dim := fieldData.Dim / 8
+		result := &BinaryVectorFieldData{NumRows: rows, Data: make([]byte, 0, numRows*dim), Dim: fieldData.Dim}
+		for i := range selected {
+			if selected[i] {
+				result.Data = append(result.Data, fieldData.Data[i*dim:(i+1)*dim]...)
+			}
+		}
+		return result, nil
+	case *FloatVectorFieldData:
+		dim := fieldData.Dim
+		result := &FloatVectorFieldData{NumRows: rows, Data: make([]float32, 0, numRows*dim), Dim: fieldData.Dim}
+		for i := range selected {
+			if selected[i] {
+				result.Data = append(result.Data, fieldData.Data[i*dim:(i+1)*dim]...)
+			}
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported field data %T", data)
+	}
+}
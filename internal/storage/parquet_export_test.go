@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParquetTableWriter(t *testing.T) {
+	w, err := NewParquetTableWriter()
+	assert.Nil(t, err)
+	defer w.Close()
+
+	err = w.SetCompression(CompressionSnappy)
+	assert.Nil(t, err)
+	err = w.AddColumn("bool", &BoolFieldData{Data: []bool{true, false}})
+	assert.Nil(t, err)
+	err = w.AddColumn("int8", &Int8FieldData{Data: []int8{1, 2}})
+	assert.Nil(t, err)
+	err = w.AddColumn("int16", &Int16FieldData{Data: []int16{1, 2}})
+	assert.Nil(t, err)
+	err = w.AddColumn("int32", &Int32FieldData{Data: []int32{1, 2}})
+	assert.Nil(t, err)
+	err = w.AddColumn("int64", &Int64FieldData{Data: []int64{1, 2}})
+	assert.Nil(t, err)
+	err = w.AddColumn("float", &FloatFieldData{Data: []float32{1, 2}})
+	assert.Nil(t, err)
+	err = w.AddColumn("double", &DoubleFieldData{Data: []float64{1, 2}})
+	assert.Nil(t, err)
+	err = w.AddColumn("string", &StringFieldData{Data: []string{"", "abc"}})
+	assert.Nil(t, err)
+	err = w.AddColumn("binary_vector", &BinaryVectorFieldData{Data: []byte{1, 2}, Dim: 8})
+	assert.Nil(t, err)
+	err = w.AddColumn("float_vector", &FloatVectorFieldData{Data: []float32{1, 2, 3, 4}, Dim: 2})
+	assert.Nil(t, err)
+
+	err = w.AddColumn("short", &Int64FieldData{Data: []int64{1}})
+	assert.NotNil(t, err)
+	err = w.AddColumn("no_dim", &FloatVectorFieldData{Data: []float32{1, 2}})
+	assert.NotNil(t, err)
+	err = w.AddColumn("unknown", []int64{1, 2})
+	assert.NotNil(t, err)
+
+	buf, err := w.Finish()
+	assert.Nil(t, err)
+	assert.Equal(t, "PAR1", string(buf[:4]))
+	assert.Equal(t, "PAR1", string(buf[len(buf)-4:]))
+}
+
+func TestExportInsertData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vector", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "bin_vector", DataType: schemapb.DataType_BinaryVector},
+		},
+	}
+	newData := func() *InsertData {
+		return &InsertData{
+			Data: map[FieldID]FieldData{
+				0:   &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+				1:   &Int64FieldData{NumRows: []int64{3}, Data: []int64{10, 20, 30}},
+				100: &Int64FieldData{NumRows: []int64{3}, Data: []int64{4, 5, 6}},
+				101: &FloatVectorFieldData{NumRows: []int64{3}, Data: []float32{1, 2, 3, 4, 5, 6}, Dim: 2},
+				102: &BinaryVectorFieldData{NumRows: []int64{3}, Data: []byte{1, 2, 3}, Dim: 8},
+			},
+		}
+	}
+
+	buf, rows, err := ExportInsertData(schema, newData(), nil, 0, CompressionZstd)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rows)
+	assert.Equal(t, "PAR1", string(buf[:4]))
+
+	buf, rows, err = ExportInsertData(schema, newData(), nil, 20, CompressionNone)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rows)
+	assert.NotEmpty(t, buf)
+
+	buf, rows, err = ExportInsertData(schema, newData(), nil, 5, CompressionNone)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rows)
+	assert.Nil(t, buf)
+
+	// pk 4 is deleted after it's inserted, the delete of pk 6 at 25 is before it's inserted
+	deleteData := &DeleteData{Pks: []int64{4, 6}, Tss: []Timestamp{15, 25}}
+	buf, rows, err = ExportInsertData(schema, newData(), deleteData, 0, CompressionNone)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rows)
+	assert.NotEmpty(t, buf)
+
+	// the delete at 15 is not visible at 12
+	_, rows, err = ExportInsertData(schema, newData(), deleteData, 12, CompressionNone)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rows)
+	_, rows, err = ExportInsertData(schema, newData(), deleteData, 20, CompressionNone)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rows)
+
+	data := newData()
+	delete(data.Data, 100)
+	_, _, err = ExportInsertData(schema, data, deleteData, 0, CompressionNone)
+	assert.NotNil(t, err)
+
+	data = newData()
+	delete(data.Data, 101)
+	_, _, err = ExportInsertData(schema, data, nil, 0, CompressionNone)
+	assert.NotNil(t, err)
+}
+
+func TestFilterFieldData(t *testing.T) {
+	selected := []bool{true, false, true}
+	fieldData, err := filterFieldData(&StringFieldData{Data: []string{"a", "b", "c"}}, selected, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "c"}, fieldData.(*StringFieldData).Data)
+	assert.Equal(t, []int64{2}, fieldData.(*StringFieldData).NumRows)
+
+	fieldData, err = filterFieldData(&FloatVectorFieldData{Data: []float32{1, 2, 3, 4, 5, 6}, Dim: 2}, selected, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 2, 5, 6}, fieldData.(*FloatVectorFieldData).Data)
+
+	fieldData, err = filterFieldData(&BinaryVectorFieldData{Data: []byte{1, 2, 3, 4, 5, 6}, Dim: 16}, selected, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 5, 6}, fieldData.(*BinaryVectorFieldData).Data)
+
+	fieldData, err = filterFieldData(&BoolFieldData{Data: []bool{true, true, false}}, selected, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, false}, fieldData.(*BoolFieldData).Data)
+
+	_, err = filterFieldData([]int64{1}, selected, 2)
+	assert.NotNil(t, err)
+}
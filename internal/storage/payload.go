@@ -14,7 +14,7 @@ package storage
 /*
 #cgo CFLAGS: -I${SRCDIR}/cwrapper
 
-#cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -lwrapper -lparquet -larrow -lthrift -lutf8proc -lstdc++ -lm
+#cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -lwrapper -lparquet -larrow -lthrift -lutf8proc -lsnappy -lzstd -llz4 -lstdc++ -lm
 #include <stdlib.h>
 #include "ParquetWrapper.h"
 */
@@ -39,9 +39,11 @@ type PayloadWriterInterface interface {
 	AddOneStringToPayload(msgs string) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	SetPayloadCompression(compression CompressionType) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
+	GetPayloadRawSizeFromWriter() (int, error)
 	ReleasePayloadWriter() error
 	Close() error
 }
@@ -66,6 +68,7 @@ type PayloadReaderInterface interface {
 type PayloadWriter struct {
 	payloadWriterPtr C.CPayloadWriter
 	colType          schemapb.DataType
+	rawSize          int // size of the added data before encoding
 }
 
 type PayloadReader struct {
@@ -175,6 +178,7 @@ func (w *PayloadWriter) AddBoolToPayload(msgs []bool) error {
 		defer C.free(unsafe.Pointer(status.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length
 	return nil
 }
 
@@ -194,6 +198,7 @@ func (w *PayloadWriter) AddInt8ToPayload(msgs []int8) error {
 		defer C.free(unsafe.Pointer(status.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length
 	return nil
 }
 
@@ -214,6 +219,7 @@ func (w *PayloadWriter) AddInt16ToPayload(msgs []int16) error {
 		defer C.free(unsafe.Pointer(status.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length * 2
 	return nil
 }
 
@@ -234,6 +240,7 @@ func (w *PayloadWriter) AddInt32ToPayload(msgs []int32) error {
 		defer C.free(unsafe.Pointer(status.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length * 4
 	return nil
 }
 
@@ -254,6 +261,7 @@ func (w *PayloadWriter) AddInt64ToPayload(msgs []int64) error {
 		defer C.free(unsafe.Pointer(status.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length * 8
 	return nil
 }
 
@@ -274,6 +282,7 @@ func (w *PayloadWriter) AddFloatToPayload(msgs []float32) error {
 		defer C.free(unsafe.Pointer(status.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length * 4
 	return nil
 }
 
@@ -294,6 +303,7 @@ func (w *PayloadWriter) AddDoubleToPayload(msgs []float64) error {
 		defer C.free(unsafe.Pointer(status.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length * 8
 	return nil
 }
 
@@ -315,6 +325,7 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 		defer C.free(unsafe.Pointer(st.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length
 	return nil
 }
 
@@ -339,6 +350,7 @@ func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 		defer C.free(unsafe.Pointer(st.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length
 	return nil
 }
 
@@ -363,6 +375,19 @@ func (w *PayloadWriter) AddFloatVectorToPayload(floatVec []float32, dim int) err
 		defer C.free(unsafe.Pointer(st.error_msg))
 		return errors.New(msg)
 	}
+	w.rawSize += length * 4
+	return nil
+}
+
+// SetPayloadCompression sets the codec of the payload, it must be called before FinishPayloadWriter
+func (w *PayloadWriter) SetPayloadCompression(compression CompressionType) error {
+	st := C.SetPayloadWriterCompression(w.payloadWriterPtr, C.int(compression))
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
 	return nil
 }
 
@@ -394,6 +419,11 @@ func (w *PayloadWriter) GetPayloadLengthFromWriter() (int, error) {
 	return int(length), nil
 }
 
+// GetPayloadRawSizeFromWriter returns the size of the added data before it is encoded and compressed
+func (w *PayloadWriter) GetPayloadRawSizeFromWriter() (int, error) {
+	return w.rawSize, nil
+}
+
 func (w *PayloadWriter) ReleasePayloadWriter() error {
 	st := C.ReleasePayloadWriter(w.payloadWriterPtr)
 	errCode := commonpb.ErrorCode(st.error_code)
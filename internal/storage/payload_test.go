@@ -891,4 +891,37 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		_, _, err = r.GetFloatVectorFromPayload()
 		assert.NotNil(t, err)
 	})
+	t.Run("TestCompression", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_FloatVector)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+		defer w.ReleasePayloadWriter()
+
+		err = w.SetPayloadCompression(CompressionType(100))
+		assert.NotNil(t, err)
+		err = w.SetPayloadCompression(CompressionZstd)
+		assert.Nil(t, err)
+		err = w.AddFloatVectorToPayload(make([]float32, 8*1000), 8)
+		assert.Nil(t, err)
+		size, err := w.GetPayloadRawSizeFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, 8*1000*4, size)
+
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		err = w.SetPayloadCompression(CompressionSnappy)
+		assert.NotNil(t, err)
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+		assert.Less(t, len(buffer), size)
+
+		r, err := NewPayloadReader(schemapb.DataType_FloatVector, buffer)
+		require.Nil(t, err)
+		defer r.ReleasePayloadReader()
+		vecs, dim, err := r.GetFloatVectorFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 8, dim)
+		assert.Equal(t, make([]float32, 8*1000), vecs)
+	})
 }
@@ -86,6 +86,10 @@ func printBinlogFile(filename string) error {
 	}
 	fmt.Printf("\tPayloadDataType: %v\n", dataTypeName)
 	fmt.Printf("\tPostHeaderLengths: %v\n", r.descriptorEvent.descriptorEventData.PostHeaderLengths)
+	fmt.Printf("\tCompression: %s\n", r.descriptorEvent.descriptorEventData.Compression.String())
+	if r.descriptorEvent.descriptorEventData.KeyID != "" {
+		fmt.Printf("\tKeyID: %s\n", r.descriptorEvent.descriptorEventData.KeyID)
+	}
 	eventNum := 0
 	for {
 		event, err := r.NextEventReader()
@@ -113,6 +117,7 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			fmt.Printf("\tChecksum: %08x\n", evd.Checksum)
 			if err := printPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
@@ -126,6 +131,7 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			fmt.Printf("\tChecksum: %08x\n", evd.Checksum)
 			if err := printPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
@@ -139,6 +145,7 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			fmt.Printf("\tChecksum: %08x\n", evd.Checksum)
 			if err := printDDLPayloadValues(event.eventHeader.TypeCode, r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
@@ -152,6 +159,7 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			fmt.Printf("\tChecksum: %08x\n", evd.Checksum)
 			if err := printDDLPayloadValues(event.eventHeader.TypeCode, r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
@@ -165,6 +173,7 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			fmt.Printf("\tChecksum: %08x\n", evd.Checksum)
 			if err := printDDLPayloadValues(event.eventHeader.TypeCode, r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
@@ -178,6 +187,7 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			fmt.Printf("\tChecksum: %08x\n", evd.Checksum)
 			if err := printDDLPayloadValues(event.eventHeader.TypeCode, r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
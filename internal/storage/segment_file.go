@@ -0,0 +1,390 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+)
+
+// A segment file (binlog format v2) packs all the fields of a flush into one object, the rows are split into
+// row groups and every field of a row group is stored as a column chunk, which is an insert binlog of the field.
+// The header at the beginning of the file locates the footer, which indexes the column chunks, so that a reader
+// only needs to read the header, the footer and the column chunks of the fields it wants.
+//
+//	header: magic number (int32) | version (int32) | footer offset (int64) | footer length (int64)
+//	column chunks
+//	footer: number of row groups (int32), for each row group:
+//	        number of rows (int64) | number of columns (int32) | columns: field id, offset, length (int64 each)
+const (
+	SegmentFileMagicNumber int32 = 0xfffabd
+	SegmentFileVersion     int32 = 2
+
+	// SegmentFileDir takes the place of the field id in the key of a segment file, which is
+	// <insert log root>/<collection>/<partition>/<segment>/segment/<log id>
+	SegmentFileDir = "segment"
+
+	BinlogFormatV1 = "v1"
+	BinlogFormatV2 = "v2"
+)
+
+type segmentFileHeader struct {
+	MagicNumber  int32
+	Version      int32
+	FooterOffset int64
+	FooterLength int64
+}
+
+var segmentFileHeaderLength = binary.Size(segmentFileHeader{})
+
+// IsSegmentFileKey tells if the binlog saved with key is a segment file
+func IsSegmentFileKey(key string) bool {
+	return path.Base(path.Dir(key)) == SegmentFileDir
+}
+
+// SegmentFileColumnKey returns the key of the column of a field in a segment file, which is used where a path
+// refers to the binlog of a single field
+func SegmentFileColumnKey(key string, fieldID FieldID) string {
+	return path.Join(key, strconv.FormatInt(fieldID, 10))
+}
+
+// ParseSegmentFileColumnKey splits a key returned by SegmentFileColumnKey, ok is false if it's not such a key
+func ParseSegmentFileColumnKey(columnKey string) (key string, fieldID FieldID, ok bool) {
+	key = path.Dir(columnKey)
+	if !IsSegmentFileKey(key) {
+		return "", 0, false
+	}
+	fieldID, err := strconv.ParseInt(path.Base(columnKey), 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return key, fieldID, true
+}
+
+// IsSegmentFile tells if data is the content of a segment file
+func IsSegmentFile(data []byte) bool {
+	var magicNumber int32
+	if len(data) < binary.Size(magicNumber) {
+		return false
+	}
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &magicNumber); err != nil {
+		return false
+	}
+	return magicNumber == SegmentFileMagicNumber
+}
+
+type SegmentFileColumn struct {
+	FieldID FieldID
+	Offset  int64
+	Length  int64
+}
+
+type SegmentFileRowGroup struct {
+	NumRows int64
+	Columns []SegmentFileColumn
+}
+
+type SegmentFileFooter struct {
+	RowGroups []SegmentFileRowGroup
+}
+
+// NumRows returns the total number of rows of all the row groups
+func (footer *SegmentFileFooter) NumRows() int64 {
+	var numRows int64
+	for _, rowGroup := range footer.RowGroups {
+		numRows += rowGroup.NumRows
+	}
+	return numRows
+}
+
+func (footer *SegmentFileFooter) write(buffer io.Writer) error {
+	if err := binary.Write(buffer, binary.LittleEndian, int32(len(footer.RowGroups))); err != nil {
+		return err
+	}
+	for _, rowGroup := range footer.RowGroups {
+		if err := binary.Write(buffer, binary.LittleEndian, rowGroup.NumRows); err != nil {
+			return err
+		}
+		if err := binary.Write(buffer, binary.LittleEndian, int32(len(rowGroup.Columns))); err != nil {
+			return err
+		}
+		for _, column := range rowGroup.Columns {
+			if err := binary.Write(buffer, binary.LittleEndian, column); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var (
+	segmentFileRowGroupLength = binary.Size(int64(0)) + binary.Size(int32(0))
+	segmentFileColumnLength   = binary.Size(SegmentFileColumn{})
+)
+
+// readSegmentFileFooter decodes the footer in data, the counts are checked against the remaining bytes before
+// anything is allocated and the column chunks must lie between the header and the footer at footerOffset
+func readSegmentFileFooter(data []byte, footerOffset int64) (*SegmentFileFooter, error) {
+	buffer := bytes.NewReader(data)
+	var numRowGroups int32
+	if err := binary.Read(buffer, binary.LittleEndian, &numRowGroups); err != nil {
+		return nil, err
+	}
+	if numRowGroups < 0 || int64(numRowGroups)*int64(segmentFileRowGroupLength) > int64(buffer.Len()) {
+		return nil, fmt.Errorf("invalid number of row groups %d", numRowGroups)
+	}
+	footer := &SegmentFileFooter{RowGroups: make([]SegmentFileRowGroup, 0, numRowGroups)}
+	for i := int32(0); i < numRowGroups; i++ {
+		var rowGroup SegmentFileRowGroup
+		if err := binary.Read(buffer, binary.LittleEndian, &rowGroup.NumRows); err != nil {
+			return nil, err
+		}
+		if rowGroup.NumRows < 0 {
+			return nil, fmt.Errorf("invalid number of rows %d of row group %d", rowGroup.NumRows, i)
+		}
+		var numColumns int32
+		if err := binary.Read(buffer, binary.LittleEndian, &numColumns); err != nil {
+			return nil, err
+		}
+		if numColumns < 0 || int64(numColumns)*int64(segmentFileColumnLength) > int64(buffer.Len()) {
+			return nil, fmt.Errorf("invalid number of columns %d of row group %d", numColumns, i)
+		}
+		rowGroup.Columns = make([]SegmentFileColumn, numColumns)
+		if err := binary.Read(buffer, binary.LittleEndian, rowGroup.Columns); err != nil {
+			return nil, err
+		}
+		for _, column := range rowGroup.Columns {
+			if column.Offset < int64(segmentFileHeaderLength) || column.Length < 0 || column.Length > footerOffset-column.Offset {
+				return nil, fmt.Errorf("invalid range [%d, %d) of field %d in row group %d", column.Offset,
+					column.Offset+column.Length, column.FieldID, i)
+			}
+		}
+		footer.RowGroups = append(footer.RowGroups, rowGroup)
+	}
+	if buffer.Len() != 0 {
+		return nil, fmt.Errorf("%d bytes left after the footer", buffer.Len())
+	}
+	return footer, nil
+}
+
+// SegmentFileWriter builds a segment file in memory
+type SegmentFileWriter struct {
+	buffer   *bytes.Buffer
+	footer   SegmentFileFooter
+	finished bool
+}
+
+func NewSegmentFileWriter() *SegmentFileWriter {
+	w := &SegmentFileWriter{buffer: new(bytes.Buffer)}
+	// the header is filled when the writer is finished
+	w.buffer.Write(make([]byte, segmentFileHeaderLength))
+	return w
+}
+
+// AddRowGroup appends a row group, the keys of the blobs are the field ids as InsertCodec.Serialize returns
+func (w *SegmentFileWriter) AddRowGroup(numRows int64, blobs []*Blob) error {
+	if w.finished {
+		return errors.New("segment file is finished")
+	}
+	rowGroup := SegmentFileRowGroup{NumRows: numRows, Columns: make([]SegmentFileColumn, 0, len(blobs))}
+	for _, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid field id %s", blob.Key)
+		}
+		rowGroup.Columns = append(rowGroup.Columns, SegmentFileColumn{
+			FieldID: fieldID,
+			Offset:  int64(w.buffer.Len()),
+			Length:  int64(len(blob.Value)),
+		})
+		w.buffer.Write(blob.Value)
+	}
+	w.footer.RowGroups = append(w.footer.RowGroups, rowGroup)
+	return nil
+}
+
+// Finish writes the footer and returns the content of the segment file
+func (w *SegmentFileWriter) Finish() ([]byte, error) {
+	if w.finished {
+		return nil, errors.New("segment file is finished")
+	}
+	header := segmentFileHeader{
+		MagicNumber:  SegmentFileMagicNumber,
+		Version:      SegmentFileVersion,
+		FooterOffset: int64(w.buffer.Len()),
+	}
+	if err := w.footer.write(w.buffer); err != nil {
+		return nil, err
+	}
+	header.FooterLength = int64(w.buffer.Len()) - header.FooterOffset
+
+	headerBuffer := new(bytes.Buffer)
+	if err := binary.Write(headerBuffer, binary.LittleEndian, header); err != nil {
+		return nil, err
+	}
+	data := w.buffer.Bytes()
+	copy(data, headerBuffer.Bytes())
+	w.finished = true
+	return data, nil
+}
+
+// SegmentFileReader reads the column chunks of a segment file by ranges, the header and the footer are
+// read when the reader is created
+type SegmentFileReader struct {
+	key    string
+	readAt func(p []byte, off int64) (int, error)
+	footer *SegmentFileFooter
+}
+
+// NewSegmentFileReader opens the segment file saved with key in the object storage of cm
+func NewSegmentFileReader(cm ChunkManager, key string) (*SegmentFileReader, error) {
+	size, err := cm.Size(key)
+	if err != nil {
+		return nil, err
+	}
+	return newSegmentFileReader(key, size, func(p []byte, off int64) (int, error) {
+		return cm.ReadAt(key, p, off)
+	})
+}
+
+// NewSegmentFileReaderFromBytes opens a segment file whose content is already loaded
+func NewSegmentFileReaderFromBytes(key string, data []byte) (*SegmentFileReader, error) {
+	return newSegmentFileReader(key, int64(len(data)), bytes.NewReader(data).ReadAt)
+}
+
+func newSegmentFileReader(key string, size int64, readAt func(p []byte, off int64) (int, error)) (*SegmentFileReader, error) {
+	if size < int64(segmentFileHeaderLength) {
+		return nil, fmt.Errorf("%s is not a segment file, size %d", key, size)
+	}
+	reader := &SegmentFileReader{key: key, readAt: readAt}
+	buf, err := reader.read(0, int64(segmentFileHeaderLength))
+	if err != nil {
+		return nil, err
+	}
+	var header segmentFileHeader
+	if err := binary.Read(bytes.NewReader(buf), binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+	if header.MagicNumber != SegmentFileMagicNumber {
+		return nil, fmt.Errorf("%s is not a segment file", key)
+	}
+	if header.Version != SegmentFileVersion {
+		return nil, fmt.Errorf("unsupported segment file version %d", header.Version)
+	}
+	// the footer is checked against the file size before it's read, a corrupted header must not make the reader
+	// allocate the footer length
+	if header.FooterOffset < int64(segmentFileHeaderLength) || header.FooterOffset > size ||
+		header.FooterLength < int64(binary.Size(int32(0))) || header.FooterLength > size-header.FooterOffset {
+		return nil, fmt.Errorf("invalid footer range [%d, %d) of segment file %s of size %d", header.FooterOffset,
+			header.FooterOffset+header.FooterLength, key, size)
+	}
+	buf, err = reader.read(header.FooterOffset, header.FooterLength)
+	if err != nil {
+		return nil, err
+	}
+	if reader.footer, err = readSegmentFileFooter(buf, header.FooterOffset); err != nil {
+		return nil, fmt.Errorf("read footer of segment file %s failed, %s", key, err.Error())
+	}
+	return reader, nil
+}
+
+func (reader *SegmentFileReader) read(offset, length int64) ([]byte, error) {
+	if offset < 0 || length < 0 {
+		return nil, fmt.Errorf("invalid range [%d, %d) of segment file %s", offset, offset+length, reader.key)
+	}
+	buf := make([]byte, length)
+	n, err := reader.readAt(buf, offset)
+	// reading to the end of the file may return io.EOF along with the whole range
+	if n == len(buf) {
+		return buf, nil
+	}
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return nil, fmt.Errorf("read segment file %s failed, %s", reader.key, err.Error())
+}
+
+func (reader *SegmentFileReader) Footer() *SegmentFileFooter {
+	return reader.footer
+}
+
+// ReadColumn reads the column chunk of a field in a row group, the key of the blob is the key of the file
+func (reader *SegmentFileReader) ReadColumn(rowGroup int, fieldID FieldID) (*Blob, error) {
+	if rowGroup < 0 || rowGroup >= len(reader.footer.RowGroups) {
+		return nil, fmt.Errorf("row group %d out of range", rowGroup)
+	}
+	for _, column := range reader.footer.RowGroups[rowGroup].Columns {
+		if column.FieldID == fieldID {
+			value, err := reader.read(column.Offset, column.Length)
+			if err != nil {
+				return nil, err
+			}
+			return &Blob{Key: reader.key, Value: value}, nil
+		}
+	}
+	return nil, fmt.Errorf("field %d not found in segment file %s", fieldID, reader.key)
+}
+
+// ReadColumns reads the column chunks of the fields in all the row groups, the blobs are in the order of the
+// row groups, all the fields are read if fieldIDs is empty
+func (reader *SegmentFileReader) ReadColumns(fieldIDs []FieldID) ([]*Blob, error) {
+	blobs := make([]*Blob, 0)
+	for i, rowGroup := range reader.footer.RowGroups {
+		ids := fieldIDs
+		if len(ids) == 0 {
+			ids = make([]FieldID, 0, len(rowGroup.Columns))
+			for _, column := range rowGroup.Columns {
+				ids = append(ids, column.FieldID)
+			}
+		}
+		for _, fieldID := range ids {
+			blob, err := reader.ReadColumn(i, fieldID)
+			if err != nil {
+				return nil, err
+			}
+			blobs = append(blobs, blob)
+		}
+	}
+	return blobs, nil
+}
+
+// expandSegmentFiles replaces the segment files in blobs with their column chunks, a segment file which is
+// passed several times, once for each of its fields for example, is expanded only once
+func expandSegmentFiles(blobs []*Blob) ([]*Blob, error) {
+	result := make([]*Blob, 0, len(blobs))
+	expanded := make(map[string]bool)
+	for _, blob := range blobs {
+		if !IsSegmentFile(blob.Value) {
+			result = append(result, blob)
+			continue
+		}
+		if expanded[blob.Key] {
+			continue
+		}
+		expanded[blob.Key] = true
+		reader, err := NewSegmentFileReaderFromBytes(blob.Key, blob.Value)
+		if err != nil {
+			return nil, err
+		}
+		columns, err := reader.ReadColumns(nil)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, columns...)
+	}
+	return result, nil
+}
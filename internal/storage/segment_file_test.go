@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentFileKey(t *testing.T) {
+	key := "files/insert_log/1/2/3/segment/4"
+	assert.True(t, IsSegmentFileKey(key))
+	assert.False(t, IsSegmentFileKey("files/insert_log/1/2/3/100/4"))
+
+	columnKey := SegmentFileColumnKey(key, 101)
+	assert.Equal(t, key+"/101", columnKey)
+	file, fieldID, ok := ParseSegmentFileColumnKey(columnKey)
+	assert.True(t, ok)
+	assert.Equal(t, key, file)
+	assert.Equal(t, FieldID(101), fieldID)
+
+	_, _, ok = ParseSegmentFileColumnKey(key)
+	assert.False(t, ok)
+	_, _, ok = ParseSegmentFileColumnKey(key + "/vector")
+	assert.False(t, ok)
+}
+
+func TestSegmentFileWriterReader(t *testing.T) {
+	w := NewSegmentFileWriter()
+	err := w.AddRowGroup(2, []*Blob{{Key: "100", Value: []byte("abc")}, {Key: "101", Value: []byte("de")}})
+	assert.Nil(t, err)
+	err = w.AddRowGroup(1, []*Blob{{Key: "100", Value: []byte("f")}, {Key: "101", Value: []byte("ghij")}})
+	assert.Nil(t, err)
+	err = w.AddRowGroup(1, []*Blob{{Key: "field", Value: []byte("f")}})
+	assert.NotNil(t, err)
+	data, err := w.Finish()
+	assert.Nil(t, err)
+	assert.True(t, IsSegmentFile(data))
+	assert.False(t, IsSegmentFile(data[:2]))
+
+	_, err = w.Finish()
+	assert.NotNil(t, err)
+	err = w.AddRowGroup(1, nil)
+	assert.NotNil(t, err)
+
+	reader, err := NewSegmentFileReaderFromBytes("file", data)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(reader.Footer().RowGroups))
+	assert.Equal(t, int64(3), reader.Footer().NumRows())
+
+	blob, err := reader.ReadColumn(1, 101)
+	assert.Nil(t, err)
+	assert.Equal(t, "file", blob.Key)
+	assert.Equal(t, "ghij", string(blob.Value))
+	_, err = reader.ReadColumn(2, 101)
+	assert.NotNil(t, err)
+	_, err = reader.ReadColumn(0, 102)
+	assert.NotNil(t, err)
+
+	blobs, err := reader.ReadColumns([]FieldID{100})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(blobs))
+	assert.Equal(t, "abc", string(blobs[0].Value))
+	assert.Equal(t, "f", string(blobs[1].Value))
+
+	blobs, err = reader.ReadColumns(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(blobs))
+
+	// read by ranges from a chunk manager
+	cm := NewLocalChunkManager(t.TempDir())
+	err = cm.Write("file", data)
+	assert.Nil(t, err)
+	reader, err = NewSegmentFileReader(cm, "file")
+	assert.Nil(t, err)
+	blob, err = reader.ReadColumn(0, 101)
+	assert.Nil(t, err)
+	assert.Equal(t, "de", string(blob.Value))
+
+	_, err = NewSegmentFileReaderFromBytes("file", []byte("not a segment file, not a segment file"))
+	assert.NotNil(t, err)
+	_, err = NewSegmentFileReaderFromBytes("file", data[:len(data)-1])
+	assert.NotNil(t, err)
+}
+
+func TestSegmentFileCorruptedFooter(t *testing.T) {
+	w := NewSegmentFileWriter()
+	err := w.AddRowGroup(2, []*Blob{{Key: "100", Value: []byte("abc")}, {Key: "101", Value: []byte("de")}})
+	assert.Nil(t, err)
+	data, err := w.Finish()
+	assert.Nil(t, err)
+	footerOffset := int64(binary.LittleEndian.Uint64(data[8:16]))
+
+	corrupt := func(f func(data []byte)) []byte {
+		corrupted := make([]byte, len(data))
+		copy(corrupted, data)
+		f(corrupted)
+		return corrupted
+	}
+	cases := map[string][]byte{
+		"footer offset": corrupt(func(data []byte) { binary.LittleEndian.PutUint64(data[8:16], uint64(len(data)+1)) }),
+		"footer length": corrupt(func(data []byte) { binary.LittleEndian.PutUint64(data[16:24], 1<<62) }),
+		"row groups": corrupt(func(data []byte) {
+			binary.LittleEndian.PutUint32(data[footerOffset:], 1<<30)
+		}),
+		"negative row groups": corrupt(func(data []byte) {
+			binary.LittleEndian.PutUint32(data[footerOffset:], 0xffffffff)
+		}),
+		"columns": corrupt(func(data []byte) {
+			binary.LittleEndian.PutUint32(data[footerOffset+12:], 1<<30)
+		}),
+		"column range": corrupt(func(data []byte) {
+			binary.LittleEndian.PutUint64(data[footerOffset+24:], uint64(len(data)))
+		}),
+	}
+	for name, corrupted := range cases {
+		_, err := NewSegmentFileReaderFromBytes("file", corrupted)
+		assert.NotNil(t, err, name)
+	}
+	_, err = NewSegmentFileReaderFromBytes("file", data[:segmentFileHeaderLength-1])
+	assert.NotNil(t, err)
+}
+
+func TestInsertCodecSegmentFile(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+		{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+		{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_String},
+		{FieldID: FloatVectorField, Name: "field_float_vector", DataType: schemapb.DataType_FloatVector},
+	}
+	insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{
+		ID:     CollectionID,
+		Schema: &schemapb.CollectionSchema{Name: "schema", Fields: fields},
+	})
+	defer insertCodec.Close()
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:       &Int64FieldData{NumRows: []int64{5}, Data: []int64{5, 4, 3, 2, 1}},
+			TimestampField:   &Int64FieldData{NumRows: []int64{5}, Data: []int64{5, 4, 3, 2, 1}},
+			StringField:      &StringFieldData{NumRows: []int64{5}, Data: []string{"5", "4", "3", "2", "1"}},
+			FloatVectorField: &FloatVectorFieldData{NumRows: []int64{5}, Data: []float32{5, 5, 4, 4, 3, 3, 2, 2, 1, 1}, Dim: 2},
+		},
+	}
+	blob, statsBlobs, err := insertCodec.SerializeSegmentFile(PartitionID, SegmentID, insertData, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, len(fields), len(statsBlobs))
+	assert.True(t, blob.RawSize > 0)
+
+	reader, err := NewSegmentFileReaderFromBytes("file", blob.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(reader.Footer().RowGroups))
+	assert.Equal(t, int64(5), reader.Footer().NumRows())
+
+	// the file is passed once for each field as the binlog paths of a segment list it
+	blob.Key = "1/insert_log/2/3/4/segment/100"
+	partID, segID, resultData, err := insertCodec.Deserialize([]*Blob{blob, blob})
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(PartitionID), partID)
+	assert.Equal(t, UniqueID(SegmentID), segID)
+	assert.Equal(t, []int64{2, 2, 1}, resultData.Data[TimestampField].(*Int64FieldData).NumRows)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, resultData.Data[RowIDField].(*Int64FieldData).Data)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, resultData.Data[StringField].(*StringFieldData).Data)
+	assert.Equal(t, []float32{1, 1, 2, 2, 3, 3, 4, 4, 5, 5}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+
+	// only the columns of a field
+	blobs, err := reader.ReadColumns([]FieldID{FloatVectorField})
+	assert.Nil(t, err)
+	_, _, resultData, err = insertCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resultData.Data))
+	assert.Equal(t, []float32{1, 1, 2, 2, 3, 3, 4, 4, 5, 5}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+}
@@ -34,10 +34,19 @@ func (sw *StatsWriter) StatsInt64(msgs []int64) error {
 		return nil
 	}
 
+	// the data is sorted by row id, which isn't the order of the other fields
 	stats := &Int64Stats{
-		Max: msgs[len(msgs)-1],
+		Max: msgs[0],
 		Min: msgs[0],
 	}
+	for _, v := range msgs[1:] {
+		if v > stats.Max {
+			stats.Max = v
+		}
+		if v < stats.Min {
+			stats.Min = v
+		}
+	}
 	b, err := json.Marshal(stats)
 	if err != nil {
 		return err
@@ -34,4 +34,8 @@ func TestStatsInt64(t *testing.T) {
 		Min: 1,
 	}
 	assert.Equal(t, stats, expectedStats)
+
+	err = sw.StatsInt64([]int64{5, 9, 1, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"max":9,"min":1}`, string(sw.GetBuffer()))
 }
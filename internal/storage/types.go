@@ -16,5 +16,7 @@ type ChunkManager interface {
 	Write(key string, content []byte) error
 	Exist(key string) bool
 	Read(key string) ([]byte, error)
+	// Size returns the size of the content of key
+	Size(key string) (int64, error)
 	ReadAt(key string, p []byte, off int64) (n int, err error)
 }
@@ -27,6 +27,8 @@ type VectorChunkManager struct {
 	schema *etcdpb.CollectionMeta
 
 	localCacheEnable bool
+
+	keyManager *KeyManager
 }
 
 func NewVectorChunkManager(localChunkManager ChunkManager, remoteChunkManager ChunkManager, schema *etcdpb.CollectionMeta, localCacheEnable bool) *VectorChunkManager {
@@ -39,21 +41,99 @@ func NewVectorChunkManager(localChunkManager ChunkManager, remoteChunkManager Ch
 	}
 }
 
-func (vcm *VectorChunkManager) downloadVectorFile(key string) ([]byte, error) {
-	if vcm.localChunkManager.Exist(key) {
-		return vcm.localChunkManager.Read(key)
+// SetKeyManager sets the KeyManager decrypting the encrypted vector binlogs
+func (vcm *VectorChunkManager) SetKeyManager(keyManager *KeyManager) {
+	vcm.keyManager = keyManager
+}
+
+// isCached tells whether the vector data of key is cached locally. The cache files are encrypted if encryption is
+// enabled, a cache file left by the last run with encryption enabled or disabled otherwise is taken as not cached,
+// and it's overwritten once the data is downloaded again.
+func (vcm *VectorChunkManager) isCached(key string) bool {
+	if !vcm.localChunkManager.Exist(key) {
+		return false
 	}
-	insertCodec := NewInsertCodec(vcm.schema)
-	content, err := vcm.remoteChunkManager.Read(key)
+	magic := make([]byte, binary.Size(EncryptedCacheMagicNumber))
+	if _, err := vcm.localChunkManager.ReadAt(key, magic, 0); err != nil && err != io.EOF {
+		return false
+	}
+	return IsEncryptedCacheFile(magic) == (vcm.keyManager != nil)
+}
+
+// writeCache caches the vector data of key locally, the data decrypted from the encrypted binlogs is encrypted again
+// by a new data key, so no plain data is written to the local disk
+func (vcm *VectorChunkManager) writeCache(key string, content []byte) error {
+	if vcm.keyManager == nil {
+		return vcm.localChunkManager.Write(key, content)
+	}
+	dataKey, err := vcm.keyManager.NewDataKey()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	blob := &Blob{
-		Key:   key,
-		Value: content,
+	encrypted, err := encryptCacheFile(dataKey, content)
+	if err != nil {
+		return err
 	}
+	return vcm.localChunkManager.Write(key, encrypted)
+}
 
-	_, _, data, err := insertCodec.Deserialize([]*Blob{blob})
+func (vcm *VectorChunkManager) readCache(key string) ([]byte, error) {
+	content, err := vcm.localChunkManager.Read(key)
+	if err != nil || vcm.keyManager == nil {
+		return content, err
+	}
+	return decryptCacheFile(vcm.keyManager, content)
+}
+
+// cacheReaderAt returns the reader of the cached vector data of key, the encrypted blocks are decrypted on read
+func (vcm *VectorChunkManager) cacheReaderAt(key string) (io.ReaderAt, int64, error) {
+	reader := chunkReaderAt{vcm.localChunkManager, key}
+	if vcm.keyManager == nil {
+		size, err := vcm.localChunkManager.Size(key)
+		return reader, size, err
+	}
+	file, err := openEncryptedCacheFile(vcm.keyManager, reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	return file, file.size, nil
+}
+
+// chunkReaderAt is the io.ReaderAt of the content of key in a ChunkManager
+type chunkReaderAt struct {
+	chunkManager ChunkManager
+	key          string
+}
+
+func (r chunkReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	return r.chunkManager.ReadAt(r.key, p, off)
+}
+
+func (vcm *VectorChunkManager) downloadVectorFile(key string) ([]byte, error) {
+	insertCodec := NewInsertCodec(vcm.schema)
+	insertCodec.KeyManager = vcm.keyManager
+	var blobs []*Blob
+	if file, fieldID, ok := ParseSegmentFileColumnKey(key); ok {
+		// only the column chunks of the field are downloaded from the segment file
+		reader, err := NewSegmentFileReader(vcm.remoteChunkManager, file)
+		if err != nil {
+			return nil, err
+		}
+		if blobs, err = reader.ReadColumns([]FieldID{fieldID}); err != nil {
+			return nil, err
+		}
+	} else {
+		content, err := vcm.remoteChunkManager.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		blobs = []*Blob{{
+			Key:   key,
+			Value: content,
+		}}
+	}
+
+	_, _, data, err := insertCodec.Deserialize(blobs)
 	if err != nil {
 		return nil, err
 	}
@@ -78,8 +158,10 @@ func (vcm *VectorChunkManager) downloadVectorFile(key string) ([]byte, error) {
 	return results, nil
 }
 
+// GetPath returns the path of the local cache file of key, or the remote path if it's not cached. The cache files
+// are encrypted if encryption is enabled, so the remote path is always returned then.
 func (vcm *VectorChunkManager) GetPath(key string) (string, error) {
-	if vcm.localChunkManager.Exist(key) && vcm.localCacheEnable {
+	if vcm.localCacheEnable && vcm.keyManager == nil && vcm.isCached(key) {
 		return vcm.localChunkManager.GetPath(key)
 	}
 	return vcm.remoteChunkManager.GetPath(key)
@@ -89,45 +171,61 @@ func (vcm *VectorChunkManager) Write(key string, content []byte) error {
 	if !vcm.localCacheEnable {
 		return errors.New("Cannot write local file for local cache is not allowed")
 	}
-	return vcm.localChunkManager.Write(key, content)
+	return vcm.writeCache(key, content)
 }
 
 func (vcm *VectorChunkManager) Exist(key string) bool {
-	return vcm.localChunkManager.Exist(key)
+	return vcm.isCached(key)
 }
 
 func (vcm *VectorChunkManager) Read(key string) ([]byte, error) {
 	if vcm.localCacheEnable {
-		if vcm.localChunkManager.Exist(key) {
-			return vcm.localChunkManager.Read(key)
+		if vcm.isCached(key) {
+			return vcm.readCache(key)
 		}
 		bytes, err := vcm.downloadVectorFile(key)
 		if err != nil {
 			return nil, err
 		}
-		err = vcm.localChunkManager.Write(key, bytes)
+		err = vcm.writeCache(key, bytes)
 		if err != nil {
 			return nil, err
 		}
-		return vcm.localChunkManager.Read(key)
+		return bytes, nil
 	}
 	return vcm.downloadVectorFile(key)
 }
 
+// Size returns the size of the vector data of key, which is downloaded and decoded if it's not cached locally
+func (vcm *VectorChunkManager) Size(key string) (int64, error) {
+	if vcm.localCacheEnable && vcm.isCached(key) {
+		_, size, err := vcm.cacheReaderAt(key)
+		return size, err
+	}
+	content, err := vcm.Read(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(content)), nil
+}
+
 func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
 	if vcm.localCacheEnable {
-		if vcm.localChunkManager.Exist(key) {
-			return vcm.localChunkManager.ReadAt(key, p, off)
-		}
-		bytes, err := vcm.downloadVectorFile(key)
-		if err != nil {
-			return -1, err
+		if !vcm.isCached(key) {
+			bytes, err := vcm.downloadVectorFile(key)
+			if err != nil {
+				return -1, err
+			}
+			err = vcm.writeCache(key, bytes)
+			if err != nil {
+				return -1, err
+			}
 		}
-		err = vcm.localChunkManager.Write(key, bytes)
+		reader, _, err := vcm.cacheReaderAt(key)
 		if err != nil {
 			return -1, err
 		}
-		return vcm.localChunkManager.ReadAt(key, p, off)
+		return reader.ReadAt(p, off)
 	}
 	bytes, err := vcm.downloadVectorFile(key)
 	if err != nil {
@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordEncrypt returns the salted bcrypt hash of a password
+func PasswordEncrypt(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// PasswordVerify checks whether the password matches the bcrypt hash
+func PasswordVerify(password string, encryptedPassword string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(encryptedPassword), []byte(password)) == nil
+}
+
+// SHA256 returns the hex encoded sha256 digest of s, it's used to remember the passwords which
+// have been verified, since bcrypt is too slow to run for every request
+func SHA256(s string) string {
+	digest := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(digest[:])
+}
+
+// ParseBasicAuth parses the value of a basic authorization header, which is "Basic " followed by
+// the base64 encoding of "username:password"
+func ParseBasicAuth(auth string) (username string, password string, err error) {
+	const prefix = "Basic "
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", "", errors.New("authorization is not basic auth")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(auth[len(prefix):])
+	if err != nil {
+		return "", "", err
+	}
+	idx := strings.IndexByte(string(decoded), ':')
+	if idx < 0 {
+		return "", "", errors.New("invalid basic auth credential")
+	}
+	return string(decoded[:idx]), string(decoded[idx+1:]), nil
+}
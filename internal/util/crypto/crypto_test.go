@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package crypto
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordEncrypt(t *testing.T) {
+	encrypted, err := PasswordEncrypt("123456")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "123456", encrypted)
+	assert.True(t, PasswordVerify("123456", encrypted))
+	assert.False(t, PasswordVerify("1234567", encrypted))
+
+	// the hash is salted
+	encrypted2, err := PasswordEncrypt("123456")
+	assert.Nil(t, err)
+	assert.NotEqual(t, encrypted, encrypted2)
+}
+
+func TestSHA256(t *testing.T) {
+	assert.Equal(t, SHA256("abc"), SHA256("abc"))
+	assert.NotEqual(t, SHA256("abc"), SHA256("abd"))
+	assert.Equal(t, 64, len(SHA256("abc")))
+}
+
+func TestParseBasicAuth(t *testing.T) {
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte("root:Milvus:1"))
+	username, password, err := ParseBasicAuth(auth)
+	assert.Nil(t, err)
+	assert.Equal(t, "root", username)
+	assert.Equal(t, "Milvus:1", password)
+
+	_, _, err = ParseBasicAuth("Bearer xxx")
+	assert.NotNil(t, err)
+	_, _, err = ParseBasicAuth("Basic !!!")
+	assert.NotNil(t, err)
+	_, _, err = ParseBasicAuth("Basic " + base64.StdEncoding.EncodeToString([]byte("root")))
+	assert.NotNil(t, err)
+}
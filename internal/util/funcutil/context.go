@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package funcutil
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// callerMetadataKey is the grpc metadata which carries the user of a request from proxy to the coordinators
+const callerMetadataKey = "milvus-caller"
+
+// AppendCallerToOutgoingContext attaches the caller to the grpc requests sent with the returned context
+func AppendCallerToOutgoingContext(ctx context.Context, caller string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, callerMetadataKey, caller)
+}
+
+// GetCallerFromIncomingContext returns the caller attached to a grpc request, empty if there is none
+func GetCallerFromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	callers := md.Get(callerMetadataKey)
+	if len(callers) == 0 {
+		return ""
+	}
+	return callers[0]
+}
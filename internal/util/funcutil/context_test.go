@@ -0,0 +1,33 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package funcutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCallerContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", GetCallerFromIncomingContext(ctx))
+
+	ctx = AppendCallerToOutgoingContext(ctx, "user1")
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+
+	// the outgoing metadata is received as the incoming metadata by the server
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	assert.Equal(t, "user1", GetCallerFromIncomingContext(ctx))
+}
@@ -29,6 +29,13 @@ import (
 
 type UniqueID = typeutil.UniqueID
 
+const (
+	// StorageTypeMinio stores the binlogs and index files in MinIO
+	StorageTypeMinio = "minio"
+	// StorageTypeLocal stores the binlogs and index files in the local file system, for standalone mode only
+	StorageTypeLocal = "local"
+)
+
 type Base interface {
 	Load(key string) (string, error)
 	LoadRange(key, endKey string, limit int) ([]string, []string, error)
@@ -201,6 +208,71 @@ func (gp *BaseTable) tryloadFromEnv() {
 	}
 }
 
+// LoadStorage returns the object storage type and the root path of the local storage, MinIO is used if
+// storage.type is not set
+func (gp *BaseTable) LoadStorage() (string, string) {
+	storageType, _ := gp.Load("storage.type")
+	switch storageType {
+	case "":
+		storageType = StorageTypeMinio
+	case StorageTypeMinio, StorageTypeLocal:
+	default:
+		panic("unknown storage type " + storageType)
+	}
+	storagePath, _ := gp.Load("storage.path")
+	if storagePath == "" {
+		storagePath = "/var/lib/milvus/storage"
+	}
+	return storageType, storagePath
+}
+
+// LoadEncryptionKeyFile returns the path of the master key file, the binlog payloads and index files are encrypted
+// if it's set
+func (gp *BaseTable) LoadEncryptionKeyFile() string {
+	keyFile, _ := gp.Load("storage.encryptionKeyFile")
+	return keyFile
+}
+
+// LoadColdTier returns the bucket and the root path of the cold tier, the objects moved to the cold tier are saved
+// in the bucket, minio.bucketName if it's empty, with the root path prepended to their keys. The cold tier is
+// disabled if the root path is empty.
+func (gp *BaseTable) LoadColdTier() (string, string) {
+	bucketName, _ := gp.Load("storage.coldTier.bucketName")
+	rootPath, _ := gp.Load("storage.coldTier.rootPath")
+	return bucketName, rootPath
+}
+
+// LoadDownload returns the max number of concurrent requests, the part size in bytes and the number of attempts of a
+// request the binlogs and index files are downloaded with, a file larger than the part size is downloaded by ranged
+// requests of a part each
+func (gp *BaseTable) LoadDownload() (int, int64, uint) {
+	parallelism := 16
+	if value, err := gp.Load("storage.download.parallelism"); err == nil && value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			panic("invalid storage.download.parallelism " + value)
+		}
+		parallelism = n
+	}
+	partSize := int64(64)
+	if value, err := gp.Load("storage.download.partSize"); err == nil && value != "" {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n <= 0 {
+			panic("invalid storage.download.partSize " + value)
+		}
+		partSize = n
+	}
+	retryAttempts := uint(5)
+	if value, err := gp.Load("storage.download.retryAttempts"); err == nil && value != "" {
+		n, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || n == 0 {
+			panic("invalid storage.download.retryAttempts " + value)
+		}
+		retryAttempts = uint(n)
+	}
+	return parallelism, partSize << 20, retryAttempts
+}
+
 func (gp *BaseTable) Load(key string) (string, error) {
 	return gp.params.Load(strings.ToLower(key))
 }
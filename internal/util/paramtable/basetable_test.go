@@ -111,3 +111,76 @@ func TestGlobalParamsTable_LoadYaml(t *testing.T) {
 	_, err = baseParams.Load("pulsar.port")
 	assert.Nil(t, err)
 }
+
+func TestGlobalParamsTable_LoadStorage(t *testing.T) {
+	params := BaseTable{}
+	params.Init()
+	storageType, storagePath := params.LoadStorage()
+	assert.Equal(t, StorageTypeMinio, storageType)
+	assert.NotEmpty(t, storagePath)
+
+	err := params.Save("storage.type", StorageTypeLocal)
+	assert.Nil(t, err)
+	err = params.Save("storage.path", "/tmp/milvus/storage")
+	assert.Nil(t, err)
+	storageType, storagePath = params.LoadStorage()
+	assert.Equal(t, StorageTypeLocal, storageType)
+	assert.Equal(t, "/tmp/milvus/storage", storagePath)
+
+	err = params.Save("storage.type", "unknown")
+	assert.Nil(t, err)
+	assert.Panics(t, func() { params.LoadStorage() })
+}
+
+func TestGlobalParamsTable_LoadEncryptionKeyFile(t *testing.T) {
+	params := BaseTable{}
+	params.Init()
+	assert.Empty(t, params.LoadEncryptionKeyFile())
+
+	err := params.Save("storage.encryptionKeyFile", "/tmp/milvus/keyfile")
+	assert.Nil(t, err)
+	assert.Equal(t, "/tmp/milvus/keyfile", params.LoadEncryptionKeyFile())
+}
+
+func TestGlobalParamsTable_LoadDownload(t *testing.T) {
+	params := BaseTable{}
+	params.Init()
+	parallelism, partSize, retryAttempts := params.LoadDownload()
+	assert.Equal(t, 16, parallelism)
+	assert.Equal(t, int64(64<<20), partSize)
+	assert.Equal(t, uint(5), retryAttempts)
+
+	err := params.Save("storage.download.parallelism", "4")
+	assert.Nil(t, err)
+	err = params.Save("storage.download.partSize", "8")
+	assert.Nil(t, err)
+	err = params.Save("storage.download.retryAttempts", "3")
+	assert.Nil(t, err)
+	parallelism, partSize, retryAttempts = params.LoadDownload()
+	assert.Equal(t, 4, parallelism)
+	assert.Equal(t, int64(8<<20), partSize)
+	assert.Equal(t, uint(3), retryAttempts)
+
+	err = params.Save("storage.download.retryAttempts", "0")
+	assert.Nil(t, err)
+	assert.Panics(t, func() { params.LoadDownload() })
+
+	err = params.Save("storage.download.retryAttempts", "3")
+	assert.Nil(t, err)
+	err = params.Save("storage.download.parallelism", "0")
+	assert.Nil(t, err)
+	assert.Panics(t, func() { params.LoadDownload() })
+}
+
+func TestGlobalParamsTable_LoadColdTier(t *testing.T) {
+	params := BaseTable{}
+	params.Init()
+	bucketName, rootPath := params.LoadColdTier()
+	assert.Empty(t, bucketName)
+	assert.Empty(t, rootPath)
+
+	err := params.Save("storage.coldTier.bucketName", "a-bucket-cold")
+	assert.Nil(t, err)
+	bucketName, _ = params.LoadColdTier()
+	assert.Equal(t, "a-bucket-cold", bucketName)
+}
@@ -34,12 +34,14 @@ const (
 // Session is a struct to store service's session, including ServerID, ServerName,
 // Address.
 // Exclusive indicates that this server can only start one.
+// Stopping indicates that this server is draining its work before shutdown.
 type Session struct {
 	ctx        context.Context
 	ServerID   int64  `json:"ServerID,omitempty"`
 	ServerName string `json:"ServerName,omitempty"`
 	Address    string `json:"Address,omitempty"`
 	Exclusive  bool   `json:"Exclusive,omitempty"`
+	Stopping   bool   `json:"Stopping,omitempty"`
 
 	etcdCli  *clientv3.Client
 	leaseID  clientv3.LeaseID
@@ -183,10 +185,7 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 			return err
 		}
 
-		key := s.ServerName
-		if !s.Exclusive {
-			key = key + "-" + strconv.FormatInt(s.ServerID, 10)
-		}
+		key := s.serviceKey()
 		txnResp, err := s.etcdCli.Txn(s.ctx).If(
 			clientv3.Compare(
 				clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
@@ -218,6 +217,31 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 	return ch, nil
 }
 
+// MarkStopping marks the service as stopping in its session. The session is kept alive
+// so that other services can still reach the server while it hands off its work.
+func (s *Session) MarkStopping() error {
+	s.Stopping = true
+	sessionJSON, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = s.etcdCli.Put(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, s.serviceKey()), string(sessionJSON), clientv3.WithLease(s.leaseID))
+	if err != nil {
+		log.Error("mark session stopping", zap.Error(err))
+		return err
+	}
+	log.Debug("Session marked stopping", zap.Int64("ServerID", s.ServerID))
+	return nil
+}
+
+func (s *Session) serviceKey() string {
+	key := s.ServerName
+	if !s.Exclusive {
+		key = key + "-" + strconv.FormatInt(s.ServerID, 10)
+	}
+	return key
+}
+
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
 func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) (failChannel <-chan bool) {
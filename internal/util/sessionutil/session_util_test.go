@@ -90,6 +90,39 @@ func TestInit(t *testing.T) {
 	assert.Contains(t, sessions, "inittest-"+strconv.FormatInt(s.ServerID, 10))
 }
 
+func TestMarkStopping(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	endpoints, err := Params.Load("_EtcdEndpoints")
+	if err != nil {
+		panic(err)
+	}
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+
+	etcdEndpoints := strings.Split(endpoints, ",")
+	etcdKV, err := etcdkv.NewEtcdKV(etcdEndpoints, metaRoot)
+	assert.NoError(t, err)
+	err = etcdKV.RemoveWithPrefix("")
+	assert.NoError(t, err)
+
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	s := NewSession(ctx, metaRoot, etcdEndpoints)
+	s.Init("stoppingtest", "testAddr", false)
+	key := "stoppingtest-" + strconv.FormatInt(s.ServerID, 10)
+	sessions, _, err := s.GetSessions("stoppingtest")
+	assert.Nil(t, err)
+	assert.False(t, sessions[key].Stopping)
+
+	err = s.MarkStopping()
+	assert.Nil(t, err)
+	sessions, _, err = s.GetSessions("stoppingtest")
+	assert.Nil(t, err)
+	assert.True(t, sessions[key].Stopping)
+}
+
 func TestUpdateSessions(t *testing.T) {
 	ctx := context.Background()
 	Params.Init()
@@ -134,6 +134,45 @@ func (helper *SchemaHelper) GetVectorDimFromID(filedID int64) (int, error) {
 	return 0, fmt.Errorf("fieldID(%d) not has dim", filedID)
 }
 
+// GetPartitionKeyField returns the field marked as partition key, nil if the schema doesn't have one
+func GetPartitionKeyField(schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, error) {
+	var keyField *schemapb.FieldSchema
+	for _, field := range schema.Fields {
+		if !field.IsPartitionKey {
+			continue
+		}
+		if keyField != nil {
+			return nil, errors.New("partition key is not unique")
+		}
+		if field.IsPrimaryKey {
+			return nil, fmt.Errorf("primary key field %s can't be the partition key", field.Name)
+		}
+		if !IsIntergerType(field.DataType) {
+			return nil, fmt.Errorf("partition key field %s should be integer type, got %s",
+				field.Name, schemapb.DataType_name[int32(field.DataType)])
+		}
+		keyField = field
+	}
+	return keyField, nil
+}
+
+// PartitionKeyPartitionName returns the name of the idx-th hidden partition of a partition key collection
+func PartitionKeyPartitionName(defaultPartitionName string, idx int) string {
+	return fmt.Sprintf("%s_%d", defaultPartitionName, idx)
+}
+
+// PartitionKeyIndex returns the index of the hidden partition which the partition key value is routed to
+func PartitionKeyIndex(value int64, numPartitions int) (int, error) {
+	if numPartitions <= 0 {
+		return 0, fmt.Errorf("invalid number of partitions %d", numPartitions)
+	}
+	h, err := Hash32Int64(value)
+	if err != nil {
+		return 0, err
+	}
+	return int(h % uint32(numPartitions)), nil
+}
+
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPartitionKeyField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64},
+		},
+	}
+	field, err := GetPartitionKeyField(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, field)
+
+	schema.Fields[1].IsPartitionKey = true
+	field, err = GetPartitionKeyField(schema)
+	assert.Nil(t, err)
+	assert.Equal(t, "tenant", field.Name)
+
+	schema.Fields[0].IsPartitionKey = true
+	_, err = GetPartitionKeyField(schema)
+	assert.NotNil(t, err)
+
+	schema.Fields[0].IsPartitionKey = false
+	schema.Fields[1].DataType = schemapb.DataType_Float
+	_, err = GetPartitionKeyField(schema)
+	assert.NotNil(t, err)
+}
+
+func TestPartitionKeyIndex(t *testing.T) {
+	_, err := PartitionKeyIndex(1, 0)
+	assert.NotNil(t, err)
+
+	for v := int64(-100); v < 100; v++ {
+		idx, err := PartitionKeyIndex(v, 16)
+		assert.Nil(t, err)
+		assert.True(t, idx >= 0 && idx < 16)
+		idx2, err := PartitionKeyIndex(v, 16)
+		assert.Nil(t, err)
+		assert.Equal(t, idx, idx2)
+	}
+	assert.Equal(t, "_default_3", PartitionKeyPartitionName("_default", 3))
+}